@@ -2,17 +2,21 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/qdm12/gluetun/internal/alpine"
+	"github.com/qdm12/gluetun/internal/balancer"
 	"github.com/qdm12/gluetun/internal/cli"
 	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/dns"
@@ -20,17 +24,25 @@ import (
 	"github.com/qdm12/gluetun/internal/healthcheck"
 	"github.com/qdm12/gluetun/internal/httpproxy"
 	gluetunLogging "github.com/qdm12/gluetun/internal/logging"
+	"github.com/qdm12/gluetun/internal/loopstate"
+	"github.com/qdm12/gluetun/internal/metrics"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/openvpn"
 	"github.com/qdm12/gluetun/internal/params"
+	"github.com/qdm12/gluetun/internal/provider"
 	"github.com/qdm12/gluetun/internal/publicip"
 	"github.com/qdm12/gluetun/internal/routing"
 	"github.com/qdm12/gluetun/internal/server"
 	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/gluetun/internal/shadowsocks"
+	"github.com/qdm12/gluetun/internal/sidecar"
 	"github.com/qdm12/gluetun/internal/storage"
+	"github.com/qdm12/gluetun/internal/telegram"
+	"github.com/qdm12/gluetun/internal/tracing"
+	"github.com/qdm12/gluetun/internal/transparentproxy"
 	"github.com/qdm12/gluetun/internal/updater"
 	versionpkg "github.com/qdm12/gluetun/internal/version"
+	"github.com/qdm12/gluetun/internal/wireguard"
 	"github.com/qdm12/golibs/command"
 	"github.com/qdm12/golibs/files"
 	"github.com/qdm12/golibs/logging"
@@ -64,19 +76,40 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		case "openvpnconfig":
 			err = cli.OpenvpnConfig()
 		case "update":
-			err = cli.Update(args[2:])
+			err = cli.Update(background, args[2:])
+		case "validate":
+			err = cli.Validate()
+		case "check":
+			err = cli.Check(background)
+		case "regions":
+			err = cli.Regions(args[2:])
+		case "rollback":
+			err = cli.Rollback(args[2:])
+		case "genkey":
+			err = cli.GenKey(background, args[2:])
 		default:
 			err = fmt.Errorf("command %q is unknown", args[1])
 		}
-		if err != nil {
+		var exitErr *cli.ExitCodeError
+		switch {
+		case err == nil:
+			return 0
+		case errors.As(err, &exitErr):
+			if exitErr.Err != nil {
+				fmt.Println(err)
+			}
+			return exitErr.Code
+		default:
 			fmt.Println(err)
 			return 1
 		}
-		return 0
 	}
 	ctx, cancel := context.WithCancel(background)
 	defer cancel()
-	logger := createLogger()
+	// secrets are masked in all logs, including OpenVPN and Unbound output
+	redactor := gluetunLogging.NewRedactor()
+	baseLogger, setLogFormat := gluetunLogging.NewLogger(os.Stdout, logging.InfoLevel)
+	logger := gluetunLogging.NewRedactingLogger(baseLogger, redactor)
 
 	const clientTimeout = 15 * time.Second
 	httpClient := &http.Client{Timeout: clientTimeout}
@@ -85,6 +118,7 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 	fileManager := files.NewFileManager()
 	alpineConf := alpine.NewConfigurator(fileManager)
 	ovpnConf := openvpn.NewConfigurator(logger, fileManager)
+	wireguardConf := wireguard.NewConfigurator(logger, fileManager)
 	dnsConf := dns.NewConfigurator(logger, client, fileManager)
 	routingConf := routing.NewRouting(logger)
 	firewallConf := firewall.NewConfigurator(logger, routingConf, fileManager)
@@ -94,9 +128,10 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 	fmt.Println(gluetunLogging.Splash(buildInfo))
 
 	printVersions(ctx, logger, map[string]func(ctx context.Context) (string, error){
-		"OpenVPN":  ovpnConf.Version,
-		"Unbound":  dnsConf.Version,
-		"IPtables": firewallConf.Version,
+		"OpenVPN":   ovpnConf.Version,
+		"Wireguard": wireguardConf.Version,
+		"Unbound":   dnsConf.Version,
+		"IPtables":  firewallConf.Version,
 	})
 
 	allSettings, err := settings.GetAllSettings(paramsReader)
@@ -104,8 +139,25 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		logger.Error(err)
 		return 1
 	}
+	redactor.AddSecrets(allSettings.Secrets()...)
+	setLogFormat(gluetunLogging.Format{
+		TimeLayout: allSettings.System.LogTimeLayout,
+		UTC:        allSettings.System.LogTimeUTC,
+		Color:      allSettings.System.LogColor,
+	})
 	logger.Info(allSettings.String())
 
+	if allSettings.OpenVPN.Scramble != "" {
+		supported, err := ovpnConf.SupportsScramble(ctx)
+		if err != nil {
+			logger.Error(err)
+			return 1
+		} else if !supported {
+			logger.Error("OPENVPN_SCRAMBLE is set but the OpenVPN binary is not built with the XOR scramble patch")
+			return 1
+		}
+	}
+
 	// TODO run this in a loop or in openvpn to reload from file without restarting
 	storage := storage.New(logger)
 	const updateServerFile = true
@@ -133,6 +185,12 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		firewallConf.SetDebug()
 		routingConf.SetDebug()
 	}
+	if allSettings.Firewall.DryRun {
+		// the dry run is only for the validate command, since running the
+		// VPN without the firewall rules would leak traffic outside the tunnel.
+		logger.Warn("firewall dry run is only used by the validate command, " +
+			"the firewall rules are applied")
+	}
 
 	defaultInterface, defaultGateway, err := routingConf.DefaultRoute()
 	if err != nil {
@@ -140,20 +198,40 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		return 1
 	}
 
-	localSubnet, err := routingConf.LocalSubnet()
+	localNetworks, err := routingConf.LocalNetworks(allSettings.Firewall.LocalInterfaces)
 	if err != nil {
 		logger.Error(err)
 		return 1
 	}
 
-	defaultIP, err := routingConf.DefaultIP()
+	firewallConf.SetNetworkInformation(defaultInterface, defaultGateway, localNetworks)
+
+	vpnInterface, err := routingConf.FreeInterfaceName(allSettings.VPNInterface())
 	if err != nil {
 		logger.Error(err)
 		return 1
+	} else if vpnInterface != allSettings.VPNInterface() {
+		logger.Warn("interface %s already exists, using %s as VPN interface instead",
+			allSettings.VPNInterface(), vpnInterface)
+		allSettings.OpenVPN.Interface = vpnInterface
+		allSettings.Wireguard.Interface = vpnInterface
+	}
+	routingConf.SetVPNInterface(vpnInterface)
+	firewallConf.SetVPNInterface(vpnInterface)
+
+	// the extra tunnels use the next free interface names
+	extraTunnels := make([]openvpn.Tunnel, len(allSettings.OpenVPN.ExtraTunnels))
+	extraInterface := vpnInterface
+	for i, profile := range allSettings.OpenVPN.ExtraTunnels {
+		extraInterface, err = routingConf.FreeInterfaceName(nextInterfaceName(extraInterface))
+		if err != nil {
+			logger.Error(err)
+			return 1
+		}
+		extraTunnels[i] = openvpn.Tunnel{Number: i + 1, Interface: extraInterface, Profile: profile}
 	}
 
-	firewallConf.SetNetworkInformation(defaultInterface, defaultGateway, localSubnet, defaultIP)
-
+	routingConf.SetMetric(allSettings.Firewall.RoutesMetric)
 	if err := routingConf.Setup(); err != nil {
 		logger.Error(err)
 		return 1
@@ -165,7 +243,14 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		}
 	}()
 
-	if err := firewallConf.SetOutboundSubnets(ctx, allSettings.Firewall.OutboundSubnets); err != nil {
+	// static routes destinations are allowed through the firewall
+	// but routed separately once the tunnel is up.
+	firewallOutboundSubnets := make([]net.IPNet, len(allSettings.Firewall.OutboundSubnets))
+	copy(firewallOutboundSubnets, allSettings.Firewall.OutboundSubnets)
+	for _, route := range allSettings.Firewall.StaticRoutes {
+		firewallOutboundSubnets = append(firewallOutboundSubnets, route.Destination)
+	}
+	if err := firewallConf.SetOutboundSubnets(ctx, firewallOutboundSubnets); err != nil {
 		logger.Error(err)
 		return 1
 	}
@@ -173,13 +258,42 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		logger.Error(err)
 		return 1
 	}
+	if err := firewallConf.SetVPNBypassSubnets(ctx, allSettings.Firewall.VPNBypassSubnets); err != nil {
+		logger.Error(err)
+		return 1
+	}
+	if err := firewallConf.SetOutboundPorts(ctx, allSettings.Firewall.OutboundPorts); err != nil {
+		logger.Error(err)
+		return 1
+	}
+	err = firewallConf.SetVPNBypassOwners(ctx, allSettings.Firewall.VPNBypassUIDs, allSettings.Firewall.VPNBypassGIDs)
+	if err != nil {
+		logger.Error(err)
+		return 1
+	}
+	err = firewallConf.SetVPNForcedOwners(ctx, allSettings.Firewall.VPNForcedUIDs, allSettings.Firewall.VPNForcedGIDs)
+	if err != nil {
+		logger.Error(err)
+		return 1
+	}
+	if err := firewallConf.SetMSSClamping(ctx, allSettings.Firewall.MSSClamping); err != nil {
+		logger.Error(err)
+		return 1
+	}
+	err = firewallConf.SetICMPEcho(ctx, allSettings.Firewall.InputICMPEcho, allSettings.Firewall.VPNInputICMPEcho)
+	if err != nil {
+		logger.Error(err)
+		return 1
+	}
 
-	if err := ovpnConf.CheckTUN(); err != nil {
-		logger.Warn(err)
-		err = ovpnConf.CreateTUN()
-		if err != nil {
-			logger.Error(err)
-			return 1
+	if allSettings.VPNType == constants.OpenVPN {
+		if err := ovpnConf.CheckTUN(); err != nil {
+			logger.Warn(err)
+			err = ovpnConf.CreateTUN()
+			if err != nil {
+				logger.Error(err)
+				return 1
+			}
 		}
 	}
 
@@ -189,6 +303,17 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 	defer close(tunnelReadyCh)
 	defer close(dnsReadyCh)
 
+	var ispIP net.IP
+	if allSettings.PublicIP.LeakCheck {
+		// the firewall is not enabled yet so this goes through the ISP
+		ispIP, err = publicip.NewIPGetter(client, allSettings.PublicIP.Methods).Get(ctx)
+		if err != nil {
+			logger.Error("cannot obtain ISP public IP address: %s", err)
+			return 1
+		}
+		logger.Info("ISP public IP address is %s", ispIP)
+	}
+
 	if allSettings.Firewall.Enabled {
 		err := firewallConf.SetEnabled(ctx, true) // disabled by default
 		if err != nil {
@@ -198,7 +323,7 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 	}
 
 	for _, vpnPort := range allSettings.Firewall.VPNInputPorts {
-		err = firewallConf.SetAllowedPort(ctx, vpnPort, string(constants.TUN))
+		err = firewallConf.SetAllowedPort(ctx, vpnPort, vpnInterface)
 		if err != nil {
 			logger.Error(err)
 			return 1
@@ -213,71 +338,343 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		}
 	} // TODO move inside firewall?
 
+	if allSettings.TransparentProxy.Enabled {
+		err = firewallConf.SetTransparentProxy(ctx,
+			allSettings.TransparentProxy.Port, allSettings.TransparentProxy.Subnets)
+		if err != nil {
+			logger.Error(err)
+			return 1
+		}
+		if err := routingConf.SetTransparentProxyRoutes(); err != nil {
+			logger.Error(err)
+			return 1
+		}
+	}
+
 	wg := &sync.WaitGroup{}
 
-	go collectStreamLines(ctx, streamMerger, logger, signalTunnelReady)
+	tracer := tracing.NewNoop()
+	if allSettings.Tracing.Endpoint != "" {
+		tracer = tracing.NewOTLP(httpClient, allSettings.Tracing.Endpoint,
+			allSettings.Tracing.ServiceName, logger)
+	}
+	wg.Add(1)
+	go tracer.Run(ctx, wg)
+
+	if allSettings.Firewall.RoutesProtection {
+		wg.Add(1)
+		go routingConf.Protect(ctx, wg, allSettings.Firewall.RoutesProtectionPeriod)
+	}
+
+	var profiles map[string]openvpn.Profile
+	if allSettings.OpenVPN.ProfilesFilepath != "" {
+		getProviderExtra := func(provider models.VPNProvider) (models.ExtraConfigOptions, error) {
+			return settings.GetProviderExtraConfig(paramsReader, provider)
+		}
+		profiles, err = openvpn.ReadProfiles(fileManager, allSettings.OpenVPN.ProfilesFilepath,
+			allSettings.OpenVPN, getProviderExtra)
+		if err != nil {
+			logger.Error(err)
+			return 1
+		}
+		for _, profile := range profiles {
+			redactor.AddSecrets(profile.User, profile.Password)
+		}
+		logger.Info("%d profiles loaded from %s", len(profiles), allSettings.OpenVPN.ProfilesFilepath)
+	}
+
+	var apiKeys []server.APIKey
+	if allSettings.ControlServer.AuthFilepath != "" {
+		apiKeys, err = server.ReadAPIKeys(fileManager, allSettings.ControlServer.AuthFilepath)
+		if err != nil {
+			logger.Error(err)
+			return 1
+		}
+		for _, apiKey := range apiKeys {
+			redactor.AddSecrets(apiKey.Key)
+		}
+		logger.Info("%d API keys loaded from %s", len(apiKeys), allSettings.ControlServer.AuthFilepath)
+	}
+
+	overridesFilepath := allSettings.System.OverridesFilepath
+	var overrides models.Overrides
+	var saveProfile func(name string) error
+	var saveComponentStatus func(component, status string) error
+	if overridesFilepath != "" {
+		overrides, err = storage.ReadOverrides(overridesFilepath)
+		if err != nil {
+			logger.Error(err)
+			return 1
+		}
+		applyComponentOverrides(&allSettings, overrides)
+		saveProfile = func(name string) error {
+			return storage.UpdateOverrides(overridesFilepath, func(overrides *models.Overrides) {
+				overrides.OpenVPNProfile = name
+			})
+		}
+		saveComponentStatus = func(component, status string) error {
+			return storage.UpdateOverrides(overridesFilepath, func(overrides *models.Overrides) {
+				overrides.SetComponentStatus(component, status)
+			})
+		}
+	}
+
+	loopStates := loopstate.NewRegistry()
+	openvpnLooper := openvpn.NewLooper(allSettings.OpenVPN, profiles, saveProfile, redactor.AddSecrets,
+		uid, gid, allServers, ovpnConf, firewallConf, routingConf, logger, loopStates.Reporter("openvpn"),
+		httpClient, fileManager, streamMerger, tracer, cancel)
+
+	if overrides.OpenVPNProfile != "" {
+		if err := openvpnLooper.SelectProfile(overrides.OpenVPNProfile); err != nil {
+			logger.Warn("cannot restore profile from %s: %s", overridesFilepath, err)
+		} else {
+			logger.Info("restored profile %s from %s", overrides.OpenVPNProfile, overridesFilepath)
+		}
+	}
+	// port forwarding is restored after the profile, which can change the provider.
+	openvpnProvider := openvpnLooper.GetSettings().Provider
+	portForwarding := overrides.ComponentRunning("portforwarding", openvpnProvider.PortForwarding.Enabled)
+	switch {
+	case portForwarding == openvpnProvider.PortForwarding.Enabled:
+	case portForwarding && (allSettings.VPNType == constants.Wireguard ||
+		openvpnProvider.Name != constants.PrivateInternetAccess):
+		logger.Warn("cannot restore port forwarding from %s: not supported for %s",
+			overridesFilepath, openvpnProvider.Name)
+	default:
+		openvpnLooper.SetPortForwarding(portForwarding)
+	}
+
+	tunnelLoopers := make([]openvpn.Looper, len(extraTunnels))
+	processTunnelEvents := make(map[string]func(event openvpn.Event), len(extraTunnels))
+	balancedTunnels := []balancer.Tunnel{{
+		Interface:           vpnInterface,
+		GetConnectionStatus: openvpnLooper.GetConnectionStatus,
+	}}
+	for i, tunnel := range extraTunnels {
+		tunnelConf := openvpn.NewTunnelConfigurator(logger, fileManager, tunnel)
+		tunnelLoopers[i], err = openvpn.NewTunnelLooper(tunnel, allSettings.OpenVPN, profiles,
+			redactor.AddSecrets, uid, gid, allServers, tunnelConf, firewallConf, routingConf, logger,
+			loopStates.Reporter("openvpn/"+tunnel.Interface), httpClient, fileManager, streamMerger, tracer, cancel)
+		if err != nil {
+			logger.Error(err)
+			return 1
+		}
+		processTunnelEvents["openvpn "+tunnel.Interface] = tunnelLoopers[i].ProcessEvent
+		balancedTunnels = append(balancedTunnels, balancer.Tunnel{
+			Interface:           tunnel.Interface,
+			GetConnectionStatus: tunnelLoopers[i].GetConnectionStatus,
+		})
+	}
 
-	openvpnLooper := openvpn.NewLooper(allSettings.VPNSP, allSettings.OpenVPN, uid, gid, allServers,
-		ovpnConf, firewallConf, routingConf, logger, httpClient, fileManager, streamMerger, cancel)
+	go collectStreamLines(ctx, streamMerger, logger, allSettings.System.LogFilter,
+		signalTunnelReady, openvpnLooper.ProcessEvent, processTunnelEvents)
 	wg.Add(1)
 	// wait for restartOpenvpn
 	go openvpnLooper.Run(ctx, wg)
+	for _, tunnelLooper := range tunnelLoopers {
+		wg.Add(1)
+		// wait for the main tunnel to be started
+		go tunnelLooper.Run(ctx, wg)
+	}
+	if len(tunnelLoopers) > 0 {
+		wg.Add(1)
+		go balancer.New(balancedTunnels, firewallConf, routingConf, logger).Run(ctx, wg)
+	}
+
+	wireguardLooper := wireguard.NewLooper(allSettings.Wireguard, allServers,
+		wireguardConf, firewallConf, routingConf, logger, loopStates.Reporter("wireguard"),
+		signalTunnelReady, cancel)
+	wg.Add(1)
+	// wait for the first restart if Wireguard is used
+	go wireguardLooper.Run(ctx, wg)
+
+	// the connection status and restart of the VPN type used,
+	// for the health checks, metrics, public IP and Telegram bot.
+	getVPNStatus, restartVPN := openvpnLooper.GetConnectionStatus, openvpnLooper.Restart
+	if allSettings.VPNType == constants.Wireguard {
+		getVPNStatus, restartVPN = wireguardLooper.GetConnectionStatus, wireguardLooper.Restart
+	}
+	setAllServers := func(allServers models.AllServers) {
+		openvpnLooper.SetAllServers(allServers)
+		for _, tunnelLooper := range tunnelLoopers {
+			tunnelLooper.SetAllServers(allServers)
+		}
+		wireguardLooper.SetAllServers(allServers)
+	}
 
 	updaterOptions := updater.NewOptions("127.0.0.1")
 	updaterLooper := updater.NewLooper(updaterOptions, allSettings.UpdaterPeriod,
-		allServers, storage, openvpnLooper.SetAllServers, httpClient, logger)
+		allServers, storage, setAllServers, httpClient, logger, loopStates.Reporter("updater"), tracer)
 	wg.Add(1)
 	// wait for updaterLooper.Restart() or its ticket launched with RunRestartTicker
 	go updaterLooper.Run(ctx, wg)
 
-	unboundLooper := dns.NewLooper(dnsConf, allSettings.DNS, logger, streamMerger, uid, gid)
+	unboundLooper := dns.NewLooper(dnsConf, allSettings.DNS, logger, loopStates.Reporter("dns"),
+		streamMerger, tracer, uid, gid)
 	wg.Add(1)
 	// wait for unboundLooper.Restart or its ticker launched with RunRestartTicker
 	go unboundLooper.Run(ctx, wg, signalDNSReady)
 
-	publicIPLooper := publicip.NewLooper(client, logger, fileManager,
-		allSettings.System.IPStatusFilepath, allSettings.PublicIPPeriod, uid, gid)
+	httpProxyLooper := httpproxy.NewLooper(logger, loopStates.Reporter("httpproxy"), allSettings.HTTPProxy)
+	shadowsocksLoopers := shadowsocks.NewLoopers(allSettings.ShadowSocks, logger, loopStates, defaultInterface)
+	var onLeak func()
+	if allSettings.PublicIP.LeakStopProxies {
+		onLeak = func() {
+			logger.Warn("stopping proxies because of the public IP leak")
+			httpProxyLooper.Stop()
+			for _, shadowsocksLooper := range shadowsocksLoopers {
+				shadowsocksLooper.Stop()
+			}
+		}
+	}
+
+	getVPNServer := func() (provider models.VPNProvider, server *models.OpenVPNConnection) {
+		status := getVPNStatus()
+		return status.Provider, status.Server
+	}
+	var getEgressIPs func() (ips []net.IP)
+	if allSettings.PublicIP.EgressCheck {
+		getEgressIPs = func() (ips []net.IP) {
+			vpnProvider, server := getVPNServer()
+			if server != nil {
+				ips = append(ips, server.IP)
+			}
+			providerIPs, _ := provider.GetIPs(vpnProvider, openvpnLooper.GetAllServers())
+			return append(ips, providerIPs...)
+		}
+	}
+	publicIPLooper := publicip.NewLooper(client, logger, fileManager, loopStates.Reporter("publicip"),
+		allSettings.PublicIP, vpnInterface,
+		allSettings.System.IPStatusFilepath, uid, gid, ispIP, onLeak, getEgressIPs, getVPNServer)
 	wg.Add(1)
 	go publicIPLooper.Run(ctx, wg)
 	wg.Add(1)
 	go publicIPLooper.RunRestartTicker(ctx, wg)
-	publicIPLooper.SetPeriod(allSettings.PublicIPPeriod) // call after RunRestartTicker
+	publicIPLooper.SetPeriod(allSettings.PublicIP.Period) // call after RunRestartTicker
 
-	httpProxyLooper := httpproxy.NewLooper(logger, allSettings.HTTPProxy)
 	wg.Add(1)
 	go httpProxyLooper.Run(ctx, wg)
 
-	shadowsocksLooper := shadowsocks.NewLooper(allSettings.ShadowSocks, logger, defaultInterface)
-	restartShadowsocks := shadowsocksLooper.Restart
-	wg.Add(1)
-	go shadowsocksLooper.Run(ctx, wg)
+	for _, shadowsocksLooper := range shadowsocksLoopers {
+		wg.Add(1)
+		go shadowsocksLooper.Run(ctx, wg)
+	}
 
 	if allSettings.HTTPProxy.Enabled {
 		httpProxyLooper.Restart()
 	}
-	if allSettings.ShadowSocks.Enabled {
-		restartShadowsocks()
+	for name, shadowsocksLooper := range shadowsocksLoopers {
+		switch {
+		case overrides.ComponentRunning("shadowsocks/"+name, allSettings.ShadowSocks.Enabled):
+			shadowsocksLooper.Restart()
+		case shadowsocksLooper.GetSettings().Enabled: // listener stopped through the control server
+			listenerSettings := shadowsocksLooper.GetSettings()
+			listenerSettings.Enabled = false
+			shadowsocksLooper.SetSettings(listenerSettings)
+		}
 	}
 
+	portForwardingEnabled := func() bool {
+		return openvpnLooper.GetSettings().Provider.PortForwarding.Enabled
+	}
+	// routes through the tunnel in route-nopull mode are set with
+	// the static routes each time the tunnel is up.
+	staticRoutes := append([]models.StaticRoute{}, allSettings.Firewall.StaticRoutes...)
+	switch {
+	case allSettings.VPNType == constants.Wireguard && allSettings.OpenVPN.RouteNoPull:
+		logger.Warn("OPENVPN_ROUTE_NOPULL and OPENVPN_ROUTES are ignored with Wireguard, " +
+			"all the traffic goes through the tunnel")
+	case allSettings.VPNType != constants.Wireguard:
+		for _, subnet := range allSettings.OpenVPN.Routes {
+			staticRoutes = append(staticRoutes, models.StaticRoute{Destination: subnet, Interface: vpnInterface})
+		}
+	}
 	wg.Add(1)
 	go routeReadyEvents(ctx, wg, tunnelReadyCh, dnsReadyCh,
 		unboundLooper, updaterLooper, publicIPLooper, routingConf, logger, httpClient,
-		allSettings.VersionInformation, allSettings.OpenVPN.Provider.PortForwarding.Enabled, openvpnLooper.PortForward,
+		allSettings.VersionInformation, portForwardingEnabled, openvpnLooper.PortForward,
+		staticRoutes, allSettings.Firewall.ConntrackFlush,
 	)
-	controlServerAddress := fmt.Sprintf("0.0.0.0:%d", allSettings.ControlServer.Port)
 	controlServerLogging := allSettings.ControlServer.Log
-	httpServer := server.New(controlServerAddress, controlServerLogging,
-		logger, buildInfo, openvpnLooper, unboundLooper, updaterLooper)
+	var restartDNS func()
+	if allSettings.Health.DNSRestart && allSettings.DNS.Enabled {
+		restartDNS = unboundLooper.Restart
+	}
+	dnsServer := allSettings.DNS.PlaintextAddress
+	if allSettings.DNS.Enabled {
+		dnsServer = net.IP{127, 0, 0, 1}
+	}
+	healthMonitor := healthcheck.NewMonitor(allSettings.Health, dnsServer, getVPNStatus,
+		restartDNS, logger)
+	wg.Add(1)
+	go healthMonitor.Run(ctx, wg)
+
+	metricsInterfaces := []string{vpnInterface}
+	for _, network := range localNetworks {
+		metricsInterfaces = append(metricsInterfaces, network.InterfaceName)
+	}
+	metricsCollector := metrics.NewCollector(metricsInterfaces,
+		fileManager, getVPNStatus, healthMonitor.Probes, unboundLooper.GetBlockStats, logger)
+	wg.Add(1)
+	go metricsCollector.Run(ctx, wg)
+	healthHandler := healthcheck.NewHandler(logger, getVPNStatus, publicIPLooper, unboundLooper, healthMonitor)
+	httpServer := server.New(allSettings.ControlServer.Listeners(), controlServerLogging,
+		logger, buildInfo, allSettings.VPNType, openvpnLooper, wireguardLooper, unboundLooper, updaterLooper,
+		httpProxyLooper, shadowsocksLoopers, publicIPLooper, loopStates, firewallConf, metricsCollector,
+		healthHandler, apiKeys, saveComponentStatus)
 	wg.Add(1)
 	go httpServer.Run(ctx, wg)
 
-	healthcheckServer := healthcheck.NewServer(
-		constants.HealthcheckAddress, logger)
+	if allSettings.TransparentProxy.Enabled {
+		transparentProxyServer := transparentproxy.NewServer(
+			fmt.Sprintf("0.0.0.0:%d", allSettings.TransparentProxy.Port), logger)
+		wg.Add(1)
+		go transparentProxyServer.Run(ctx, wg)
+	}
+
+	healthcheckServer := healthcheck.NewServer(constants.HealthcheckAddress, logger, healthHandler)
 	wg.Add(1)
 	go healthcheckServer.Run(ctx, wg)
 
-	// Start openvpn for the first time
-	openvpnLooper.Restart()
+	if allSettings.Telegram.Token != "" {
+		// longer than the bot long polling timeout
+		const telegramClientTimeout = time.Minute
+		telegramBot := telegram.NewBot(allSettings.Telegram, &http.Client{Timeout: telegramClientTimeout},
+			getVPNStatus, publicIPLooper.GetStatus, restartVPN, logger)
+		wg.Add(1)
+		go telegramBot.Run(ctx, wg)
+	}
+
+	sidecarExitCh := make(chan int, 1)
+	if allSettings.Sidecar.Kind != "" {
+		sidecarWatcher := sidecar.NewWatcher(allSettings.Sidecar, fileManager, httpClient, logger)
+		go func() {
+			exitCode, err := sidecarWatcher.Wait(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logger.Error(err)
+				}
+				return
+			}
+			sidecarExitCh <- exitCode
+		}()
+	}
+
+	// Start the VPN for the first time, unless it was stopped through the control server
+	vpnComponent := "openvpn"
+	stopVPN := openvpnLooper.Stop
+	if allSettings.VPNType == constants.Wireguard {
+		vpnComponent, stopVPN = "wireguard", wireguardLooper.Stop
+	}
+	if overrides.ComponentRunning(vpnComponent, true) {
+		restartVPN()
+		for _, tunnelLooper := range tunnelLoopers {
+			tunnelLooper.Start()
+		}
+	} else {
+		logger.Info("%s stopped as restored from %s", vpnComponent, overridesFilepath)
+		stopVPN()
+	}
 
 	signalsCh := make(chan os.Signal, 1)
 	signal.Notify(signalsCh,
@@ -286,10 +683,14 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		os.Interrupt,
 	)
 	shutdownErrorsCount := 0
+	exitCode := 0
 	select {
 	case signal := <-signalsCh:
 		logger.Warn("Caught OS signal %s, shutting down", signal)
 		cancel()
+	case exitCode = <-sidecarExitCh:
+		logger.Warn("Sidecar target exited, shutting down")
+		cancel()
 	case <-ctx.Done():
 		logger.Warn("context canceled, shutting down")
 	}
@@ -298,9 +699,10 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		logger.Error(err)
 		shutdownErrorsCount++
 	}
-	if allSettings.OpenVPN.Provider.PortForwarding.Enabled {
-		logger.Info("Clearing forwarded port status file %s", allSettings.OpenVPN.Provider.PortForwarding.Filepath)
-		if err := fileManager.Remove(string(allSettings.OpenVPN.Provider.PortForwarding.Filepath)); err != nil {
+	// port forwarding can be enabled at runtime through the control server
+	if portForwarding := openvpnLooper.GetSettings().Provider.PortForwarding; portForwarding.Enabled {
+		logger.Info("Clearing forwarded port status file %s", portForwarding.Filepath)
+		if err := fileManager.Remove(string(portForwarding.Filepath)); err != nil {
 			logger.Error(err)
 			shutdownErrorsCount++
 		}
@@ -320,19 +722,11 @@ func _main(background context.Context, args []string) int { //nolint:gocognit,go
 		return 1
 	}
 	if shutdownErrorsCount > 0 {
-		logger.Warn("Shutdown had %d errors")
+		logger.Warn("Shutdown had %d errors", shutdownErrorsCount)
 		return 1
 	}
 	logger.Info("Shutdown successful")
-	return 0
-}
-
-func createLogger() logging.Logger {
-	logger, err := logging.NewLogger(logging.ConsoleEncoding, logging.InfoLevel)
-	if err != nil {
-		panic(err)
-	}
-	return logger
+	return exitCode
 }
 
 func printVersions(ctx context.Context, logger logging.Logger,
@@ -352,10 +746,24 @@ func printVersions(ctx context.Context, logger logging.Logger,
 
 //nolint:lll
 func collectStreamLines(ctx context.Context, streamMerger command.StreamMerger,
-	logger logging.Logger, signalTunnelReady func()) {
+	logger logging.Logger, filter *regexp.Regexp,
+	signalTunnelReady func(), processOpenVPNEvent func(event openvpn.Event),
+	processTunnelEvents map[string]func(event openvpn.Event)) {
 	// Blocking line merging paramsReader for openvpn and unbound
 	logger.Info("Launching standard output merger")
 	streamMerger.CollectLines(ctx, func(line string) {
+		event, ok := openvpn.ParseLine(line)
+		if ok {
+			processOpenVPNEvent(event)
+		}
+		for name, processTunnelEvent := range processTunnelEvents {
+			if tunnelEvent, ok := openvpn.ParseTunnelLine(line, name); ok {
+				processTunnelEvent(tunnelEvent)
+			}
+		}
+		if filter != nil && filter.MatchString(line) {
+			return
+		}
 		line, level := gluetunLogging.PostProcessLine(line)
 		if line == "" {
 			return
@@ -371,7 +779,7 @@ func collectStreamLines(ctx context.Context, streamMerger command.StreamMerger,
 			logger.Error(line)
 		}
 		switch {
-		case strings.Contains(line, "Initialization Sequence Completed"):
+		case ok && event.Kind == openvpn.EventConnected: // only for the main tunnel
 			signalTunnelReady()
 		case strings.Contains(line, "TLS Error: TLS key negotiation failed to occur within 60 seconds (check your network connectivity)"):
 			logger.Warn("This means that either...")
@@ -385,11 +793,32 @@ func collectStreamLines(ctx context.Context, streamMerger command.StreamMerger,
 	})
 }
 
+// nextInterfaceName returns the interface name following the name given,
+// such as tun1 for tun0.
+func nextInterfaceName(name string) string {
+	prefix := strings.TrimRight(name, "0123456789")
+	number := -1
+	if prefix != name {
+		number, _ = strconv.Atoi(name[len(prefix):])
+	}
+	return prefix + strconv.Itoa(number+1)
+}
+
+// applyComponentOverrides enables or disables the components
+// according to their statuses restored from the overrides file.
+func applyComponentOverrides(allSettings *settings.Settings, overrides models.Overrides) {
+	allSettings.DNS.Enabled = overrides.ComponentRunning("dns", allSettings.DNS.Enabled)
+	allSettings.HTTPProxy.Enabled = overrides.ComponentRunning("httpproxy", allSettings.HTTPProxy.Enabled)
+	allSettings.ShadowSocks.Enabled = overrides.ComponentRunning("shadowsocks", allSettings.ShadowSocks.Enabled)
+}
+
 func routeReadyEvents(ctx context.Context, wg *sync.WaitGroup, tunnelReadyCh, dnsReadyCh <-chan struct{},
 	unboundLooper dns.Looper, updaterLooper updater.Looper, publicIPLooper publicip.Looper,
 	routing routing.Routing, logger logging.Logger, httpClient *http.Client,
-	versionInformation, portForwardingEnabled bool, startPortForward func(vpnGateway net.IP)) {
+	versionInformation bool, portForwardingEnabled func() bool, startPortForward func(vpnGateway net.IP),
+	staticRoutes []models.StaticRoute, conntrackFlush bool) {
 	defer wg.Done()
+	var previousVPNLocalIP net.IP
 	tickerWg := &sync.WaitGroup{}
 	// for linters only
 	var restartTickerContext context.Context
@@ -400,7 +829,10 @@ func routeReadyEvents(ctx context.Context, wg *sync.WaitGroup, tunnelReadyCh, dn
 			restartTickerCancel() // for linters only
 			tickerWg.Wait()
 			return
-		case <-tunnelReadyCh: // blocks until openvpn is connected
+		case <-tunnelReadyCh: // blocks until OpenVPN or Wireguard is connected
+			if conntrackFlush {
+				previousVPNLocalIP = flushPreviousConntrack(routing, logger, previousVPNLocalIP)
+			}
 			unboundLooper.Restart()
 			restartTickerCancel() // stop previous restart tickers
 			tickerWg.Wait()
@@ -414,7 +846,13 @@ func routeReadyEvents(ctx context.Context, wg *sync.WaitGroup, tunnelReadyCh, dn
 			} else {
 				logger.Info("VPN routing IP address: %s", vpnDestination)
 			}
-			if portForwardingEnabled {
+			if err := routing.SetStaticRoutes(staticRoutes); err != nil {
+				logger.Error(err)
+			}
+			if err := routing.ProtectVPNRoutes(); err != nil {
+				logger.Warn(err)
+			}
+			if portForwardingEnabled() {
 				// vpnGateway required only for PIA
 				vpnGateway, err := routing.VPNLocalGatewayIP()
 				if err != nil {
@@ -437,3 +875,24 @@ func routeReadyEvents(ctx context.Context, wg *sync.WaitGroup, tunnelReadyCh, dn
 		}
 	}
 }
+
+// flushPreviousConntrack deletes the conntrack entries of the flows from the
+// IP address of the previous VPN connection, if any, and returns the IP address
+// of the current VPN connection.
+func flushPreviousConntrack(routing routing.Routing, logger logging.Logger,
+	previousVPNLocalIP net.IP) (vpnLocalIP net.IP) {
+	vpnLocalIP, err := routing.VPNLocalIP()
+	if err != nil {
+		logger.Warn(err)
+	}
+	if previousVPNLocalIP == nil {
+		return vpnLocalIP
+	}
+	flushed, err := routing.FlushConntrack(previousVPNLocalIP)
+	if err != nil {
+		logger.Warn(err)
+	} else if flushed > 0 {
+		logger.Info("flushed %d conntrack entries of the previous VPN connection", flushed)
+	}
+	return vpnLocalIP
+}
@@ -10,5 +10,6 @@ require (
 	github.com/qdm12/ss-server v0.1.0
 	github.com/stretchr/testify v1.6.1
 	github.com/vishvananda/netlink v1.1.0
+	golang.org/x/crypto v0.0.0-20200728195943-123391ffb6de
 	golang.org/x/sys v0.0.0-20201018121011-98379d014ca7
 )
@@ -0,0 +1,219 @@
+// Package balancer balances the connections across several VPN tunnels,
+// removing the tunnels found unhealthy from the balancing.
+package balancer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/routing"
+	"github.com/qdm12/golibs/logging"
+)
+
+const (
+	checkPeriod  = 10 * time.Second
+	probeTimeout = 5 * time.Second
+	// probeAddress is reached through each tunnel to check it.
+	probeAddress = "1.1.1.1:443"
+	// failureThreshold is the number of consecutive failed probes
+	// after which a connected tunnel is removed from the balancing.
+	failureThreshold = 2
+)
+
+// Balancer balances the new connections across the healthy tunnels.
+type Balancer interface {
+	Run(ctx context.Context, wg *sync.WaitGroup)
+}
+
+// Tunnel is a VPN tunnel the connections are balanced across.
+type Tunnel struct {
+	Interface string
+	// GetConnectionStatus returns the OpenVPN connection status of the tunnel.
+	GetConnectionStatus func() openvpn.ConnectionStatus
+}
+
+type tunnel struct {
+	Tunnel
+	mark    int
+	healthy bool
+	// failures is the number of consecutive failed probes.
+	failures int
+	// routedSince is the time since which the tunnel is connected when its
+	// routes were set, since they are removed with the tunnel interface.
+	routedSince time.Time
+}
+
+type balancer struct {
+	tunnels            []*tunnel
+	balanced           []firewall.BalancedTunnel
+	setBalancedTunnels func(ctx context.Context, tunnels []firewall.BalancedTunnel) error
+	setTunnelRoutes    func(intf string, mark int) error
+	removeTunnelRoutes func(mark int) error
+	probe              func(ctx context.Context, mark int) error
+	logger             logging.Logger
+}
+
+// New returns a balancer for the tunnels given, the first one being the main
+// tunnel. Each tunnel is marked with constants.TunnelMark plus its index.
+func New(tunnels []Tunnel, fw firewall.Configurator, routing routing.Routing,
+	logger logging.Logger) Balancer {
+	b := &balancer{
+		tunnels:            make([]*tunnel, len(tunnels)),
+		setBalancedTunnels: fw.SetBalancedTunnels,
+		setTunnelRoutes:    routing.SetTunnelRoutes,
+		removeTunnelRoutes: routing.RemoveTunnelRoutes,
+		probe:              probeTunnel,
+		logger:             logger.WithPrefix("balancer: "),
+	}
+	for i, t := range tunnels {
+		b.tunnels[i] = &tunnel{Tunnel: t, mark: constants.TunnelMark + i}
+	}
+	return b
+}
+
+func (b *balancer) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(checkPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.update(ctx)
+		case <-ctx.Done():
+			b.tearDown()
+			return
+		}
+	}
+}
+
+// update checks each tunnel and balances the connections
+// across the healthy tunnels if they changed.
+func (b *balancer) update(ctx context.Context) {
+	for _, t := range b.tunnels {
+		err := b.check(ctx, t)
+		if ctx.Err() != nil {
+			return
+		}
+		switch {
+		case err == nil && !t.healthy:
+			t.healthy = true
+			b.logger.Info("tunnel %s is balanced", t.Interface)
+		case err != nil && t.healthy && t.failures >= failureThreshold:
+			t.healthy = false
+			b.logger.Warn("tunnel %s is no longer balanced: %s", t.Interface, err)
+		}
+	}
+	balanced := balancedTunnels(b.tunnels)
+	if equalTunnels(balanced, b.balanced) {
+		return
+	}
+	if err := b.setBalancedTunnels(ctx, balanced); err != nil {
+		b.logger.Error(err)
+		return
+	}
+	b.balanced = balanced
+	if len(balanced) == 0 {
+		b.logger.Info("connections are no longer balanced")
+		return
+	}
+	names := make([]string, len(balanced))
+	for i := range balanced {
+		names[i] = balanced[i].Interface
+	}
+	b.logger.Info("balancing connections across %s", strings.Join(names, ", "))
+}
+
+// check returns an error if the tunnel is not connected, setting its routes
+// if needed, or if the tunnel probe fails.
+func (b *balancer) check(ctx context.Context, t *tunnel) error {
+	status := t.GetConnectionStatus()
+	if status.State != openvpn.StateConnected {
+		t.failures = failureThreshold // no need to wait for probes to fail
+		return fmt.Errorf("OpenVPN is %s", status.State)
+	}
+	if !t.routedSince.Equal(status.Since) {
+		if err := b.setTunnelRoutes(t.Interface, t.mark); err != nil {
+			t.failures = failureThreshold
+			return err
+		}
+		t.routedSince = status.Since
+	}
+	probeCtx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	if err := b.probe(probeCtx, t.mark); err != nil {
+		t.failures++
+		return fmt.Errorf("cannot reach %s: %w", probeAddress, err)
+	}
+	t.failures = 0
+	return nil
+}
+
+// balancedTunnels returns the healthy tunnels to balance the connections to,
+// which are none if the main tunnel is the only healthy tunnel.
+func balancedTunnels(tunnels []*tunnel) (balanced []firewall.BalancedTunnel) {
+	for _, t := range tunnels {
+		if t.healthy {
+			balanced = append(balanced, firewall.BalancedTunnel{Interface: t.Interface, Mark: t.mark})
+		}
+	}
+	if len(balanced) == 1 && balanced[0].Mark == constants.TunnelMark {
+		return nil
+	}
+	return balanced
+}
+
+func equalTunnels(a, b []firewall.BalancedTunnel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// tearDown stops balancing the connections and removes the tunnel routes.
+func (b *balancer) tearDown() {
+	if err := b.setBalancedTunnels(context.Background(), nil); err != nil {
+		b.logger.Error(err)
+	}
+	for _, t := range b.tunnels {
+		if err := b.removeTunnelRoutes(t.mark); err != nil {
+			b.logger.Error(err)
+		}
+	}
+}
+
+// probeTunnel connects to the probe address with the tunnel mark
+// given, so the connection goes through its tunnel.
+func probeTunnel(ctx context.Context, mark int) error {
+	dialer := net.Dialer{
+		Control: func(network, address string, rawConn syscall.RawConn) error {
+			var markErr error
+			err := rawConn.Control(func(fd uintptr) {
+				markErr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_MARK, mark)
+			})
+			if err != nil {
+				return err
+			} else if markErr != nil {
+				return fmt.Errorf("cannot set mark %d: %w", mark, markErr)
+			}
+			return nil
+		},
+	}
+	connection, err := dialer.DialContext(ctx, "tcp", probeAddress)
+	if err != nil {
+		return err
+	}
+	return connection.Close()
+}
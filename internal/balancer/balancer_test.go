@@ -0,0 +1,121 @@
+package balancer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_balancer_update(t *testing.T) {
+	t.Parallel()
+	since := time.Unix(1000, 0)
+	connected := func() openvpn.ConnectionStatus {
+		return openvpn.ConnectionStatus{State: openvpn.StateConnected, Since: since}
+	}
+	disconnected := func() openvpn.ConnectionStatus {
+		return openvpn.ConnectionStatus{State: openvpn.StateDisconnected}
+	}
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	var balanced []firewall.BalancedTunnel
+	var routed []string
+	failingMarks := map[int]bool{}
+	b := &balancer{
+		tunnels: []*tunnel{
+			{Tunnel: Tunnel{Interface: "tun0", GetConnectionStatus: connected}, mark: 29952},
+			{Tunnel: Tunnel{Interface: "tun1", GetConnectionStatus: connected}, mark: 29953},
+			{Tunnel: Tunnel{Interface: "tun2", GetConnectionStatus: disconnected}, mark: 29954},
+		},
+		setBalancedTunnels: func(ctx context.Context, tunnels []firewall.BalancedTunnel) error {
+			balanced = tunnels
+			return nil
+		},
+		setTunnelRoutes: func(intf string, mark int) error {
+			routed = append(routed, intf)
+			return nil
+		},
+		probe: func(ctx context.Context, mark int) error {
+			if failingMarks[mark] {
+				return errors.New("timeout")
+			}
+			return nil
+		},
+		logger: logger,
+	}
+	ctx := context.Background()
+
+	b.update(ctx)
+
+	assert.Equal(t, []firewall.BalancedTunnel{
+		{Interface: "tun0", Mark: 29952},
+		{Interface: "tun1", Mark: 29953},
+	}, balanced)
+	assert.Equal(t, []string{"tun0", "tun1"}, routed)
+
+	// a failed probe does not remove a tunnel at once
+	failingMarks[29953] = true
+	b.update(ctx)
+	assert.Len(t, balanced, 2)
+
+	// the tunnel is removed after consecutive failed probes,
+	// and the main tunnel alone is not balanced
+	b.update(ctx)
+	assert.Empty(t, balanced)
+	assert.Equal(t, []string{"tun0", "tun1"}, routed)
+
+	// the tunnel is balanced again once it is healthy
+	delete(failingMarks, 29953)
+	b.update(ctx)
+	assert.Len(t, balanced, 2)
+}
+
+func Test_balancedTunnels(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		tunnels  []*tunnel
+		balanced []firewall.BalancedTunnel
+	}{
+		"no healthy tunnel": {
+			tunnels: []*tunnel{{Tunnel: Tunnel{Interface: "tun0"}, mark: 29952}},
+		},
+		"main tunnel only": {
+			tunnels: []*tunnel{
+				{Tunnel: Tunnel{Interface: "tun0"}, mark: 29952, healthy: true},
+				{Tunnel: Tunnel{Interface: "tun1"}, mark: 29953},
+			},
+		},
+		"extra tunnel only": {
+			tunnels: []*tunnel{
+				{Tunnel: Tunnel{Interface: "tun0"}, mark: 29952},
+				{Tunnel: Tunnel{Interface: "tun1"}, mark: 29953, healthy: true},
+			},
+			balanced: []firewall.BalancedTunnel{{Interface: "tun1", Mark: 29953}},
+		},
+		"healthy tunnels": {
+			tunnels: []*tunnel{
+				{Tunnel: Tunnel{Interface: "tun0"}, mark: 29952, healthy: true},
+				{Tunnel: Tunnel{Interface: "tun1"}, mark: 29953},
+				{Tunnel: Tunnel{Interface: "tun2"}, mark: 29954, healthy: true},
+			},
+			balanced: []firewall.BalancedTunnel{
+				{Interface: "tun0", Mark: 29952},
+				{Interface: "tun2", Mark: 29954},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			balanced := balancedTunnels(testCase.tunnels)
+			assert.Equal(t, testCase.balanced, balanced)
+		})
+	}
+}
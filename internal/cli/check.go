@@ -0,0 +1,289 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/params"
+	"github.com/qdm12/gluetun/internal/publicip"
+	"github.com/qdm12/gluetun/internal/routing"
+	"github.com/qdm12/gluetun/internal/server"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/files"
+	"github.com/qdm12/golibs/logging"
+)
+
+// checkAddress is the address dialed to check the Internet is reachable.
+const checkAddress = "1.1.1.1:443"
+
+var errBindDevice = errors.New("cannot bind to network interface")
+
+// Check runs connectivity and leak tests against the running gluetun,
+// for example with docker exec, and prints a pass or fail report.
+func Check(ctx context.Context) error {
+	logger, err := newLogger(logging.ErrorLevel)
+	if err != nil {
+		return err
+	}
+	fileManager := files.NewFileManager()
+	report := &validationReport{kind: "check"}
+
+	paramsReader := params.NewReader(logger, fileManager)
+	allSettings, err := settings.GetAllSettings(paramsReader)
+	report.add("settings", err)
+	if err != nil {
+		return report.finish()
+	}
+
+	const timeout = 5 * time.Second
+	report.add("tunnel reachability", checkTunnel(ctx, allSettings.VPNInterface(), timeout))
+	report.add("DNS leak", checkDNS(ctx, fileManager, allSettings.DNS))
+	report.add("kill switch", checkKillSwitch(ctx, logger, timeout))
+
+	apiKey, err := readAPIKey(fileManager, allSettings.ControlServer.AuthFilepath)
+	if err != nil {
+		report.add("control server API key", err)
+		return report.finish()
+	}
+	host := "127.0.0.1"
+	if address := allSettings.ControlServer.ListeningAddress; address != nil && !address.IsUnspecified() {
+		host = address.String()
+	}
+	client := &controlClient{
+		client: &http.Client{Timeout: timeout},
+		url:    fmt.Sprintf("http://%s/v1", net.JoinHostPort(host, fmt.Sprint(allSettings.ControlServer.Port))),
+		apiKey: apiKey,
+	}
+	var status publicip.Status
+	err = client.get(ctx, "/publicip/ip", &status)
+	report.add("public IP leak", checkPublicIP(status, err))
+	if err == nil && status.UnexpectedEgress {
+		report.warn("public IP egress", fmt.Sprintf("public IP address %s is not next to "+
+			"a VPN provider server IP address, traffic may be misrouted", status.IP))
+	}
+	report.add("IPv6 leak", checkIPv6(status, err))
+	if !allSettings.OpenVPN.Provider.PortForwarding.Enabled {
+		report.skip("forwarded port listener", "port forwarding is disabled")
+	} else if tunIP, err := interfaceIP(allSettings.VPNInterface()); err != nil {
+		report.add("forwarded port listener", err)
+	} else {
+		report.add("forwarded port listener", checkPortForwarded(ctx, client, tunIP, timeout))
+	}
+
+	return report.finish()
+}
+
+// checkTunnel checks the Internet is reachable and routed through the tunnel.
+func checkTunnel(ctx context.Context, vpnInterface string, timeout time.Duration) (err error) {
+	tun, err := net.InterfaceByName(vpnInterface)
+	if err != nil {
+		return fmt.Errorf("cannot find tunnel interface: %w", err)
+	} else if tun.Flags&net.FlagUp == 0 {
+		return fmt.Errorf("tunnel interface %s is down", tun.Name)
+	}
+	dialer := net.Dialer{Timeout: timeout}
+	connection, err := dialer.DialContext(ctx, "tcp", checkAddress)
+	if err != nil {
+		return err
+	}
+	defer connection.Close()
+	localIP := connection.LocalAddr().(*net.TCPAddr).IP
+	addresses, err := tun.Addrs()
+	if err != nil {
+		return fmt.Errorf("cannot list tunnel interface addresses: %w", err)
+	}
+	for _, address := range addresses {
+		if ipNet, ok := address.(*net.IPNet); ok && ipNet.IP.Equal(localIP) {
+			return nil
+		}
+	}
+	return fmt.Errorf("connection to %s is not going through %s but from %s", checkAddress, tun.Name, localIP)
+}
+
+// checkDNS checks the DNS queries are only sent to the local DNS over TLS
+// server, and that it resolves names.
+func checkDNS(ctx context.Context, fileManager files.FileManager, settings settings.DNS) (err error) {
+	if !settings.Enabled {
+		return fmt.Errorf("DNS over TLS is disabled, queries are sent in plaintext to %s", settings.PlaintextAddress)
+	}
+	data, err := fileManager.ReadFile(string(constants.ResolvConf))
+	if err != nil {
+		return err
+	}
+	for _, nameserver := range resolvConfNameservers(data) {
+		if ip := net.ParseIP(nameserver); ip == nil || !ip.IsLoopback() {
+			return fmt.Errorf("nameserver %s in %s bypasses DNS over TLS", nameserver, constants.ResolvConf)
+		}
+	}
+	if _, err := net.DefaultResolver.LookupHost(ctx, "github.com"); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resolvConfNameservers(data []byte) (nameservers []string) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 1 && fields[0] == "nameserver" {
+			nameservers = append(nameservers, fields[1])
+		}
+	}
+	return nameservers
+}
+
+// checkKillSwitch checks connections outside the tunnel are blocked,
+// by dialing out through the default interface directly.
+func checkKillSwitch(ctx context.Context, logger logging.Logger, timeout time.Duration) (err error) {
+	defaultInterface, _, err := routing.NewRouting(logger).DefaultRoute()
+	if err != nil {
+		return err
+	}
+	dialer := net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, rawConn syscall.RawConn) error {
+			var bindErr error
+			err := rawConn.Control(func(fd uintptr) {
+				bindErr = syscall.SetsockoptString(int(fd), syscall.SOL_SOCKET, syscall.SO_BINDTODEVICE, defaultInterface)
+			})
+			if err != nil {
+				return err
+			} else if bindErr != nil {
+				return fmt.Errorf("%w %s: %s", errBindDevice, defaultInterface, bindErr)
+			}
+			return nil
+		},
+	}
+	connection, err := dialer.DialContext(ctx, "tcp", checkAddress)
+	switch {
+	case err == nil:
+		connection.Close()
+		return fmt.Errorf("connection to %s through %s is not blocked", checkAddress, defaultInterface)
+	case errors.Is(err, errBindDevice):
+		return err
+	default: // blocked by the firewall
+		return nil
+	}
+}
+
+func checkPublicIP(status publicip.Status, err error) error {
+	switch {
+	case err != nil:
+		return err
+	case status.IP == nil:
+		return fmt.Errorf("public IP address is not known yet")
+	case status.Leaking:
+		return fmt.Errorf("public IP address %s is the ISP public IP address", status.IP)
+	default:
+		return nil
+	}
+}
+
+func checkIPv6(status publicip.Status, err error) error {
+	switch {
+	case err != nil:
+		return err
+	case status.IPv6Bypass:
+		return fmt.Errorf("public IPv6 address %s is reachable outside the VPN tunnel", status.IPv6)
+	default:
+		return nil
+	}
+}
+
+// interfaceIP returns the first IPv4 address of the network interface given.
+func interfaceIP(name string) (ip net.IP, err error) {
+	networkInterface, err := net.InterfaceByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find tunnel interface: %w", err)
+	}
+	addresses, err := networkInterface.Addrs()
+	if err != nil {
+		return nil, fmt.Errorf("cannot list tunnel interface addresses: %w", err)
+	}
+	for _, address := range addresses {
+		if ipNet, ok := address.(*net.IPNet); ok && ipNet.IP.To4() != nil {
+			return ipNet.IP, nil
+		}
+	}
+	return nil, fmt.Errorf("tunnel interface %s has no IPv4 address", name)
+}
+
+// checkPortForwarded checks a port is forwarded and a program listens on it
+// on the tunnel IP address given, which is where the VPN server sends the
+// connections to the forwarded port. It does not check the port is reachable
+// from the Internet, since VPN servers do not route connections from their
+// clients back to their own public IP address.
+func checkPortForwarded(ctx context.Context, client *controlClient, tunIP net.IP, timeout time.Duration) error {
+	var portForwarded struct {
+		Port uint16 `json:"port"`
+	}
+	if err := client.get(ctx, "/openvpn/portforwarded", &portForwarded); err != nil {
+		return err
+	} else if portForwarded.Port == 0 {
+		return fmt.Errorf("no port is forwarded")
+	}
+	dialer := net.Dialer{Timeout: timeout}
+	address := net.JoinHostPort(tunIP.String(), strconv.Itoa(int(portForwarded.Port)))
+	connection, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("port %d is forwarded but nothing listens on it on %s: %w", portForwarded.Port, tunIP, err)
+	}
+	connection.Close()
+	return nil
+}
+
+// readAPIKey returns the first API key with the read role from the
+// control server API keys file, or an empty string if there is no file.
+func readAPIKey(fileManager files.FileManager, filepath string) (key string, err error) {
+	if filepath == "" {
+		return "", nil
+	}
+	keys, err := server.ReadAPIKeys(fileManager, filepath)
+	if err != nil {
+		return "", err
+	}
+	for _, key := range keys {
+		for _, role := range key.Roles {
+			if role == server.RoleRead {
+				return key.Key, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no API key with the %s role in %s", server.RoleRead, filepath)
+}
+
+type controlClient struct {
+	client *http.Client
+	url    string
+	apiKey string
+}
+
+func (c *controlClient) get(ctx context.Context, path string, result interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, nil)
+	if err != nil {
+		return err
+	}
+	if c.apiKey != "" {
+		request.Header.Set("X-API-Key", c.apiKey)
+	}
+	response, err := c.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("cannot reach the control server: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("control server responded to %s with %s", path, response.Status)
+	}
+	return json.NewDecoder(response.Body).Decode(result)
+}
@@ -0,0 +1,278 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/publicip"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/files/mock_files"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_resolvConfNameservers(t *testing.T) {
+	t.Parallel()
+	data := []byte("# comment\nnameserver 127.0.0.1\nsearch local\nnameserver\nnameserver ::1 \n")
+
+	nameservers := resolvConfNameservers(data)
+
+	assert.Equal(t, []string{"127.0.0.1", "::1"}, nameservers)
+}
+
+func Test_checkDNS(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		settings settings.DNS
+		data     string
+		readErr  error
+		err      string
+	}{
+		"disabled": {
+			settings: settings.DNS{PlaintextAddress: net.IP{1, 1, 1, 1}},
+			err:      "DNS over TLS is disabled, queries are sent in plaintext to 1.1.1.1",
+		},
+		"read error": {
+			settings: settings.DNS{Enabled: true},
+			readErr:  errors.New("permission denied"),
+			err:      "permission denied",
+		},
+		"non loopback nameserver": {
+			settings: settings.DNS{Enabled: true},
+			data:     "nameserver 127.0.0.1\nnameserver 8.8.8.8\n",
+			err:      "nameserver 8.8.8.8 in /etc/resolv.conf bypasses DNS over TLS",
+		},
+		"invalid nameserver": {
+			settings: settings.DNS{Enabled: true},
+			data:     "nameserver localhost\n",
+			err:      "nameserver localhost in /etc/resolv.conf bypasses DNS over TLS",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			fileManager := mock_files.NewMockFileManager(mockCtrl)
+			if testCase.settings.Enabled {
+				fileManager.EXPECT().ReadFile(string(constants.ResolvConf)).
+					Return([]byte(testCase.data), testCase.readErr)
+			}
+
+			err := checkDNS(context.Background(), fileManager, testCase.settings)
+
+			require.Error(t, err)
+			assert.Equal(t, testCase.err, err.Error())
+		})
+	}
+}
+
+func Test_checkPublicIP(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		status publicip.Status
+		err    error
+		result string
+	}{
+		"error": {
+			err:    errors.New("cannot reach the control server"),
+			result: "cannot reach the control server",
+		},
+		"unknown": {
+			result: "public IP address is not known yet",
+		},
+		"leaking": {
+			status: publicip.Status{IP: net.IP{1, 2, 3, 4}, Leaking: true},
+			result: "public IP address 1.2.3.4 is the ISP public IP address",
+		},
+		"unexpected egress": {
+			status: publicip.Status{IP: net.IP{1, 2, 3, 4}, UnexpectedEgress: true},
+		},
+		"success": {
+			status: publicip.Status{IP: net.IP{1, 2, 3, 4}},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkPublicIP(testCase.status, testCase.err)
+
+			if testCase.result != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.result, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_checkIPv6(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		status publicip.Status
+		err    error
+		result string
+	}{
+		"error": {
+			err:    errors.New("cannot reach the control server"),
+			result: "cannot reach the control server",
+		},
+		"bypass": {
+			status: publicip.Status{IPv6: net.ParseIP("2001:db8::1"), IPv6Bypass: true},
+			result: "public IPv6 address 2001:db8::1 is reachable outside the VPN tunnel",
+		},
+		"success": {
+			status: publicip.Status{IPv6: net.ParseIP("2001:db8::1")},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkIPv6(testCase.status, testCase.err)
+
+			if testCase.result != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.result, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_readAPIKey(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		filepath string
+		data     string
+		key      string
+		err      string
+	}{
+		"no file": {},
+		"read key": {
+			filepath: "auth.json",
+			data: `{"keys":[{"name":"admin","key":"a","roles":["control"]},` +
+				`{"name":"monitoring","key":"b","roles":["read"]}]}`,
+			key: "b",
+		},
+		"no read key": {
+			filepath: "auth.json",
+			data:     `{"keys":[{"name":"admin","key":"a","roles":["control"]}]}`,
+			err:      "no API key with the read role in auth.json",
+		},
+		"invalid file": {
+			filepath: "auth.json",
+			data:     `{"keys":[]}`,
+			err:      "no API key defined in auth.json",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			fileManager := mock_files.NewMockFileManager(mockCtrl)
+			if testCase.filepath != "" {
+				fileManager.EXPECT().ReadFile(testCase.filepath).Return([]byte(testCase.data), nil)
+			}
+
+			key, err := readAPIKey(fileManager, testCase.filepath)
+
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.key, key)
+		})
+	}
+}
+
+func Test_controlClient_get(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ip":"1.2.3.4","leaking":true}`))
+	}))
+	defer server.Close()
+
+	client := &controlClient{client: server.Client(), url: server.URL + "/v1", apiKey: "key"}
+	var status publicip.Status
+	err := client.get(context.Background(), "/publicip/ip", &status)
+	require.NoError(t, err)
+	assert.Equal(t, publicip.Status{IP: net.IP{1, 2, 3, 4}.To16(), Leaking: true}, status)
+
+	client.apiKey = ""
+	err = client.get(context.Background(), "/publicip/ip", &status)
+	require.Error(t, err)
+	assert.Equal(t, "control server responded to /publicip/ip with 401 Unauthorized", err.Error())
+}
+
+func Test_checkPortForwarded(t *testing.T) {
+	t.Parallel()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() }) // the subtests run after this function returns
+	listeningPort := listener.Addr().(*net.TCPAddr).Port
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedPort := closedListener.Addr().(*net.TCPAddr).Port
+	closedListener.Close()
+
+	testCases := map[string]struct {
+		port int
+		err  string
+	}{
+		"no port forwarded": {
+			err: "no port is forwarded",
+		},
+		"nothing listening": {
+			port: closedPort,
+			err: fmt.Sprintf("port %d is forwarded but nothing listens on it on 127.0.0.1: "+
+				"dial tcp 127.0.0.1:%d: connect: connection refused", closedPort, closedPort),
+		},
+		"listening": {
+			port: listeningPort,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/openvpn/portforwarded", r.URL.Path)
+				_, _ = fmt.Fprintf(w, `{"port":%d}`, testCase.port)
+			}))
+			defer server.Close()
+			client := &controlClient{client: server.Client(), url: server.URL + "/v1"}
+
+			err := checkPortForwarded(context.Background(), client, net.IP{127, 0, 0, 1}, time.Second)
+
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
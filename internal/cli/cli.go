@@ -2,23 +2,60 @@ package cli
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"sort"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/firewall"
 	"github.com/qdm12/gluetun/internal/healthcheck"
+	gluetunLogging "github.com/qdm12/gluetun/internal/logging"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/openvpn"
 	"github.com/qdm12/gluetun/internal/params"
 	"github.com/qdm12/gluetun/internal/provider"
+	"github.com/qdm12/gluetun/internal/routing"
 	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/gluetun/internal/storage"
 	"github.com/qdm12/gluetun/internal/updater"
+	"github.com/qdm12/gluetun/internal/wireguard"
 	"github.com/qdm12/golibs/files"
 	"github.com/qdm12/golibs/logging"
+	"github.com/qdm12/golibs/network"
 )
 
+// newLogger returns a console logger for the level given, formatted
+// according to the LOG_TIME_FORMAT, LOG_TIME_UTC and LOG_COLOR settings
+// like the logger of the main program.
+func newLogger(level logging.Level) (logger logging.Logger, err error) {
+	logger, setFormat := gluetunLogging.NewLogger(os.Stdout, level)
+	paramsReader := params.NewReader(logger, files.NewFileManager())
+	var format gluetunLogging.Format
+	format.TimeLayout, err = paramsReader.GetLogTimeLayout()
+	if err != nil {
+		return nil, err
+	}
+	format.UTC, err = paramsReader.GetLogTimeUTC()
+	if err != nil {
+		return nil, err
+	}
+	format.Color, err = paramsReader.GetLogColor()
+	if err != nil {
+		return nil, err
+	}
+	setFormat(format)
+	return logger, nil
+}
+
 func ClientKey(args []string) error {
 	flagSet := flag.NewFlagSet("clientkey", flag.ExitOnError)
 	filepath := flagSet.String("path", string(constants.ClientKey), "file path to the client.key file")
@@ -50,7 +87,7 @@ func HealthCheck(ctx context.Context) error {
 }
 
 func OpenvpnConfig() error {
-	logger, err := logging.NewLogger(logging.ConsoleEncoding, logging.InfoLevel)
+	logger, err := newLogger(logging.InfoLevel)
 	if err != nil {
 		return err
 	}
@@ -63,8 +100,10 @@ func OpenvpnConfig() error {
 	if err != nil {
 		return err
 	}
-	providerConf := provider.New(allSettings.OpenVPN.Provider.Name, allServers, time.Now)
-	connection, err := providerConf.GetOpenVPNConnection(allSettings.OpenVPN.Provider.ServerSelection)
+	selection := allSettings.OpenVPN.Provider.ServerSelection
+	picker := provider.NewPicker(selection.PickMode, selection.PickSeed, time.Now)
+	providerConf := provider.New(allSettings.OpenVPN.Provider.Name, allServers, time.Now, picker)
+	connection, err := providerConf.GetOpenVPNConnection(selection)
 	if err != nil {
 		return err
 	}
@@ -82,33 +121,80 @@ func OpenvpnConfig() error {
 	return nil
 }
 
-func Update(args []string) error {
+// Exit codes of the update command, such that automation running it can
+// tell a partial failure from a total failure. Note the exit code 2 is
+// already used for invalid flags.
+const (
+	ExitCodeUpdateFailed   = 1
+	ExitCodeUpdatePartial  = 3
+	ExitCodeUpdateCanceled = 4
+)
+
+// ExitCodeError is an error for which the program should exit with
+// the code given. The error is not printed if Err is nil.
+type ExitCodeError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitCodeError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("exit code %d", e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *ExitCodeError) Unwrap() error { return e.Err }
+
+func Update(ctx context.Context, args []string) error { //nolint:gocognit,gocyclo
 	options := updater.Options{CLI: true}
-	var flushToFile bool
+	var flushToFile, jsonOutput bool
 	flagSet := flag.NewFlagSet("update", flag.ExitOnError)
 	flagSet.BoolVar(&flushToFile, "file", false, "Write results to /gluetun/servers.json (for end users)")
 	flagSet.BoolVar(&options.Stdout, "stdout", false, "Write results to console to modify the program (for maintainers)")
+	flagSet.BoolVar(&jsonOutput, "json", false, "Write logs and a final report as JSON lines (for automation)")
 	flagSet.StringVar(&options.DNSAddress, "dns", "1.1.1.1", "DNS resolver address to use")
 	flagSet.BoolVar(&options.Cyberghost, "cyberghost", false, "Update Cyberghost servers")
+	flagSet.BoolVar(&options.HideMyAss, "hidemyass", false, "Update HideMyAss servers")
 	flagSet.BoolVar(&options.Mullvad, "mullvad", false, "Update Mullvad servers")
 	flagSet.BoolVar(&options.Nordvpn, "nordvpn", false, "Update Nordvpn servers")
 	flagSet.BoolVar(&options.PIA, "pia", false, "Update Private Internet Access post-summer 2020 servers")
 	flagSet.BoolVar(&options.Privado, "privado", false, "Update Privado servers")
 	flagSet.BoolVar(&options.Purevpn, "purevpn", false, "Update Purevpn servers")
 	flagSet.BoolVar(&options.Surfshark, "surfshark", false, "Update Surfshark servers")
+	flagSet.BoolVar(&options.VPNUnlimited, "vpnunlimited", false, "Update VPN Unlimited servers")
 	flagSet.BoolVar(&options.Vyprvpn, "vyprvpn", false, "Update Vyprvpn servers")
+	flagSet.BoolVar(&options.WeVPN, "wevpn", false, "Update WeVPN servers")
 	flagSet.BoolVar(&options.Windscribe, "windscribe", false, "Update Windscribe servers")
 	if err := flagSet.Parse(args); err != nil {
 		return err
 	}
-	logger, err := logging.NewLogger(logging.ConsoleEncoding, logging.InfoLevel)
+	var logger logging.Logger
+	var err error
+	if jsonOutput {
+		logger, err = logging.NewLogger(logging.JSONEncoding, logging.InfoLevel)
+	} else {
+		logger, err = newLogger(logging.InfoLevel)
+	}
 	if err != nil {
 		return err
 	}
 	if !flushToFile && !options.Stdout {
 		return fmt.Errorf("at least one of -file or -stdout must be specified")
 	}
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	signalsCh := make(chan os.Signal, 1)
+	signal.Notify(signalsCh, syscall.SIGINT, syscall.SIGTERM, os.Interrupt)
+	defer signal.Stop(signalsCh)
+	go func() {
+		select {
+		case signal := <-signalsCh:
+			logger.Warn("Caught OS signal %s, canceling update", signal)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 	const clientTimeout = 10 * time.Second
 	httpClient := &http.Client{Timeout: clientTimeout}
 	storage := storage.New(logger)
@@ -117,16 +203,357 @@ func Update(args []string) error {
 	if err != nil {
 		return fmt.Errorf("cannot update servers: %w", err)
 	}
-	updater := updater.New(options, httpClient, currentServers, logger)
-	allServers, err := updater.UpdateServers(ctx)
-	if err != nil {
+	serversUpdater := updater.New(options, httpClient, currentServers, logger)
+	allServers, err := serversUpdater.UpdateServers(ctx)
+	canceled := errors.Is(err, updater.ErrCanceled)
+	if err != nil && !canceled {
 		return err
 	}
-	if flushToFile {
+	report := serversUpdater.Report()
+	failed := report.Failed()
+	allFailed := failed > 0 && failed == len(report.Providers)
+	if flushToFile && !allFailed {
 		if err := storage.FlushToFile(allServers); err != nil {
 			return fmt.Errorf("cannot update servers: %w", err)
 		}
 	}
 
+	if jsonOutput {
+		data, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	}
+
+	var exitErr *ExitCodeError
+	switch {
+	case canceled:
+		exitErr = &ExitCodeError{Code: ExitCodeUpdateCanceled,
+			Err: fmt.Errorf("update canceled after updating %d provider(s)", report.Updated())}
+	case failed == 0:
+		return nil
+	case allFailed:
+		exitErr = &ExitCodeError{Code: ExitCodeUpdateFailed,
+			Err: fmt.Errorf("cannot update servers of any of the %d provider(s)", failed)}
+	default:
+		exitErr = &ExitCodeError{Code: ExitCodeUpdatePartial,
+			Err: fmt.Errorf("cannot update servers of %d out of %d providers", failed, len(report.Providers))}
+	}
+	if jsonOutput { // the report already contains the errors
+		exitErr.Err = nil
+	}
+	return exitErr
+}
+
+// Regions prints the countries, regions, cities and hostnames which can be
+// used to filter the servers of a provider, from the servers stored.
+func Regions(args []string) error {
+	flagSet := flag.NewFlagSet("regions", flag.ExitOnError)
+	providerName := flagSet.String("provider", string(constants.PrivateInternetAccess), "VPN service provider")
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
+	logger, err := newLogger(logging.ErrorLevel)
+	if err != nil {
+		return err
+	}
+	allServers, err := storage.New(logger).SyncServers(constants.GetAllServers(), false)
+	if err != nil {
+		return err
+	}
+	locations, ok := provider.GetLocations(models.VPNProvider(strings.ToLower(*providerName)), allServers)
+	if !ok {
+		return fmt.Errorf("VPN service provider %q is not valid", *providerName)
+	}
+	sections := []struct {
+		name   string
+		values []string
+	}{
+		{"Countries (COUNTRY)", locations.Countries},
+		{"Regions (REGION)", locations.Regions},
+		{"Cities (CITY)", locations.Cities},
+		{"Hostnames (HOSTNAME)", locations.Hostnames},
+	}
+	for _, section := range sections {
+		if len(section.values) == 0 {
+			continue
+		}
+		fmt.Println(section.name + ":")
+		for _, value := range section.values {
+			fmt.Println("  " + value)
+		}
+	}
+	return nil
+}
+
+// Validate parses all the settings and checks them against the servers
+// stored and the files used, without modifying anything on the system.
+func Validate() error {
+	logger, err := newLogger(logging.ErrorLevel)
+	if err != nil {
+		return err
+	}
+	fileManager := files.NewFileManager()
+	report := &validationReport{kind: "validation"}
+
+	paramsReader := params.NewReader(logger, fileManager)
+	allSettings, err := settings.GetAllSettings(paramsReader)
+	report.add("settings", err)
+	if err != nil {
+		return report.finish()
+	}
+	fmt.Println(allSettings.String())
+
+	allServers, serversErr := storage.New(logger).SyncServers(constants.GetAllServers(), false)
+	report.add("servers information", serversErr)
+	var connection models.OpenVPNConnection
+	if serversErr == nil && allSettings.VPNType == constants.Wireguard {
+		selection := allSettings.Wireguard.Provider.ServerSelection
+		picker := provider.NewPicker(selection.PickMode, selection.PickSeed, time.Now)
+		providerConf := provider.New(allSettings.Wireguard.Provider.Name, allServers, time.Now, picker)
+		var wireguardConnection models.WireguardConnection
+		wireguardConnection, err = providerConf.GetWireguardConnection(selection)
+		connection = wireguardConnection.Endpoint()
+		if err == nil {
+			report.add(fmt.Sprintf("Wireguard server selection (i.e. %s:%d)",
+				connection.IP, connection.Port), nil)
+		} else {
+			report.add("Wireguard server selection", err)
+		}
+	} else if serversErr == nil {
+		selection := allSettings.OpenVPN.Provider.ServerSelection
+		picker := provider.NewPicker(selection.PickMode, selection.PickSeed, time.Now)
+		providerConf := provider.New(allSettings.OpenVPN.Provider.Name, allServers, time.Now, picker)
+		connection, err = providerConf.GetOpenVPNConnection(selection)
+		if err == nil {
+			report.add(fmt.Sprintf("server selection (i.e. %s:%d %s)",
+				connection.IP, connection.Port, connection.Protocol), nil)
+		} else {
+			report.add("server selection", err)
+		}
+	}
+
+	if path := allSettings.OpenVPN.ProfilesFilepath; path != "" {
+		getProviderExtra := func(provider models.VPNProvider) (models.ExtraConfigOptions, error) {
+			return settings.GetProviderExtraConfig(paramsReader, provider)
+		}
+		profiles, err := openvpn.ReadProfiles(fileManager, path, allSettings.OpenVPN, getProviderExtra)
+		report.add("profiles file", err)
+		names := make([]string, 0, len(profiles))
+		for name := range profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if serversErr != nil {
+				break
+			}
+			profile := profiles[name]
+			providerName := profile.Provider
+			if providerName == "" {
+				providerName = allSettings.OpenVPN.Provider.Name
+			}
+			picker := provider.NewPicker(profile.ServerSelection.PickMode, profile.ServerSelection.PickSeed, time.Now)
+			providerConf := provider.New(providerName, allServers, time.Now, picker)
+			_, err := providerConf.GetOpenVPNConnection(profile.ServerSelection)
+			report.add(fmt.Sprintf("profile %s server selection", name), err)
+		}
+	}
+
+	switch {
+	case allSettings.VPNType == constants.Wireguard:
+	case allSettings.OpenVPN.User == "":
+		report.add("OpenVPN credentials", fmt.Errorf("user is empty"))
+	default:
+		report.add("OpenVPN credentials", nil)
+	}
+
+	inputFiles := []struct{ name, path string }{
+		{"HTTP proxy TLS certificate", allSettings.HTTPProxy.TLSCertificate},
+		{"HTTP proxy TLS key", allSettings.HTTPProxy.TLSKey},
+	}
+	for _, inputFile := range inputFiles {
+		if inputFile.path == "" {
+			continue
+		}
+		exists, err := fileManager.FileExists(inputFile.path)
+		if err == nil && !exists {
+			err = fmt.Errorf("file %s does not exist", inputFile.path)
+		}
+		report.add(inputFile.name, err)
+	}
+
+	if allSettings.Firewall.DryRun {
+		err := dryRunFirewall(context.Background(), logger, fileManager,
+			allSettings.Firewall, allSettings.VPNInterface(), connection)
+		report.add("firewall rules (dry run)", err)
+	}
+
+	return report.finish()
+}
+
+// dryRunFirewall prints the iptables commands the firewall would run
+// to enable itself with the settings, VPN interface and VPN connection given.
+func dryRunFirewall(ctx context.Context, logger logging.Logger, fileManager files.FileManager,
+	settings settings.Firewall, vpnInterface string, connection models.OpenVPNConnection) (err error) {
+	routingConf := routing.NewRouting(logger)
+	routingConf.SetVPNInterface(vpnInterface)
+	firewallConf := firewall.NewConfigurator(logger, routingConf, fileManager)
+	firewallConf.SetDryRun()
+	firewallConf.SetVPNInterface(vpnInterface)
+	defaultInterface, defaultGateway, err := routingConf.DefaultRoute()
+	if err != nil {
+		return err
+	}
+	localNetworks, err := routingConf.LocalNetworks(settings.LocalInterfaces)
+	if err != nil {
+		return err
+	}
+	firewallConf.SetNetworkInformation(defaultInterface, defaultGateway, localNetworks)
+	outboundSubnets := make([]net.IPNet, len(settings.OutboundSubnets))
+	copy(outboundSubnets, settings.OutboundSubnets)
+	for _, route := range settings.StaticRoutes {
+		outboundSubnets = append(outboundSubnets, route.Destination)
+	}
+	if err := firewallConf.SetOutboundSubnets(ctx, outboundSubnets); err != nil {
+		return err
+	}
+	if err := firewallConf.SetVPNBypassSubnets(ctx, settings.VPNBypassSubnets); err != nil {
+		return err
+	}
+	if err := firewallConf.SetOutboundPorts(ctx, settings.OutboundPorts); err != nil {
+		return err
+	}
+	if err := firewallConf.SetVPNBypassOwners(ctx, settings.VPNBypassUIDs, settings.VPNBypassGIDs); err != nil {
+		return err
+	}
+	if err := firewallConf.SetVPNForcedOwners(ctx, settings.VPNForcedUIDs, settings.VPNForcedGIDs); err != nil {
+		return err
+	}
+	if err := firewallConf.SetMSSClamping(ctx, settings.MSSClamping); err != nil {
+		return err
+	}
+	if err := firewallConf.SetICMPEcho(ctx, settings.InputICMPEcho, settings.VPNInputICMPEcho); err != nil {
+		return err
+	}
+	for _, port := range settings.VPNInputPorts {
+		if err := firewallConf.SetAllowedPort(ctx, port, vpnInterface); err != nil {
+			return err
+		}
+	}
+	for _, port := range settings.InputPorts {
+		if err := firewallConf.SetAllowedPort(ctx, port, defaultInterface); err != nil {
+			return err
+		}
+	}
+	if connection.IP != nil {
+		if err := firewallConf.SetVPNConnection(ctx, connection); err != nil {
+			return err
+		}
+	}
+	return firewallConf.SetEnabled(ctx, true)
+}
+
+type validationReport struct {
+	kind     string
+	failures int
+}
+
+func (r *validationReport) add(check string, err error) {
+	if err != nil {
+		r.failures++
+		fmt.Printf("[FAIL] %s: %s\n", check, err)
+		return
+	}
+	fmt.Printf("[OK] %s\n", check)
+}
+
+// warn reports a check which may have failed, without counting it as a failure.
+func (r *validationReport) warn(check, reason string) {
+	fmt.Printf("[WARN] %s: %s\n", check, reason)
+}
+
+func (r *validationReport) skip(check, reason string) {
+	fmt.Printf("[SKIP] %s: %s\n", check, reason)
+}
+
+func (r *validationReport) finish() error {
+	if r.failures > 0 {
+		return fmt.Errorf("%d %s check(s) failed", r.failures, r.kind)
+	}
+	fmt.Printf("all %s checks passed\n", r.kind)
+	return nil
+}
+
+// Rollback lists the backups of the servers data file, or restores
+// the backup number given with -backup.
+func Rollback(args []string) error {
+	flagSet := flag.NewFlagSet("rollback", flag.ExitOnError)
+	backup := flagSet.Int("backup", 0, "Backup number to restore /gluetun/servers.json from, 1 being the most recent")
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
+	logger, err := newLogger(logging.InfoLevel)
+	if err != nil {
+		return err
+	}
+	storage := storage.New(logger)
+	if *backup > 0 {
+		_, err := storage.Rollback(constants.GetAllServers(), *backup)
+		return err
+	}
+	backups, err := storage.ListBackups()
+	if err != nil {
+		return err
+	}
+	if len(backups) == 0 {
+		fmt.Println("No backup available")
+		return nil
+	}
+	for _, backup := range backups {
+		fmt.Printf("%d: %d servers, written on %s\n",
+			backup.Number, backup.Servers, backup.Modified.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// GenKey prints a new Wireguard key pair, and optionally registers
+// the public key with the VPN service provider given with -register.
+func GenKey(ctx context.Context, args []string) error {
+	flagSet := flag.NewFlagSet("genkey", flag.ExitOnError)
+	register := flagSet.String("register", "", "Provider to register the public key with, only mullvad is supported")
+	account := flagSet.String("account", "", "Account number to register the public key with")
+	if err := flagSet.Parse(args); err != nil {
+		return err
+	}
+	switch models.VPNProvider(strings.ToLower(*register)) {
+	case "":
+	case constants.Mullvad:
+		if *account == "" {
+			return fmt.Errorf("-account must be set to register the key with %s", *register)
+		}
+	default:
+		return fmt.Errorf("registering a key with %q is not supported", *register)
+	}
+	privateKey, publicKey, err := wireguard.GenerateKeyPair()
+	if err != nil {
+		return err
+	}
+	lines := []string{
+		"PrivateKey = " + privateKey,
+		"PublicKey = " + publicKey,
+	}
+	if *register != "" {
+		const timeout = 10 * time.Second
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		addresses, err := wireguard.RegisterMullvadKey(ctx, network.NewClient(timeout), *account, publicKey)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, "Address = "+strings.Join(addresses, ","))
+	}
+	fmt.Println(strings.Join(lines, "\n"))
 	return nil
 }
@@ -2,4 +2,7 @@ package constants
 
 const (
 	HealthcheckAddress = "127.0.0.1:9999"
+	// DockerEmbeddedDNS is the address of the Docker embedded DNS server
+	// resolving container names on user defined networks.
+	DockerEmbeddedDNS = "127.0.0.11"
 )
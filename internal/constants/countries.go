@@ -1,6 +1,12 @@
-package updater
+package constants
 
-func getCountryCodes() map[string]string { //nolint:dupl
+import (
+	"sort"
+	"strings"
+)
+
+// CountryCodes returns a map of lowercase ISO 3166-1 alpha-2 country codes to country names.
+func CountryCodes() map[string]string { //nolint:dupl
 	return map[string]string{
 		"af": "Afghanistan",
 		"ax": "Aland Islands",
@@ -252,3 +258,79 @@ func getCountryCodes() map[string]string { //nolint:dupl
 		"zw": "Zimbabwe",
 	}
 }
+
+// countryAliases maps lowercase country names used by VPN providers, which
+// differ from the ones in CountryCodes, to their ISO 3166-1 alpha-2 code.
+func countryAliases() map[string]string {
+	return map[string]string{
+		"usa":                      "us",
+		"united states of america": "us",
+		"uk":                       "gb",
+		"great britain":            "gb",
+		"korea, south":             "kr",
+		"south korea":              "kr",
+		"russia":                   "ru",
+		"czechia":                  "cz",
+		"the bahamas":              "bs",
+		"british virgin island":    "vg",
+		"laos":                     "la",
+		"north macedonia":          "mk",
+		"syria":                    "sy",
+		"palestine":                "ps",
+		"brunei":                   "bn",
+		"macau":                    "mo",
+		"uae":                      "ae",
+		"viet nam":                 "vn",
+	}
+}
+
+// CountryCode returns the lowercase ISO 3166-1 alpha-2 code for the country
+// given, which can be a country name, a known alias or already a country code.
+// It returns an empty string if the country is not recognized.
+func CountryCode(country string) (code string) {
+	country = strings.ToLower(strings.TrimSpace(country))
+	countryCodes := CountryCodes()
+	if _, ok := countryCodes[country]; ok {
+		return country
+	}
+	if code, ok := countryAliases()[country]; ok {
+		return code
+	}
+	for code, name := range countryCodes {
+		if strings.ToLower(name) == country {
+			return code
+		}
+	}
+	return ""
+}
+
+// CountryChoices returns the countries given together with their
+// ISO 3166-1 alpha-2 codes and known aliases, so a country can be
+// specified using any of them.
+func CountryChoices(countries []string) (choices []string) {
+	uniqueChoices := make(map[string]struct{}, len(countries))
+	codes := make(map[string]struct{}, len(countries))
+	for _, country := range countries {
+		uniqueChoices[country] = struct{}{}
+		if code := CountryCode(country); code != "" {
+			codes[code] = struct{}{}
+			uniqueChoices[code] = struct{}{}
+		}
+	}
+	for alias, code := range countryAliases() {
+		if _, ok := codes[code]; ok {
+			uniqueChoices[alias] = struct{}{}
+		}
+	}
+	for code, name := range CountryCodes() {
+		if _, ok := codes[code]; ok {
+			uniqueChoices[name] = struct{}{}
+		}
+	}
+	choices = make([]string, 0, len(uniqueChoices))
+	for choice := range uniqueChoices {
+		choices = append(choices, choice)
+	}
+	sort.Strings(choices)
+	return choices
+}
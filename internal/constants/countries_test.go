@@ -0,0 +1,38 @@
+package constants
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CountryCode(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		country string
+		code    string
+	}{
+		"empty":         {},
+		"code":          {country: "US", code: "us"},
+		"name":          {country: "United States", code: "us"},
+		"alias":         {country: "USA", code: "us"},
+		"lowercase":     {country: "united kingdom", code: "gb"},
+		"provider name": {country: "Korea, South", code: "kr"},
+		"unknown":       {country: "Atlantis"},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			code := CountryCode(testCase.country)
+			assert.Equal(t, testCase.code, code)
+		})
+	}
+}
+
+func Test_CountryChoices(t *testing.T) {
+	t.Parallel()
+	choices := CountryChoices([]string{"UK", "Atlantis"})
+	expected := []string{"Atlantis", "UK", "United Kingdom", "gb", "great britain", "uk"}
+	assert.Equal(t, expected, choices)
+}
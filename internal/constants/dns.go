@@ -59,6 +59,9 @@ func DNSProviderMapping() map[models.DNSProvider]models.DNSProviderData {
 			SupportsTLS:  true,
 			SupportsIPv6: true,
 			Host:         models.DNSHost("dns.quad9.net"),
+			DNSCryptStamps: []string{
+				"sdns://AQMAAAAAAAAADDkuOS45Ljk6ODQ0MyBnyEe4yHWM0SAkVUO-dWdG3zTfHYTAC4xHA2jfgh2GPhkyLmRuc2NyeXB0LWNlcnQucXVhZDkubmV0", //nolint:lll
+			},
 		},
 		Quadrant: {
 			IPs: []net.IP{
@@ -0,0 +1,14 @@
+package constants
+
+const (
+	// ICMPEchoLocal is to answer ICMP echo requests from the local subnet only.
+	ICMPEchoLocal = "local"
+	// ICMPEchoOn is to answer ICMP echo requests from any address.
+	ICMPEchoOn = "on"
+	// ICMPEchoOff is to drop all ICMP echo requests.
+	ICMPEchoOff = "off"
+)
+
+func ICMPEchoChoices() []string {
+	return []string{ICMPEchoLocal, ICMPEchoOn, ICMPEchoOff}
+}
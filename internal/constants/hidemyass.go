@@ -0,0 +1,88 @@
+package constants
+
+import (
+	"net"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+func HideMyAssCountryChoices() (choices []string) {
+	servers := HideMyAssServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].Country
+	}
+	return choices
+}
+
+func HideMyAssRegionChoices() (choices []string) {
+	servers := HideMyAssServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].Region
+	}
+	return choices
+}
+
+func HideMyAssCityChoices() (choices []string) {
+	servers := HideMyAssServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].City
+	}
+	return choices
+}
+
+//nolint:lll
+func HideMyAssServers() []models.HideMyAssServer {
+	// IP addresses are resolved by the updater and are empty until then
+	return []models.HideMyAssServer{
+		{Country: "Albania", CountryCode: "al", Region: "", City: "Tirana", Hostname: "al.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Argentina", CountryCode: "ar", Region: "", City: "Buenos Aires", Hostname: "ar.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Austria", CountryCode: "at", Region: "", City: "Vienna", Hostname: "at.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Australia", CountryCode: "au", Region: "New South Wales", City: "Sydney", Hostname: "au-nsw.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Australia", CountryCode: "au", Region: "Victoria", City: "Melbourne", Hostname: "au-vic.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Belgium", CountryCode: "be", Region: "", City: "Brussels", Hostname: "be.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Bulgaria", CountryCode: "bg", Region: "", City: "Sofia", Hostname: "bg.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Brazil", CountryCode: "br", Region: "", City: "Sao Paulo", Hostname: "br.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Canada", CountryCode: "ca", Region: "British Columbia", City: "Vancouver", Hostname: "ca-bc.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Canada", CountryCode: "ca", Region: "Ontario", City: "Toronto", Hostname: "ca-on.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Switzerland", CountryCode: "ch", Region: "", City: "Zurich", Hostname: "ch.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Chile", CountryCode: "cl", Region: "", City: "Santiago", Hostname: "cl.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Czech Republic", CountryCode: "cz", Region: "", City: "Prague", Hostname: "cz.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Germany", CountryCode: "de", Region: "", City: "Frankfurt", Hostname: "de.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Denmark", CountryCode: "dk", Region: "", City: "Copenhagen", Hostname: "dk.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Spain", CountryCode: "es", Region: "", City: "Madrid", Hostname: "es.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Finland", CountryCode: "fi", Region: "", City: "Helsinki", Hostname: "fi.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "France", CountryCode: "fr", Region: "", City: "Paris", Hostname: "fr.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "United Kingdom", CountryCode: "gb", Region: "", City: "London", Hostname: "gb.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Greece", CountryCode: "gr", Region: "", City: "Athens", Hostname: "gr.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Hong Kong", CountryCode: "hk", Region: "", City: "Hong Kong", Hostname: "hk.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Hungary", CountryCode: "hu", Region: "", City: "Budapest", Hostname: "hu.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Ireland", CountryCode: "ie", Region: "", City: "Dublin", Hostname: "ie.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Israel", CountryCode: "il", Region: "", City: "Tel Aviv", Hostname: "il.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "India", CountryCode: "in", Region: "", City: "Chennai", Hostname: "in.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Iceland", CountryCode: "is", Region: "", City: "Reykjavik", Hostname: "is.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Italy", CountryCode: "it", Region: "", City: "Milan", Hostname: "it.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Japan", CountryCode: "jp", Region: "", City: "Tokyo", Hostname: "jp.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Luxembourg", CountryCode: "lu", Region: "", City: "Luxembourg", Hostname: "lu.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Mexico", CountryCode: "mx", Region: "", City: "Mexico City", Hostname: "mx.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Netherlands", CountryCode: "nl", Region: "", City: "Amsterdam", Hostname: "nl.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Norway", CountryCode: "no", Region: "", City: "Oslo", Hostname: "no.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "New Zealand", CountryCode: "nz", Region: "", City: "Auckland", Hostname: "nz.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Poland", CountryCode: "pl", Region: "", City: "Warsaw", Hostname: "pl.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Portugal", CountryCode: "pt", Region: "", City: "Lisbon", Hostname: "pt.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Romania", CountryCode: "ro", Region: "", City: "Bucharest", Hostname: "ro.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Sweden", CountryCode: "se", Region: "", City: "Stockholm", Hostname: "se.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Singapore", CountryCode: "sg", Region: "", City: "Singapore", Hostname: "sg.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Turkey", CountryCode: "tr", Region: "", City: "Istanbul", Hostname: "tr.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "Ukraine", CountryCode: "ua", Region: "", City: "Kiev", Hostname: "ua.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "USA", CountryCode: "us", Region: "California", City: "Los Angeles", Hostname: "us-ca.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "USA", CountryCode: "us", Region: "Florida", City: "Miami", Hostname: "us-fl.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "USA", CountryCode: "us", Region: "Illinois", City: "Chicago", Hostname: "us-il.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "USA", CountryCode: "us", Region: "New York", City: "New York City", Hostname: "us-ny.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "USA", CountryCode: "us", Region: "Texas", City: "Dallas", Hostname: "us-tx.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "USA", CountryCode: "us", Region: "Washington", City: "Seattle", Hostname: "us-wa.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "South Africa", CountryCode: "za", Region: "", City: "Johannesburg", Hostname: "za.hma.rocks", IPs: []net.IP{}, Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+	}
+}
@@ -0,0 +1,24 @@
+package constants
+
+const (
+	// LogColorAuto colors logs only if the output is a terminal.
+	LogColorAuto = "auto"
+	LogColorOn   = "on"
+	LogColorOff  = "off"
+)
+
+// LogColorChoices returns the choices to color the logs.
+func LogColorChoices() []string {
+	return []string{LogColorAuto, LogColorOn, LogColorOff}
+}
+
+const (
+	// LogTimeISO8601 is the default timestamp layout, with milliseconds.
+	LogTimeISO8601 = "iso8601"
+	LogTimeRFC3339 = "rfc3339"
+	// LogTimeNone disables timestamps, for example when the logs
+	// are collected by journald which adds its own timestamps.
+	LogTimeNone = "none"
+	// LogTimeLayoutISO8601 is the Go time layout for LogTimeISO8601, as used by zap.
+	LogTimeLayoutISO8601 = "2006-01-02T15:04:05.000Z0700"
+)
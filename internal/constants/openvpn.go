@@ -8,3 +8,78 @@ const (
 	TUN models.VPNDevice = "tun0"
 	TAP models.VPNDevice = "tap0"
 )
+
+const (
+	// OpenVPNAuthFailedSwitch is to pick another server after repeated authentication failures.
+	OpenVPNAuthFailedSwitch = "switch"
+	// OpenVPNAuthFailedStop is to stop OpenVPN after repeated authentication failures.
+	OpenVPNAuthFailedStop = "stop"
+)
+
+func OpenVPNAuthFailedActionChoices() []string {
+	return []string{OpenVPNAuthFailedSwitch, OpenVPNAuthFailedStop}
+}
+
+// OpenVPNTLSVersionChoices returns the values allowed for tls-version-min.
+func OpenVPNTLSVersionChoices() []string {
+	return []string{"1.0", "1.1", "1.2", "1.3"}
+}
+
+const (
+	// ServerPickRandom picks a random connection among the servers matching the selection.
+	ServerPickRandom = "random"
+	// ServerPickRoundRobin picks the next connection among the servers matching
+	// the selection for each attempt.
+	ServerPickRoundRobin = "roundrobin"
+	// ServerPickFirst always picks the first connection of the servers matching the selection.
+	ServerPickFirst = "first"
+)
+
+// ServerPickChoices returns the modes to pick a connection among the servers matching the selection.
+func ServerPickChoices() []string {
+	return []string{ServerPickRandom, ServerPickRoundRobin, ServerPickFirst}
+}
+
+const (
+	// OpenVPNScrambleXormask xors each byte of the packets with the scramble key.
+	OpenVPNScrambleXormask = "xormask"
+	// OpenVPNScrambleReverse reverses the order of the bytes of the packets.
+	OpenVPNScrambleReverse = "reverse"
+	// OpenVPNScrambleXorptrpos xors each byte of the packets with its position.
+	OpenVPNScrambleXorptrpos = "xorptrpos"
+	// OpenVPNScrambleObfuscate combines all the methods above with the scramble key.
+	OpenVPNScrambleObfuscate = "obfuscate"
+)
+
+// OpenVPNScrambleChoices returns the methods of the XOR scramble patch.
+func OpenVPNScrambleChoices() []string {
+	return []string{OpenVPNScrambleXormask, OpenVPNScrambleReverse,
+		OpenVPNScrambleXorptrpos, OpenVPNScrambleObfuscate}
+}
+
+// OpenVPNDataCipherChoices returns the data channel ciphers which can be negotiated.
+func OpenVPNDataCipherChoices() []string {
+	return []string{
+		"aes-128-cbc", "aes-192-cbc", "aes-256-cbc",
+		"aes-128-gcm", "aes-192-gcm", "aes-256-gcm",
+		"chacha20-poly1305",
+	}
+}
+
+const (
+	// OpenVPNCompressionLZO enables the legacy LZO compression with comp-lzo.
+	OpenVPNCompressionLZO = "lzo"
+	// OpenVPNCompressionLZ4V2 enables the LZ4 compression with compress lz4-v2.
+	OpenVPNCompressionLZ4V2 = "lz4-v2"
+	// OpenVPNCompressionStub does not compress but keeps the compression framing,
+	// for servers configured with compression.
+	OpenVPNCompressionStub = "stub"
+	// OpenVPNCompressionOff removes all compression directives.
+	OpenVPNCompressionOff = "off"
+)
+
+// OpenVPNCompressionChoices returns the compression settings which can be set.
+func OpenVPNCompressionChoices() []string {
+	return []string{OpenVPNCompressionLZO, OpenVPNCompressionLZ4V2,
+		OpenVPNCompressionStub, OpenVPNCompressionOff}
+}
@@ -7,6 +7,8 @@ import (
 const (
 	// UnboundConf is the file path to the Unbound configuration file.
 	UnboundConf models.Filepath = "/etc/unbound/unbound.conf"
+	// DNSCryptConf is the file path to the dnscrypt-proxy configuration file.
+	DNSCryptConf models.Filepath = "/etc/dnscrypt-proxy/dnscrypt-proxy.toml"
 	// ResolvConf is the file path to the system resolv.conf file.
 	ResolvConf models.Filepath = "/etc/resolv.conf"
 	// CACertificates is the file path to the CA certificates file.
@@ -15,6 +17,10 @@ const (
 	OpenVPNAuthConf models.Filepath = "/etc/openvpn/auth.conf"
 	// OpenVPNConf is the file path to the OpenVPN client configuration file.
 	OpenVPNConf models.Filepath = "/etc/openvpn/target.ovpn"
+	// WireguardConf is the file path to the Wireguard configuration file.
+	WireguardConf models.Filepath = "/etc/wireguard/wg.conf"
+	// OpenVPNPID is the file path OpenVPN writes its process ID to.
+	OpenVPNPID models.Filepath = "/etc/openvpn/openvpn.pid"
 	// PIAPortForward is the file path to the port forwarding JSON information for PIA servers.
 	PIAPortForward models.Filepath = "/gluetun/piaportforward.json"
 	// TunnelDevice is the file path to tun device.
@@ -25,8 +31,12 @@ const (
 	RootHints models.Filepath = "/etc/unbound/root.hints"
 	// RootKey is the filepath to the root.key file used by Unbound.
 	RootKey models.Filepath = "/etc/unbound/root.key"
-	// Client key filepath, used by Cyberghost.
+	// Client key filepath, used by Cyberghost, VPN Unlimited and HideMyAss.
 	ClientKey models.Filepath = "/gluetun/client.key"
-	// Client certificate filepath, used by Cyberghost.
+	// Client certificate filepath, used by Cyberghost, VPN Unlimited and HideMyAss.
 	ClientCertificate models.Filepath = "/gluetun/client.crt"
+	// Certificate authority filepath, used by VPN Unlimited, HideMyAss and WeVPN.
+	CertificateAuthority models.Filepath = "/gluetun/ca.crt"
+	// OpenVPN static key filepath for tls-crypt, used by WeVPN.
+	TLSCryptKey models.Filepath = "/gluetun/tls-crypt.key"
 )
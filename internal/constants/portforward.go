@@ -0,0 +1,18 @@
+package constants
+
+const (
+	// PortForwardingFormatPlain writes only the forwarded port number to the status file.
+	PortForwardingFormatPlain = "plain"
+	// PortForwardingFormatJSON writes the forwarded port as a JSON object to the status file.
+	PortForwardingFormatJSON = "json"
+	// PortForwardingFormatTemplate writes the forwarded port using a user defined Go template.
+	PortForwardingFormatTemplate = "template"
+)
+
+func PortForwardingFormatChoices() []string {
+	return []string{
+		PortForwardingFormatPlain,
+		PortForwardingFormatJSON,
+		PortForwardingFormatTemplate,
+	}
+}
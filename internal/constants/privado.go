@@ -11,6 +11,24 @@ const (
 	PrivadoCertificate = "MIIFKDCCAxCgAwIBAgIJAMtrmqZxIV/OMA0GCSqGSIb3DQEBDQUAMBIxEDAOBgNVBAMMB1ByaXZhZG8wHhcNMjAwMTA4MjEyODQ1WhcNMzUwMTA5MjEyODQ1WjASMRAwDgYDVQQDDAdQcml2YWRvMIICIjANBgkqhkiG9w0BAQEFAAOCAg8AMIICCgKCAgEAxPwOgiwNJzZTnKIXwAB0TSu/Lu2qt2U2I8obtQjwhi/7OrfmbmYykSdro70al2XPhnwAGGdCxW6LDnp0UN/IOhD11mgBPo14f5CLkBQjSJ6VN5miPbvK746LsNZl9H8rQGvDuPo4CG9BfPZMiDRGlsMxij/jztzgT1gmuxQ7WHfFRcNzBas1dHa9hV/d3TU6/t47x4SE/ljdcCtJiu7Zn6ODKQoys3mB7Luz2ngqUJWvkqsg+E4+3eJ0M8Hlbn5TPaRJBID7DAdYo6Vs6xGCYr981ThFcmoIQ10js10yANrrfGAzd03b3TnLAgko0uQMHjliMZL6L8sWOPHxyxJI0us88SFh4UgcFyRHKHPKux7w24SxAlZUYoUcTHp9VjG5XvDKYxzgV2RdM4ulBGbQRQ3y3/CyddsyQYMvA55Ets0LfPaBvDIcct70iXijGsdvlX1du3ArGpG7Vaje/RU4nbbGT6HYRdt5YyZfof288ukMOSj20nVcmS+c/4tqsxSerRb1aq5LOi1IemSkTMeC5gCbexk+L1vl7NT/58sxjGmu5bXwnvev/lIItfi2AlITrfUSEv19iDMKkeshwn/+sFJBMWYyluP+yJ56yR+MWoXvLlSWphLDTqq19yx3BZn0P1tgbXoR0g8PTdJFcz8z3RIb7myVLYulV1oGG/3rka0CAwEAAaOBgDB+MB0GA1UdDgQWBBTFtJkZCVDuDAD6k5bJzefjJdO3DTBCBgNVHSMEOzA5gBTFtJkZCVDuDAD6k5bJzefjJdO3DaEWpBQwEjEQMA4GA1UEAwwHUHJpdmFkb4IJAMtrmqZxIV/OMAwGA1UdEwQFMAMBAf8wCwYDVR0PBAQDAgEGMA0GCSqGSIb3DQEBDQUAA4ICAQB7MUSXMeBb9wlSv4sUaT1JHEwE26nlBw+TKmezfuPU5pBlY0LYr6qQZY95DHqsRJ7ByUzGUrGo17dNGXlcuNc6TAaQQEDRPo6y+LVh2TWMk15TUMI+MkqryJtCret7xGvDigKYMJgBy58HN3RAVr1B7cL9youwzLgc2Y/NcFKvnQJKeiIYAJ7g0CcnJiQvgZTS7xdwkEBXfsngmUCIG320DLPEL+Ze0HiUrxwWljMRya6i40AeH3Zu2i532xX1wV5+cjA4RJWIKg6ri/Q54iFGtZrA9/nc6y9uoQHkmz8cGyVUmJxFzMrrIICVqUtVRxLhkTMe4UzwRWTBeGgtW4tS0yq1QonAKfOyjgRw/CeY55D2UGvnAFZdTadtYXS4Alu2P9zdwoEk3fzHiVmDjqfJVr5wz9383aABUFrPI3nz6ed/Z6LZflKh1k+DUDEp8NxU4klUULWsSOKoa5zGX51G8cdHxwQLImXvtGuN5eSR8jCTgxFZhdps/xes4KkyfIz9FMYG748M+uOTgKITf4zdJ9BAyiQaOufVQZ8WjhWzWk9YHec9VqPkzpWNGkVjiRI5ewuXwZzZ164tMv2hikBXSuUCnFz37/ZNwGlDi0oBdDszCk2GxccdFHHaCSmpjU5MrdJ+5IhtTKGeTx+US2hTIVHQFIO99DmacxSYvLNcSQ=="
 )
 
+func PrivadoCountryChoices() (choices []string) {
+	servers := PrivadoServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].Country
+	}
+	return choices
+}
+
+func PrivadoCityChoices() (choices []string) {
+	servers := PrivadoServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].City
+	}
+	return choices
+}
+
 func PrivadoHostnameChoices() (choices []string) {
 	servers := PrivadoServers()
 	choices = make([]string, len(servers))
@@ -20,187 +38,187 @@ func PrivadoHostnameChoices() (choices []string) {
 	return choices
 }
 
-//nolint:gomnd
+//nolint:gomnd,lll
 func PrivadoServers() []models.PrivadoServer {
 	return []models.PrivadoServer{
-		{Hostname: "akl-001.vpn.privado.io", IP: net.IP{23, 254, 104, 114}},
-		{Hostname: "akl-002.vpn.privado.io", IP: net.IP{23, 254, 104, 120}},
-		{Hostname: "akl-003.vpn.privado.io", IP: net.IP{23, 254, 104, 51}},
-		{Hostname: "ams-001.vpn.privado.io", IP: net.IP{91, 148, 224, 10}},
-		{Hostname: "ams-002.vpn.privado.io", IP: net.IP{91, 148, 224, 20}},
-		{Hostname: "ams-003.vpn.privado.io", IP: net.IP{91, 148, 224, 30}},
-		{Hostname: "ams-004.vpn.privado.io", IP: net.IP{91, 148, 224, 40}},
-		{Hostname: "ams-005.vpn.privado.io", IP: net.IP{91, 148, 224, 50}},
-		{Hostname: "ams-006.vpn.privado.io", IP: net.IP{91, 148, 224, 60}},
-		{Hostname: "ams-007.vpn.privado.io", IP: net.IP{91, 148, 224, 70}},
-		{Hostname: "ams-008.vpn.privado.io", IP: net.IP{91, 148, 224, 80}},
-		{Hostname: "ams-009.vpn.privado.io", IP: net.IP{91, 148, 228, 10}},
-		{Hostname: "ams-010.vpn.privado.io", IP: net.IP{91, 148, 228, 20}},
-		{Hostname: "ams-011.vpn.privado.io", IP: net.IP{91, 148, 228, 30}},
-		{Hostname: "ams-012.vpn.privado.io", IP: net.IP{91, 148, 228, 40}},
-		{Hostname: "ams-013.vpn.privado.io", IP: net.IP{91, 148, 228, 50}},
-		{Hostname: "ams-014.vpn.privado.io", IP: net.IP{91, 148, 228, 60}},
-		{Hostname: "ams-015.vpn.privado.io", IP: net.IP{91, 148, 228, 70}},
-		{Hostname: "ams-016.vpn.privado.io", IP: net.IP{91, 148, 228, 80}},
-		{Hostname: "arn-001.vpn.privado.io", IP: net.IP{86, 106, 103, 67}},
-		{Hostname: "arn-002.vpn.privado.io", IP: net.IP{86, 106, 103, 74}},
-		{Hostname: "arn-003.vpn.privado.io", IP: net.IP{86, 106, 103, 81}},
-		{Hostname: "ath-001.vpn.privado.io", IP: net.IP{188, 123, 126, 61}},
-		{Hostname: "ath-002.vpn.privado.io", IP: net.IP{188, 123, 126, 64}},
-		{Hostname: "ath-003.vpn.privado.io", IP: net.IP{188, 123, 126, 68}},
-		{Hostname: "ath-004.vpn.privado.io", IP: net.IP{188, 123, 126, 72}},
-		{Hostname: "beg-001.vpn.privado.io", IP: net.IP{89, 38, 224, 19}},
-		{Hostname: "beg-002.vpn.privado.io", IP: net.IP{89, 38, 224, 25}},
-		{Hostname: "bkk-001.vpn.privado.io", IP: net.IP{119, 59, 111, 3}},
-		{Hostname: "bkk-002.vpn.privado.io", IP: net.IP{119, 59, 111, 11}},
-		{Hostname: "bom-001.vpn.privado.io", IP: net.IP{103, 26, 204, 61}},
-		{Hostname: "bom-002.vpn.privado.io", IP: net.IP{103, 26, 204, 70}},
-		{Hostname: "bru-001.vpn.privado.io", IP: net.IP{217, 138, 211, 163}},
-		{Hostname: "bru-002.vpn.privado.io", IP: net.IP{217, 138, 211, 170}},
-		{Hostname: "bru-003.vpn.privado.io", IP: net.IP{217, 138, 211, 177}},
-		{Hostname: "bru-004.vpn.privado.io", IP: net.IP{217, 138, 211, 184}},
-		{Hostname: "bts-001.vpn.privado.io", IP: net.IP{37, 120, 221, 227}},
-		{Hostname: "bts-002.vpn.privado.io", IP: net.IP{37, 120, 221, 233}},
-		{Hostname: "bud-001.vpn.privado.io", IP: net.IP{185, 128, 26, 194}},
-		{Hostname: "bud-002.vpn.privado.io", IP: net.IP{185, 128, 26, 200}},
-		{Hostname: "cdg-001.vpn.privado.io", IP: net.IP{89, 40, 183, 99}},
-		{Hostname: "cdg-002.vpn.privado.io", IP: net.IP{89, 40, 183, 106}},
-		{Hostname: "cdg-003.vpn.privado.io", IP: net.IP{89, 40, 183, 113}},
-		{Hostname: "cdg-004.vpn.privado.io", IP: net.IP{89, 40, 183, 120}},
-		{Hostname: "cph-001.vpn.privado.io", IP: net.IP{2, 58, 46, 35}},
-		{Hostname: "cph-002.vpn.privado.io", IP: net.IP{2, 58, 46, 42}},
-		{Hostname: "cph-003.vpn.privado.io", IP: net.IP{2, 58, 46, 49}},
-		{Hostname: "cph-004.vpn.privado.io", IP: net.IP{2, 58, 46, 56}},
-		{Hostname: "dca-001.vpn.privado.io", IP: net.IP{85, 12, 61, 10}},
-		{Hostname: "dca-002.vpn.privado.io", IP: net.IP{85, 12, 61, 20}},
-		{Hostname: "dca-003.vpn.privado.io", IP: net.IP{85, 12, 61, 30}},
-		{Hostname: "dca-004.vpn.privado.io", IP: net.IP{85, 12, 61, 40}},
-		{Hostname: "dca-005.vpn.privado.io", IP: net.IP{85, 12, 61, 50}},
-		{Hostname: "dca-006.vpn.privado.io", IP: net.IP{85, 12, 61, 60}},
-		{Hostname: "dca-007.vpn.privado.io", IP: net.IP{85, 12, 61, 70}},
-		{Hostname: "dca-008.vpn.privado.io", IP: net.IP{85, 12, 61, 80}},
-		{Hostname: "dca-013.vpn.privado.io", IP: net.IP{185, 247, 68, 3}},
-		{Hostname: "dca-014.vpn.privado.io", IP: net.IP{185, 247, 68, 10}},
-		{Hostname: "dca-015.vpn.privado.io", IP: net.IP{185, 247, 68, 17}},
-		{Hostname: "dca-016.vpn.privado.io", IP: net.IP{185, 247, 68, 24}},
-		{Hostname: "dfw-001.vpn.privado.io", IP: net.IP{23, 105, 32, 243}},
-		{Hostname: "dfw-002.vpn.privado.io", IP: net.IP{23, 105, 32, 244}},
-		{Hostname: "dub-001.vpn.privado.io", IP: net.IP{84, 247, 48, 227}},
-		{Hostname: "dub-002.vpn.privado.io", IP: net.IP{84, 247, 48, 234}},
-		{Hostname: "dub-003.vpn.privado.io", IP: net.IP{84, 247, 48, 241}},
-		{Hostname: "dub-004.vpn.privado.io", IP: net.IP{84, 247, 48, 248}},
-		{Hostname: "eze-001.vpn.privado.io", IP: net.IP{168, 205, 93, 211}},
-		{Hostname: "eze-002.vpn.privado.io", IP: net.IP{168, 205, 93, 217}},
-		{Hostname: "fra-001.vpn.privado.io", IP: net.IP{91, 148, 232, 10}},
-		{Hostname: "fra-002.vpn.privado.io", IP: net.IP{91, 148, 232, 20}},
-		{Hostname: "fra-003.vpn.privado.io", IP: net.IP{91, 148, 232, 30}},
-		{Hostname: "fra-004.vpn.privado.io", IP: net.IP{91, 148, 232, 40}},
-		{Hostname: "fra-005.vpn.privado.io", IP: net.IP{91, 148, 233, 7}},
-		{Hostname: "fra-006.vpn.privado.io", IP: net.IP{91, 148, 233, 8}},
-		{Hostname: "fra-007.vpn.privado.io", IP: net.IP{91, 148, 233, 9}},
-		{Hostname: "fra-008.vpn.privado.io", IP: net.IP{91, 148, 233, 10}},
-		{Hostname: "gru-001.vpn.privado.io", IP: net.IP{177, 54, 145, 193}},
-		{Hostname: "gru-002.vpn.privado.io", IP: net.IP{177, 54, 145, 197}},
-		{Hostname: "hel-001.vpn.privado.io", IP: net.IP{194, 34, 134, 219}},
-		{Hostname: "hel-002.vpn.privado.io", IP: net.IP{194, 34, 134, 227}},
-		{Hostname: "hkg-001.vpn.privado.io", IP: net.IP{209, 58, 185, 88}},
-		{Hostname: "hkg-002.vpn.privado.io", IP: net.IP{209, 58, 185, 97}},
-		{Hostname: "hkg-003.vpn.privado.io", IP: net.IP{209, 58, 185, 108}},
-		{Hostname: "hkg-004.vpn.privado.io", IP: net.IP{209, 58, 185, 120}},
-		{Hostname: "icn-001.vpn.privado.io", IP: net.IP{169, 56, 73, 146}},
-		{Hostname: "icn-002.vpn.privado.io", IP: net.IP{169, 56, 73, 153}},
-		{Hostname: "iev-001.vpn.privado.io", IP: net.IP{176, 103, 52, 40}},
-		{Hostname: "iev-002.vpn.privado.io", IP: net.IP{176, 103, 53, 40}},
-		{Hostname: "ist-001.vpn.privado.io", IP: net.IP{185, 84, 183, 3}},
-		{Hostname: "ist-002.vpn.privado.io", IP: net.IP{185, 84, 183, 4}},
-		{Hostname: "jfk-001.vpn.privado.io", IP: net.IP{217, 138, 208, 99}},
-		{Hostname: "jfk-002.vpn.privado.io", IP: net.IP{217, 138, 208, 106}},
-		{Hostname: "jfk-003.vpn.privado.io", IP: net.IP{217, 138, 208, 113}},
-		{Hostname: "jfk-004.vpn.privado.io", IP: net.IP{217, 138, 208, 120}},
-		{Hostname: "jnb-001.vpn.privado.io", IP: net.IP{172, 107, 93, 131}},
-		{Hostname: "jnb-002.vpn.privado.io", IP: net.IP{172, 107, 93, 137}},
-		{Hostname: "lax-009.vpn.privado.io", IP: net.IP{45, 152, 182, 227}},
-		{Hostname: "lax-010.vpn.privado.io", IP: net.IP{45, 152, 182, 234}},
-		{Hostname: "lax-011.vpn.privado.io", IP: net.IP{45, 152, 182, 241}},
-		{Hostname: "lax-012.vpn.privado.io", IP: net.IP{45, 152, 182, 248}},
-		{Hostname: "lis-001.vpn.privado.io", IP: net.IP{89, 26, 243, 153}},
-		{Hostname: "lis-002.vpn.privado.io", IP: net.IP{89, 26, 243, 154}},
-		{Hostname: "lon-001.vpn.privado.io", IP: net.IP{217, 138, 195, 163}},
-		{Hostname: "lon-002.vpn.privado.io", IP: net.IP{217, 138, 195, 170}},
-		{Hostname: "lon-003.vpn.privado.io", IP: net.IP{217, 138, 195, 177}},
-		{Hostname: "lon-004.vpn.privado.io", IP: net.IP{217, 138, 195, 184}},
-		{Hostname: "mad-001.vpn.privado.io", IP: net.IP{217, 138, 218, 131}},
-		{Hostname: "man-001.vpn.privado.io", IP: net.IP{217, 138, 196, 131}},
-		{Hostname: "man-002.vpn.privado.io", IP: net.IP{217, 138, 196, 138}},
-		{Hostname: "man-003.vpn.privado.io", IP: net.IP{217, 138, 196, 145}},
-		{Hostname: "man-004.vpn.privado.io", IP: net.IP{217, 138, 196, 152}},
-		{Hostname: "mex-001.vpn.privado.io", IP: net.IP{169, 57, 96, 52}},
-		{Hostname: "mex-002.vpn.privado.io", IP: net.IP{169, 57, 96, 57}},
-		{Hostname: "mia-001.vpn.privado.io", IP: net.IP{86, 106, 87, 131}},
-		{Hostname: "mia-002.vpn.privado.io", IP: net.IP{86, 106, 87, 138}},
-		{Hostname: "mia-003.vpn.privado.io", IP: net.IP{86, 106, 87, 145}},
-		{Hostname: "mia-004.vpn.privado.io", IP: net.IP{86, 106, 87, 152}},
-		{Hostname: "mxp-001.vpn.privado.io", IP: net.IP{89, 40, 182, 195}},
-		{Hostname: "mxp-002.vpn.privado.io", IP: net.IP{89, 40, 182, 201}},
-		{Hostname: "nrt-001.vpn.privado.io", IP: net.IP{217, 138, 252, 3}},
-		{Hostname: "nrt-002.vpn.privado.io", IP: net.IP{217, 138, 252, 10}},
-		{Hostname: "nrt-003.vpn.privado.io", IP: net.IP{217, 138, 252, 17}},
-		{Hostname: "nrt-004.vpn.privado.io", IP: net.IP{217, 138, 252, 24}},
-		{Hostname: "ord-001.vpn.privado.io", IP: net.IP{23, 108, 95, 129}},
-		{Hostname: "ord-002.vpn.privado.io", IP: net.IP{23, 108, 95, 167}},
-		{Hostname: "osl-001.vpn.privado.io", IP: net.IP{84, 247, 50, 115}},
-		{Hostname: "osl-002.vpn.privado.io", IP: net.IP{84, 247, 50, 119}},
-		{Hostname: "osl-003.vpn.privado.io", IP: net.IP{84, 247, 50, 123}},
-		{Hostname: "otp-001.vpn.privado.io", IP: net.IP{89, 46, 102, 179}},
-		{Hostname: "otp-002.vpn.privado.io", IP: net.IP{89, 46, 102, 185}},
-		{Hostname: "phx-001.vpn.privado.io", IP: net.IP{91, 148, 236, 10}},
-		{Hostname: "phx-002.vpn.privado.io", IP: net.IP{91, 148, 236, 20}},
-		{Hostname: "phx-003.vpn.privado.io", IP: net.IP{91, 148, 236, 30}},
-		{Hostname: "phx-004.vpn.privado.io", IP: net.IP{91, 148, 236, 40}},
-		{Hostname: "phx-005.vpn.privado.io", IP: net.IP{91, 148, 236, 50}},
-		{Hostname: "phx-006.vpn.privado.io", IP: net.IP{91, 148, 236, 60}},
-		{Hostname: "phx-007.vpn.privado.io", IP: net.IP{91, 148, 236, 70}},
-		{Hostname: "phx-008.vpn.privado.io", IP: net.IP{91, 148, 236, 80}},
-		{Hostname: "prg-001.vpn.privado.io", IP: net.IP{185, 216, 35, 99}},
-		{Hostname: "prg-002.vpn.privado.io", IP: net.IP{185, 216, 35, 105}},
-		{Hostname: "rix-001.vpn.privado.io", IP: net.IP{109, 248, 149, 35}},
-		{Hostname: "rix-002.vpn.privado.io", IP: net.IP{109, 248, 149, 40}},
-		{Hostname: "rkv-001.vpn.privado.io", IP: net.IP{82, 221, 131, 78}},
-		{Hostname: "rkv-002.vpn.privado.io", IP: net.IP{82, 221, 131, 127}},
-		{Hostname: "sea-001.vpn.privado.io", IP: net.IP{23, 81, 208, 96}},
-		{Hostname: "sea-002.vpn.privado.io", IP: net.IP{23, 81, 208, 104}},
-		{Hostname: "sin-001.vpn.privado.io", IP: net.IP{92, 119, 178, 131}},
-		{Hostname: "sin-002.vpn.privado.io", IP: net.IP{92, 119, 178, 138}},
-		{Hostname: "sin-003.vpn.privado.io", IP: net.IP{92, 119, 178, 145}},
-		{Hostname: "sin-004.vpn.privado.io", IP: net.IP{92, 119, 178, 152}},
-		{Hostname: "sof-001.vpn.privado.io", IP: net.IP{217, 138, 221, 163}},
-		{Hostname: "sof-002.vpn.privado.io", IP: net.IP{217, 138, 221, 169}},
-		{Hostname: "stl-001.vpn.privado.io", IP: net.IP{148, 72, 170, 145}},
-		{Hostname: "stl-002.vpn.privado.io", IP: net.IP{148, 72, 172, 82}},
-		{Hostname: "syd-001.vpn.privado.io", IP: net.IP{93, 115, 35, 35}},
-		{Hostname: "syd-002.vpn.privado.io", IP: net.IP{93, 115, 35, 42}},
-		{Hostname: "syd-003.vpn.privado.io", IP: net.IP{93, 115, 35, 49}},
-		{Hostname: "syd-004.vpn.privado.io", IP: net.IP{93, 115, 35, 56}},
-		{Hostname: "vie-001.vpn.privado.io", IP: net.IP{5, 253, 207, 227}},
-		{Hostname: "vie-002.vpn.privado.io", IP: net.IP{5, 253, 207, 234}},
-		{Hostname: "vie-003.vpn.privado.io", IP: net.IP{5, 253, 207, 241}},
-		{Hostname: "vie-004.vpn.privado.io", IP: net.IP{5, 253, 207, 248}},
-		{Hostname: "vno-001.vpn.privado.io", IP: net.IP{185, 64, 104, 176}},
-		{Hostname: "vno-002.vpn.privado.io", IP: net.IP{185, 64, 104, 180}},
-		{Hostname: "waw-001.vpn.privado.io", IP: net.IP{217, 138, 209, 163}},
-		{Hostname: "waw-002.vpn.privado.io", IP: net.IP{217, 138, 209, 164}},
-		{Hostname: "waw-003.vpn.privado.io", IP: net.IP{217, 138, 209, 165}},
-		{Hostname: "waw-004.vpn.privado.io", IP: net.IP{217, 138, 209, 166}},
-		{Hostname: "yul-001.vpn.privado.io", IP: net.IP{217, 138, 213, 67}},
-		{Hostname: "yul-002.vpn.privado.io", IP: net.IP{217, 138, 213, 74}},
-		{Hostname: "yul-003.vpn.privado.io", IP: net.IP{217, 138, 213, 81}},
-		{Hostname: "yul-004.vpn.privado.io", IP: net.IP{217, 138, 213, 88}},
-		{Hostname: "yvr-001.vpn.privado.io", IP: net.IP{71, 19, 248, 57}},
-		{Hostname: "yvr-002.vpn.privado.io", IP: net.IP{71, 19, 248, 113}},
-		{Hostname: "yyz-003.vpn.privado.io", IP: net.IP{199, 189, 27, 19}},
-		{Hostname: "zrh-001.vpn.privado.io", IP: net.IP{185, 156, 175, 195}},
-		{Hostname: "zrh-002.vpn.privado.io", IP: net.IP{185, 156, 175, 202}},
-		{Hostname: "zrh-003.vpn.privado.io", IP: net.IP{185, 156, 175, 209}},
-		{Hostname: "zrh-004.vpn.privado.io", IP: net.IP{185, 156, 175, 216}},
+		{Country: "New Zealand", CountryCode: "nz", City: "Auckland", Hostname: "akl-001.vpn.privado.io", IP: net.IP{23, 254, 104, 114}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "New Zealand", CountryCode: "nz", City: "Auckland", Hostname: "akl-002.vpn.privado.io", IP: net.IP{23, 254, 104, 120}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "New Zealand", CountryCode: "nz", City: "Auckland", Hostname: "akl-003.vpn.privado.io", IP: net.IP{23, 254, 104, 51}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-001.vpn.privado.io", IP: net.IP{91, 148, 224, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-002.vpn.privado.io", IP: net.IP{91, 148, 224, 20}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-003.vpn.privado.io", IP: net.IP{91, 148, 224, 30}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-004.vpn.privado.io", IP: net.IP{91, 148, 224, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-005.vpn.privado.io", IP: net.IP{91, 148, 224, 50}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-006.vpn.privado.io", IP: net.IP{91, 148, 224, 60}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-007.vpn.privado.io", IP: net.IP{91, 148, 224, 70}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-008.vpn.privado.io", IP: net.IP{91, 148, 224, 80}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-009.vpn.privado.io", IP: net.IP{91, 148, 228, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-010.vpn.privado.io", IP: net.IP{91, 148, 228, 20}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-011.vpn.privado.io", IP: net.IP{91, 148, 228, 30}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-012.vpn.privado.io", IP: net.IP{91, 148, 228, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-013.vpn.privado.io", IP: net.IP{91, 148, 228, 50}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-014.vpn.privado.io", IP: net.IP{91, 148, 228, 60}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-015.vpn.privado.io", IP: net.IP{91, 148, 228, 70}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "ams-016.vpn.privado.io", IP: net.IP{91, 148, 228, 80}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Sweden", CountryCode: "se", City: "Stockholm", Hostname: "arn-001.vpn.privado.io", IP: net.IP{86, 106, 103, 67}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Sweden", CountryCode: "se", City: "Stockholm", Hostname: "arn-002.vpn.privado.io", IP: net.IP{86, 106, 103, 74}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Sweden", CountryCode: "se", City: "Stockholm", Hostname: "arn-003.vpn.privado.io", IP: net.IP{86, 106, 103, 81}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Greece", CountryCode: "gr", City: "Athens", Hostname: "ath-001.vpn.privado.io", IP: net.IP{188, 123, 126, 61}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Greece", CountryCode: "gr", City: "Athens", Hostname: "ath-002.vpn.privado.io", IP: net.IP{188, 123, 126, 64}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Greece", CountryCode: "gr", City: "Athens", Hostname: "ath-003.vpn.privado.io", IP: net.IP{188, 123, 126, 68}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Greece", CountryCode: "gr", City: "Athens", Hostname: "ath-004.vpn.privado.io", IP: net.IP{188, 123, 126, 72}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Serbia", CountryCode: "rs", City: "Belgrade", Hostname: "beg-001.vpn.privado.io", IP: net.IP{89, 38, 224, 19}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Serbia", CountryCode: "rs", City: "Belgrade", Hostname: "beg-002.vpn.privado.io", IP: net.IP{89, 38, 224, 25}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Thailand", CountryCode: "th", City: "Bangkok", Hostname: "bkk-001.vpn.privado.io", IP: net.IP{119, 59, 111, 3}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Thailand", CountryCode: "th", City: "Bangkok", Hostname: "bkk-002.vpn.privado.io", IP: net.IP{119, 59, 111, 11}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "India", CountryCode: "in", City: "Mumbai", Hostname: "bom-001.vpn.privado.io", IP: net.IP{103, 26, 204, 61}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "India", CountryCode: "in", City: "Mumbai", Hostname: "bom-002.vpn.privado.io", IP: net.IP{103, 26, 204, 70}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Belgium", CountryCode: "be", City: "Brussels", Hostname: "bru-001.vpn.privado.io", IP: net.IP{217, 138, 211, 163}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Belgium", CountryCode: "be", City: "Brussels", Hostname: "bru-002.vpn.privado.io", IP: net.IP{217, 138, 211, 170}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Belgium", CountryCode: "be", City: "Brussels", Hostname: "bru-003.vpn.privado.io", IP: net.IP{217, 138, 211, 177}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Belgium", CountryCode: "be", City: "Brussels", Hostname: "bru-004.vpn.privado.io", IP: net.IP{217, 138, 211, 184}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Slovakia", CountryCode: "sk", City: "Bratislava", Hostname: "bts-001.vpn.privado.io", IP: net.IP{37, 120, 221, 227}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Slovakia", CountryCode: "sk", City: "Bratislava", Hostname: "bts-002.vpn.privado.io", IP: net.IP{37, 120, 221, 233}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Hungary", CountryCode: "hu", City: "Budapest", Hostname: "bud-001.vpn.privado.io", IP: net.IP{185, 128, 26, 194}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Hungary", CountryCode: "hu", City: "Budapest", Hostname: "bud-002.vpn.privado.io", IP: net.IP{185, 128, 26, 200}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "France", CountryCode: "fr", City: "Paris", Hostname: "cdg-001.vpn.privado.io", IP: net.IP{89, 40, 183, 99}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "France", CountryCode: "fr", City: "Paris", Hostname: "cdg-002.vpn.privado.io", IP: net.IP{89, 40, 183, 106}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "France", CountryCode: "fr", City: "Paris", Hostname: "cdg-003.vpn.privado.io", IP: net.IP{89, 40, 183, 113}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "France", CountryCode: "fr", City: "Paris", Hostname: "cdg-004.vpn.privado.io", IP: net.IP{89, 40, 183, 120}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Denmark", CountryCode: "dk", City: "Copenhagen", Hostname: "cph-001.vpn.privado.io", IP: net.IP{2, 58, 46, 35}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Denmark", CountryCode: "dk", City: "Copenhagen", Hostname: "cph-002.vpn.privado.io", IP: net.IP{2, 58, 46, 42}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Denmark", CountryCode: "dk", City: "Copenhagen", Hostname: "cph-003.vpn.privado.io", IP: net.IP{2, 58, 46, 49}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Denmark", CountryCode: "dk", City: "Copenhagen", Hostname: "cph-004.vpn.privado.io", IP: net.IP{2, 58, 46, 56}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-001.vpn.privado.io", IP: net.IP{85, 12, 61, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-002.vpn.privado.io", IP: net.IP{85, 12, 61, 20}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-003.vpn.privado.io", IP: net.IP{85, 12, 61, 30}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-004.vpn.privado.io", IP: net.IP{85, 12, 61, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-005.vpn.privado.io", IP: net.IP{85, 12, 61, 50}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-006.vpn.privado.io", IP: net.IP{85, 12, 61, 60}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-007.vpn.privado.io", IP: net.IP{85, 12, 61, 70}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-008.vpn.privado.io", IP: net.IP{85, 12, 61, 80}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-013.vpn.privado.io", IP: net.IP{185, 247, 68, 3}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-014.vpn.privado.io", IP: net.IP{185, 247, 68, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-015.vpn.privado.io", IP: net.IP{185, 247, 68, 17}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Washington DC", Hostname: "dca-016.vpn.privado.io", IP: net.IP{185, 247, 68, 24}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Dallas", Hostname: "dfw-001.vpn.privado.io", IP: net.IP{23, 105, 32, 243}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Dallas", Hostname: "dfw-002.vpn.privado.io", IP: net.IP{23, 105, 32, 244}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Ireland", CountryCode: "ie", City: "Dublin", Hostname: "dub-001.vpn.privado.io", IP: net.IP{84, 247, 48, 227}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Ireland", CountryCode: "ie", City: "Dublin", Hostname: "dub-002.vpn.privado.io", IP: net.IP{84, 247, 48, 234}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Ireland", CountryCode: "ie", City: "Dublin", Hostname: "dub-003.vpn.privado.io", IP: net.IP{84, 247, 48, 241}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Ireland", CountryCode: "ie", City: "Dublin", Hostname: "dub-004.vpn.privado.io", IP: net.IP{84, 247, 48, 248}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Argentina", CountryCode: "ar", City: "Buenos Aires", Hostname: "eze-001.vpn.privado.io", IP: net.IP{168, 205, 93, 211}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Argentina", CountryCode: "ar", City: "Buenos Aires", Hostname: "eze-002.vpn.privado.io", IP: net.IP{168, 205, 93, 217}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-001.vpn.privado.io", IP: net.IP{91, 148, 232, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-002.vpn.privado.io", IP: net.IP{91, 148, 232, 20}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-003.vpn.privado.io", IP: net.IP{91, 148, 232, 30}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-004.vpn.privado.io", IP: net.IP{91, 148, 232, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-005.vpn.privado.io", IP: net.IP{91, 148, 233, 7}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-006.vpn.privado.io", IP: net.IP{91, 148, 233, 8}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-007.vpn.privado.io", IP: net.IP{91, 148, 233, 9}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "fra-008.vpn.privado.io", IP: net.IP{91, 148, 233, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Brazil", CountryCode: "br", City: "Sao Paulo", Hostname: "gru-001.vpn.privado.io", IP: net.IP{177, 54, 145, 193}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Brazil", CountryCode: "br", City: "Sao Paulo", Hostname: "gru-002.vpn.privado.io", IP: net.IP{177, 54, 145, 197}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Finland", CountryCode: "fi", City: "Helsinki", Hostname: "hel-001.vpn.privado.io", IP: net.IP{194, 34, 134, 219}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Finland", CountryCode: "fi", City: "Helsinki", Hostname: "hel-002.vpn.privado.io", IP: net.IP{194, 34, 134, 227}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Hong Kong", CountryCode: "hk", City: "Hong Kong", Hostname: "hkg-001.vpn.privado.io", IP: net.IP{209, 58, 185, 88}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Hong Kong", CountryCode: "hk", City: "Hong Kong", Hostname: "hkg-002.vpn.privado.io", IP: net.IP{209, 58, 185, 97}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Hong Kong", CountryCode: "hk", City: "Hong Kong", Hostname: "hkg-003.vpn.privado.io", IP: net.IP{209, 58, 185, 108}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Hong Kong", CountryCode: "hk", City: "Hong Kong", Hostname: "hkg-004.vpn.privado.io", IP: net.IP{209, 58, 185, 120}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Korea", CountryCode: "kr", City: "Seoul", Hostname: "icn-001.vpn.privado.io", IP: net.IP{169, 56, 73, 146}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Korea", CountryCode: "kr", City: "Seoul", Hostname: "icn-002.vpn.privado.io", IP: net.IP{169, 56, 73, 153}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Ukraine", CountryCode: "ua", City: "Kiev", Hostname: "iev-001.vpn.privado.io", IP: net.IP{176, 103, 52, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Ukraine", CountryCode: "ua", City: "Kiev", Hostname: "iev-002.vpn.privado.io", IP: net.IP{176, 103, 53, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Turkey", CountryCode: "tr", City: "Istanbul", Hostname: "ist-001.vpn.privado.io", IP: net.IP{185, 84, 183, 3}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Turkey", CountryCode: "tr", City: "Istanbul", Hostname: "ist-002.vpn.privado.io", IP: net.IP{185, 84, 183, 4}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "New York", Hostname: "jfk-001.vpn.privado.io", IP: net.IP{217, 138, 208, 99}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "New York", Hostname: "jfk-002.vpn.privado.io", IP: net.IP{217, 138, 208, 106}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "New York", Hostname: "jfk-003.vpn.privado.io", IP: net.IP{217, 138, 208, 113}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "New York", Hostname: "jfk-004.vpn.privado.io", IP: net.IP{217, 138, 208, 120}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "South Africa", CountryCode: "za", City: "Johannesburg", Hostname: "jnb-001.vpn.privado.io", IP: net.IP{172, 107, 93, 131}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "South Africa", CountryCode: "za", City: "Johannesburg", Hostname: "jnb-002.vpn.privado.io", IP: net.IP{172, 107, 93, 137}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Los Angeles", Hostname: "lax-009.vpn.privado.io", IP: net.IP{45, 152, 182, 227}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Los Angeles", Hostname: "lax-010.vpn.privado.io", IP: net.IP{45, 152, 182, 234}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Los Angeles", Hostname: "lax-011.vpn.privado.io", IP: net.IP{45, 152, 182, 241}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Los Angeles", Hostname: "lax-012.vpn.privado.io", IP: net.IP{45, 152, 182, 248}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Portugal", CountryCode: "pt", City: "Lisbon", Hostname: "lis-001.vpn.privado.io", IP: net.IP{89, 26, 243, 153}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Portugal", CountryCode: "pt", City: "Lisbon", Hostname: "lis-002.vpn.privado.io", IP: net.IP{89, 26, 243, 154}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "London", Hostname: "lon-001.vpn.privado.io", IP: net.IP{217, 138, 195, 163}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "London", Hostname: "lon-002.vpn.privado.io", IP: net.IP{217, 138, 195, 170}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "London", Hostname: "lon-003.vpn.privado.io", IP: net.IP{217, 138, 195, 177}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "London", Hostname: "lon-004.vpn.privado.io", IP: net.IP{217, 138, 195, 184}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Spain", CountryCode: "es", City: "Madrid", Hostname: "mad-001.vpn.privado.io", IP: net.IP{217, 138, 218, 131}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "Manchester", Hostname: "man-001.vpn.privado.io", IP: net.IP{217, 138, 196, 131}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "Manchester", Hostname: "man-002.vpn.privado.io", IP: net.IP{217, 138, 196, 138}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "Manchester", Hostname: "man-003.vpn.privado.io", IP: net.IP{217, 138, 196, 145}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "Manchester", Hostname: "man-004.vpn.privado.io", IP: net.IP{217, 138, 196, 152}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Mexico", CountryCode: "mx", City: "Mexico City", Hostname: "mex-001.vpn.privado.io", IP: net.IP{169, 57, 96, 52}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Mexico", CountryCode: "mx", City: "Mexico City", Hostname: "mex-002.vpn.privado.io", IP: net.IP{169, 57, 96, 57}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Miami", Hostname: "mia-001.vpn.privado.io", IP: net.IP{86, 106, 87, 131}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Miami", Hostname: "mia-002.vpn.privado.io", IP: net.IP{86, 106, 87, 138}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Miami", Hostname: "mia-003.vpn.privado.io", IP: net.IP{86, 106, 87, 145}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Miami", Hostname: "mia-004.vpn.privado.io", IP: net.IP{86, 106, 87, 152}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Italy", CountryCode: "it", City: "Milan", Hostname: "mxp-001.vpn.privado.io", IP: net.IP{89, 40, 182, 195}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Italy", CountryCode: "it", City: "Milan", Hostname: "mxp-002.vpn.privado.io", IP: net.IP{89, 40, 182, 201}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Japan", CountryCode: "jp", City: "Tokyo", Hostname: "nrt-001.vpn.privado.io", IP: net.IP{217, 138, 252, 3}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Japan", CountryCode: "jp", City: "Tokyo", Hostname: "nrt-002.vpn.privado.io", IP: net.IP{217, 138, 252, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Japan", CountryCode: "jp", City: "Tokyo", Hostname: "nrt-003.vpn.privado.io", IP: net.IP{217, 138, 252, 17}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Japan", CountryCode: "jp", City: "Tokyo", Hostname: "nrt-004.vpn.privado.io", IP: net.IP{217, 138, 252, 24}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Chicago", Hostname: "ord-001.vpn.privado.io", IP: net.IP{23, 108, 95, 129}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Chicago", Hostname: "ord-002.vpn.privado.io", IP: net.IP{23, 108, 95, 167}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Norway", CountryCode: "no", City: "Oslo", Hostname: "osl-001.vpn.privado.io", IP: net.IP{84, 247, 50, 115}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Norway", CountryCode: "no", City: "Oslo", Hostname: "osl-002.vpn.privado.io", IP: net.IP{84, 247, 50, 119}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Norway", CountryCode: "no", City: "Oslo", Hostname: "osl-003.vpn.privado.io", IP: net.IP{84, 247, 50, 123}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Romania", CountryCode: "ro", City: "Bucharest", Hostname: "otp-001.vpn.privado.io", IP: net.IP{89, 46, 102, 179}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Romania", CountryCode: "ro", City: "Bucharest", Hostname: "otp-002.vpn.privado.io", IP: net.IP{89, 46, 102, 185}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-001.vpn.privado.io", IP: net.IP{91, 148, 236, 10}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-002.vpn.privado.io", IP: net.IP{91, 148, 236, 20}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-003.vpn.privado.io", IP: net.IP{91, 148, 236, 30}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-004.vpn.privado.io", IP: net.IP{91, 148, 236, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-005.vpn.privado.io", IP: net.IP{91, 148, 236, 50}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-006.vpn.privado.io", IP: net.IP{91, 148, 236, 60}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-007.vpn.privado.io", IP: net.IP{91, 148, 236, 70}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "phx-008.vpn.privado.io", IP: net.IP{91, 148, 236, 80}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Czech Republic", CountryCode: "cz", City: "Prague", Hostname: "prg-001.vpn.privado.io", IP: net.IP{185, 216, 35, 99}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Czech Republic", CountryCode: "cz", City: "Prague", Hostname: "prg-002.vpn.privado.io", IP: net.IP{185, 216, 35, 105}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Latvia", CountryCode: "lv", City: "Riga", Hostname: "rix-001.vpn.privado.io", IP: net.IP{109, 248, 149, 35}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Latvia", CountryCode: "lv", City: "Riga", Hostname: "rix-002.vpn.privado.io", IP: net.IP{109, 248, 149, 40}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Iceland", CountryCode: "is", City: "Reykjavik", Hostname: "rkv-001.vpn.privado.io", IP: net.IP{82, 221, 131, 78}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Iceland", CountryCode: "is", City: "Reykjavik", Hostname: "rkv-002.vpn.privado.io", IP: net.IP{82, 221, 131, 127}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Seattle", Hostname: "sea-001.vpn.privado.io", IP: net.IP{23, 81, 208, 96}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "Seattle", Hostname: "sea-002.vpn.privado.io", IP: net.IP{23, 81, 208, 104}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Singapore", CountryCode: "sg", City: "Singapore", Hostname: "sin-001.vpn.privado.io", IP: net.IP{92, 119, 178, 131}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Singapore", CountryCode: "sg", City: "Singapore", Hostname: "sin-002.vpn.privado.io", IP: net.IP{92, 119, 178, 138}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Singapore", CountryCode: "sg", City: "Singapore", Hostname: "sin-003.vpn.privado.io", IP: net.IP{92, 119, 178, 145}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Singapore", CountryCode: "sg", City: "Singapore", Hostname: "sin-004.vpn.privado.io", IP: net.IP{92, 119, 178, 152}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Bulgaria", CountryCode: "bg", City: "Sofia", Hostname: "sof-001.vpn.privado.io", IP: net.IP{217, 138, 221, 163}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Bulgaria", CountryCode: "bg", City: "Sofia", Hostname: "sof-002.vpn.privado.io", IP: net.IP{217, 138, 221, 169}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "St. Louis", Hostname: "stl-001.vpn.privado.io", IP: net.IP{148, 72, 170, 145}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "United States", CountryCode: "us", City: "St. Louis", Hostname: "stl-002.vpn.privado.io", IP: net.IP{148, 72, 172, 82}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Australia", CountryCode: "au", City: "Sydney", Hostname: "syd-001.vpn.privado.io", IP: net.IP{93, 115, 35, 35}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Australia", CountryCode: "au", City: "Sydney", Hostname: "syd-002.vpn.privado.io", IP: net.IP{93, 115, 35, 42}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Australia", CountryCode: "au", City: "Sydney", Hostname: "syd-003.vpn.privado.io", IP: net.IP{93, 115, 35, 49}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Australia", CountryCode: "au", City: "Sydney", Hostname: "syd-004.vpn.privado.io", IP: net.IP{93, 115, 35, 56}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Austria", CountryCode: "at", City: "Vienna", Hostname: "vie-001.vpn.privado.io", IP: net.IP{5, 253, 207, 227}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Austria", CountryCode: "at", City: "Vienna", Hostname: "vie-002.vpn.privado.io", IP: net.IP{5, 253, 207, 234}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Austria", CountryCode: "at", City: "Vienna", Hostname: "vie-003.vpn.privado.io", IP: net.IP{5, 253, 207, 241}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Austria", CountryCode: "at", City: "Vienna", Hostname: "vie-004.vpn.privado.io", IP: net.IP{5, 253, 207, 248}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Lithuania", CountryCode: "lt", City: "Vilnius", Hostname: "vno-001.vpn.privado.io", IP: net.IP{185, 64, 104, 176}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Lithuania", CountryCode: "lt", City: "Vilnius", Hostname: "vno-002.vpn.privado.io", IP: net.IP{185, 64, 104, 180}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Poland", CountryCode: "pl", City: "Warsaw", Hostname: "waw-001.vpn.privado.io", IP: net.IP{217, 138, 209, 163}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Poland", CountryCode: "pl", City: "Warsaw", Hostname: "waw-002.vpn.privado.io", IP: net.IP{217, 138, 209, 164}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Poland", CountryCode: "pl", City: "Warsaw", Hostname: "waw-003.vpn.privado.io", IP: net.IP{217, 138, 209, 165}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Poland", CountryCode: "pl", City: "Warsaw", Hostname: "waw-004.vpn.privado.io", IP: net.IP{217, 138, 209, 166}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Canada", CountryCode: "ca", City: "Montreal", Hostname: "yul-001.vpn.privado.io", IP: net.IP{217, 138, 213, 67}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Canada", CountryCode: "ca", City: "Montreal", Hostname: "yul-002.vpn.privado.io", IP: net.IP{217, 138, 213, 74}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Canada", CountryCode: "ca", City: "Montreal", Hostname: "yul-003.vpn.privado.io", IP: net.IP{217, 138, 213, 81}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Canada", CountryCode: "ca", City: "Montreal", Hostname: "yul-004.vpn.privado.io", IP: net.IP{217, 138, 213, 88}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Canada", CountryCode: "ca", City: "Vancouver", Hostname: "yvr-001.vpn.privado.io", IP: net.IP{71, 19, 248, 57}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Canada", CountryCode: "ca", City: "Vancouver", Hostname: "yvr-002.vpn.privado.io", IP: net.IP{71, 19, 248, 113}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Canada", CountryCode: "ca", City: "Toronto", Hostname: "yyz-003.vpn.privado.io", IP: net.IP{199, 189, 27, 19}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Switzerland", CountryCode: "ch", City: "Zurich", Hostname: "zrh-001.vpn.privado.io", IP: net.IP{185, 156, 175, 195}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Switzerland", CountryCode: "ch", City: "Zurich", Hostname: "zrh-002.vpn.privado.io", IP: net.IP{185, 156, 175, 202}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Switzerland", CountryCode: "ch", City: "Zurich", Hostname: "zrh-003.vpn.privado.io", IP: net.IP{185, 156, 175, 209}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Country: "Switzerland", CountryCode: "ch", City: "Zurich", Hostname: "zrh-004.vpn.privado.io", IP: net.IP{185, 156, 175, 216}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
 	}
 }
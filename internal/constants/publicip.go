@@ -0,0 +1,28 @@
+package constants
+
+const (
+	// PublicIPMethodHTTP obtains the public IP address from HTTP echo services.
+	PublicIPMethodHTTP = "http"
+	// PublicIPMethodDNS obtains the public IP address from DNS servers
+	// answering with the IP address of the client querying them.
+	PublicIPMethodDNS = "dns"
+)
+
+// PublicIPMethodChoices returns the methods to obtain the public IP address.
+func PublicIPMethodChoices() []string {
+	return []string{PublicIPMethodHTTP, PublicIPMethodDNS}
+}
+
+const (
+	// IPInfo is the ipinfo.io geolocation API.
+	IPInfo = "ipinfo"
+	// IPAPI is the ip-api.com geolocation API.
+	IPAPI = "ip-api"
+	// IPData is the ipdata.co geolocation API, which requires an API key.
+	IPData = "ipdata"
+)
+
+// PublicIPGeolocationChoices returns the geolocation APIs which can be used.
+func PublicIPGeolocationChoices() []string {
+	return []string{IPInfo, IPAPI, IPData}
+}
@@ -44,155 +44,155 @@ func PurevpnCityChoices() (choices []string) {
 //nolint:lll
 func PurevpnServers() []models.PurevpnServer {
 	return []models.PurevpnServer{
-		{Region: "Africa", Country: "Algeria", City: "Algiers", IPs: []net.IP{{172, 94, 64, 2}}},
-		{Region: "Africa", Country: "Angola", City: "Benguela", IPs: []net.IP{{45, 115, 26, 2}}},
-		{Region: "Africa", Country: "Cape Verde", City: "Praia", IPs: []net.IP{{45, 74, 25, 2}}},
-		{Region: "Africa", Country: "Egypt", City: "Cairo", IPs: []net.IP{{192, 198, 120, 122}}},
-		{Region: "Africa", Country: "Ethiopia", City: "Addis Ababa", IPs: []net.IP{{104, 250, 178, 4}}},
-		{Region: "Africa", Country: "Ghana", City: "Accra", IPs: []net.IP{{196, 251, 67, 4}}},
-		{Region: "Africa", Country: "Kenya", City: "Mombasa", IPs: []net.IP{{102, 135, 0, 2}}},
-		{Region: "Africa", Country: "Madagascar", City: "Antananarivo", IPs: []net.IP{{206, 123, 156, 131}}},
-		{Region: "Africa", Country: "Mauritania", City: "Nouakchott", IPs: []net.IP{{206, 123, 158, 63}}},
-		{Region: "Africa", Country: "Mauritius", City: "Port Louis", IPs: []net.IP{{104, 250, 181, 4}}},
-		{Region: "Africa", Country: "Morocco", City: "Rabat", IPs: []net.IP{{104, 243, 250, 126}}},
-		{Region: "Africa", Country: "Niger", City: "Niamey", IPs: []net.IP{{206, 123, 157, 131}}},
-		{Region: "Africa", Country: "Nigeria", City: "Suleja", IPs: []net.IP{{102, 165, 25, 38}}},
-		{Region: "Africa", Country: "Senegal", City: "Dakar", IPs: []net.IP{{206, 123, 158, 131}}},
-		{Region: "Africa", Country: "Seychelles", City: "Victoria", IPs: []net.IP{{172, 111, 128, 126}}},
-		{Region: "Africa", Country: "South Africa", City: "Johannesburg", IPs: []net.IP{{45, 74, 45, 2}}},
-		{Region: "Africa", Country: "Tanzania", City: "Dar Es Salaam", IPs: []net.IP{{102, 135, 0, 2}}},
-		{Region: "Africa", Country: "Tunisia", City: "Tunis", IPs: []net.IP{{206, 123, 159, 4}}},
-		{Region: "Asia", Country: "Afghanistan", City: "Kabul", IPs: []net.IP{{172, 111, 208, 2}}},
-		{Region: "Asia", Country: "Armenia", City: "Singapore", IPs: []net.IP{{37, 120, 208, 147}}},
-		{Region: "Asia", Country: "Azerbaijan", City: "Baku", IPs: []net.IP{{104, 250, 177, 4}}},
-		{Region: "Asia", Country: "Bangladesh", City: "Dhaka", IPs: []net.IP{{206, 123, 154, 190}}},
-		{Region: "Asia", Country: "Brunei Darussalam", City: "Bandar Seri Begawan", IPs: []net.IP{{36, 255, 98, 2}}},
-		{Region: "Asia", Country: "Cambodia", City: "Phnom Penh", IPs: []net.IP{{104, 250, 176, 122}}},
-		{Region: "Asia", Country: "India", City: "Chennai", IPs: []net.IP{{129, 227, 107, 242}}},
-		{Region: "Asia", Country: "Indonesia", City: "Jakarta", IPs: []net.IP{{103, 55, 9, 2}}},
-		{Region: "Asia", Country: "Japan", City: "Tokyo", IPs: []net.IP{{172, 94, 56, 2}}},
-		{Region: "Asia", Country: "Kazakhstan", City: "Almaty", IPs: []net.IP{{206, 123, 152, 4}}},
-		{Region: "Asia", Country: "Korea, South", City: "Seoul", IPs: []net.IP{{45, 115, 25, 1}}},
-		{Region: "Asia", Country: "Kyrgyzstan", City: "Bishkek", IPs: []net.IP{{206, 123, 151, 131}}},
-		{Region: "Asia", Country: "Laos", City: "Vientiane", IPs: []net.IP{{206, 123, 153, 4}}},
-		{Region: "Asia", Country: "Macao", City: "Beyrouth", IPs: []net.IP{{104, 243, 240, 121}}},
-		{Region: "Asia", Country: "Malaysia", City: "Johor Baharu", IPs: []net.IP{{103, 28, 90, 54}, {103, 28, 90, 55}, {103, 28, 90, 71}, {103, 28, 90, 72}, {103, 117, 20, 21}, {103, 117, 20, 163}, {103, 117, 20, 164}, {103, 117, 20, 201}}},
-		{Region: "Asia", Country: "Malaysia", City: "Kuala Lumpur", IPs: []net.IP{{104, 250, 160, 4}}},
-		{Region: "Asia", Country: "Mongolia", City: "Ulaanbaatar", IPs: []net.IP{{206, 123, 153, 131}}},
-		{Region: "Asia", Country: "Pakistan", City: "Islamabad", IPs: []net.IP{{104, 250, 187, 3}}},
-		{Region: "Asia", Country: "Papua New Guinea", City: "Port Moresby", IPs: []net.IP{{206, 123, 155, 131}}},
-		{Region: "Asia", Country: "Philippines", City: "Manila", IPs: []net.IP{{129, 227, 119, 84}}},
-		{Region: "Asia", Country: "Sri Lanka", City: "Colombo", IPs: []net.IP{{206, 123, 154, 4}}},
-		{Region: "Asia", Country: "Taiwan", City: "Taipei", IPs: []net.IP{{128, 1, 155, 178}}},
-		{Region: "Asia", Country: "Tajikistan", City: "Dushanbe", IPs: []net.IP{{206, 123, 151, 4}}},
-		{Region: "Asia", Country: "Thailand", City: "Bangkok", IPs: []net.IP{{104, 37, 6, 4}}},
-		{Region: "Asia", Country: "Turkey", City: "Istanbul", IPs: []net.IP{{185, 220, 58, 3}}},
-		{Region: "Asia", Country: "Turkmenistan", City: "Ashgabat", IPs: []net.IP{{206, 123, 152, 131}}},
-		{Region: "Asia", Country: "Uzbekistan", City: "Tashkent", IPs: []net.IP{{206, 123, 150, 131}}},
-		{Region: "Asia", Country: "Vietnam", City: "Hanoi", IPs: []net.IP{{192, 253, 249, 132}}},
-		{Region: "Europe", Country: "Albania", City: "Tirane", IPs: []net.IP{{46, 243, 224, 2}}},
-		{Region: "Europe", Country: "Armenia", City: "Yerevan", IPs: []net.IP{{172, 94, 35, 4}}},
-		{Region: "Europe", Country: "Austria", City: "Vienna", IPs: []net.IP{{172, 94, 109, 2}}},
-		{Region: "Europe", Country: "Belgium", City: "Brussels", IPs: []net.IP{{185, 210, 217, 147}}},
-		{Region: "Europe", Country: "Bosnia and Herzegovina", City: "Sarajevo", IPs: []net.IP{{104, 250, 169, 122}}},
-		{Region: "Europe", Country: "Bulgaria", City: "Sofia", IPs: []net.IP{{217, 138, 221, 114}}},
-		{Region: "Europe", Country: "Croatia", City: "Zagreb", IPs: []net.IP{{104, 250, 163, 2}}},
-		{Region: "Europe", Country: "Cyprus", City: "Nicosia", IPs: []net.IP{{188, 72, 119, 4}}},
-		{Region: "Europe", Country: "Denmark", City: "Copenhagen", IPs: []net.IP{{89, 45, 7, 5}}},
-		{Region: "Europe", Country: "Estonia", City: "Tallinn", IPs: []net.IP{{185, 166, 87, 2}, {188, 72, 111, 4}}},
-		{Region: "Europe", Country: "France", City: "Paris", IPs: []net.IP{{172, 94, 53, 2}, {172, 111, 219, 2}}},
-		{Region: "Europe", Country: "Georgia", City: "Tbilisi", IPs: []net.IP{{141, 101, 156, 2}}},
-		{Region: "Europe", Country: "Germany", City: "Frankfurt", IPs: []net.IP{{172, 94, 8, 4}}},
-		{Region: "Europe", Country: "Germany", City: "Munich", IPs: []net.IP{{172, 94, 8, 4}}},
-		{Region: "Europe", Country: "Germany", City: "Nuremberg", IPs: []net.IP{{172, 94, 125, 2}}},
-		{Region: "Europe", Country: "Greece", City: "Thessaloniki", IPs: []net.IP{{172, 94, 109, 2}}},
-		{Region: "Europe", Country: "Hungary", City: "Budapest", IPs: []net.IP{{172, 111, 129, 2}, {188, 72, 125, 126}}},
-		{Region: "Europe", Country: "Iceland", City: "Reykjavik", IPs: []net.IP{{192, 253, 250, 1}}},
-		{Region: "Europe", Country: "Ireland", City: "Dublin", IPs: []net.IP{{185, 210, 217, 147}}},
-		{Region: "Europe", Country: "Isle of Man", City: "Onchan", IPs: []net.IP{{46, 243, 144, 2}}},
-		{Region: "Europe", Country: "Italy", City: "Milano", IPs: []net.IP{{45, 9, 251, 2}}},
-		{Region: "Europe", Country: "Latvia", City: "RIGA", IPs: []net.IP{{185, 118, 76, 5}}},
-		{Region: "Europe", Country: "Liechtenstein", City: "Vaduz", IPs: []net.IP{{104, 250, 164, 4}}},
-		{Region: "Europe", Country: "Lithuania", City: "Vilnius", IPs: []net.IP{{188, 72, 116, 3}}},
-		{Region: "Europe", Country: "Luxembourg", City: "Luxembourg", IPs: []net.IP{{188, 72, 114, 2}}},
-		{Region: "Europe", Country: "Malta", City: "Sliema", IPs: []net.IP{{46, 243, 241, 4}}},
-		{Region: "Europe", Country: "Monaco", City: "Monaco", IPs: []net.IP{{104, 250, 168, 132}}},
-		{Region: "Europe", Country: "Montenegro", City: "Podgorica", IPs: []net.IP{{104, 250, 165, 121}}},
-		{Region: "Europe", Country: "Netherlands", City: "Amsterdam", IPs: []net.IP{{92, 119, 179, 195}}},
-		{Region: "Europe", Country: "Norway", City: "Oslo", IPs: []net.IP{{82, 102, 22, 211}}},
-		{Region: "Europe", Country: "Poland", City: "Warsaw", IPs: []net.IP{{5, 253, 206, 251}}},
-		{Region: "Europe", Country: "Portugal", City: "Lisbon", IPs: []net.IP{{45, 74, 10, 1}}},
-		{Region: "Europe", Country: "Romania", City: "Bucharest", IPs: []net.IP{{192, 253, 253, 2}}},
-		{Region: "Europe", Country: "Serbia", City: "Niš", IPs: []net.IP{{104, 250, 166, 2}}},
-		{Region: "Europe", Country: "Slovakia", City: "Bratislava", IPs: []net.IP{{188, 72, 112, 3}}},
-		{Region: "Europe", Country: "Slovenia", City: "Ljubljana", IPs: []net.IP{{104, 243, 246, 129}}},
-		{Region: "Europe", Country: "Spain", City: "Barcelona", IPs: []net.IP{{185, 230, 124, 147}}},
-		{Region: "Europe", Country: "Sweden", City: "Stockholm", IPs: []net.IP{{45, 74, 46, 2}}},
-		{Region: "Europe", Country: "Switzerland", City: "Zurich", IPs: []net.IP{{172, 111, 217, 2}}},
-		{Region: "Europe", Country: "United Kingdom", City: "Gosport", IPs: []net.IP{{45, 74, 0, 2}, {45, 74, 62, 2}}},
-		{Region: "Europe", Country: "United Kingdom", City: "London", IPs: []net.IP{{45, 74, 0, 2}, {45, 74, 62, 2}}},
-		{Region: "Europe", Country: "United Kingdom", City: "Maidenhead", IPs: []net.IP{{172, 111, 183, 2}}},
-		{Region: "Europe", Country: "United Kingdom", City: "Manchester", IPs: []net.IP{{172, 111, 183, 2}}},
-		{Region: "Middle East", Country: "Bahrain", City: "Manama", IPs: []net.IP{{46, 243, 150, 4}}},
-		{Region: "Middle East", Country: "Jordan", City: "Amman", IPs: []net.IP{{172, 111, 152, 3}}},
-		{Region: "Middle East", Country: "Kuwait", City: "Kuwait", IPs: []net.IP{{206, 123, 146, 4}}},
-		{Region: "Middle East", Country: "Oman", City: "Salalah", IPs: []net.IP{{46, 243, 148, 125}}},
-		{Region: "Middle East", Country: "Qatar", City: "Doha", IPs: []net.IP{{46, 243, 147, 2}}},
-		{Region: "Middle East", Country: "Saudi Arabia", City: "Jeddah", IPs: []net.IP{{45, 74, 1, 4}}},
-		{Region: "Middle East", Country: "United Arab Emirates", City: "Dubai", IPs: []net.IP{{104, 37, 6, 4}}},
-		{Region: "North America", Country: "Aruba", City: "Oranjestad", IPs: []net.IP{{104, 243, 246, 129}}},
-		{Region: "North America", Country: "Barbados", City: "Bridgetown", IPs: []net.IP{{172, 94, 97, 2}}},
-		{Region: "North America", Country: "Belize", City: "Belmopan", IPs: []net.IP{{104, 243, 241, 4}}},
-		{Region: "North America", Country: "Bermuda", City: "Hamilton", IPs: []net.IP{{172, 94, 76, 2}}},
-		{Region: "North America", Country: "Canada", City: "Montreal", IPs: []net.IP{{172, 94, 7, 2}}},
-		{Region: "North America", Country: "Canada", City: "Toronto", IPs: []net.IP{{172, 94, 7, 2}}},
-		{Region: "North America", Country: "Canada", City: "Vancouver", IPs: []net.IP{{107, 181, 177, 42}}},
-		{Region: "North America", Country: "Cayman Islands", City: "George Town", IPs: []net.IP{{172, 94, 113, 2}}},
-		{Region: "North America", Country: "Costa Rica", City: "San Jose", IPs: []net.IP{{104, 243, 245, 1}}},
-		{Region: "North America", Country: "Dominica", City: "Roseau", IPs: []net.IP{{45, 74, 22, 2}}},
-		{Region: "North America", Country: "Dominican Republic", City: "Santo Domingo", IPs: []net.IP{{45, 74, 23, 129}}},
-		{Region: "North America", Country: "El Salvador", City: "San Salvador", IPs: []net.IP{{45, 74, 17, 129}}},
-		{Region: "North America", Country: "Grenada", City: "St George's", IPs: []net.IP{{45, 74, 21, 129}}},
-		{Region: "North America", Country: "Guatemala", City: "Guatemala", IPs: []net.IP{{45, 74, 17, 2}}},
-		{Region: "North America", Country: "Haiti", City: "PORT-AU-PRINCE", IPs: []net.IP{{45, 74, 24, 2}}},
-		{Region: "North America", Country: "Honduras", City: "TEGUCIGALPA", IPs: []net.IP{{45, 74, 18, 2}}},
-		{Region: "North America", Country: "Jamaica", City: "Kingston", IPs: []net.IP{{104, 250, 182, 126}}},
-		{Region: "North America", Country: "Mexico", City: "Mexico City", IPs: []net.IP{{104, 243, 243, 131}}},
-		{Region: "North America", Country: "Montserrat", City: "plymouth", IPs: []net.IP{{45, 74, 26, 190}}},
-		{Region: "North America", Country: "Puerto Rico", City: "San Juan", IPs: []net.IP{{104, 37, 2, 2}}},
-		{Region: "North America", Country: "Saint Lucia", City: "Castries", IPs: []net.IP{{45, 74, 23, 2}}},
-		{Region: "North America", Country: "The Bahamas", City: "Freeport", IPs: []net.IP{{104, 243, 242, 2}}},
-		{Region: "North America", Country: "Trinidad and Tobago", City: "Port of Spain", IPs: []net.IP{{45, 74, 21, 2}}},
-		{Region: "North America", Country: "Turks and Caicos Islands", City: "Balfour Town", IPs: []net.IP{{45, 74, 24, 129}}},
-		{Region: "North America", Country: "United States", City: "Ashburn", IPs: []net.IP{{46, 243, 249, 2}}},
-		{Region: "North America", Country: "United States", City: "Chicago", IPs: []net.IP{{46, 243, 249, 4}}},
-		{Region: "North America", Country: "United States", City: "Columbus", IPs: []net.IP{{172, 94, 115, 2}}},
-		{Region: "North America", Country: "United States", City: "Georgia", IPs: []net.IP{{141, 101, 168, 4}}},
-		{Region: "North America", Country: "United States", City: "Houston", IPs: []net.IP{{172, 94, 1, 4}}},
-		{Region: "North America", Country: "United States", City: "Los Angeles", IPs: []net.IP{{141, 101, 169, 4}}},
-		{Region: "North America", Country: "United States", City: "Miami", IPs: []net.IP{{5, 254, 79, 114}}},
-		{Region: "North America", Country: "United States", City: "New Jersey", IPs: []net.IP{{172, 94, 1, 4}}},
-		{Region: "North America", Country: "United States", City: "New York", IPs: []net.IP{{172, 94, 1, 4}}},
-		{Region: "North America", Country: "United States", City: "Phoenix", IPs: []net.IP{{172, 94, 26, 4}}},
-		{Region: "North America", Country: "United States", City: "Salt Lake City", IPs: []net.IP{{141, 101, 168, 4}}},
-		{Region: "North America", Country: "United States", City: "San Francisco", IPs: []net.IP{{172, 94, 1, 4}}},
-		{Region: "North America", Country: "United States", City: "Seattle", IPs: []net.IP{{172, 94, 86, 2}}},
-		{Region: "North America", Country: "United States", City: "Washington, D.C.", IPs: []net.IP{{141, 101, 169, 4}}},
-		{Region: "Oceania", Country: "Australia", City: "Brisbane", IPs: []net.IP{{172, 111, 236, 2}}},
-		{Region: "Oceania", Country: "Australia", City: "Melbourne", IPs: []net.IP{{118, 127, 62, 2}}},
-		{Region: "Oceania", Country: "Australia", City: "Sydney", IPs: []net.IP{{192, 253, 241, 2}}},
-		{Region: "Oceania", Country: "New Zealand", City: "Auckland", IPs: []net.IP{{43, 228, 156, 4}}},
-		{Region: "South America", Country: "Argentina", City: "Buenos Aires", IPs: []net.IP{{104, 243, 244, 1}}},
-		{Region: "South America", Country: "Bolivia", City: "Sucre", IPs: []net.IP{{172, 94, 77, 2}}},
-		{Region: "South America", Country: "Brazil", City: "Sao Paulo", IPs: []net.IP{{104, 243, 244, 2}}},
-		{Region: "South America", Country: "British Virgin Island", City: "Road Town", IPs: []net.IP{{104, 250, 184, 130}}},
-		{Region: "South America", Country: "Chile", City: "Santiago", IPs: []net.IP{{191, 96, 183, 251}}},
-		{Region: "South America", Country: "Colombia", City: "Bogota", IPs: []net.IP{{172, 111, 132, 1}}},
-		{Region: "South America", Country: "Ecuador", City: "Quito", IPs: []net.IP{{104, 250, 180, 126}}},
-		{Region: "South America", Country: "Guyana", City: "Georgetown", IPs: []net.IP{{45, 74, 20, 129}}},
-		{Region: "South America", Country: "Panama", City: "Panama City", IPs: []net.IP{{104, 243, 243, 131}}},
-		{Region: "South America", Country: "Paraguay", City: "Asuncion", IPs: []net.IP{{45, 74, 19, 129}}},
-		{Region: "South America", Country: "Peru", City: "Lima", IPs: []net.IP{{172, 111, 131, 1}}},
-		{Region: "South America", Country: "Suriname", City: "Paramaribo", IPs: []net.IP{{45, 74, 20, 4}}},
+		{Region: "Africa", Country: "Algeria", CountryCode: "dz", City: "Algiers", Hostname: "dz2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 64, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Angola", CountryCode: "ao", City: "Benguela", Hostname: "ao2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 115, 26, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Cape Verde", CountryCode: "cv", City: "Praia", Hostname: "cv2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 25, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Egypt", CountryCode: "eg", City: "Cairo", Hostname: "eg2-auto-udp.ptoserver.com", IPs: []net.IP{{192, 198, 120, 122}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Ethiopia", CountryCode: "et", City: "Addis Ababa", Hostname: "et2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 178, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Ghana", CountryCode: "gh", City: "Accra", Hostname: "gh2-auto-udp.ptoserver.com", IPs: []net.IP{{196, 251, 67, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Kenya", CountryCode: "ke", City: "Mombasa", Hostname: "ke2-auto-udp.ptoserver.com", IPs: []net.IP{{102, 135, 0, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Madagascar", CountryCode: "mg", City: "Antananarivo", Hostname: "mg2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 156, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Mauritania", CountryCode: "mr", City: "Nouakchott", Hostname: "mr2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 158, 63}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Mauritius", CountryCode: "mu", City: "Port Louis", Hostname: "mu2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 181, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Morocco", CountryCode: "ma", City: "Rabat", Hostname: "ma2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 250, 126}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Niger", CountryCode: "ne", City: "Niamey", Hostname: "ne2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 157, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Nigeria", CountryCode: "ng", City: "Suleja", Hostname: "ng2-auto-udp.ptoserver.com", IPs: []net.IP{{102, 165, 25, 38}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Senegal", CountryCode: "sn", City: "Dakar", Hostname: "sn2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 158, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Seychelles", CountryCode: "sc", City: "Victoria", Hostname: "sc2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 128, 126}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "South Africa", CountryCode: "za", City: "Johannesburg", Hostname: "za2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 45, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Tanzania", CountryCode: "tz", City: "Dar Es Salaam", Hostname: "tz2-auto-udp.ptoserver.com", IPs: []net.IP{{102, 135, 0, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Africa", Country: "Tunisia", CountryCode: "tn", City: "Tunis", Hostname: "tn2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 159, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Afghanistan", CountryCode: "af", City: "Kabul", Hostname: "af2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 208, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Armenia", CountryCode: "am", City: "Singapore", Hostname: "amsin2-auto-udp.ptoserver.com", IPs: []net.IP{{37, 120, 208, 147}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Azerbaijan", CountryCode: "az", City: "Baku", Hostname: "az2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 177, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Bangladesh", CountryCode: "bd", City: "Dhaka", Hostname: "bd2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 154, 190}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Brunei Darussalam", CountryCode: "bn", City: "Bandar Seri Begawan", Hostname: "bn2-auto-udp.ptoserver.com", IPs: []net.IP{{36, 255, 98, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Cambodia", CountryCode: "kh", City: "Phnom Penh", Hostname: "kh2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 176, 122}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "India", CountryCode: "in", City: "Chennai", Hostname: "in2-auto-udp.ptoserver.com", IPs: []net.IP{{129, 227, 107, 242}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Indonesia", CountryCode: "id", City: "Jakarta", Hostname: "id2-auto-udp.ptoserver.com", IPs: []net.IP{{103, 55, 9, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Japan", CountryCode: "jp", City: "Tokyo", Hostname: "jp2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 56, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Kazakhstan", CountryCode: "kz", City: "Almaty", Hostname: "kz2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 152, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Korea, South", CountryCode: "kr", City: "Seoul", Hostname: "kr2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 115, 25, 1}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Kyrgyzstan", CountryCode: "kg", City: "Bishkek", Hostname: "kg2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 151, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Laos", CountryCode: "la", City: "Vientiane", Hostname: "la2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 153, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Macao", CountryCode: "mo", City: "Beyrouth", Hostname: "mo2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 240, 121}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Malaysia", CountryCode: "my", City: "Johor Baharu", Hostname: "myjb2-auto-udp.ptoserver.com", IPs: []net.IP{{103, 28, 90, 54}, {103, 28, 90, 55}, {103, 28, 90, 71}, {103, 28, 90, 72}, {103, 117, 20, 21}, {103, 117, 20, 163}, {103, 117, 20, 164}, {103, 117, 20, 201}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Malaysia", CountryCode: "my", City: "Kuala Lumpur", Hostname: "mykl2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 160, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Mongolia", CountryCode: "mn", City: "Ulaanbaatar", Hostname: "mn2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 153, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Pakistan", CountryCode: "pk", City: "Islamabad", Hostname: "pk2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 187, 3}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Papua New Guinea", CountryCode: "pg", City: "Port Moresby", Hostname: "pg2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 155, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Philippines", CountryCode: "ph", City: "Manila", Hostname: "ph2-auto-udp.ptoserver.com", IPs: []net.IP{{129, 227, 119, 84}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Sri Lanka", CountryCode: "lk", City: "Colombo", Hostname: "lk2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 154, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Taiwan", CountryCode: "tw", City: "Taipei", Hostname: "tw2-auto-udp.ptoserver.com", IPs: []net.IP{{128, 1, 155, 178}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Tajikistan", CountryCode: "tj", City: "Dushanbe", Hostname: "tj2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 151, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Thailand", CountryCode: "th", City: "Bangkok", Hostname: "th2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 37, 6, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Turkey", CountryCode: "tr", City: "Istanbul", Hostname: "tr2-auto-udp.ptoserver.com", IPs: []net.IP{{185, 220, 58, 3}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Turkmenistan", CountryCode: "tm", City: "Ashgabat", Hostname: "tm2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 152, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Uzbekistan", CountryCode: "uz", City: "Tashkent", Hostname: "uz2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 150, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Asia", Country: "Vietnam", CountryCode: "vn", City: "Hanoi", Hostname: "vn2-auto-udp.ptoserver.com", IPs: []net.IP{{192, 253, 249, 132}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Albania", CountryCode: "al", City: "Tirane", Hostname: "al2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 224, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Armenia", CountryCode: "am", City: "Yerevan", Hostname: "amyer2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 35, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Austria", CountryCode: "at", City: "Vienna", Hostname: "at2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 109, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Belgium", CountryCode: "be", City: "Brussels", Hostname: "be2-auto-udp.ptoserver.com", IPs: []net.IP{{185, 210, 217, 147}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Bosnia and Herzegovina", CountryCode: "ba", City: "Sarajevo", Hostname: "ba2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 169, 122}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Bulgaria", CountryCode: "bg", City: "Sofia", Hostname: "bg2-auto-udp.ptoserver.com", IPs: []net.IP{{217, 138, 221, 114}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Croatia", CountryCode: "hr", City: "Zagreb", Hostname: "hr2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 163, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Cyprus", CountryCode: "cy", City: "Nicosia", Hostname: "cy2-auto-udp.ptoserver.com", IPs: []net.IP{{188, 72, 119, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Denmark", CountryCode: "dk", City: "Copenhagen", Hostname: "dk2-auto-udp.ptoserver.com", IPs: []net.IP{{89, 45, 7, 5}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Estonia", CountryCode: "ee", City: "Tallinn", Hostname: "ee2-auto-udp.ptoserver.com", IPs: []net.IP{{185, 166, 87, 2}, {188, 72, 111, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "France", CountryCode: "fr", City: "Paris", Hostname: "fr2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 53, 2}, {172, 111, 219, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Georgia", CountryCode: "ge", City: "Tbilisi", Hostname: "ge2-auto-udp.ptoserver.com", IPs: []net.IP{{141, 101, 156, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "defra2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 8, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Germany", CountryCode: "de", City: "Munich", Hostname: "demun2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 8, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Germany", CountryCode: "de", City: "Nuremberg", Hostname: "denur2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 125, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Greece", CountryCode: "gr", City: "Thessaloniki", Hostname: "gr2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 109, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Hungary", CountryCode: "hu", City: "Budapest", Hostname: "hu2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 129, 2}, {188, 72, 125, 126}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Iceland", CountryCode: "is", City: "Reykjavik", Hostname: "is2-auto-udp.ptoserver.com", IPs: []net.IP{{192, 253, 250, 1}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Ireland", CountryCode: "ie", City: "Dublin", Hostname: "ie2-auto-udp.ptoserver.com", IPs: []net.IP{{185, 210, 217, 147}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Isle of Man", CountryCode: "im", City: "Onchan", Hostname: "im2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 144, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Italy", CountryCode: "it", City: "Milano", Hostname: "it2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 9, 251, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Latvia", CountryCode: "lv", City: "RIGA", Hostname: "lv2-auto-udp.ptoserver.com", IPs: []net.IP{{185, 118, 76, 5}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Liechtenstein", CountryCode: "li", City: "Vaduz", Hostname: "li2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 164, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Lithuania", CountryCode: "lt", City: "Vilnius", Hostname: "lt2-auto-udp.ptoserver.com", IPs: []net.IP{{188, 72, 116, 3}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Luxembourg", CountryCode: "lu", City: "Luxembourg", Hostname: "lu2-auto-udp.ptoserver.com", IPs: []net.IP{{188, 72, 114, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Malta", CountryCode: "mt", City: "Sliema", Hostname: "mt2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 241, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Monaco", CountryCode: "mc", City: "Monaco", Hostname: "mc2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 168, 132}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Montenegro", CountryCode: "me", City: "Podgorica", Hostname: "me2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 165, 121}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "nl2-auto-udp.ptoserver.com", IPs: []net.IP{{92, 119, 179, 195}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Norway", CountryCode: "no", City: "Oslo", Hostname: "no2-auto-udp.ptoserver.com", IPs: []net.IP{{82, 102, 22, 211}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Poland", CountryCode: "pl", City: "Warsaw", Hostname: "pl2-auto-udp.ptoserver.com", IPs: []net.IP{{5, 253, 206, 251}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Portugal", CountryCode: "pt", City: "Lisbon", Hostname: "pt2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 10, 1}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Romania", CountryCode: "ro", City: "Bucharest", Hostname: "ro2-auto-udp.ptoserver.com", IPs: []net.IP{{192, 253, 253, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Serbia", CountryCode: "rs", City: "Niš", Hostname: "rs2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 166, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Slovakia", CountryCode: "sk", City: "Bratislava", Hostname: "sk2-auto-udp.ptoserver.com", IPs: []net.IP{{188, 72, 112, 3}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Slovenia", CountryCode: "si", City: "Ljubljana", Hostname: "si2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 246, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Spain", CountryCode: "es", City: "Barcelona", Hostname: "es2-auto-udp.ptoserver.com", IPs: []net.IP{{185, 230, 124, 147}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Sweden", CountryCode: "se", City: "Stockholm", Hostname: "se2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 46, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "Switzerland", CountryCode: "ch", City: "Zurich", Hostname: "ch2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 217, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "United Kingdom", CountryCode: "gb", City: "Gosport", Hostname: "gbgos2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 0, 2}, {45, 74, 62, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "United Kingdom", CountryCode: "gb", City: "London", Hostname: "gblon2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 0, 2}, {45, 74, 62, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "United Kingdom", CountryCode: "gb", City: "Maidenhead", Hostname: "gbmai2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 183, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Europe", Country: "United Kingdom", CountryCode: "gb", City: "Manchester", Hostname: "gbman2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 183, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Middle East", Country: "Bahrain", CountryCode: "bh", City: "Manama", Hostname: "bh2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 150, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Middle East", Country: "Jordan", CountryCode: "jo", City: "Amman", Hostname: "jo2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 152, 3}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Middle East", Country: "Kuwait", CountryCode: "kw", City: "Kuwait", Hostname: "kw2-auto-udp.ptoserver.com", IPs: []net.IP{{206, 123, 146, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Middle East", Country: "Oman", CountryCode: "om", City: "Salalah", Hostname: "om2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 148, 125}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Middle East", Country: "Qatar", CountryCode: "qa", City: "Doha", Hostname: "qa2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 147, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Middle East", Country: "Saudi Arabia", CountryCode: "sa", City: "Jeddah", Hostname: "sa2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 1, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Middle East", Country: "United Arab Emirates", CountryCode: "ae", City: "Dubai", Hostname: "ae2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 37, 6, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Aruba", CountryCode: "aw", City: "Oranjestad", Hostname: "aw2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 246, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Barbados", CountryCode: "bb", City: "Bridgetown", Hostname: "bb2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 97, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Belize", CountryCode: "bz", City: "Belmopan", Hostname: "bz2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 241, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Bermuda", CountryCode: "bm", City: "Hamilton", Hostname: "bm2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 76, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Canada", CountryCode: "ca", City: "Montreal", Hostname: "camon2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 7, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Canada", CountryCode: "ca", City: "Toronto", Hostname: "cator2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 7, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Canada", CountryCode: "ca", City: "Vancouver", Hostname: "cavan2-auto-udp.ptoserver.com", IPs: []net.IP{{107, 181, 177, 42}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Cayman Islands", CountryCode: "ky", City: "George Town", Hostname: "ky2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 113, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Costa Rica", CountryCode: "cr", City: "San Jose", Hostname: "cr2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 245, 1}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Dominica", CountryCode: "dm", City: "Roseau", Hostname: "dm2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 22, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Dominican Republic", CountryCode: "do", City: "Santo Domingo", Hostname: "do2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 23, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "El Salvador", CountryCode: "sv", City: "San Salvador", Hostname: "sv2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 17, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Grenada", CountryCode: "gd", City: "St George's", Hostname: "gd2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 21, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Guatemala", CountryCode: "gt", City: "Guatemala", Hostname: "gt2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 17, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Haiti", CountryCode: "ht", City: "PORT-AU-PRINCE", Hostname: "ht2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 24, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Honduras", CountryCode: "hn", City: "TEGUCIGALPA", Hostname: "hn2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 18, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Jamaica", CountryCode: "jm", City: "Kingston", Hostname: "jm2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 182, 126}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Mexico", CountryCode: "mx", City: "Mexico City", Hostname: "mx2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 243, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Montserrat", CountryCode: "ms", City: "plymouth", Hostname: "ms2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 26, 190}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Puerto Rico", CountryCode: "pr", City: "San Juan", Hostname: "pr2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 37, 2, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Saint Lucia", CountryCode: "lc", City: "Castries", Hostname: "lc2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 23, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "The Bahamas", CountryCode: "bs", City: "Freeport", Hostname: "bs2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 242, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Trinidad and Tobago", CountryCode: "tt", City: "Port of Spain", Hostname: "tt2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 21, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "Turks and Caicos Islands", CountryCode: "tc", City: "Balfour Town", Hostname: "tc2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 24, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Ashburn", Hostname: "usash2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 249, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Chicago", Hostname: "uschi2-auto-udp.ptoserver.com", IPs: []net.IP{{46, 243, 249, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Columbus", Hostname: "uscol2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 115, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Georgia", Hostname: "usgeo2-auto-udp.ptoserver.com", IPs: []net.IP{{141, 101, 168, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Houston", Hostname: "ushou2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 1, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Los Angeles", Hostname: "usla2-auto-udp.ptoserver.com", IPs: []net.IP{{141, 101, 169, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Miami", Hostname: "usmia2-auto-udp.ptoserver.com", IPs: []net.IP{{5, 254, 79, 114}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "New Jersey", Hostname: "usnj2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 1, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "New York", Hostname: "usny2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 1, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Phoenix", Hostname: "uspho2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 26, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Salt Lake City", Hostname: "usslc2-auto-udp.ptoserver.com", IPs: []net.IP{{141, 101, 168, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "San Francisco", Hostname: "ussf2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 1, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Seattle", Hostname: "ussea2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 86, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North America", Country: "United States", CountryCode: "us", City: "Washington, D.C.", Hostname: "uswdc2-auto-udp.ptoserver.com", IPs: []net.IP{{141, 101, 169, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Oceania", Country: "Australia", CountryCode: "au", City: "Brisbane", Hostname: "aubri2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 236, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Oceania", Country: "Australia", CountryCode: "au", City: "Melbourne", Hostname: "aumel2-auto-udp.ptoserver.com", IPs: []net.IP{{118, 127, 62, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Oceania", Country: "Australia", CountryCode: "au", City: "Sydney", Hostname: "ausyd2-auto-udp.ptoserver.com", IPs: []net.IP{{192, 253, 241, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Oceania", Country: "New Zealand", CountryCode: "nz", City: "Auckland", Hostname: "nz2-auto-udp.ptoserver.com", IPs: []net.IP{{43, 228, 156, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Argentina", CountryCode: "ar", City: "Buenos Aires", Hostname: "ar2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 244, 1}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Bolivia", CountryCode: "bo", City: "Sucre", Hostname: "bo2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 94, 77, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Brazil", CountryCode: "br", City: "Sao Paulo", Hostname: "br2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 244, 2}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "British Virgin Island", CountryCode: "vg", City: "Road Town", Hostname: "vg2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 184, 130}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Chile", CountryCode: "cl", City: "Santiago", Hostname: "cl2-auto-udp.ptoserver.com", IPs: []net.IP{{191, 96, 183, 251}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Colombia", CountryCode: "co", City: "Bogota", Hostname: "co2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 132, 1}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Ecuador", CountryCode: "ec", City: "Quito", Hostname: "ec2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 250, 180, 126}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Guyana", CountryCode: "gy", City: "Georgetown", Hostname: "gy2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 20, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Panama", CountryCode: "pa", City: "Panama City", Hostname: "pa2-auto-udp.ptoserver.com", IPs: []net.IP{{104, 243, 243, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Paraguay", CountryCode: "py", City: "Asuncion", Hostname: "py2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 19, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Peru", CountryCode: "pe", City: "Lima", Hostname: "pe2-auto-udp.ptoserver.com", IPs: []net.IP{{172, 111, 131, 1}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South America", Country: "Suriname", CountryCode: "sr", City: "Paramaribo", Hostname: "sr2-auto-udp.ptoserver.com", IPs: []net.IP{{45, 74, 20, 4}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
 	}
 }
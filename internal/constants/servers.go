@@ -7,45 +7,60 @@ func GetAllServers() (allServers models.AllServers) {
 	return models.AllServers{
 		Version: 1, // used for migration of the top level scheme
 		Cyberghost: models.CyberghostServers{
-			Version:   1,          // model version
+			Version:   3,          // model version
 			Timestamp: 1599323261, // latest takes precedence
 			Servers:   CyberghostServers(),
 		},
-		Mullvad: models.MullvadServers{
+		HideMyAss: models.HideMyAssServers{
 			Version:   1,
+			Timestamp: 0, // IP addresses are only known once updated
+			Servers:   HideMyAssServers(),
+		},
+		Mullvad: models.MullvadServers{
+			Version:   4,
 			Timestamp: 1603660367,
 			Servers:   MullvadServers(),
 		},
 		Nordvpn: models.NordvpnServers{
-			Version:   1,
+			Version:   3,
 			Timestamp: 1599323261,
 			Servers:   NordvpnServers(),
 		},
 		Pia: models.PiaServers{
-			Version:   2,
+			Version:   3,
 			Timestamp: 1605392393,
 			Servers:   PIAServers(),
 		},
 		Purevpn: models.PurevpnServers{
-			Version:   1,
+			Version:   4,
 			Timestamp: 1599323261,
 			Servers:   PurevpnServers(),
 		},
 		Privado: models.PrivadoServers{
-			Version:   2,
+			Version:   4,
 			Timestamp: 1604963273,
 			Servers:   PrivadoServers(),
 		},
 		Surfshark: models.SurfsharkServers{
-			Version:   1,
+			Version:   3,
 			Timestamp: 1599957644,
 			Servers:   SurfsharkServers(),
 		},
-		Vyprvpn: models.VyprvpnServers{
+		VPNUnlimited: models.VPNUnlimitedServers{
 			Version:   1,
+			Timestamp: 0, // IP addresses are only known once updated
+			Servers:   VPNUnlimitedServers(),
+		},
+		Vyprvpn: models.VyprvpnServers{
+			Version:   3,
 			Timestamp: 1599323261,
 			Servers:   VyprvpnServers(),
 		},
+		WeVPN: models.WeVPNServers{
+			Version:   1,
+			Timestamp: 0, // IP addresses are only known once updated
+			Servers:   WeVPNServers(),
+		},
 		Windscribe: models.WindscribeServers{
 			Version:   2,
 			Timestamp: 1604019438,
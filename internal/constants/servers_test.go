@@ -37,42 +37,57 @@ func Test_versions(t *testing.T) {
 		"Cyberghost": {
 			model:   models.CyberghostServer{},
 			version: allServers.Cyberghost.Version,
-			digest:  "fd6242bb",
+			digest:  "706a0fc4",
+		},
+		"HideMyAss": {
+			model:   models.HideMyAssServer{},
+			version: allServers.HideMyAss.Version,
+			digest:  "469aa723",
 		},
 		"Mullvad": {
 			model:   models.MullvadServer{},
 			version: allServers.Mullvad.Version,
-			digest:  "665e9dc1",
+			digest:  "bf6dd9ac",
 		},
 		"Nordvpn": {
 			model:   models.NordvpnServer{},
 			version: allServers.Nordvpn.Version,
-			digest:  "040de8d0",
+			digest:  "3981bca5",
 		},
 		"Private Internet Access": {
 			model:   models.PIAServer{},
 			version: allServers.Pia.Version,
-			digest:  "f1e01afe",
+			digest:  "6d38c1ad",
 		},
 		"Privado": {
 			model:   models.PrivadoServer{},
 			version: allServers.Privado.Version,
-			digest:  "1d5aeb23",
+			digest:  "a657ad37",
 		},
 		"Purevpn": {
 			model:   models.PurevpnServer{},
 			version: allServers.Purevpn.Version,
-			digest:  "cc1a2219",
+			digest:  "b18884bd",
 		},
 		"Surfshark": {
 			model:   models.SurfsharkServer{},
 			version: allServers.Surfshark.Version,
-			digest:  "042bef64",
+			digest:  "1f1ee96f",
 		},
 		"Vyprvpn": {
 			model:   models.VyprvpnServer{},
 			version: allServers.Vyprvpn.Version,
-			digest:  "042bef64",
+			digest:  "1f1ee96f",
+		},
+		"VPN Unlimited": {
+			model:   models.VPNUnlimitedServer{},
+			version: allServers.VPNUnlimited.Version,
+			digest:  "c9efd5dd",
+		},
+		"WeVPN": {
+			model:   models.WeVPNServer{},
+			version: allServers.WeVPN.Version,
+			digest:  "cdf9c97a",
 		},
 		"Windscribe": {
 			model:   models.WindscribeServer{},
@@ -118,17 +133,22 @@ func Test_timestamps(t *testing.T) {
 		"Cyberghost": {
 			servers:   allServers.Cyberghost.Servers,
 			timestamp: allServers.Cyberghost.Timestamp,
-			digest:    "160631de",
+			digest:    "1a3deb8f",
+		},
+		"HideMyAss": {
+			servers:   allServers.HideMyAss.Servers,
+			timestamp: allServers.HideMyAss.Timestamp,
+			digest:    "8c8b175a",
 		},
 		"Mullvad": {
 			servers:   allServers.Mullvad.Servers,
 			timestamp: allServers.Mullvad.Timestamp,
-			digest:    "93859652",
+			digest:    "83a0373c",
 		},
 		"Nordvpn": {
 			servers:   allServers.Nordvpn.Servers,
 			timestamp: allServers.Nordvpn.Timestamp,
-			digest:    "9fc9a579",
+			digest:    "a2746199",
 		},
 		"Private Internet Access": {
 			servers:   allServers.Pia.Servers,
@@ -138,22 +158,32 @@ func Test_timestamps(t *testing.T) {
 		"Purevpn": {
 			servers:   allServers.Purevpn.Servers,
 			timestamp: allServers.Purevpn.Timestamp,
-			digest:    "cdf9b708",
+			digest:    "dc6ca500",
 		},
 		"Privado": {
 			servers:   allServers.Privado.Servers,
 			timestamp: allServers.Privado.Timestamp,
-			digest:    "df2a046d",
+			digest:    "40b864bc",
 		},
 		"Surfshark": {
 			servers:   allServers.Surfshark.Servers,
 			timestamp: allServers.Surfshark.Timestamp,
-			digest:    "79484811",
+			digest:    "25664b2a",
 		},
 		"Vyprvpn": {
 			servers:   allServers.Vyprvpn.Servers,
 			timestamp: allServers.Vyprvpn.Timestamp,
-			digest:    "1992457c",
+			digest:    "ce4638c4",
+		},
+		"VPN Unlimited": {
+			servers:   allServers.VPNUnlimited.Servers,
+			timestamp: allServers.VPNUnlimited.Timestamp,
+			digest:    "c8936543",
+		},
+		"WeVPN": {
+			servers:   allServers.WeVPN.Servers,
+			timestamp: allServers.WeVPN.Timestamp,
+			digest:    "ce6e9ac0",
 		},
 		"Windscribe": {
 			servers:   allServers.Windscribe.Servers,
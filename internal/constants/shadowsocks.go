@@ -0,0 +1,20 @@
+package constants
+
+// ShadowsocksDefaultListener is the name of the Shadowsocks listener
+// configured with SHADOWSOCKS_PORT, SHADOWSOCKS_METHOD and SHADOWSOCKS_PASSWORD.
+const ShadowsocksDefaultListener = "default"
+
+const (
+	ShadowsocksChacha20IetfPoly1305 = "chacha20-ietf-poly1305"
+	ShadowsocksAES128GCM            = "aes-128-gcm"
+	ShadowsocksAES256GCM            = "aes-256-gcm"
+)
+
+// ShadowsocksCipherChoices returns the AEAD ciphers supported by the Shadowsocks server.
+func ShadowsocksCipherChoices() []string {
+	return []string{
+		ShadowsocksChacha20IetfPoly1305,
+		ShadowsocksAES128GCM,
+		ShadowsocksAES256GCM,
+	}
+}
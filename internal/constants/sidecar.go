@@ -0,0 +1,11 @@
+package constants
+
+const (
+	// SidecarProcess watches a process by name, which requires the
+	// process namespace to be shared between containers.
+	SidecarProcess = "process"
+	// SidecarFile watches for a file to be created.
+	SidecarFile = "file"
+	// SidecarHTTP watches an HTTP endpoint to stop responding.
+	SidecarHTTP = "http"
+)
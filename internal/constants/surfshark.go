@@ -23,163 +23,163 @@ func SurfsharkRegionChoices() (choices []string) {
 
 func SurfsharkServers() []models.SurfsharkServer {
 	return []models.SurfsharkServer{
-		{Region: "Albania", IPs: []net.IP{{31, 171, 152, 197}, {31, 171, 154, 147}}},
-		{Region: "Argentina Buenos Aires", IPs: []net.IP{{91, 206, 168, 13}, {91, 206, 168, 24}}},
-		{Region: "Australia Adelaide", IPs: []net.IP{{45, 248, 79, 67}, {45, 248, 79, 69}}},
-		{Region: "Australia Brisbane", IPs: []net.IP{{144, 48, 39, 107}, {144, 48, 39, 131}}},
-		{Region: "Australia Melbourne", IPs: []net.IP{{103, 192, 80, 141}, {144, 48, 38, 141}}},
-		{Region: "Australia Perth", IPs: []net.IP{{45, 248, 78, 45}, {124, 150, 139, 27}}},
-		{Region: "Australia Sydney", IPs: []net.IP{{45, 125, 247, 195}, {180, 149, 228, 117}}},
-		{Region: "Australia US", IPs: []net.IP{{45, 76, 117, 108}}},
-		{Region: "Austria", IPs: []net.IP{{5, 253, 207, 83}, {37, 120, 212, 131}}},
-		{Region: "Azerbaijan", IPs: []net.IP{{94, 20, 21, 85}, {94, 20, 21, 87}}},
-		{Region: "Belgium", IPs: []net.IP{{5, 253, 205, 181}, {5, 253, 205, 213}}},
-		{Region: "Bosnia and Herzegovina", IPs: []net.IP{{185, 99, 3, 7}, {185, 212, 111, 41}}},
-		{Region: "Brazil", IPs: []net.IP{{191, 96, 73, 214}, {191, 96, 73, 216}}},
-		{Region: "Bulgaria", IPs: []net.IP{{37, 120, 152, 37}}},
-		{Region: "Canada Montreal", IPs: []net.IP{{172, 98, 82, 85}, {198, 8, 85, 19}}},
-		{Region: "Canada Toronto", IPs: []net.IP{{68, 71, 244, 200}, {104, 200, 138, 163}}},
-		{Region: "Canada Toronto mp001", IPs: []net.IP{{138, 197, 151, 26}}},
-		{Region: "Canada US", IPs: []net.IP{{159, 203, 57, 80}}},
-		{Region: "Canada Vancouver", IPs: []net.IP{{66, 115, 147, 79}, {66, 115, 147, 87}}},
-		{Region: "Chile", IPs: []net.IP{{31, 169, 121, 16}}},
-		{Region: "Colombia", IPs: []net.IP{{45, 129, 32, 8}}},
-		{Region: "Costa Rica", IPs: []net.IP{{176, 227, 241, 19}, {176, 227, 241, 21}}},
-		{Region: "Croatia", IPs: []net.IP{{89, 164, 99, 109}, {89, 164, 99, 111}}},
-		{Region: "Cyprus", IPs: []net.IP{{195, 47, 194, 34}}},
-		{Region: "Czech Republic", IPs: []net.IP{{185, 152, 64, 151}, {185, 152, 64, 178}}},
-		{Region: "Denmark", IPs: []net.IP{{37, 120, 194, 115}, {95, 174, 65, 71}}},
-		{Region: "Estonia", IPs: []net.IP{{165, 231, 163, 23}, {185, 174, 159, 69}}},
-		{Region: "Finland", IPs: []net.IP{{196, 244, 191, 179}, {196, 244, 191, 181}}},
-		{Region: "France Bordeaux", IPs: []net.IP{{185, 108, 106, 67}, {185, 108, 106, 150}}},
-		{Region: "France Marseilles", IPs: []net.IP{{185, 166, 84, 53}, {185, 166, 84, 75}}},
-		{Region: "France Paris", IPs: []net.IP{{45, 83, 90, 181}, {45, 89, 174, 103}}},
-		{Region: "France Sweden", IPs: []net.IP{{199, 247, 8, 20}}},
-		{Region: "Germany Berlin", IPs: []net.IP{{152, 89, 163, 19}, {217, 138, 216, 243}}},
-		{Region: "Germany Frankfurt am Main", IPs: []net.IP{{185, 158, 135, 36}, {185, 220, 70, 83}}},
-		{Region: "Germany Frankfurt am Main st001", IPs: []net.IP{{45, 87, 212, 179}}},
-		{Region: "Germany Frankfurt am Main st002", IPs: []net.IP{{45, 87, 212, 181}}},
-		{Region: "Germany Frankfurt am Main st003", IPs: []net.IP{{45, 87, 212, 183}}},
-		{Region: "Germany Frankfurt mp001", IPs: []net.IP{{46, 101, 189, 14}}},
-		{Region: "Germany Munich", IPs: []net.IP{{178, 238, 231, 51}, {178, 238, 231, 55}}},
-		{Region: "Germany Nuremberg", IPs: []net.IP{{62, 171, 149, 162}, {62, 171, 151, 182}}},
-		{Region: "Germany Singapour", IPs: []net.IP{{159, 89, 14, 157}}},
-		{Region: "Germany UK", IPs: []net.IP{{46, 101, 250, 73}}},
-		{Region: "Greece", IPs: []net.IP{{194, 150, 167, 34}, {194, 150, 167, 40}}},
-		{Region: "Hong Kong", IPs: []net.IP{{84, 17, 57, 73}, {212, 102, 42, 201}}},
-		{Region: "Hungary", IPs: []net.IP{{37, 120, 144, 151}, {37, 120, 144, 213}}},
-		{Region: "Iceland", IPs: []net.IP{{82, 221, 128, 166}, {82, 221, 143, 243}}},
-		{Region: "India Chennai", IPs: []net.IP{{103, 108, 117, 118}, {103, 108, 117, 151}}},
-		{Region: "India Indore", IPs: []net.IP{{103, 39, 132, 189}, {137, 59, 52, 109}}},
-		{Region: "India Mumbai", IPs: []net.IP{{103, 221, 233, 88}, {165, 231, 253, 147}}},
-		{Region: "India UK", IPs: []net.IP{{134, 209, 148, 122}}},
-		{Region: "Indonesia", IPs: []net.IP{{103, 120, 66, 214}, {103, 120, 66, 216}}},
-		{Region: "Ireland", IPs: []net.IP{{185, 108, 128, 159}, {185, 108, 128, 181}}},
-		{Region: "Israel", IPs: []net.IP{{87, 239, 255, 109}}},
-		{Region: "Italy Milan", IPs: []net.IP{{84, 17, 58, 148}, {185, 128, 27, 37}}},
-		{Region: "Italy Rome", IPs: []net.IP{{82, 102, 26, 61}, {82, 102, 26, 115}}},
-		{Region: "Japan Tokyo", IPs: []net.IP{{45, 87, 213, 5}, {103, 208, 221, 227}}},
-		{Region: "Japan Tokyo st001", IPs: []net.IP{{45, 87, 213, 19}}},
-		{Region: "Japan Tokyo st002", IPs: []net.IP{{45, 87, 213, 21}}},
-		{Region: "Japan Tokyo st003", IPs: []net.IP{{45, 87, 213, 23}}},
-		{Region: "Japan Tokyo st004", IPs: []net.IP{{217, 138, 212, 19}}},
-		{Region: "Japan Tokyo st005", IPs: []net.IP{{217, 138, 212, 21}}},
-		{Region: "Japan Tokyo st006", IPs: []net.IP{{82, 102, 28, 123}}},
-		{Region: "Japan Tokyo st007", IPs: []net.IP{{82, 102, 28, 125}}},
-		{Region: "Kazakhstan", IPs: []net.IP{{95, 57, 207, 200}}},
-		{Region: "Korea", IPs: []net.IP{{61, 14, 210, 239}, {61, 97, 243, 112}}},
-		{Region: "Latvia", IPs: []net.IP{{188, 92, 78, 140}, {188, 92, 78, 142}}},
-		{Region: "Libya", IPs: []net.IP{{41, 208, 72, 157}, {41, 208, 72, 204}}},
-		{Region: "Luxembourg", IPs: []net.IP{{185, 153, 151, 60}, {185, 153, 151, 83}}},
-		{Region: "Malaysia", IPs: []net.IP{{42, 0, 30, 152}, {42, 0, 30, 209}}},
-		{Region: "Mexico City Mexico", IPs: []net.IP{{194, 41, 112, 9}, {194, 41, 112, 19}}},
-		{Region: "Moldova", IPs: []net.IP{{178, 175, 128, 235}, {178, 175, 128, 237}}},
-		{Region: "Netherlands Amsterdam", IPs: []net.IP{{89, 46, 223, 74}, {89, 46, 223, 217}}},
-		{Region: "Netherlands Amsterdam mp001", IPs: []net.IP{{188, 166, 43, 117}}},
-		{Region: "Netherlands Amsterdam st001", IPs: []net.IP{{81, 19, 209, 51}}},
-		{Region: "Netherlands US", IPs: []net.IP{{188, 166, 98, 91}}},
-		{Region: "New Zealand", IPs: []net.IP{{180, 149, 231, 67}}},
-		{Region: "Nigeria", IPs: []net.IP{{102, 165, 23, 6}, {102, 165, 23, 42}}},
-		{Region: "North Macedonia", IPs: []net.IP{{185, 225, 28, 93}, {185, 225, 28, 101}}},
-		{Region: "Norway", IPs: []net.IP{{45, 12, 223, 213}, {84, 247, 50, 69}}},
-		{Region: "Paraguay", IPs: []net.IP{{181, 40, 18, 56}, {186, 16, 32, 163}}},
-		{Region: "Philippines", IPs: []net.IP{{45, 134, 224, 10}, {45, 134, 224, 20}}},
-		{Region: "Poland Gdansk", IPs: []net.IP{{5, 187, 53, 51}}},
-		{Region: "Poland Warsaw", IPs: []net.IP{{185, 246, 208, 77}, {185, 246, 208, 105}}},
-		{Region: "Portugal Lisbon", IPs: []net.IP{{5, 154, 174, 26}, {5, 154, 174, 173}}},
-		{Region: "Portugal Loule", IPs: []net.IP{{176, 61, 146, 111}, {176, 61, 146, 123}}},
-		{Region: "Portugal Porto", IPs: []net.IP{{194, 39, 127, 242}}},
-		{Region: "Romania", IPs: []net.IP{{86, 106, 137, 147}, {86, 106, 137, 149}}},
-		{Region: "Russia Moscow", IPs: []net.IP{{213, 183, 56, 145}, {213, 183, 56, 160}}},
-		{Region: "Russia St. Petersburg", IPs: []net.IP{{213, 183, 54, 23}, {213, 183, 54, 165}}},
-		{Region: "Serbia", IPs: []net.IP{{37, 120, 193, 53}, {152, 89, 160, 211}}},
-		{Region: "Singapore", IPs: []net.IP{{89, 187, 162, 186}, {89, 187, 163, 210}}},
-		{Region: "Singapore Hong Kong", IPs: []net.IP{{206, 189, 83, 129}}},
-		{Region: "Singapore Netherlands", IPs: []net.IP{{104, 248, 148, 18}}},
-		{Region: "Singapore in", IPs: []net.IP{{128, 199, 193, 35}}},
-		{Region: "Singapore mp001", IPs: []net.IP{{206, 189, 94, 229}}},
-		{Region: "Singapore st001", IPs: []net.IP{{217, 138, 201, 91}}},
-		{Region: "Singapore st002", IPs: []net.IP{{217, 138, 201, 93}}},
-		{Region: "Singapore st003", IPs: []net.IP{{84, 247, 49, 19}}},
-		{Region: "Singapore st004", IPs: []net.IP{{84, 247, 49, 21}}},
-		{Region: "Slovekia", IPs: []net.IP{{193, 37, 255, 39}, {193, 37, 255, 41}}},
-		{Region: "Slovenia", IPs: []net.IP{{195, 158, 249, 38}, {195, 158, 249, 42}}},
-		{Region: "South Africa", IPs: []net.IP{{154, 127, 49, 226}, {154, 127, 49, 232}}},
-		{Region: "Spain Barcelona", IPs: []net.IP{{185, 188, 61, 15}, {185, 188, 61, 25}}},
-		{Region: "Spain Madrid", IPs: []net.IP{{188, 208, 141, 18}, {188, 208, 141, 20}}},
-		{Region: "Spain Valencia", IPs: []net.IP{{185, 153, 150, 48}, {196, 196, 150, 71}}},
-		{Region: "Sweden", IPs: []net.IP{{45, 83, 91, 149}, {185, 76, 9, 41}}},
-		{Region: "Switzerland", IPs: []net.IP{{84, 17, 53, 219}, {84, 17, 53, 221}}},
-		{Region: "Taiwan", IPs: []net.IP{{2, 58, 241, 5}, {2, 58, 241, 43}}},
-		{Region: "Thailand", IPs: []net.IP{{45, 64, 186, 132}, {45, 64, 186, 163}}},
-		{Region: "Turkey", IPs: []net.IP{{185, 195, 79, 5}, {185, 195, 79, 19}}},
-		{Region: "Turkey Istanbul", IPs: []net.IP{{107, 150, 95, 155}, {107, 150, 95, 157}}},
-		{Region: "UK France", IPs: []net.IP{{188, 166, 168, 247}}},
-		{Region: "UK Germany", IPs: []net.IP{{45, 77, 58, 16}}},
-		{Region: "UK Glasgow", IPs: []net.IP{{185, 108, 105, 157}}},
-		{Region: "UK London", IPs: []net.IP{{178, 239, 166, 218}, {185, 44, 78, 164}}},
-		{Region: "UK London mp001", IPs: []net.IP{{206, 189, 119, 92}}},
-		{Region: "UK London st001", IPs: []net.IP{{217, 146, 82, 83}}},
-		{Region: "UK London st002", IPs: []net.IP{{185, 134, 22, 80}}},
-		{Region: "UK London st003", IPs: []net.IP{{185, 134, 22, 92}}},
-		{Region: "UK London st004", IPs: []net.IP{{185, 44, 76, 186}}},
-		{Region: "UK London st005", IPs: []net.IP{{185, 44, 76, 188}}},
-		{Region: "UK Manchester", IPs: []net.IP{{193, 148, 17, 83}, {217, 138, 196, 91}}},
-		{Region: "US Atlanta", IPs: []net.IP{{66, 115, 166, 147}, {66, 115, 166, 151}}},
-		{Region: "US Bend", IPs: []net.IP{{45, 43, 14, 95}}},
-		{Region: "US Boston", IPs: []net.IP{{173, 237, 207, 13}, {199, 217, 107, 20}}},
-		{Region: "US Buffalo", IPs: []net.IP{{107, 174, 20, 130}}},
-		{Region: "US Charlotte", IPs: []net.IP{{66, 11, 124, 136}, {192, 154, 254, 135}}},
-		{Region: "US Chicago", IPs: []net.IP{{74, 119, 146, 197}, {89, 187, 182, 173}}},
-		{Region: "US Dallas", IPs: []net.IP{{66, 115, 177, 133}, {66, 115, 177, 158}}},
-		{Region: "US Denver", IPs: []net.IP{{212, 102, 44, 76}, {212, 102, 44, 98}}},
-		{Region: "US Gahanna", IPs: []net.IP{{104, 244, 209, 99}, {104, 244, 211, 171}}},
-		{Region: "US Houston", IPs: []net.IP{{104, 148, 30, 53}, {199, 10, 64, 115}}},
-		{Region: "US Kansas City", IPs: []net.IP{{173, 208, 202, 59}, {173, 208, 202, 61}}},
-		{Region: "US Las Vegas", IPs: []net.IP{{89, 187, 187, 149}, {185, 242, 5, 215}}},
-		{Region: "US Latham", IPs: []net.IP{{45, 43, 19, 74}, {45, 43, 19, 92}}},
-		{Region: "US Los Angeles", IPs: []net.IP{{38, 95, 110, 73}, {192, 111, 134, 202}}},
-		{Region: "US Maryland", IPs: []net.IP{{23, 82, 8, 173}, {23, 105, 163, 94}}},
-		{Region: "US Miami", IPs: []net.IP{{89, 187, 173, 250}, {172, 83, 42, 143}}},
-		{Region: "US Netherlands", IPs: []net.IP{{142, 93, 58, 71}}},
-		{Region: "US New York City", IPs: []net.IP{{84, 17, 35, 78}, {89, 187, 178, 92}}},
-		{Region: "US New York City mp001", IPs: []net.IP{{45, 55, 60, 159}}},
-		{Region: "US New York City st001", IPs: []net.IP{{92, 119, 177, 19}}},
-		{Region: "US New York City st002", IPs: []net.IP{{92, 119, 177, 21}}},
-		{Region: "US New York City st003", IPs: []net.IP{{92, 119, 177, 23}}},
-		{Region: "US New York City st004", IPs: []net.IP{{193, 148, 18, 51}}},
-		{Region: "US New York City st005", IPs: []net.IP{{193, 148, 18, 53}}},
-		{Region: "US Orlando", IPs: []net.IP{{198, 147, 22, 135}, {198, 147, 22, 213}}},
-		{Region: "US Phoenix", IPs: []net.IP{{184, 170, 240, 179}, {199, 58, 187, 3}}},
-		{Region: "US Portugal", IPs: []net.IP{{142, 93, 81, 242}}},
-		{Region: "US Saint Louis", IPs: []net.IP{{148, 72, 174, 43}, {148, 72, 174, 51}}},
-		{Region: "US Salt Lake City", IPs: []net.IP{{104, 200, 131, 165}, {104, 200, 131, 249}}},
-		{Region: "US San Francisco", IPs: []net.IP{{107, 181, 166, 39}, {107, 181, 166, 83}}},
-		{Region: "US San Francisco mp001", IPs: []net.IP{{165, 232, 53, 25}}},
-		{Region: "US Seatle", IPs: []net.IP{{199, 229, 250, 163}}},
-		{Region: "US Tampa", IPs: []net.IP{{209, 216, 92, 197}, {209, 216, 92, 205}}},
-		{Region: "Ukraine", IPs: []net.IP{{45, 9, 238, 23}, {45, 9, 238, 30}}},
-		{Region: "United Arab Emirates", IPs: []net.IP{{45, 9, 250, 99}, {45, 9, 250, 103}}},
-		{Region: "Vietnam", IPs: []net.IP{{202, 143, 110, 29}, {202, 143, 110, 32}}},
+		{Region: "Albania", Hostname: "al-tia.prod.surfshark.com", IPs: []net.IP{{31, 171, 152, 197}, {31, 171, 154, 147}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Argentina Buenos Aires", Hostname: "ar-bua.prod.surfshark.com", IPs: []net.IP{{91, 206, 168, 13}, {91, 206, 168, 24}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Australia Adelaide", Hostname: "au-adl.prod.surfshark.com", IPs: []net.IP{{45, 248, 79, 67}, {45, 248, 79, 69}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Australia Brisbane", Hostname: "au-bne.prod.surfshark.com", IPs: []net.IP{{144, 48, 39, 107}, {144, 48, 39, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Australia Melbourne", Hostname: "au-mel.prod.surfshark.com", IPs: []net.IP{{103, 192, 80, 141}, {144, 48, 38, 141}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Australia Perth", Hostname: "au-per.prod.surfshark.com", IPs: []net.IP{{45, 248, 78, 45}, {124, 150, 139, 27}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Australia Sydney", Hostname: "au-syd.prod.surfshark.com", IPs: []net.IP{{45, 125, 247, 195}, {180, 149, 228, 117}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Australia US", Hostname: "au-us.prod.surfshark.com", IPs: []net.IP{{45, 76, 117, 108}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Austria", Hostname: "at-vie.prod.surfshark.com", IPs: []net.IP{{5, 253, 207, 83}, {37, 120, 212, 131}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Azerbaijan", Hostname: "az-bak.prod.surfshark.com", IPs: []net.IP{{94, 20, 21, 85}, {94, 20, 21, 87}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Belgium", Hostname: "be-bru.prod.surfshark.com", IPs: []net.IP{{5, 253, 205, 181}, {5, 253, 205, 213}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Bosnia and Herzegovina", Hostname: "ba-sjj.prod.surfshark.com", IPs: []net.IP{{185, 99, 3, 7}, {185, 212, 111, 41}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Brazil", Hostname: "br-sao.prod.surfshark.com", IPs: []net.IP{{191, 96, 73, 214}, {191, 96, 73, 216}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Bulgaria", Hostname: "bg-sof.prod.surfshark.com", IPs: []net.IP{{37, 120, 152, 37}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Canada Montreal", Hostname: "ca-mon.prod.surfshark.com", IPs: []net.IP{{172, 98, 82, 85}, {198, 8, 85, 19}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Canada Toronto", Hostname: "ca-tor.prod.surfshark.com", IPs: []net.IP{{68, 71, 244, 200}, {104, 200, 138, 163}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Canada Toronto mp001", Hostname: "ca-tor-mp001.prod.surfshark.com", IPs: []net.IP{{138, 197, 151, 26}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Canada US", Hostname: "ca-us.prod.surfshark.com", IPs: []net.IP{{159, 203, 57, 80}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Canada Vancouver", Hostname: "ca-van.prod.surfshark.com", IPs: []net.IP{{66, 115, 147, 79}, {66, 115, 147, 87}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Chile", Hostname: "cl-san.prod.surfshark.com", IPs: []net.IP{{31, 169, 121, 16}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Colombia", Hostname: "co-bog.prod.surfshark.com", IPs: []net.IP{{45, 129, 32, 8}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Costa Rica", Hostname: "cr-sjn.prod.surfshark.com", IPs: []net.IP{{176, 227, 241, 19}, {176, 227, 241, 21}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Croatia", Hostname: "hr-zag.prod.surfshark.com", IPs: []net.IP{{89, 164, 99, 109}, {89, 164, 99, 111}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Cyprus", Hostname: "cy-nic.prod.surfshark.com", IPs: []net.IP{{195, 47, 194, 34}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Czech Republic", Hostname: "cz-prg.prod.surfshark.com", IPs: []net.IP{{185, 152, 64, 151}, {185, 152, 64, 178}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Denmark", Hostname: "dk-cph.prod.surfshark.com", IPs: []net.IP{{37, 120, 194, 115}, {95, 174, 65, 71}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Estonia", Hostname: "ee-tll.prod.surfshark.com", IPs: []net.IP{{165, 231, 163, 23}, {185, 174, 159, 69}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Finland", Hostname: "fi-hel.prod.surfshark.com", IPs: []net.IP{{196, 244, 191, 179}, {196, 244, 191, 181}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "France Bordeaux", Hostname: "fr-bod.prod.surfshark.com", IPs: []net.IP{{185, 108, 106, 67}, {185, 108, 106, 150}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "France Marseilles", Hostname: "fr-mrs.prod.surfshark.com", IPs: []net.IP{{185, 166, 84, 53}, {185, 166, 84, 75}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "France Paris", Hostname: "fr-par.prod.surfshark.com", IPs: []net.IP{{45, 83, 90, 181}, {45, 89, 174, 103}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "France Sweden", Hostname: "fr-se.prod.surfshark.com", IPs: []net.IP{{199, 247, 8, 20}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Berlin", Hostname: "de-ber.prod.surfshark.com", IPs: []net.IP{{152, 89, 163, 19}, {217, 138, 216, 243}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Frankfurt am Main", Hostname: "de-fra.prod.surfshark.com", IPs: []net.IP{{185, 158, 135, 36}, {185, 220, 70, 83}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Frankfurt am Main st001", Hostname: "de-fra-st001.prod.surfshark.com", IPs: []net.IP{{45, 87, 212, 179}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Frankfurt am Main st002", Hostname: "de-fra-st002.prod.surfshark.com", IPs: []net.IP{{45, 87, 212, 181}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Frankfurt am Main st003", Hostname: "de-fra-st003.prod.surfshark.com", IPs: []net.IP{{45, 87, 212, 183}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Frankfurt mp001", Hostname: "de-fra-mp001.prod.surfshark.com", IPs: []net.IP{{46, 101, 189, 14}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Munich", Hostname: "de-muc.prod.surfshark.com", IPs: []net.IP{{178, 238, 231, 51}, {178, 238, 231, 55}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Nuremberg", Hostname: "de-nue.prod.surfshark.com", IPs: []net.IP{{62, 171, 149, 162}, {62, 171, 151, 182}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany Singapour", Hostname: "de-sg.prod.surfshark.com", IPs: []net.IP{{159, 89, 14, 157}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Germany UK", Hostname: "de-uk.prod.surfshark.com", IPs: []net.IP{{46, 101, 250, 73}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Greece", Hostname: "gr-ath.prod.surfshark.com", IPs: []net.IP{{194, 150, 167, 34}, {194, 150, 167, 40}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Hong Kong", Hostname: "hk-hkg.prod.surfshark.com", IPs: []net.IP{{84, 17, 57, 73}, {212, 102, 42, 201}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Hungary", Hostname: "hu-bud.prod.surfshark.com", IPs: []net.IP{{37, 120, 144, 151}, {37, 120, 144, 213}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Iceland", Hostname: "is-rkv.prod.surfshark.com", IPs: []net.IP{{82, 221, 128, 166}, {82, 221, 143, 243}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "India Chennai", Hostname: "in-chn.prod.surfshark.com", IPs: []net.IP{{103, 108, 117, 118}, {103, 108, 117, 151}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "India Indore", Hostname: "in-idr.prod.surfshark.com", IPs: []net.IP{{103, 39, 132, 189}, {137, 59, 52, 109}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "India Mumbai", Hostname: "in-mum.prod.surfshark.com", IPs: []net.IP{{103, 221, 233, 88}, {165, 231, 253, 147}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "India UK", Hostname: "in-uk.prod.surfshark.com", IPs: []net.IP{{134, 209, 148, 122}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Indonesia", Hostname: "id-jak.prod.surfshark.com", IPs: []net.IP{{103, 120, 66, 214}, {103, 120, 66, 216}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Ireland", Hostname: "ie-dub.prod.surfshark.com", IPs: []net.IP{{185, 108, 128, 159}, {185, 108, 128, 181}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Israel", Hostname: "il-tlv.prod.surfshark.com", IPs: []net.IP{{87, 239, 255, 109}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Italy Milan", Hostname: "it-mil.prod.surfshark.com", IPs: []net.IP{{84, 17, 58, 148}, {185, 128, 27, 37}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Italy Rome", Hostname: "it-rom.prod.surfshark.com", IPs: []net.IP{{82, 102, 26, 61}, {82, 102, 26, 115}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo", Hostname: "jp-tok.prod.surfshark.com", IPs: []net.IP{{45, 87, 213, 5}, {103, 208, 221, 227}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo st001", Hostname: "jp-tok-st001.prod.surfshark.com", IPs: []net.IP{{45, 87, 213, 19}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo st002", Hostname: "jp-tok-st002.prod.surfshark.com", IPs: []net.IP{{45, 87, 213, 21}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo st003", Hostname: "jp-tok-st003.prod.surfshark.com", IPs: []net.IP{{45, 87, 213, 23}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo st004", Hostname: "jp-tok-st004.prod.surfshark.com", IPs: []net.IP{{217, 138, 212, 19}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo st005", Hostname: "jp-tok-st005.prod.surfshark.com", IPs: []net.IP{{217, 138, 212, 21}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo st006", Hostname: "jp-tok-st006.prod.surfshark.com", IPs: []net.IP{{82, 102, 28, 123}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Japan Tokyo st007", Hostname: "jp-tok-st007.prod.surfshark.com", IPs: []net.IP{{82, 102, 28, 125}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Kazakhstan", Hostname: "kz-ura.prod.surfshark.com", IPs: []net.IP{{95, 57, 207, 200}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Korea", Hostname: "kr-seo.prod.surfshark.com", IPs: []net.IP{{61, 14, 210, 239}, {61, 97, 243, 112}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Latvia", Hostname: "lv-rig.prod.surfshark.com", IPs: []net.IP{{188, 92, 78, 140}, {188, 92, 78, 142}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Libya", Hostname: "ly-tip.prod.surfshark.com", IPs: []net.IP{{41, 208, 72, 157}, {41, 208, 72, 204}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Luxembourg", Hostname: "lu-ste.prod.surfshark.com", IPs: []net.IP{{185, 153, 151, 60}, {185, 153, 151, 83}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Malaysia", Hostname: "my-kul.prod.surfshark.com", IPs: []net.IP{{42, 0, 30, 152}, {42, 0, 30, 209}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Mexico City Mexico", Hostname: "mx-mex.prod.surfshark.com", IPs: []net.IP{{194, 41, 112, 9}, {194, 41, 112, 19}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Moldova", Hostname: "md-chi.prod.surfshark.com", IPs: []net.IP{{178, 175, 128, 235}, {178, 175, 128, 237}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Netherlands Amsterdam", Hostname: "nl-ams.prod.surfshark.com", IPs: []net.IP{{89, 46, 223, 74}, {89, 46, 223, 217}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Netherlands Amsterdam mp001", Hostname: "nl-ams-mp001.prod.surfshark.com", IPs: []net.IP{{188, 166, 43, 117}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Netherlands Amsterdam st001", Hostname: "nl-ams-st001.prod.surfshark.com", IPs: []net.IP{{81, 19, 209, 51}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Netherlands US", Hostname: "nl-us.prod.surfshark.com", IPs: []net.IP{{188, 166, 98, 91}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "New Zealand", Hostname: "nz-akl.prod.surfshark.com", IPs: []net.IP{{180, 149, 231, 67}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Nigeria", Hostname: "ng-lag.prod.surfshark.com", IPs: []net.IP{{102, 165, 23, 6}, {102, 165, 23, 42}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "North Macedonia", Hostname: "mk-skp.prod.surfshark.com", IPs: []net.IP{{185, 225, 28, 93}, {185, 225, 28, 101}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Norway", Hostname: "no-osl.prod.surfshark.com", IPs: []net.IP{{45, 12, 223, 213}, {84, 247, 50, 69}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Paraguay", Hostname: "py-asu.prod.surfshark.com", IPs: []net.IP{{181, 40, 18, 56}, {186, 16, 32, 163}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Philippines", Hostname: "ph-mnl.prod.surfshark.com", IPs: []net.IP{{45, 134, 224, 10}, {45, 134, 224, 20}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Poland Gdansk", Hostname: "pl-gdn.prod.surfshark.com", IPs: []net.IP{{5, 187, 53, 51}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Poland Warsaw", Hostname: "pl-waw.prod.surfshark.com", IPs: []net.IP{{185, 246, 208, 77}, {185, 246, 208, 105}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Portugal Lisbon", Hostname: "pt-lis.prod.surfshark.com", IPs: []net.IP{{5, 154, 174, 26}, {5, 154, 174, 173}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Portugal Loule", Hostname: "pt-lou.prod.surfshark.com", IPs: []net.IP{{176, 61, 146, 111}, {176, 61, 146, 123}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Portugal Porto", Hostname: "pt-opo.prod.surfshark.com", IPs: []net.IP{{194, 39, 127, 242}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Romania", Hostname: "ro-buc.prod.surfshark.com", IPs: []net.IP{{86, 106, 137, 147}, {86, 106, 137, 149}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Russia Moscow", Hostname: "ru-mos.prod.surfshark.com", IPs: []net.IP{{213, 183, 56, 145}, {213, 183, 56, 160}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Russia St. Petersburg", Hostname: "ru-spt.prod.surfshark.com", IPs: []net.IP{{213, 183, 54, 23}, {213, 183, 54, 165}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Serbia", Hostname: "rs-beg.prod.surfshark.com", IPs: []net.IP{{37, 120, 193, 53}, {152, 89, 160, 211}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore", Hostname: "sg-sng.prod.surfshark.com", IPs: []net.IP{{89, 187, 162, 186}, {89, 187, 163, 210}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore Hong Kong", Hostname: "sg-hk.prod.surfshark.com", IPs: []net.IP{{206, 189, 83, 129}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore Netherlands", Hostname: "sg-nl.prod.surfshark.com", IPs: []net.IP{{104, 248, 148, 18}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore in", Hostname: "sg-in.prod.surfshark.com", IPs: []net.IP{{128, 199, 193, 35}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore mp001", Hostname: "sg-sng-mp001.prod.surfshark.com", IPs: []net.IP{{206, 189, 94, 229}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore st001", Hostname: "sg-sng-st001.prod.surfshark.com", IPs: []net.IP{{217, 138, 201, 91}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore st002", Hostname: "sg-sng-st002.prod.surfshark.com", IPs: []net.IP{{217, 138, 201, 93}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore st003", Hostname: "sg-sng-st003.prod.surfshark.com", IPs: []net.IP{{84, 247, 49, 19}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Singapore st004", Hostname: "sg-sng-st004.prod.surfshark.com", IPs: []net.IP{{84, 247, 49, 21}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Slovekia", Hostname: "sk-bts.prod.surfshark.com", IPs: []net.IP{{193, 37, 255, 39}, {193, 37, 255, 41}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Slovenia", Hostname: "si-lju.prod.surfshark.com", IPs: []net.IP{{195, 158, 249, 38}, {195, 158, 249, 42}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "South Africa", Hostname: "za-jnb.prod.surfshark.com", IPs: []net.IP{{154, 127, 49, 226}, {154, 127, 49, 232}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Spain Barcelona", Hostname: "es-bcn.prod.surfshark.com", IPs: []net.IP{{185, 188, 61, 15}, {185, 188, 61, 25}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Spain Madrid", Hostname: "es-mad.prod.surfshark.com", IPs: []net.IP{{188, 208, 141, 18}, {188, 208, 141, 20}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Spain Valencia", Hostname: "es-vlc.prod.surfshark.com", IPs: []net.IP{{185, 153, 150, 48}, {196, 196, 150, 71}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Sweden", Hostname: "se-sto.prod.surfshark.com", IPs: []net.IP{{45, 83, 91, 149}, {185, 76, 9, 41}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Switzerland", Hostname: "ch-zur.prod.surfshark.com", IPs: []net.IP{{84, 17, 53, 219}, {84, 17, 53, 221}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Taiwan", Hostname: "tw-tai.prod.surfshark.com", IPs: []net.IP{{2, 58, 241, 5}, {2, 58, 241, 43}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Thailand", Hostname: "th-bkk.prod.surfshark.com", IPs: []net.IP{{45, 64, 186, 132}, {45, 64, 186, 163}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Turkey", Hostname: "tr-bur.prod.surfshark.com", IPs: []net.IP{{185, 195, 79, 5}, {185, 195, 79, 19}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Turkey Istanbul", Hostname: "tr-ist.prod.surfshark.com", IPs: []net.IP{{107, 150, 95, 155}, {107, 150, 95, 157}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK France", Hostname: "uk-fr.prod.surfshark.com", IPs: []net.IP{{188, 166, 168, 247}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK Germany", Hostname: "uk-de.prod.surfshark.com", IPs: []net.IP{{45, 77, 58, 16}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK Glasgow", Hostname: "uk-gla.prod.surfshark.com", IPs: []net.IP{{185, 108, 105, 157}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK London", Hostname: "uk-lon.prod.surfshark.com", IPs: []net.IP{{178, 239, 166, 218}, {185, 44, 78, 164}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK London mp001", Hostname: "uk-lon-mp001.prod.surfshark.com", IPs: []net.IP{{206, 189, 119, 92}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK London st001", Hostname: "uk-lon-st001.prod.surfshark.com", IPs: []net.IP{{217, 146, 82, 83}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK London st002", Hostname: "uk-lon-st002.prod.surfshark.com", IPs: []net.IP{{185, 134, 22, 80}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK London st003", Hostname: "uk-lon-st003.prod.surfshark.com", IPs: []net.IP{{185, 134, 22, 92}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK London st004", Hostname: "uk-lon-st004.prod.surfshark.com", IPs: []net.IP{{185, 44, 76, 186}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK London st005", Hostname: "uk-lon-st005.prod.surfshark.com", IPs: []net.IP{{185, 44, 76, 188}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "UK Manchester", Hostname: "uk-man.prod.surfshark.com", IPs: []net.IP{{193, 148, 17, 83}, {217, 138, 196, 91}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Atlanta", Hostname: "us-atl.prod.surfshark.com", IPs: []net.IP{{66, 115, 166, 147}, {66, 115, 166, 151}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Bend", Hostname: "us-bdn.prod.surfshark.com", IPs: []net.IP{{45, 43, 14, 95}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Boston", Hostname: "us-bos.prod.surfshark.com", IPs: []net.IP{{173, 237, 207, 13}, {199, 217, 107, 20}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Buffalo", Hostname: "us-buf.prod.surfshark.com", IPs: []net.IP{{107, 174, 20, 130}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Charlotte", Hostname: "us-clt.prod.surfshark.com", IPs: []net.IP{{66, 11, 124, 136}, {192, 154, 254, 135}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Chicago", Hostname: "us-chi.prod.surfshark.com", IPs: []net.IP{{74, 119, 146, 197}, {89, 187, 182, 173}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Dallas", Hostname: "us-dal.prod.surfshark.com", IPs: []net.IP{{66, 115, 177, 133}, {66, 115, 177, 158}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Denver", Hostname: "us-den.prod.surfshark.com", IPs: []net.IP{{212, 102, 44, 76}, {212, 102, 44, 98}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Gahanna", Hostname: "us-dtw.prod.surfshark.com", IPs: []net.IP{{104, 244, 209, 99}, {104, 244, 211, 171}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Houston", Hostname: "us-hou.prod.surfshark.com", IPs: []net.IP{{104, 148, 30, 53}, {199, 10, 64, 115}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Kansas City", Hostname: "us-kan.prod.surfshark.com", IPs: []net.IP{{173, 208, 202, 59}, {173, 208, 202, 61}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Las Vegas", Hostname: "us-las.prod.surfshark.com", IPs: []net.IP{{89, 187, 187, 149}, {185, 242, 5, 215}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Latham", Hostname: "us-ltm.prod.surfshark.com", IPs: []net.IP{{45, 43, 19, 74}, {45, 43, 19, 92}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Los Angeles", Hostname: "us-lax.prod.surfshark.com", IPs: []net.IP{{38, 95, 110, 73}, {192, 111, 134, 202}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Maryland", Hostname: "us-mnz.prod.surfshark.com", IPs: []net.IP{{23, 82, 8, 173}, {23, 105, 163, 94}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Miami", Hostname: "us-mia.prod.surfshark.com", IPs: []net.IP{{89, 187, 173, 250}, {172, 83, 42, 143}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Netherlands", Hostname: "us-nl.prod.surfshark.com", IPs: []net.IP{{142, 93, 58, 71}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US New York City", Hostname: "us-nyc.prod.surfshark.com", IPs: []net.IP{{84, 17, 35, 78}, {89, 187, 178, 92}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US New York City mp001", Hostname: "us-nyc-mp001.prod.surfshark.com", IPs: []net.IP{{45, 55, 60, 159}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US New York City st001", Hostname: "us-nyc-st001.prod.surfshark.com", IPs: []net.IP{{92, 119, 177, 19}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US New York City st002", Hostname: "us-nyc-st002.prod.surfshark.com", IPs: []net.IP{{92, 119, 177, 21}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US New York City st003", Hostname: "us-nyc-st003.prod.surfshark.com", IPs: []net.IP{{92, 119, 177, 23}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US New York City st004", Hostname: "us-nyc-st004.prod.surfshark.com", IPs: []net.IP{{193, 148, 18, 51}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US New York City st005", Hostname: "us-nyc-st005.prod.surfshark.com", IPs: []net.IP{{193, 148, 18, 53}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Orlando", Hostname: "us-orl.prod.surfshark.com", IPs: []net.IP{{198, 147, 22, 135}, {198, 147, 22, 213}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Phoenix", Hostname: "us-phx.prod.surfshark.com", IPs: []net.IP{{184, 170, 240, 179}, {199, 58, 187, 3}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Portugal", Hostname: "us-pt.prod.surfshark.com", IPs: []net.IP{{142, 93, 81, 242}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Saint Louis", Hostname: "us-stl.prod.surfshark.com", IPs: []net.IP{{148, 72, 174, 43}, {148, 72, 174, 51}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Salt Lake City", Hostname: "us-slc.prod.surfshark.com", IPs: []net.IP{{104, 200, 131, 165}, {104, 200, 131, 249}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US San Francisco", Hostname: "us-sfo.prod.surfshark.com", IPs: []net.IP{{107, 181, 166, 39}, {107, 181, 166, 83}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US San Francisco mp001", Hostname: "us-sfo-mp001.prod.surfshark.com", IPs: []net.IP{{165, 232, 53, 25}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Seatle", Hostname: "us-sea.prod.surfshark.com", IPs: []net.IP{{199, 229, 250, 163}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "US Tampa", Hostname: "us-tpa.prod.surfshark.com", IPs: []net.IP{{209, 216, 92, 197}, {209, 216, 92, 205}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Ukraine", Hostname: "ua-iev.prod.surfshark.com", IPs: []net.IP{{45, 9, 238, 23}, {45, 9, 238, 30}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "United Arab Emirates", Hostname: "ae-dub.prod.surfshark.com", IPs: []net.IP{{45, 9, 250, 99}, {45, 9, 250, 103}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
+		{Region: "Vietnam", Hostname: "vn-hcm.prod.surfshark.com", IPs: []net.IP{{202, 143, 110, 29}, {202, 143, 110, 32}}, Ports: models.OpenVPNPorts{TCP: nil, UDP: nil}},
 	}
 }
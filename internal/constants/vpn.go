@@ -23,6 +23,12 @@ const (
 	Purevpn models.VPNProvider = "purevpn"
 	// Privado is a VPN provider.
 	Privado models.VPNProvider = "privado"
+	// HideMyAss is a VPN provider.
+	HideMyAss models.VPNProvider = "hidemyass"
+	// VPNUnlimited is a VPN provider.
+	VPNUnlimited models.VPNProvider = "vpn unlimited"
+	// WeVPN is a VPN provider.
+	WeVPN models.VPNProvider = "wevpn"
 )
 
 const (
@@ -31,3 +37,51 @@ const (
 	// UDP is a network protocol (unreliable and faster than TCP).
 	UDP models.NetworkProtocol = "udp"
 )
+
+const (
+	// OpenVPN is the VPN type to connect using OpenVPN.
+	OpenVPN = "openvpn"
+	// Wireguard is the VPN type to connect using Wireguard.
+	Wireguard = "wireguard"
+)
+
+// VPNProviderChoices returns the VPN providers which can be used.
+func VPNProviderChoices() []string {
+	providers := []models.VPNProvider{
+		PrivateInternetAccess, Mullvad, Windscribe, Surfshark, Cyberghost, Vyprvpn,
+		Nordvpn, Purevpn, Privado, VPNUnlimited, HideMyAss, WeVPN,
+	}
+	choices := make([]string, len(providers))
+	for i := range providers {
+		choices[i] = string(providers[i])
+	}
+	return choices
+}
+
+// VPNTypeChoices returns the VPN types which can be used.
+func VPNTypeChoices() []string {
+	return []string{OpenVPN, Wireguard}
+}
+
+// VPNBypassMark is the firewall mark set on packets which must be routed
+// through the default gateway instead of the VPN tunnel.
+const VPNBypassMark = 0x6c7
+
+// TransparentProxyMark is the firewall mark set on the UDP packets
+// redirected to the transparent proxy, which are delivered locally.
+const TransparentProxyMark = 0x7470
+
+// TunnelMark is the firewall mark set on the connections balanced to the
+// main VPN tunnel, the extra tunnels using the next marks, up to
+// TunnelMark + MaxExtraTunnels.
+const TunnelMark = 0x7500
+
+// TunnelHashMark is the first firewall mark set by hashing the new connections,
+// each hash mark being then replaced by the mark of a healthy tunnel.
+const TunnelHashMark = 0x7600
+
+// TunnelMarkMask is the mask matching all the tunnel marks.
+const TunnelMarkMask = 0xfff0
+
+// MaxExtraTunnels is the maximum number of extra OpenVPN tunnels.
+const MaxExtraTunnels = 15
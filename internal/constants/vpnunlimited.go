@@ -0,0 +1,87 @@
+package constants
+
+import (
+	"net"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+func VPNUnlimitedCountryChoices() (choices []string) {
+	servers := VPNUnlimitedServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].Country
+	}
+	return choices
+}
+
+func VPNUnlimitedCityChoices() (choices []string) {
+	servers := VPNUnlimitedServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].City
+	}
+	return choices
+}
+
+func VPNUnlimitedHostnameChoices() (choices []string) {
+	servers := VPNUnlimitedServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].Hostname
+	}
+	return choices
+}
+
+//nolint:lll
+func VPNUnlimitedServers() []models.VPNUnlimitedServer {
+	// IP addresses are resolved by the updater and are empty until then
+	return []models.VPNUnlimitedServer{
+		{Country: "United Arab Emirates", CountryCode: "ae", City: "Dubai", Hostname: "ae.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Argentina", CountryCode: "ar", City: "Buenos Aires", Hostname: "ar.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Austria", CountryCode: "at", City: "Vienna", Hostname: "at.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Australia", CountryCode: "au", City: "Sydney", Hostname: "au-syd.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Australia", CountryCode: "au", City: "Melbourne", Hostname: "au-mel.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Belgium", CountryCode: "be", City: "Brussels", Hostname: "be.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Bulgaria", CountryCode: "bg", City: "Sofia", Hostname: "bg.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Brazil", CountryCode: "br", City: "Sao Paulo", Hostname: "br.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Canada", CountryCode: "ca", City: "Toronto", Hostname: "ca-tr.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Canada", CountryCode: "ca", City: "Vancouver", Hostname: "ca-vn.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Switzerland", CountryCode: "ch", City: "Zurich", Hostname: "ch.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Czech Republic", CountryCode: "cz", City: "Prague", Hostname: "cz.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "de.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Denmark", CountryCode: "dk", City: "Copenhagen", Hostname: "dk.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Spain", CountryCode: "es", City: "Madrid", Hostname: "es.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Finland", CountryCode: "fi", City: "Helsinki", Hostname: "fi.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "France", CountryCode: "fr", City: "Paris", Hostname: "fr.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "London", Hostname: "uk.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Hong Kong", CountryCode: "hk", City: "Hong Kong", Hostname: "hk.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Hungary", CountryCode: "hu", City: "Budapest", Hostname: "hu.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Ireland", CountryCode: "ie", City: "Dublin", Hostname: "ie.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Israel", CountryCode: "il", City: "Tel Aviv", Hostname: "il.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "India", CountryCode: "in", City: "Mumbai", Hostname: "in.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Italy", CountryCode: "it", City: "Milan", Hostname: "it.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Japan", CountryCode: "jp", City: "Tokyo", Hostname: "jp.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Korea", CountryCode: "kr", City: "Seoul", Hostname: "kr.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Mexico", CountryCode: "mx", City: "Mexico City", Hostname: "mx.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "nl.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Norway", CountryCode: "no", City: "Oslo", Hostname: "no.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "New Zealand", CountryCode: "nz", City: "Auckland", Hostname: "nz.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Poland", CountryCode: "pl", City: "Warsaw", Hostname: "pl.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Portugal", CountryCode: "pt", City: "Lisbon", Hostname: "pt.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Romania", CountryCode: "ro", City: "Bucharest", Hostname: "ro.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Russian Federation", CountryCode: "ru", City: "Moscow", Hostname: "ru.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Sweden", CountryCode: "se", City: "Stockholm", Hostname: "se.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Singapore", CountryCode: "sg", City: "Singapore", Hostname: "sg.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Turkey", CountryCode: "tr", City: "Istanbul", Hostname: "tr.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "Ukraine", CountryCode: "ua", City: "Kiev", Hostname: "ua.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Chicago", Hostname: "us-chi.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Dallas", Hostname: "us-dal.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Los Angeles", Hostname: "us-la.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Miami", Hostname: "us-mia.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "New York", Hostname: "us-ny.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "San Francisco", Hostname: "us-sf.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Seattle", Hostname: "us-sea.vpnunlimitedapp.com", IPs: []net.IP{}},
+		{Country: "South Africa", CountryCode: "za", City: "Johannesburg", Hostname: "za.vpnunlimitedapp.com", IPs: []net.IP{}},
+	}
+}
@@ -0,0 +1,74 @@
+package constants
+
+import (
+	"net"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+func WeVPNCountryChoices() (choices []string) {
+	servers := WeVPNServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].Country
+	}
+	return choices
+}
+
+func WeVPNCityChoices() (choices []string) {
+	servers := WeVPNServers()
+	choices = make([]string, len(servers))
+	for i := range servers {
+		choices[i] = servers[i].City
+	}
+	return choices
+}
+
+//nolint:lll
+func WeVPNServers() []models.WeVPNServer {
+	// IP addresses are resolved by the updater and are empty until then
+	return []models.WeVPNServer{
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "amsterdam.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Greece", CountryCode: "gr", City: "Athens", Hostname: "athens.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Spain", CountryCode: "es", City: "Barcelona", Hostname: "barcelona.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Germany", CountryCode: "de", City: "Berlin", Hostname: "berlin.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Belgium", CountryCode: "be", City: "Brussels", Hostname: "brussels.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Romania", CountryCode: "ro", City: "Bucharest", Hostname: "bucharest.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Hungary", CountryCode: "hu", City: "Budapest", Hostname: "budapest.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Chicago", Hostname: "chicago.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Denmark", CountryCode: "dk", City: "Copenhagen", Hostname: "copenhagen.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Dallas", Hostname: "dallas.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Ireland", CountryCode: "ie", City: "Dublin", Hostname: "dublin.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "frankfurt.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Finland", CountryCode: "fi", City: "Helsinki", Hostname: "helsinki.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Hong Kong", CountryCode: "hk", City: "Hong Kong", Hostname: "hongkong.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Turkey", CountryCode: "tr", City: "Istanbul", Hostname: "istanbul.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "South Africa", CountryCode: "za", City: "Johannesburg", Hostname: "johannesburg.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Portugal", CountryCode: "pt", City: "Lisbon", Hostname: "lisbon.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "London", Hostname: "london.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Los Angeles", Hostname: "losangeles.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Spain", CountryCode: "es", City: "Madrid", Hostname: "madrid.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United Kingdom", CountryCode: "gb", City: "Manchester", Hostname: "manchester.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Australia", CountryCode: "au", City: "Melbourne", Hostname: "melbourne.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Miami", Hostname: "miami.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Italy", CountryCode: "it", City: "Milan", Hostname: "milan.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Canada", CountryCode: "ca", City: "Montreal", Hostname: "montreal.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "New York", Hostname: "newyork.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Norway", CountryCode: "no", City: "Oslo", Hostname: "oslo.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "France", CountryCode: "fr", City: "Paris", Hostname: "paris.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Czech Republic", CountryCode: "cz", City: "Prague", Hostname: "prague.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Latvia", CountryCode: "lv", City: "Riga", Hostname: "riga.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Brazil", CountryCode: "br", City: "Sao Paulo", Hostname: "saopaulo.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "United States", CountryCode: "us", City: "Seattle", Hostname: "seattle.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Singapore", CountryCode: "sg", City: "Singapore", Hostname: "singapore.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Bulgaria", CountryCode: "bg", City: "Sofia", Hostname: "sofia.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Sweden", CountryCode: "se", City: "Stockholm", Hostname: "stockholm.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Australia", CountryCode: "au", City: "Sydney", Hostname: "sydney.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Japan", CountryCode: "jp", City: "Tokyo", Hostname: "tokyo.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Canada", CountryCode: "ca", City: "Toronto", Hostname: "toronto.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Canada", CountryCode: "ca", City: "Vancouver", Hostname: "vancouver.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Austria", CountryCode: "at", City: "Vienna", Hostname: "vienna.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Poland", CountryCode: "pl", City: "Warsaw", Hostname: "warsaw.wevpn.com", PortForward: false, IPs: []net.IP{}},
+		{Country: "Switzerland", CountryCode: "ch", City: "Zurich", Hostname: "zurich.wevpn.com", PortForward: false, IPs: []net.IP{}},
+	}
+}
@@ -0,0 +1,116 @@
+package dns
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"sync"
+)
+
+const (
+	blockCategoryMalicious    = "malicious"
+	blockCategoryAds          = "ads"
+	blockCategorySurveillance = "surveillance"
+	blockCategoryPatterns     = "patterns"
+)
+
+// BlockStats contains the number of queries blocked since gluetun started,
+// overall and for each block list category. A query for a hostname in
+// several block lists is counted for each of their categories.
+type BlockStats struct {
+	Total      uint64            `json:"total"`
+	Categories map[string]uint64 `json:"categories"`
+}
+
+// blockCounter counts the blocked queries from the Unbound
+// log lines of its local zones actions.
+type blockCounter struct {
+	// zones maps the blocked zones to their block list categories.
+	zones  map[string][]string
+	total  uint64
+	counts map[string]uint64
+	mutex  sync.RWMutex
+}
+
+func newBlockCounter() *blockCounter {
+	return &blockCounter{
+		counts: make(map[string]uint64),
+	}
+}
+
+// setZones sets the blocked zones of the Unbound configuration,
+// keeping the counts of the previous configurations.
+func (b *blockCounter) setZones(zones map[string][]string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.zones = zones
+}
+
+func (b *blockCounter) stats() (stats BlockStats) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+	stats.Total = b.total
+	stats.Categories = make(map[string]uint64, len(b.counts))
+	for category, count := range b.counts {
+		stats.Categories[category] = count
+	}
+	return stats
+}
+
+// count returns true if the line is an Unbound local zone action,
+// counting it if the zone is blocked.
+func (b *blockCounter) count(line string) (localAction bool) {
+	zone, ok := parseLocalAction(line)
+	if !ok {
+		return false
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	categories, blocked := b.zones[zone]
+	if !blocked {
+		return true
+	}
+	b.total++
+	for _, category := range categories {
+		b.counts[category]++
+	}
+	return true
+}
+
+// filter returns a stream of the lines of the Unbound stream given, without
+// the local zone actions lines which are counted instead of being logged.
+func (b *blockCounter) filter(stream io.ReadCloser) io.ReadCloser {
+	reader, writer := io.Pipe()
+	go func() {
+		defer stream.Close()
+		scanner := bufio.NewScanner(stream)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if b.count(line) {
+				continue
+			}
+			if _, err := io.WriteString(writer, line+"\n"); err != nil {
+				return // reader closed
+			}
+		}
+		_ = writer.CloseWithError(scanner.Err())
+	}()
+	return reader
+}
+
+// parseLocalAction returns the zone of an Unbound log line logged with
+// log-local-actions, in the form `info: zone. type ip@port name. type class`.
+func parseLocalAction(line string) (zone string, ok bool) {
+	const prefix = "info: "
+	i := strings.Index(line, prefix)
+	if i < 0 {
+		return "", false
+	}
+	fields := strings.Fields(line[i+len(prefix):])
+	const localActionFields = 6
+	if len(fields) != localActionFields || !strings.Contains(fields[2], "@") {
+		return "", false
+	}
+	zone = strings.ToLower(strings.TrimSuffix(fields[0], "."))
+	return zone, true
+}
@@ -0,0 +1,70 @@
+package dns
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseLocalAction(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		line string
+		zone string
+		ok   bool
+	}{
+		"empty": {},
+		"other info line": {
+			line: "[1615000000] unbound[1:0] info: start of service (unbound 1.13.1).",
+		},
+		"local action": {
+			line: "[1615000000] unbound[1:0] info: Ads.com. static 127.0.0.1@41000 ads.com. A IN",
+			zone: "ads.com",
+			ok:   true,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			zone, ok := parseLocalAction(testCase.line)
+			assert.Equal(t, testCase.zone, zone)
+			assert.Equal(t, testCase.ok, ok)
+		})
+	}
+}
+
+func Test_blockCounter(t *testing.T) {
+	t.Parallel()
+	counter := newBlockCounter()
+	counter.setZones(map[string][]string{
+		"ads.com":   {blockCategoryAds},
+		"track.com": {blockCategoryAds, blockCategorySurveillance},
+	})
+	stream := ioutil.NopCloser(strings.NewReader(
+		"info: start of service\n" +
+			"info: ads.com. static 127.0.0.1@41000 ads.com. A IN\n" +
+			"info: track.com. static 127.0.0.1@41001 track.com. AAAA IN\n" +
+			"info: allowed.com. transparent 127.0.0.1@41002 allowed.com. A IN\n" +
+			"info: ads.com. static 127.0.0.1@41003 ads.com. AAAA IN\n"))
+
+	data, err := ioutil.ReadAll(counter.filter(stream))
+	require.NoError(t, err)
+	assert.Equal(t, "info: start of service\n", string(data))
+
+	expected := BlockStats{
+		Total: 3,
+		Categories: map[string]uint64{
+			blockCategoryAds:          3,
+			blockCategorySurveillance: 1,
+		},
+	}
+	assert.Equal(t, expected, counter.stats())
+
+	// counts are kept across configurations
+	counter.setZones(nil)
+	assert.Equal(t, expected, counter.stats())
+}
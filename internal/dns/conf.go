@@ -3,38 +3,51 @@ package dns
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
+	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/golibs/files"
 	"github.com/qdm12/golibs/logging"
 	"github.com/qdm12/golibs/network"
 )
 
-func (c *configurator) MakeUnboundConf(ctx context.Context, settings settings.DNS, uid, gid int) (err error) {
+// MakeUnboundConf writes the Unbound configuration file and returns the
+// blocked zones mapped to their block list categories.
+func (c *configurator) MakeUnboundConf(ctx context.Context, settings settings.DNS, uid, gid int) (
+	blockedZones map[string][]string, err error) {
 	c.logger.Info("generating Unbound configuration")
-	lines, warnings := generateUnboundConf(ctx, settings, c.client, c.logger)
+	rpzZones, warnings := c.prepareRPZZones(ctx, settings.RPZ, uid, gid)
+	lines, blockedZones, confWarnings := generateUnboundConf(ctx, settings, rpzZones, c.client, c.logger)
+	warnings = append(warnings, confWarnings...)
 	for _, warning := range warnings {
 		c.logger.Warn(warning)
 	}
-	return c.fileManager.WriteLinesToFile(
+	err = c.fileManager.WriteLinesToFile(
 		string(constants.UnboundConf),
 		lines,
 		files.Ownership(uid, gid),
 		files.Permissions(constants.UserReadPermission))
+	return blockedZones, err
 }
 
-// MakeUnboundConf generates an Unbound configuration from the user provided settings.
-func generateUnboundConf(ctx context.Context, settings settings.DNS,
+// generateUnboundConf generates an Unbound configuration from the user provided settings.
+func generateUnboundConf(ctx context.Context, settings settings.DNS, rpzZones []rpzZone,
 	client network.Client, logger logging.Logger) (
-	lines []string, warnings []error) {
+	lines []string, blockedZones map[string][]string, warnings []error) {
 	doIPv6 := "no"
 	if settings.IPv6 {
 		doIPv6 = "yes"
 	}
+	prefetch := "no"
+	if settings.Prefetch {
+		prefetch = "yes"
+	}
 	serverSection := map[string]string{
 		// Logging
 		"verbosity":     fmt.Sprintf("%d", settings.VerbosityLevel),
@@ -42,8 +55,8 @@ func generateUnboundConf(ctx context.Context, settings settings.DNS,
 		"use-syslog":    "no",
 		// Performance
 		"num-threads":       "1",
-		"prefetch":          "yes",
-		"prefetch-key":      "yes",
+		"prefetch":          prefetch,
+		"prefetch-key":      prefetch,
 		"key-cache-size":    "16m",
 		"key-cache-slabs":   "4",
 		"msg-cache-size":    "4m",
@@ -72,11 +85,48 @@ func generateUnboundConf(ctx context.Context, settings settings.DNS,
 		"username": "\"nonrootuser\"",
 	}
 
+	if settings.ServeExpired {
+		// answer from the cache only if the upstream servers
+		// do not answer within the client timeout, see RFC 8767
+		const clientTimeoutMs = "1800"
+		serverSection["serve-expired"] = "yes"
+		serverSection["serve-expired-ttl"] = fmt.Sprintf("%d", int(settings.ServeExpiredTTL.Seconds()))
+		serverSection["serve-expired-client-timeout"] = clientTimeoutMs
+	}
+
+	// Upstream connections
+	serverSection["outgoing-num-tcp"] = fmt.Sprintf("%d", settings.MaxConnections)
+	serverSection["tcp-auth-query-timeout"] = fmt.Sprintf("%d", settings.QueryTimeout.Milliseconds())
+	if settings.ConnectionReuse {
+		serverSection["tcp-reuse-timeout"] = fmt.Sprintf("%d", settings.ConnectionIdleTimeout.Milliseconds())
+	} else {
+		// each TLS connection only carries a single query
+		serverSection["max-reuse-tcp-queries"] = "1"
+	}
+
+	if len(rpzZones) > 0 {
+		// the respip module applies the response policy zones
+		serverSection["module-config"] = "\"respip validator iterator\""
+	}
+
+	localServerLines, localForwardZonesLines := buildLocalTLDs(
+		settings.LocalTLDs, settings.LocalRecords, settings.LocalResolver)
+	dockerServerLines, dockerForwardZonesLines := buildDockerHostnames(settings.DockerHostnames)
+	if len(settings.DockerHostnames) > 0 || settings.DNSCrypt {
+		// required to forward queries to the Docker embedded DNS
+		// or to the local dnscrypt-proxy
+		serverSection["do-not-query-localhost"] = "no"
+	}
+
 	// Block lists
-	hostnamesLines, ipsLines, warnings := buildBlocked(ctx, client,
+	hostnamesLines, blockedZones, ipsLines, warnings := buildBlocked(ctx, client,
 		settings.BlockMalicious, settings.BlockAds, settings.BlockSurveillance,
-		settings.AllowedHostnames, settings.PrivateAddresses,
+		settings.AllowedHostnames, settings.PrivateAddresses, settings.BlockPatterns,
 	)
+	if len(blockedZones) > 0 {
+		// the blocked queries are counted from the local zones actions logged
+		serverSection["log-local-actions"] = "yes"
+	}
 	logger.Info("%d hostnames blocked overall", len(hostnamesLines))
 	logger.Info("%d IP addresses blocked overall", len(ipsLines))
 	sort.Slice(hostnamesLines, func(i, j int) bool { // for unit tests really
@@ -100,6 +150,8 @@ func generateUnboundConf(ctx context.Context, settings settings.DNS,
 	lines = append(lines, serverLines...)
 	lines = append(lines, hostnamesLines...)
 	lines = append(lines, ipsLines...)
+	lines = append(lines, localServerLines...)
+	lines = append(lines, dockerServerLines...)
 
 	// Forward zone
 	lines = append(lines, "forward-zone:")
@@ -107,6 +159,9 @@ func generateUnboundConf(ctx context.Context, settings settings.DNS,
 		"name":                 "\".\"",
 		"forward-tls-upstream": "yes",
 	}
+	if settings.DNSCrypt {
+		forwardZoneSection["forward-tls-upstream"] = "no"
+	}
 	if settings.Caching {
 		forwardZoneSection["forward-no-cache"] = "no"
 	} else {
@@ -121,24 +176,111 @@ func generateUnboundConf(ctx context.Context, settings settings.DNS,
 	sort.Slice(forwardZoneLines, func(i, j int) bool {
 		return forwardZoneLines[i] < forwardZoneLines[j]
 	})
-	for _, provider := range settings.Providers {
+	if settings.DNSCrypt {
+		forwardZoneLines = append(forwardZoneLines,
+			fmt.Sprintf("  forward-addr: 127.0.0.1@%d", dnscryptPort))
+	} else {
+		forwardZoneLines = append(forwardZoneLines, buildForwardAddresses(settings.Providers)...)
+	}
+	lines = append(lines, forwardZoneLines...)
+	lines = append(lines, buildDomainForwardZones(settings.DomainProviders, settings.Caching)...)
+	lines = append(lines, localForwardZonesLines...)
+	lines = append(lines, dockerForwardZonesLines...)
+	lines = append(lines, buildRPZ(rpzZones)...)
+	return lines, blockedZones, warnings
+}
+
+func buildForwardAddresses(providers []models.DNSProvider) (lines []string) {
+	for _, provider := range providers {
 		providerData := constants.DNSProviderMapping()[provider]
 		for _, IP := range providerData.IPs {
-			forwardZoneLines = append(forwardZoneLines,
+			lines = append(lines,
 				fmt.Sprintf("  forward-addr: %s@853#%s", IP, providerData.Host))
 		}
 	}
-	lines = append(lines, forwardZoneLines...)
-	return lines, warnings
+	return lines
+}
+
+// buildDomainForwardZones returns a forward zone for each domain resolved
+// with its own DNS over TLS providers.
+func buildDomainForwardZones(domainProviders []models.DNSDomainProviders, caching bool) (lines []string) {
+	noCache := "yes"
+	if caching {
+		noCache = "no"
+	}
+	for _, domainProvider := range domainProviders {
+		lines = append(lines,
+			"forward-zone:",
+			"  name: \""+domainProvider.Domain+".\"",
+			"  forward-tls-upstream: yes",
+			"  forward-no-cache: "+noCache,
+		)
+		lines = append(lines, buildForwardAddresses(domainProvider.Providers)...)
+	}
+	return lines
+}
+
+// buildLocalTLDs returns the server section lines and the forward zones
+// so that the local top level domains are never resolved upstream, but either
+// answered from the local records or forwarded to the LAN resolver if set.
+func buildLocalTLDs(tlds []string, records []models.DNSLocalRecord, resolver net.IP) (
+	serverLines, forwardZonesLines []string) {
+	for _, tld := range tlds {
+		if resolver == nil {
+			serverLines = append(serverLines, "  local-zone: \""+tld+".\" static")
+			continue
+		}
+		serverLines = append(serverLines,
+			"  private-domain: \""+tld+".\"",
+			"  domain-insecure: \""+tld+".\"",
+		)
+		forwardZonesLines = append(forwardZonesLines,
+			"forward-zone:",
+			"  name: \""+tld+".\"",
+			"  forward-addr: "+resolver.String(),
+		)
+	}
+	for _, record := range records {
+		recordType := "A"
+		if record.IP.To4() == nil {
+			recordType = "AAAA"
+		}
+		serverLines = append(serverLines,
+			fmt.Sprintf("  local-data: \"%s. %s %s\"", record.Hostname, recordType, record.IP),
+			fmt.Sprintf("  local-data-ptr: \"%s %s\"", record.IP, record.Hostname),
+		)
+	}
+	return serverLines, forwardZonesLines
+}
+
+// buildDockerHostnames returns the server section lines and the forward zones
+// lines to resolve the hostnames given using the Docker embedded DNS, in plaintext
+// and without DNSSEC validation. Their private IP addresses answers are allowed.
+func buildDockerHostnames(hostnames []string) (serverLines, forwardZonesLines []string) {
+	for _, hostname := range hostnames {
+		serverLines = append(serverLines,
+			"  private-domain: \""+hostname+"\"",
+			"  domain-insecure: \""+hostname+"\"",
+		)
+		forwardZonesLines = append(forwardZonesLines,
+			"forward-zone:",
+			"  name: \""+hostname+".\"",
+			"  forward-addr: "+constants.DockerEmbeddedDNS,
+		)
+	}
+	return serverLines, forwardZonesLines
 }
 
 func buildBlocked(ctx context.Context, client network.Client, blockMalicious, blockAds, blockSurveillance bool,
-	allowedHostnames, privateAddresses []string) (hostnamesLines, ipsLines []string, errs []error) {
+	allowedHostnames, privateAddresses []string, patterns []models.DNSBlockPattern) (
+	hostnamesLines []string, blockedZones map[string][]string, ipsLines []string, errs []error) {
 	chHostnames := make(chan []string)
 	chIPs := make(chan []string)
 	chErrors := make(chan []error)
 	go func() {
-		lines, errs := buildBlockedHostnames(ctx, client, blockMalicious, blockAds, blockSurveillance, allowedHostnames)
+		lines, zones, errs := buildBlockedHostnames(ctx, client, blockMalicious, blockAds, blockSurveillance,
+			allowedHostnames, patterns)
+		blockedZones = zones // written before lines are sent
 		chHostnames <- lines
 		chErrors <- errs
 	}()
@@ -159,7 +301,7 @@ func buildBlocked(ctx context.Context, client network.Client, blockMalicious, bl
 			n--
 		}
 	}
-	return hostnamesLines, ipsLines, errs
+	return hostnamesLines, blockedZones, ipsLines, errs
 }
 
 func getList(ctx context.Context, client network.Client, url string) (results []string, err error) {
@@ -187,56 +329,91 @@ func getList(ctx context.Context, client network.Client, url string) (results []
 	return results, nil
 }
 
+// buildBlockedHostnames returns the local zones of the hostnames of the
+// block lists enabled and of the block patterns, and the zones mapped to
+// their block list categories. The zone matched by a pattern, if any, is
+// blocked by Unbound. Since Unbound cannot match patterns, wildcard and
+// regular expression patterns are also matched against the hostnames of
+// all the block lists, including the ones not enabled.
 func buildBlockedHostnames(ctx context.Context, client network.Client, blockMalicious, blockAds, blockSurveillance bool,
-	allowedHostnames []string) (lines []string, errs []error) {
-	chResults := make(chan []string)
-	chError := make(chan error)
-	listsLeftToFetch := 0
-	if blockMalicious {
-		listsLeftToFetch++
-		go func() {
-			results, err := getList(ctx, client, string(constants.MaliciousBlockListHostnamesURL))
-			chResults <- results
-			chError <- err
-		}()
+	allowedHostnames []string, patterns []models.DNSBlockPattern) (
+	lines []string, zones map[string][]string, errs []error) {
+	var regexes []*regexp.Regexp
+	zones = make(map[string][]string)
+	for _, pattern := range patterns {
+		if pattern.Regex != nil {
+			regexes = append(regexes, pattern.Regex)
+		}
+		if pattern.Zone != "" {
+			zones[pattern.Zone] = appendCategory(zones[pattern.Zone], blockCategoryPatterns)
+		}
 	}
-	if blockAds {
-		listsLeftToFetch++
-		go func() {
-			results, err := getList(ctx, client, string(constants.AdsBlockListHostnamesURL))
-			chResults <- results
-			chError <- err
-		}()
+	type listResult struct {
+		hostnames []string
+		blocked   bool
+		category  string
+		err       error
 	}
-	if blockSurveillance {
+	chResult := make(chan listResult)
+	listsLeftToFetch := 0
+	lists := []struct {
+		url      models.URL
+		blocked  bool
+		category string
+	}{
+		{constants.MaliciousBlockListHostnamesURL, blockMalicious, blockCategoryMalicious},
+		{constants.AdsBlockListHostnamesURL, blockAds, blockCategoryAds},
+		{constants.SurveillanceBlockListHostnamesURL, blockSurveillance, blockCategorySurveillance},
+	}
+	for _, list := range lists {
+		if !list.blocked && len(regexes) == 0 {
+			continue
+		}
 		listsLeftToFetch++
-		go func() {
-			results, err := getList(ctx, client, string(constants.SurveillanceBlockListHostnamesURL))
-			chResults <- results
-			chError <- err
-		}()
+		go func(url models.URL, blocked bool, category string) {
+			hostnames, err := getList(ctx, client, string(url))
+			chResult <- listResult{hostnames: hostnames, blocked: blocked, category: category, err: err}
+		}(list.url, list.blocked, list.category)
 	}
-	uniqueResults := make(map[string]struct{})
-	for listsLeftToFetch > 0 {
-		select {
-		case results := <-chResults:
-			for _, result := range results {
-				uniqueResults[result] = struct{}{}
-			}
-		case err := <-chError:
-			listsLeftToFetch--
-			if err != nil {
-				errs = append(errs, err)
+	for ; listsLeftToFetch > 0; listsLeftToFetch-- {
+		result := <-chResult
+		if result.err != nil {
+			errs = append(errs, result.err)
+		}
+		for _, hostname := range result.hostnames {
+			switch {
+			case result.blocked:
+				zones[hostname] = appendCategory(zones[hostname], result.category)
+			case matchesAny(hostname, regexes):
+				zones[hostname] = appendCategory(zones[hostname], blockCategoryPatterns)
 			}
 		}
 	}
 	for _, allowedHostname := range allowedHostnames {
-		delete(uniqueResults, allowedHostname)
+		delete(zones, allowedHostname)
 	}
-	for result := range uniqueResults {
-		lines = append(lines, "  local-zone: \""+result+"\" static")
+	for zone := range zones {
+		lines = append(lines, "  local-zone: \""+zone+"\" static")
 	}
-	return lines, errs
+	return lines, zones, errs
+}
+
+func appendCategory(categories []string, category string) []string {
+	for _, existing := range categories {
+		if existing == category {
+			return categories
+		}
+	}
+	return append(categories, category)
+}
+
+func matchesAny(hostname string, regexes []*regexp.Regexp) bool {
+	for _, regex := range regexes {
+		if regex.MatchString(hostname) {
+			return true
+		}
+	}
+	return false
 }
 
 func buildBlockedIPs(ctx context.Context, client network.Client, blockMalicious, blockAds, blockSurveillance bool,
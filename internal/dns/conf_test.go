@@ -3,8 +3,12 @@ package dns
 import (
 	"context"
 	"fmt"
+	"net"
+	"regexp"
+	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/qdm12/gluetun/internal/constants"
@@ -19,16 +23,23 @@ import (
 func Test_generateUnboundConf(t *testing.T) {
 	t.Parallel()
 	settings := settings.DNS{
-		Providers:          []models.DNSProvider{constants.Cloudflare, constants.Quad9},
-		AllowedHostnames:   []string{"a"},
-		PrivateAddresses:   []string{"9.9.9.9"},
-		BlockMalicious:     true,
-		BlockSurveillance:  false,
-		BlockAds:           false,
-		VerbosityLevel:     2,
-		ValidationLogLevel: 3,
-		Caching:            true,
-		IPv6:               true,
+		Providers:             []models.DNSProvider{constants.Cloudflare, constants.Quad9},
+		AllowedHostnames:      []string{"a"},
+		PrivateAddresses:      []string{"9.9.9.9"},
+		BlockMalicious:        true,
+		BlockSurveillance:     false,
+		BlockAds:              false,
+		VerbosityLevel:        2,
+		ValidationLogLevel:    3,
+		Caching:               true,
+		Prefetch:              true,
+		ServeExpired:          true,
+		ServeExpiredTTL:       time.Hour,
+		IPv6:                  true,
+		ConnectionReuse:       true,
+		ConnectionIdleTimeout: time.Minute,
+		MaxConnections:        10,
+		QueryTimeout:          3 * time.Second,
 	}
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
@@ -41,8 +52,12 @@ func Test_generateUnboundConf(t *testing.T) {
 	logger := mock_logging.NewMockLogger(mockCtrl)
 	logger.EXPECT().Info("%d hostnames blocked overall", 2).Times(1)
 	logger.EXPECT().Info("%d IP addresses blocked overall", 3).Times(1)
-	lines, warnings := generateUnboundConf(ctx, settings, client, logger)
+	lines, blockedZones, warnings := generateUnboundConf(ctx, settings, nil, client, logger)
 	require.Len(t, warnings, 0)
+	assert.Equal(t, map[string][]string{
+		"b": {blockCategoryMalicious},
+		"c": {blockCategoryMalicious},
+	}, blockedZones)
 	expected := `
 server:
   cache-max-ttl: 9000
@@ -57,9 +72,11 @@ server:
   interface: 0.0.0.0
   key-cache-size: 16m
   key-cache-slabs: 4
+  log-local-actions: yes
   msg-cache-size: 4m
   msg-cache-slabs: 4
   num-threads: 1
+  outgoing-num-tcp: 10
   port: 53
   prefetch-key: yes
   prefetch: yes
@@ -67,6 +84,11 @@ server:
   rrset-cache-size: 4m
   rrset-cache-slabs: 4
   rrset-roundrobin: yes
+  serve-expired-client-timeout: 1800
+  serve-expired-ttl: 3600
+  serve-expired: yes
+  tcp-auth-query-timeout: 3000
+  tcp-reuse-timeout: 60000
   tls-cert-bundle: "/etc/ssl/certs/ca-certificates.crt"
   trust-anchor-file: "/etc/unbound/root.key"
   use-syslog: no
@@ -93,6 +115,107 @@ forward-zone:
 	assert.Equal(t, expected, "\n"+strings.Join(lines, "\n"))
 }
 
+func Test_buildDockerHostnames(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		hostnames         []string
+		serverLines       []string
+		forwardZonesLines []string
+	}{
+		"no hostname": {},
+		"two hostnames": {
+			hostnames: []string{"postgres", "db.local"},
+			serverLines: []string{
+				`  private-domain: "postgres"`,
+				`  domain-insecure: "postgres"`,
+				`  private-domain: "db.local"`,
+				`  domain-insecure: "db.local"`,
+			},
+			forwardZonesLines: []string{
+				"forward-zone:",
+				`  name: "postgres."`,
+				"  forward-addr: 127.0.0.11",
+				"forward-zone:",
+				`  name: "db.local."`,
+				"  forward-addr: 127.0.0.11",
+			},
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			serverLines, forwardZonesLines := buildDockerHostnames(tc.hostnames)
+			assert.Equal(t, tc.serverLines, serverLines)
+			assert.Equal(t, tc.forwardZonesLines, forwardZonesLines)
+		})
+	}
+}
+
+func Test_buildLocalTLDs(t *testing.T) {
+	t.Parallel()
+	records := []models.DNSLocalRecord{{Hostname: "nas.lan", IP: net.IP{192, 168, 1, 10}}}
+	tests := map[string]struct {
+		tlds              []string
+		resolver          net.IP
+		serverLines       []string
+		forwardZonesLines []string
+	}{
+		"local records only": {
+			tlds: []string{"lan", "home.arpa"},
+			serverLines: []string{
+				`  local-zone: "lan." static`,
+				`  local-zone: "home.arpa." static`,
+				`  local-data: "nas.lan. A 192.168.1.10"`,
+				`  local-data-ptr: "192.168.1.10 nas.lan"`,
+			},
+		},
+		"LAN resolver": {
+			tlds:     []string{"lan"},
+			resolver: net.IP{192, 168, 1, 1},
+			serverLines: []string{
+				`  private-domain: "lan."`,
+				`  domain-insecure: "lan."`,
+				`  local-data: "nas.lan. A 192.168.1.10"`,
+				`  local-data-ptr: "192.168.1.10 nas.lan"`,
+			},
+			forwardZonesLines: []string{
+				"forward-zone:",
+				`  name: "lan."`,
+				"  forward-addr: 192.168.1.1",
+			},
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			serverLines, forwardZonesLines := buildLocalTLDs(tc.tlds, records, tc.resolver)
+			assert.Equal(t, tc.serverLines, serverLines)
+			assert.Equal(t, tc.forwardZonesLines, forwardZonesLines)
+		})
+	}
+}
+
+func Test_buildDomainForwardZones(t *testing.T) {
+	t.Parallel()
+	domainProviders := []models.DNSDomainProviders{
+		{Domain: "bank.com", Providers: []models.DNSProvider{constants.Quad9}},
+	}
+	lines := buildDomainForwardZones(domainProviders, false)
+	expected := []string{
+		"forward-zone:",
+		`  name: "bank.com."`,
+		"  forward-tls-upstream: yes",
+		"  forward-no-cache: yes",
+		"  forward-addr: 9.9.9.9@853#dns.quad9.net",
+		"  forward-addr: 149.112.112.112@853#dns.quad9.net",
+		"  forward-addr: 2620:fe::fe@853#dns.quad9.net",
+		"  forward-addr: 2620:fe::9@853#dns.quad9.net",
+	}
+	assert.Equal(t, expected, lines)
+}
+
 func Test_buildBlocked(t *testing.T) {
 	t.Parallel()
 	type blockParams struct {
@@ -254,9 +377,9 @@ func Test_buildBlocked(t *testing.T) {
 				client.EXPECT().Get(ctx, string(constants.SurveillanceBlockListIPsURL)).
 					Return(tc.surveillance.content, 200, tc.surveillance.clientErr).Times(1)
 			}
-			hostnamesLines, ipsLines, errs := buildBlocked(ctx, client,
+			hostnamesLines, _, ipsLines, errs := buildBlocked(ctx, client,
 				tc.malicious.blocked, tc.ads.blocked, tc.surveillance.blocked,
-				tc.allowedHostnames, tc.privateAddresses)
+				tc.allowedHostnames, tc.privateAddresses, nil)
 			var errsString []string
 			for _, err := range errs {
 				errsString = append(errsString, err.Error())
@@ -317,10 +440,12 @@ func Test_buildBlockedHostnames(t *testing.T) {
 		surveillance     blockParams
 		allowedHostnames []string
 		lines            []string
+		zones            map[string][]string
 		errsString       []string
 	}{
 		"nothing blocked": {
 			lines:      nil,
+			zones:      map[string][]string{},
 			errsString: nil,
 		},
 		"only malicious blocked": {
@@ -332,6 +457,10 @@ func Test_buildBlockedHostnames(t *testing.T) {
 			lines: []string{
 				"  local-zone: \"site_a\" static",
 				"  local-zone: \"site_b\" static"},
+			zones: map[string][]string{
+				"site_a": {blockCategoryMalicious},
+				"site_b": {blockCategoryMalicious},
+			},
 			errsString: nil,
 		},
 		"all blocked with some duplicates": {
@@ -351,6 +480,11 @@ func Test_buildBlockedHostnames(t *testing.T) {
 				"  local-zone: \"site_a\" static",
 				"  local-zone: \"site_b\" static",
 				"  local-zone: \"site_c\" static"},
+			zones: map[string][]string{
+				"site_a": {blockCategoryAds, blockCategoryMalicious, blockCategorySurveillance},
+				"site_b": {blockCategoryMalicious},
+				"site_c": {blockCategoryAds, blockCategorySurveillance},
+			},
 			errsString: nil,
 		},
 		"all blocked with one errored": {
@@ -370,6 +504,11 @@ func Test_buildBlockedHostnames(t *testing.T) {
 				"  local-zone: \"site_a\" static",
 				"  local-zone: \"site_b\" static",
 				"  local-zone: \"site_c\" static"},
+			zones: map[string][]string{
+				"site_a": {blockCategoryAds, blockCategoryMalicious},
+				"site_b": {blockCategoryMalicious},
+				"site_c": {blockCategoryAds},
+			},
 			errsString: []string{"surveillance error"},
 		},
 		"blocked with allowed hostnames": {
@@ -385,6 +524,10 @@ func Test_buildBlockedHostnames(t *testing.T) {
 			lines: []string{
 				"  local-zone: \"site_a\" static",
 				"  local-zone: \"site_d\" static"},
+			zones: map[string][]string{
+				"site_a": {blockCategoryMalicious},
+				"site_d": {blockCategoryAds},
+			},
 		},
 	}
 	for name, tc := range tests { //nolint:dupl
@@ -407,19 +550,65 @@ func Test_buildBlockedHostnames(t *testing.T) {
 				client.EXPECT().Get(ctx, string(constants.SurveillanceBlockListHostnamesURL)).
 					Return(tc.surveillance.content, 200, tc.surveillance.clientErr).Times(1)
 			}
-			lines, errs := buildBlockedHostnames(ctx, client,
+			lines, zones, errs := buildBlockedHostnames(ctx, client,
 				tc.malicious.blocked, tc.ads.blocked,
-				tc.surveillance.blocked, tc.allowedHostnames)
+				tc.surveillance.blocked, tc.allowedHostnames, nil)
 			var errsString []string
 			for _, err := range errs {
 				errsString = append(errsString, err.Error())
 			}
 			assert.ElementsMatch(t, tc.errsString, errsString)
 			assert.ElementsMatch(t, tc.lines, lines)
+			for _, categories := range zones { // lists are fetched concurrently
+				sort.Strings(categories)
+			}
+			assert.Equal(t, tc.zones, zones)
 		})
 	}
 }
 
+func Test_buildBlockedHostnames_patterns(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	client := mock_network.NewMockClient(mockCtrl)
+	client.EXPECT().Get(ctx, string(constants.MaliciousBlockListHostnamesURL)).
+		Return([]byte("site_a\nad.doubleclick.net"), 200, nil).Times(1)
+	client.EXPECT().Get(ctx, string(constants.AdsBlockListHostnamesURL)).
+		Return([]byte("stats.doubleclick.com\nsite_b\nadtracker.org"), 200, nil).Times(1)
+	client.EXPECT().Get(ctx, string(constants.SurveillanceBlockListHostnamesURL)).
+		Return(nil, 200, fmt.Errorf("surveillance error")).Times(1)
+	patterns := []models.DNSBlockPattern{
+		{Pattern: "tracker.com", Zone: "tracker.com"},
+		{Pattern: "allowed.com", Zone: "allowed.com"},
+		{Pattern: "*.doubleclick.*", Regex: regexp.MustCompile(`^.*\.doubleclick\..*$`)},
+		{Pattern: "*tracker.org", Zone: "tracker.org", Regex: regexp.MustCompile(`^.*tracker\.org$`)},
+	}
+	lines, zones, errs := buildBlockedHostnames(ctx, client, true, false, false,
+		[]string{"allowed.com"}, patterns)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "surveillance error", errs[0].Error())
+	expected := []string{
+		"  local-zone: \"site_a\" static",
+		"  local-zone: \"ad.doubleclick.net\" static",
+		"  local-zone: \"stats.doubleclick.com\" static",
+		"  local-zone: \"tracker.com\" static",
+		"  local-zone: \"tracker.org\" static",
+		"  local-zone: \"adtracker.org\" static",
+	}
+	assert.ElementsMatch(t, expected, lines)
+	expectedZones := map[string][]string{
+		"site_a":                {blockCategoryMalicious},
+		"ad.doubleclick.net":    {blockCategoryMalicious},
+		"stats.doubleclick.com": {blockCategoryPatterns},
+		"tracker.com":           {blockCategoryPatterns},
+		"tracker.org":           {blockCategoryPatterns},
+		"adtracker.org":         {blockCategoryPatterns},
+	}
+	assert.Equal(t, expectedZones, zones)
+}
+
 func Test_buildBlockedIPs(t *testing.T) {
 	t.Parallel()
 	type blockParams struct {
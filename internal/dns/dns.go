@@ -15,9 +15,12 @@ import (
 type Configurator interface {
 	DownloadRootHints(ctx context.Context, uid, gid int) error
 	DownloadRootKey(ctx context.Context, uid, gid int) error
-	MakeUnboundConf(ctx context.Context, settings settings.DNS, uid, gid int) (err error)
+	MakeUnboundConf(ctx context.Context, settings settings.DNS, uid, gid int) (
+		blockedZones map[string][]string, err error)
 	UseDNSInternally(IP net.IP)
 	UseDNSSystemWide(ip net.IP, keepNameserver bool) error
+	MakeDNSCryptConf(settings settings.DNS, uid, gid int) (err error)
+	StartDNSCrypt(ctx context.Context) (stdout io.ReadCloser, waitFn func() error, err error)
 	Start(ctx context.Context, logLevel uint8) (stdout io.ReadCloser, waitFn func() error, err error)
 	WaitForUnbound() (err error)
 	Version(ctx context.Context) (version string, err error)
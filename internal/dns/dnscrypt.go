@@ -0,0 +1,61 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/files"
+)
+
+// dnscryptPort is the port dnscrypt-proxy listens on locally,
+// for Unbound to forward its queries to.
+const dnscryptPort = 5053
+
+func (c *configurator) MakeDNSCryptConf(settings settings.DNS, uid, gid int) (err error) {
+	c.logger.Info("generating dnscrypt-proxy configuration")
+	lines := buildDNSCryptConf(settings.DNSCryptStamps, settings.IPv6)
+	return c.fileManager.WriteLinesToFile(
+		string(constants.DNSCryptConf),
+		lines,
+		files.Ownership(uid, gid),
+		files.Permissions(constants.UserReadPermission))
+}
+
+func (c *configurator) StartDNSCrypt(ctx context.Context) (
+	stdout io.ReadCloser, waitFn func() error, err error) {
+	c.logger.Info("starting dnscrypt-proxy")
+	stdout, _, waitFn, err = c.commander.Start(ctx, "dnscrypt-proxy", "-config", string(constants.DNSCryptConf))
+	return stdout, waitFn, err
+}
+
+// buildDNSCryptConf returns the dnscrypt-proxy TOML configuration lines to
+// only use the DNSCrypt servers stamps given. Caching is left to Unbound.
+func buildDNSCryptConf(stamps []string, ipv6 bool) (lines []string) {
+	names := make([]string, len(stamps))
+	for i := range stamps {
+		names[i] = "'gluetun-" + strconv.Itoa(i+1) + "'"
+	}
+	lines = []string{
+		fmt.Sprintf("listen_addresses = ['127.0.0.1:%d']", dnscryptPort),
+		fmt.Sprintf("server_names = [%s]", strings.Join(names, ", ")),
+		fmt.Sprintf("ipv6_servers = %t", ipv6),
+		"dnscrypt_servers = true",
+		"doh_servers = false",
+		"require_dnssec = false",
+		"cache = false",
+		"netprobe_timeout = 0",
+		"[static]",
+	}
+	for i, stamp := range stamps {
+		lines = append(lines,
+			"  [static."+names[i]+"]",
+			"  stamp = '"+stamp+"'",
+		)
+	}
+	return lines
+}
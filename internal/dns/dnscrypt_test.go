@@ -0,0 +1,28 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildDNSCryptConf(t *testing.T) {
+	t.Parallel()
+	lines := buildDNSCryptConf([]string{"sdns://a", "sdns://b"}, false)
+	expected := []string{
+		"listen_addresses = ['127.0.0.1:5053']",
+		"server_names = ['gluetun-1', 'gluetun-2']",
+		"ipv6_servers = false",
+		"dnscrypt_servers = true",
+		"doh_servers = false",
+		"require_dnssec = false",
+		"cache = false",
+		"netprobe_timeout = 0",
+		"[static]",
+		"  [static.'gluetun-1']",
+		"  stamp = 'sdns://a'",
+		"  [static.'gluetun-2']",
+		"  stamp = 'sdns://b'",
+	}
+	assert.Equal(t, expected, lines)
+}
@@ -2,12 +2,16 @@ package dns
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"sync"
 	"time"
 
 	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/loopstate"
 	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/gluetun/internal/tracing"
 	"github.com/qdm12/golibs/command"
 	"github.com/qdm12/golibs/logging"
 )
@@ -20,14 +24,24 @@ type Looper interface {
 	Stop()
 	GetSettings() (settings settings.DNS)
 	SetSettings(settings settings.DNS)
+	// Ready returns true if DNS over TLS is disabled, or
+	// if Unbound is running and ready to resolve.
+	Ready() bool
+	// GetBlockStats returns the number of queries blocked
+	// since gluetun started, for each block list category.
+	GetBlockStats() BlockStats
 }
 
 type looper struct {
 	conf          Configurator
 	settings      settings.DNS
 	settingsMutex sync.RWMutex
+	ready         bool // guarded by settingsMutex
 	logger        logging.Logger
+	state         loopstate.Reporter
 	streamMerger  command.StreamMerger
+	blocks        *blockCounter
+	tracer        tracing.Tracer
 	uid           int
 	gid           int
 	restart       chan struct{}
@@ -38,15 +52,18 @@ type looper struct {
 	timeSince     func(time.Time) time.Duration
 }
 
-func NewLooper(conf Configurator, settings settings.DNS, logger logging.Logger,
-	streamMerger command.StreamMerger, uid, gid int) Looper {
+func NewLooper(conf Configurator, settings settings.DNS, logger logging.Logger, state loopstate.Reporter,
+	streamMerger command.StreamMerger, tracer tracing.Tracer, uid, gid int) Looper {
 	return &looper{
 		conf:         conf,
 		settings:     settings,
 		logger:       logger.WithPrefix("dns over tls: "),
+		state:        state,
 		uid:          uid,
 		gid:          gid,
 		streamMerger: streamMerger,
+		blocks:       newBlockCounter(),
+		tracer:       tracer,
 		restart:      make(chan struct{}),
 		start:        make(chan struct{}),
 		stop:         make(chan struct{}),
@@ -68,15 +85,20 @@ func (l *looper) GetSettings() (settings settings.DNS) {
 
 func (l *looper) SetSettings(settings settings.DNS) {
 	l.settingsMutex.Lock()
-	defer l.settingsMutex.Unlock()
 	updatePeriodDiffers := l.settings.UpdatePeriod != settings.UpdatePeriod
 	l.settings = settings
+	// the mutex is released before signaling the restart ticker, which
+	// reads the settings, and must not be unlocked again by a defer.
 	l.settingsMutex.Unlock()
 	if updatePeriodDiffers {
 		l.updateTicker <- struct{}{}
 	}
 }
 
+func (l *looper) GetBlockStats() BlockStats {
+	return l.blocks.stats()
+}
+
 func (l *looper) isEnabled() bool {
 	l.settingsMutex.RLock()
 	defer l.settingsMutex.RUnlock()
@@ -89,8 +111,21 @@ func (l *looper) setEnabled(enabled bool) {
 	l.settings.Enabled = enabled
 }
 
+func (l *looper) Ready() bool {
+	l.settingsMutex.RLock()
+	defer l.settingsMutex.RUnlock()
+	return !l.settings.Enabled || l.ready
+}
+
+func (l *looper) setReady(ready bool) {
+	l.settingsMutex.Lock()
+	defer l.settingsMutex.Unlock()
+	l.ready = ready
+}
+
 func (l *looper) logAndWait(ctx context.Context, err error) {
 	l.logger.Warn(err)
+	l.state.SetError(err)
 	l.logger.Info("attempting restart in 10 seconds")
 	const waitDuration = 10 * time.Second
 	timer := time.NewTimer(waitDuration)
@@ -158,6 +193,7 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup, signalDNSReady fun
 		return
 	}
 	defer l.logger.Warn("loop exited")
+	defer l.state.SetState(loopstate.Stopped)
 
 	var unboundCtx context.Context
 	var unboundCancel context.CancelFunc = func() {}
@@ -166,19 +202,35 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup, signalDNSReady fun
 	l.setEnabled(true)
 	for ctx.Err() == nil {
 		l.waitForSubsequentStart(ctx, unboundCancel)
+		l.state.SetState(loopstate.Starting)
 
 		settings := l.GetSettings()
 
 		// Setup
-		if err := l.conf.DownloadRootHints(ctx, l.uid, l.gid); err != nil {
+		setupCtx, setupSpan := l.tracer.Start(ctx, "dns.setup")
+		if err := tracing.Do(setupCtx, l.tracer, "dns.download_root_hints", func(ctx context.Context) error {
+			return l.conf.DownloadRootHints(ctx, l.uid, l.gid)
+		}); err != nil {
+			setupSpan.End(err)
 			l.logAndWait(ctx, err)
 			continue
 		}
-		if err := l.conf.DownloadRootKey(ctx, l.uid, l.gid); err != nil {
+		if err := tracing.Do(setupCtx, l.tracer, "dns.download_root_key", func(ctx context.Context) error {
+			return l.conf.DownloadRootKey(ctx, l.uid, l.gid)
+		}); err != nil {
+			setupSpan.End(err)
 			l.logAndWait(ctx, err)
 			continue
 		}
-		if err := l.conf.MakeUnboundConf(ctx, settings, l.uid, l.gid); err != nil {
+		if err := tracing.Do(setupCtx, l.tracer, "dns.configure", func(ctx context.Context) error {
+			blockedZones, err := l.conf.MakeUnboundConf(ctx, settings, l.uid, l.gid)
+			if err != nil {
+				return err
+			}
+			l.blocks.setZones(blockedZones)
+			return nil
+		}); err != nil {
+			setupSpan.End(err)
 			l.logAndWait(ctx, err)
 			continue
 		}
@@ -190,8 +242,24 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup, signalDNSReady fun
 			close(waitError)
 		}
 		unboundCtx, unboundCancel = context.WithCancel(context.Background())
+		var dnscryptError <-chan error // nil if dnscrypt-proxy is not used
+		if settings.DNSCrypt {
+			var err error
+			dnscryptError, err = l.startDNSCrypt(unboundCtx, settings)
+			if err != nil {
+				setupSpan.End(err)
+				unboundCancel()
+				const fallback = true
+				l.useUnencryptedDNS(fallback)
+				l.logAndWait(ctx, err)
+				continue
+			}
+		}
+		_, startSpan := l.tracer.Start(setupCtx, "dns.start")
 		stream, waitFn, err := l.conf.Start(unboundCtx, settings.VerbosityDetailsLevel)
+		startSpan.End(err)
 		if err != nil {
+			setupSpan.End(err)
 			unboundCancel()
 			const fallback = true
 			l.useUnencryptedDNS(fallback)
@@ -200,12 +268,15 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup, signalDNSReady fun
 		}
 
 		// Started successfully
-		go l.streamMerger.Merge(unboundCtx, stream, command.MergeName("unbound"))
+		go l.streamMerger.Merge(unboundCtx, l.blocks.filter(stream), command.MergeName("unbound"))
 		l.conf.UseDNSInternally(net.IP{127, 0, 0, 1})                                                  // use Unbound
 		if err := l.conf.UseDNSSystemWide(net.IP{127, 0, 0, 1}, settings.KeepNameserver); err != nil { // use Unbound
 			l.logger.Error(err)
 		}
-		if err := l.conf.WaitForUnbound(); err != nil {
+		if err := tracing.Do(setupCtx, l.tracer, "dns.wait_ready", func(ctx context.Context) error {
+			return l.conf.WaitForUnbound()
+		}); err != nil {
+			setupSpan.End(err)
 			unboundCancel()
 			const fallback = true
 			l.useUnencryptedDNS(fallback)
@@ -217,7 +288,10 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup, signalDNSReady fun
 			err := waitFn() // blocking
 			waitError <- err
 		}()
+		setupSpan.End(nil)
 		l.logger.Info("DNS over TLS is ready")
+		l.setReady(true)
+		l.state.SetState(loopstate.Running)
 		signalDNSReady()
 
 		stayHere := true
@@ -242,10 +316,23 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup, signalDNSReady fun
 				<-waitError
 				close(waitError)
 				l.setEnabled(false)
+				l.setReady(false)
+				l.state.SetState(loopstate.Stopped)
 				stayHere = false
 			case err := <-waitError: // unexpected error
 				close(waitError)
 				unboundCancel()
+				l.setReady(false)
+				const fallback = true
+				l.useUnencryptedDNS(fallback)
+				l.logAndWait(ctx, err)
+				stayHere = false
+			case err := <-dnscryptError: // unexpected dnscrypt-proxy exit
+				// Unbound cannot resolve without dnscrypt-proxy so it is restarted too
+				unboundCancel()
+				<-waitError
+				close(waitError)
+				l.setReady(false)
 				const fallback = true
 				l.useUnencryptedDNS(fallback)
 				l.logAndWait(ctx, err)
@@ -256,6 +343,33 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup, signalDNSReady fun
 	unboundCancel()
 }
 
+// startDNSCrypt runs dnscrypt-proxy for Unbound to forward its queries to,
+// until the context given is canceled. The channel returned receives an
+// error if dnscrypt-proxy exits before the context is canceled.
+func (l *looper) startDNSCrypt(ctx context.Context, settings settings.DNS) (
+	exitError <-chan error, err error) {
+	if err := l.conf.MakeDNSCryptConf(settings, l.uid, l.gid); err != nil {
+		return nil, err
+	}
+	stream, waitFn, err := l.conf.StartDNSCrypt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	go l.streamMerger.Merge(ctx, stream, command.MergeName("dnscrypt-proxy"))
+	errorCh := make(chan error, 1) // buffered so the goroutine never blocks
+	go func() {
+		err := waitFn() // blocking
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil {
+			err = errors.New("exited unexpectedly")
+		}
+		errorCh <- fmt.Errorf("dnscrypt-proxy: %w", err)
+	}()
+	return errorCh, nil
+}
+
 func (l *looper) useUnencryptedDNS(fallback bool) {
 	settings := l.GetSettings()
 
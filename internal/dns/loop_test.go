@@ -0,0 +1,28 @@
+package dns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_looper_SetSettings(t *testing.T) {
+	t.Parallel()
+	l := &looper{updateTicker: make(chan struct{})}
+
+	// same update period, the restart ticker is not signaled
+	l.SetSettings(settings.DNS{Enabled: true})
+	assert.Equal(t, settings.DNS{Enabled: true}, l.GetSettings())
+
+	// the restart ticker reads the settings once signaled
+	tickerSettings := make(chan settings.DNS)
+	go func() {
+		<-l.updateTicker
+		tickerSettings <- l.GetSettings()
+	}()
+	newSettings := settings.DNS{Enabled: true, UpdatePeriod: time.Hour}
+	l.SetSettings(newSettings)
+	assert.Equal(t, newSettings, <-tickerSettings)
+}
@@ -0,0 +1,123 @@
+package dns
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/golibs/files"
+)
+
+// rpzZone is a response policy zone loaded by Unbound from its zone file.
+type rpzZone struct {
+	name     string
+	zonefile string
+}
+
+// prepareRPZZones returns the response policy zones of the sources given,
+// which are zone file paths or URLs. Zones at URLs are downloaded to zone
+// files next to the Unbound configuration, so they are refreshed every time
+// the configuration is generated. Zones which cannot be obtained are skipped.
+func (c *configurator) prepareRPZZones(ctx context.Context, sources []string, uid, gid int) (
+	zones []rpzZone, warnings []error) {
+	for i, source := range sources {
+		zone, err := c.prepareRPZZone(ctx, source, i+1, uid, gid)
+		if err != nil {
+			warnings = append(warnings, fmt.Errorf("response policy zone %s: %w", source, err))
+			continue
+		}
+		zones = append(zones, zone)
+	}
+	return zones, warnings
+}
+
+func (c *configurator) prepareRPZZone(ctx context.Context, source string, number, uid, gid int) (
+	zone rpzZone, err error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		content, err := c.fileManager.ReadFile(source)
+		if err != nil {
+			return zone, err
+		}
+		zone.zonefile = source
+		zone.name, err = rpzZoneName(content)
+		return zone, err
+	}
+	content, status, err := c.client.Get(ctx, source)
+	if err != nil {
+		return zone, err
+	} else if status != http.StatusOK {
+		return zone, fmt.Errorf("HTTP status code is %d and not 200", status)
+	}
+	zone.name, err = rpzZoneName(content)
+	if err != nil {
+		return zone, err
+	}
+	zone.zonefile = filepath.Join(filepath.Dir(string(constants.UnboundConf)), fmt.Sprintf("rpz%d.zone", number))
+	err = c.fileManager.WriteToFile(zone.zonefile, content,
+		files.Ownership(uid, gid), files.Permissions(constants.UserReadPermission))
+	return zone, err
+}
+
+// rpzZoneName returns the name of the zone, from its $ORIGIN directive
+// or from the owner name of its SOA record.
+func rpzZoneName(content []byte) (name string, err error) {
+	origin := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, ";"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case fields[0] == "$ORIGIN" && len(fields) > 1:
+			origin = fields[1]
+			continue
+		}
+		if !hasSOA(fields) {
+			continue
+		}
+		owner := fields[0]
+		if line[0] == ' ' || line[0] == '\t' || owner == "@" {
+			owner = origin
+		}
+		if owner == "" {
+			return "", fmt.Errorf("SOA record has no owner name and no $ORIGIN is set")
+		}
+		if !strings.HasSuffix(owner, ".") {
+			owner += "."
+		}
+		return owner, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no SOA record found")
+}
+
+func hasSOA(fields []string) bool {
+	for _, field := range fields {
+		if strings.EqualFold(field, "SOA") {
+			return true
+		}
+	}
+	return false
+}
+
+func buildRPZ(zones []rpzZone) (lines []string) {
+	for _, zone := range zones {
+		lines = append(lines,
+			"rpz:",
+			"  name: \""+zone.name+"\"",
+			"  zonefile: \""+zone.zonefile+"\"",
+		)
+	}
+	return lines
+}
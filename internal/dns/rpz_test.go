@@ -0,0 +1,62 @@
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_rpzZoneName(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		content string
+		name    string
+		err     string
+	}{
+		"SOA owner": {
+			content: "; feed\nrpz.example.com. 3600 IN SOA ns.example.com. admin.example.com. 1 3600 600 86400 60\n" +
+				"bad.com CNAME .\n",
+			name: "rpz.example.com.",
+		},
+		"origin": {
+			content: "$ORIGIN rpz.example.com\n$TTL 300\n@ SOA localhost. root.localhost. (1 3600 600 86400 60)\n",
+			name:    "rpz.example.com.",
+		},
+		"no origin": {
+			content: "@ SOA localhost. root.localhost. 1 3600 600 86400 60\n",
+			err:     "SOA record has no owner name and no $ORIGIN is set",
+		},
+		"no SOA": {
+			content: "bad.com CNAME .\n",
+			err:     "no SOA record found",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			name, err := rpzZoneName([]byte(testCase.content))
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.name, name)
+		})
+	}
+}
+
+func Test_buildRPZ(t *testing.T) {
+	t.Parallel()
+	lines := buildRPZ([]rpzZone{
+		{name: "rpz.example.com.", zonefile: "/etc/unbound/rpz1.zone"},
+	})
+	expected := []string{
+		"rpz:",
+		`  name: "rpz.example.com."`,
+		`  zonefile: "/etc/unbound/rpz1.zone"`,
+	}
+	assert.Equal(t, expected, lines)
+}
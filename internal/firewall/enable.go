@@ -3,8 +3,6 @@ package firewall
 import (
 	"context"
 	"fmt"
-
-	"github.com/qdm12/gluetun/internal/constants"
 )
 
 func (c *configurator) SetEnabled(ctx context.Context, enabled bool) (err error) {
@@ -85,17 +83,33 @@ func (c *configurator) enable(ctx context.Context) (err error) {
 	if err = c.acceptEstablishedRelatedTraffic(ctx, remove); err != nil {
 		return fmt.Errorf("cannot enable firewall: %w", err)
 	}
+	if err = c.acceptNeighborDiscovery(ctx, remove); err != nil {
+		return fmt.Errorf("cannot enable firewall: %w", err)
+	}
 	if c.vpnConnection.IP != nil {
 		if err = c.acceptOutputTrafficToVPN(ctx, c.defaultInterface, c.vpnConnection, remove); err != nil {
 			return fmt.Errorf("cannot enable firewall: %w", err)
 		}
 	}
-	if err = c.acceptOutputThroughInterface(ctx, string(constants.TUN), remove); err != nil {
+	if err = c.acceptOutputThroughInterface(ctx, c.vpnInterface, remove); err != nil {
 		return fmt.Errorf("cannot enable firewall: %w", err)
 	}
+	for intf, connection := range c.extraConnections {
+		if connection.IP != nil {
+			if err = c.acceptOutputTrafficToVPN(ctx, c.defaultInterface, connection, remove); err != nil {
+				return fmt.Errorf("cannot enable firewall: %w", err)
+			}
+		}
+		if err = c.acceptOutputThroughInterface(ctx, intf, remove); err != nil {
+			return fmt.Errorf("cannot enable firewall: %w", err)
+		}
+	}
 
-	if err := c.acceptOutputFromIPToSubnet(ctx, c.defaultInterface, c.localIP, c.localSubnet, remove); err != nil {
-		return fmt.Errorf("cannot enable firewall: %w", err)
+	for _, network := range c.localNetworks {
+		if err := c.acceptOutputFromIPToSubnet(ctx, network.InterfaceName,
+			network.IP, network.Subnet, remove); err != nil {
+			return fmt.Errorf("cannot enable firewall: %w", err)
+		}
 	}
 
 	for _, subnet := range c.outboundSubnets {
@@ -104,18 +118,48 @@ func (c *configurator) enable(ctx context.Context) (err error) {
 		}
 	}
 
-	// Allows packets from any IP address to go through eth0 / local network
-	// to reach Gluetun.
-	if err := c.acceptInputToSubnet(ctx, c.defaultInterface, c.localSubnet, remove); err != nil {
+	if err := c.setICMPEchoRules(ctx, c.icmpEcho, c.vpnICMPEcho, remove); err != nil {
 		return fmt.Errorf("cannot enable firewall: %w", err)
 	}
 
+	// Allows packets from any IP address to go through the local networks
+	// interfaces to reach Gluetun.
+	for _, network := range c.localNetworks {
+		if err := c.acceptInputToSubnet(ctx, network.InterfaceName, network.Subnet, remove); err != nil {
+			return fmt.Errorf("cannot enable firewall: %w", err)
+		}
+	}
+
+	for _, subnet := range c.vpnBypassSubnets {
+		if err := c.acceptForwardFromSubnet(ctx, c.defaultInterface, subnet, remove); err != nil {
+			return fmt.Errorf("cannot enable firewall: %w", err)
+		}
+	}
+
+	for _, match := range c.outboundPorts {
+		if err := c.acceptOutputMatching(ctx, c.defaultInterface, match, remove); err != nil {
+			return fmt.Errorf("cannot enable firewall: %w", err)
+		}
+	}
+
+	for _, match := range c.vpnBypassOwners {
+		if err := c.acceptOutputMatching(ctx, c.defaultInterface, match, remove); err != nil {
+			return fmt.Errorf("cannot enable firewall: %w", err)
+		}
+	}
+
 	for port, intf := range c.allowedInputPorts {
 		if err := c.acceptInputToPort(ctx, intf, port, remove); err != nil {
 			return fmt.Errorf("cannot enable firewall: %w", err)
 		}
 	}
 
+	for _, subnet := range c.transparentProxySubnets {
+		if err := c.acceptInputTransparentProxy(ctx, c.defaultInterface, subnet, remove); err != nil {
+			return fmt.Errorf("cannot enable firewall: %w", err)
+		}
+	}
+
 	if err := c.runUserPostRules(ctx, "/iptables/post-rules.txt", remove); err != nil {
 		return fmt.Errorf("cannot enable firewall: %w", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"net"
 	"sync"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/routing"
 	"github.com/qdm12/golibs/command"
@@ -17,12 +18,29 @@ type Configurator interface {
 	Version(ctx context.Context) (string, error)
 	SetEnabled(ctx context.Context, enabled bool) (err error)
 	SetVPNConnection(ctx context.Context, connection models.OpenVPNConnection) (err error)
+	SetExtraVPNConnection(ctx context.Context, intf string, connection models.OpenVPNConnection) (err error)
+	SetBalancedTunnels(ctx context.Context, tunnels []BalancedTunnel) (err error)
 	SetAllowedPort(ctx context.Context, port uint16, intf string) (err error)
 	SetOutboundSubnets(ctx context.Context, subnets []net.IPNet) (err error)
+	SetOutboundPorts(ctx context.Context, ports []models.OutboundPort) (err error)
 	RemoveAllowedPort(ctx context.Context, port uint16) (err error)
+	SetTransparentProxy(ctx context.Context, port uint16, subnets []net.IPNet) (err error)
+	SetVPNBypassSubnets(ctx context.Context, subnets []net.IPNet) (err error)
+	SetVPNBypassOwners(ctx context.Context, uids, gids []uint32) (err error)
+	SetVPNForcedOwners(ctx context.Context, uids, gids []uint32) (err error)
+	SetMSSClamping(ctx context.Context, enabled bool) (err error)
+	SetICMPEcho(ctx context.Context, defaultInterface string, vpn bool) (err error)
+	Rules(ctx context.Context) (rules Rules, err error)
 	SetDebug()
-	// SetNetworkInformation is meant to be called only once
-	SetNetworkInformation(defaultInterface string, defaultGateway net.IP, localSubnet net.IPNet, localIP net.IP)
+	// SetDryRun makes the configurator print the iptables commands
+	// instead of running them.
+	SetDryRun()
+	// SetNetworkInformation is meant to be called only once, with the local
+	// network of the default interface first in the local networks.
+	SetNetworkInformation(defaultInterface string, defaultGateway net.IP, localNetworks []routing.LocalNetwork)
+	// SetVPNInterface sets the name of the VPN tunnel interface, which is
+	// tun0 by default, and is meant to be called before enabling the firewall.
+	SetVPNInterface(name string)
 }
 
 type configurator struct { //nolint:maligned
@@ -31,11 +49,18 @@ type configurator struct { //nolint:maligned
 	routing          routing.Routing
 	fileManager      files.FileManager // for custom iptables rules
 	iptablesMutex    sync.Mutex
+	ip6tables        bool
+	ip6tablesOnce    sync.Once
 	debug            bool
+	dryRun           bool
 	defaultInterface string
 	defaultGateway   net.IP
 	localSubnet      net.IPNet
 	localIP          net.IP
+	// localNetworks are the local networks allowed through the firewall,
+	// including the one of the default interface.
+	localNetworks    []routing.LocalNetwork
+	vpnInterface     string
 	networkInfoMutex sync.Mutex
 
 	// State
@@ -43,7 +68,30 @@ type configurator struct { //nolint:maligned
 	vpnConnection     models.OpenVPNConnection
 	outboundSubnets   []net.IPNet
 	allowedInputPorts map[uint16]string // port to interface mapping
-	stateMutex        sync.Mutex
+	// extra tunnel interfaces to their VPN server connection,
+	// which is the zero value if it is not set yet.
+	extraConnections map[string]models.OpenVPNConnection
+	// transparent proxy redirection, independent from the firewall being enabled
+	transparentProxyPort    uint16
+	transparentProxySubnets []net.IPNet
+	// VPN bypass marking, independent from the firewall being enabled
+	vpnBypassSubnets []net.IPNet
+	// iptables matches of the packets routed outside the VPN tunnel,
+	// for example -m owner --uid-owner 1000 ! -d 192.168.1.0/24
+	vpnBypassOwners []string
+	// iptables matches of the packets dropped if they leave through
+	// another interface than the VPN tunnel, for example -m owner --uid-owner 1000
+	vpnForcedOwners []string
+	outboundPorts   []string
+	// connections balancing, independent from the firewall being enabled
+	balancedTunnels []BalancedTunnel
+	// TCP MSS clamping, independent from the firewall being enabled
+	mssClamping bool
+	// ICMP echo requests answered through the default interface, one of
+	// the constants.ICMPEcho* values, and through the VPN tunnel.
+	icmpEcho    string
+	vpnICMPEcho bool
+	stateMutex  sync.Mutex
 }
 
 // NewConfigurator creates a new Configurator instance.
@@ -54,6 +102,9 @@ func NewConfigurator(logger logging.Logger, routing routing.Routing, fileManager
 		routing:           routing,
 		fileManager:       fileManager,
 		allowedInputPorts: make(map[uint16]string),
+		extraConnections:  make(map[string]models.OpenVPNConnection),
+		icmpEcho:          constants.ICMPEchoLocal,
+		vpnInterface:      string(constants.TUN),
 	}
 }
 
@@ -61,12 +112,25 @@ func (c *configurator) SetDebug() {
 	c.debug = true
 }
 
+func (c *configurator) SetDryRun() {
+	c.dryRun = true
+}
+
+func (c *configurator) SetVPNInterface(name string) {
+	c.networkInfoMutex.Lock()
+	defer c.networkInfoMutex.Unlock()
+	c.vpnInterface = name
+}
+
 func (c *configurator) SetNetworkInformation(
-	defaultInterface string, defaultGateway net.IP, localSubnet net.IPNet, localIP net.IP) {
+	defaultInterface string, defaultGateway net.IP, localNetworks []routing.LocalNetwork) {
 	c.networkInfoMutex.Lock()
 	defer c.networkInfoMutex.Unlock()
 	c.defaultInterface = defaultInterface
 	c.defaultGateway = defaultGateway
-	c.localSubnet = localSubnet
-	c.localIP = localIP
+	c.localNetworks = localNetworks
+	if len(localNetworks) > 0 {
+		c.localSubnet = localNetworks[0].Subnet
+		c.localIP = localNetworks[0].IP
+	}
 }
@@ -0,0 +1,56 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qdm12/gluetun/internal/constants"
+)
+
+// SetICMPEcho sets how ICMP echo requests are answered: through the default
+// interface, from the local subnet only with constants.ICMPEchoLocal, from any
+// address with constants.ICMPEchoOn or never with constants.ICMPEchoOff, and
+// through the VPN tunnel if vpn is true.
+func (c *configurator) SetICMPEcho(ctx context.Context, defaultInterface string, vpn bool) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	if defaultInterface == c.icmpEcho && vpn == c.vpnICMPEcho {
+		return nil
+	}
+
+	if !c.enabled {
+		c.icmpEcho, c.vpnICMPEcho = defaultInterface, vpn
+		return nil
+	}
+
+	c.logger.Info("setting ICMP echo...")
+	const remove = true
+	if err := c.setICMPEchoRules(ctx, c.icmpEcho, c.vpnICMPEcho, remove); err != nil {
+		return fmt.Errorf("cannot set ICMP echo: %w", err)
+	}
+	c.icmpEcho, c.vpnICMPEcho = "", false
+	if err := c.setICMPEchoRules(ctx, defaultInterface, vpn, !remove); err != nil {
+		return fmt.Errorf("cannot set ICMP echo: %w", err)
+	}
+	c.icmpEcho, c.vpnICMPEcho = defaultInterface, vpn
+	return nil
+}
+
+func (c *configurator) setICMPEchoRules(ctx context.Context, defaultInterface string, vpn, remove bool) error {
+	switch defaultInterface {
+	case constants.ICMPEchoOn:
+		if err := c.acceptInputICMPEcho(ctx, c.defaultInterface, remove); err != nil {
+			return err
+		}
+	case constants.ICMPEchoOff:
+		// inserted to drop requests before the local subnet is accepted
+		if err := c.dropInputICMPEcho(ctx, c.defaultInterface, remove); err != nil {
+			return err
+		}
+	}
+	if vpn {
+		return c.acceptInputICMPEcho(ctx, c.vpnInterface, remove)
+	}
+	return nil
+}
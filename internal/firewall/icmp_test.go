@@ -0,0 +1,138 @@
+package firewall
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/golibs/command/mock_command"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_configurator_SetICMPEcho(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		enabled          bool
+		icmpEcho         string
+		vpnICMPEcho      bool
+		defaultInterface string
+		vpn              bool
+		instructions     []string
+		runErr           error
+		err              string
+		newICMPEcho      string
+		newVPNICMPEcho   bool
+	}{
+		"unchanged": {
+			enabled:          true,
+			icmpEcho:         constants.ICMPEchoLocal,
+			defaultInterface: constants.ICMPEchoLocal,
+			newICMPEcho:      constants.ICMPEchoLocal,
+		},
+		"disabled": {
+			icmpEcho:         constants.ICMPEchoLocal,
+			defaultInterface: constants.ICMPEchoOff,
+			vpn:              true,
+			newICMPEcho:      constants.ICMPEchoOff,
+			newVPNICMPEcho:   true,
+		},
+		"local to on": {
+			enabled:          true,
+			icmpEcho:         constants.ICMPEchoLocal,
+			defaultInterface: constants.ICMPEchoOn,
+			instructions: []string{
+				"--append INPUT -i eth0 -p icmp --icmp-type echo-request -j ACCEPT",
+			},
+			newICMPEcho: constants.ICMPEchoOn,
+		},
+		"on to off": {
+			enabled:          true,
+			icmpEcho:         constants.ICMPEchoOn,
+			defaultInterface: constants.ICMPEchoOff,
+			instructions: []string{
+				"--delete INPUT -i eth0 -p icmp --icmp-type echo-request -j ACCEPT",
+				"--insert INPUT -i eth0 -p icmp --icmp-type echo-request -j DROP",
+			},
+			newICMPEcho: constants.ICMPEchoOff,
+		},
+		"off to local with VPN": {
+			enabled:          true,
+			icmpEcho:         constants.ICMPEchoOff,
+			defaultInterface: constants.ICMPEchoLocal,
+			vpn:              true,
+			instructions: []string{
+				"--delete INPUT -i eth0 -p icmp --icmp-type echo-request -j DROP",
+				"--append INPUT -i tun0 -p icmp --icmp-type echo-request -j ACCEPT",
+			},
+			newICMPEcho:    constants.ICMPEchoLocal,
+			newVPNICMPEcho: true,
+		},
+		"remove VPN": {
+			enabled:          true,
+			icmpEcho:         constants.ICMPEchoLocal,
+			vpnICMPEcho:      true,
+			defaultInterface: constants.ICMPEchoLocal,
+			instructions: []string{
+				"--delete INPUT -i tun0 -p icmp --icmp-type echo-request -j ACCEPT",
+			},
+			newICMPEcho: constants.ICMPEchoLocal,
+		},
+		"remove error": {
+			enabled:          true,
+			icmpEcho:         constants.ICMPEchoOn,
+			defaultInterface: constants.ICMPEchoOff,
+			instructions: []string{
+				"--delete INPUT -i eth0 -p icmp --icmp-type echo-request -j ACCEPT",
+			},
+			runErr: errors.New("exit status 1"),
+			err: "cannot set ICMP echo: failed executing \"iptables --delete INPUT -i eth0 " +
+				"-p icmp --icmp-type echo-request -j ACCEPT\": : exit status 1",
+			newICMPEcho: constants.ICMPEchoOn,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			if len(testCase.instructions) > 0 {
+				logger.EXPECT().Info("setting ICMP echo...")
+			}
+			calls := make([]*gomock.Call, len(testCase.instructions))
+			for i, instruction := range testCase.instructions {
+				calls[i] = commander.EXPECT().Run(ctx, "iptables", strings.Fields(instruction)).
+					Return("", testCase.runErr)
+			}
+			gomock.InOrder(calls...)
+			c := &configurator{
+				commander:        commander,
+				logger:           logger,
+				enabled:          testCase.enabled,
+				defaultInterface: "eth0",
+				vpnInterface:     "tun0",
+				icmpEcho:         testCase.icmpEcho,
+				vpnICMPEcho:      testCase.vpnICMPEcho,
+			}
+
+			err := c.SetICMPEcho(ctx, testCase.defaultInterface, testCase.vpn)
+
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.newICMPEcho, c.icmpEcho)
+			assert.Equal(t, testCase.newVPNICMPEcho, c.vpnICMPEcho)
+		})
+	}
+}
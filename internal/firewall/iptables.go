@@ -6,6 +6,7 @@ import (
 	"net"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 )
 
@@ -58,9 +59,12 @@ func (c *configurator) runIptablesInstructions(ctx context.Context, instructions
 func (c *configurator) runIptablesInstruction(ctx context.Context, instruction string) error {
 	c.iptablesMutex.Lock() // only one iptables command at once
 	defer c.iptablesMutex.Unlock()
-	if c.debug {
+	if c.debug || c.dryRun {
 		fmt.Printf("iptables %s\n", instruction)
 	}
+	if c.dryRun {
+		return nil
+	}
 	flags := strings.Fields(instruction)
 	if output, err := c.commander.Run(ctx, "iptables", flags...); err != nil {
 		return fmt.Errorf("failed executing \"iptables %s\": %s: %w", instruction, output, err)
@@ -68,8 +72,71 @@ func (c *configurator) runIptablesInstruction(ctx context.Context, instruction s
 	return nil
 }
 
+func (c *configurator) runIP6tablesInstruction(ctx context.Context, instruction string) error {
+	c.iptablesMutex.Lock() // only one iptables command at once
+	defer c.iptablesMutex.Unlock()
+	if c.debug || c.dryRun {
+		fmt.Printf("ip6tables %s\n", instruction)
+	}
+	if c.dryRun {
+		return nil
+	}
+	flags := strings.Fields(instruction)
+	if output, err := c.commander.Run(ctx, "ip6tables", flags...); err != nil {
+		return fmt.Errorf("failed executing \"ip6tables %s\": %s: %w", instruction, output, err)
+	}
+	return nil
+}
+
+// ip6tablesSupported returns true if ip6tables can be used, which is not
+// the case if the kernel has no IPv6 support. The result is cached.
+func (c *configurator) ip6tablesSupported(ctx context.Context) bool {
+	c.ip6tablesOnce.Do(func() {
+		if c.dryRun {
+			c.ip6tables = true
+			return
+		}
+		_, err := c.commander.Run(ctx, "ip6tables", "-L")
+		c.ip6tables = err == nil
+		if !c.ip6tables {
+			c.logger.Info("ip6tables is not supported, IPv6 traffic rules are not set: %s", err)
+		}
+	})
+	return c.ip6tables
+}
+
+// runMixedIptablesInstructions runs the instructions with iptables and,
+// if IPv6 is supported, with ip6tables.
+func (c *configurator) runMixedIptablesInstructions(ctx context.Context, instructions []string) error {
+	for _, instruction := range instructions {
+		if err := c.runMixedIptablesInstruction(ctx, instruction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *configurator) runMixedIptablesInstruction(ctx context.Context, instruction string) error {
+	if err := c.runIptablesInstruction(ctx, instruction); err != nil {
+		return err
+	}
+	if !c.ip6tablesSupported(ctx) {
+		return nil
+	}
+	return c.runIP6tablesInstruction(ctx, instruction)
+}
+
+// runIptablesInstructionForIP runs the instruction with ip6tables if
+// the IP address given is an IPv6 address, and with iptables otherwise.
+func (c *configurator) runIptablesInstructionForIP(ctx context.Context, ip net.IP, instruction string) error {
+	if ip.To4() == nil {
+		return c.runIP6tablesInstruction(ctx, instruction)
+	}
+	return c.runIptablesInstruction(ctx, instruction)
+}
+
 func (c *configurator) clearAllRules(ctx context.Context) error {
-	return c.runIptablesInstructions(ctx, []string{
+	return c.runMixedIptablesInstructions(ctx, []string{
 		"--flush",        // flush all chains
 		"--delete-chain", // delete all chains
 	})
@@ -81,7 +148,7 @@ func (c *configurator) setAllPolicies(ctx context.Context, policy string) error
 	default:
 		return fmt.Errorf("policy %q not recognized", policy)
 	}
-	return c.runIptablesInstructions(ctx, []string{
+	return c.runMixedIptablesInstructions(ctx, []string{
 		fmt.Sprintf("--policy INPUT %s", policy),
 		fmt.Sprintf("--policy OUTPUT %s", policy),
 		fmt.Sprintf("--policy FORWARD %s", policy),
@@ -89,7 +156,7 @@ func (c *configurator) setAllPolicies(ctx context.Context, policy string) error
 }
 
 func (c *configurator) acceptInputThroughInterface(ctx context.Context, intf string, remove bool) error {
-	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+	return c.runMixedIptablesInstruction(ctx, fmt.Sprintf(
 		"%s INPUT -i %s -j ACCEPT", appendOrDelete(remove), intf,
 	))
 }
@@ -99,19 +166,19 @@ func (c *configurator) acceptInputToSubnet(ctx context.Context, intf string, des
 	if intf == "*" { // all interfaces
 		interfaceFlag = ""
 	}
-	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+	return c.runIptablesInstructionForIP(ctx, destination.IP, fmt.Sprintf(
 		"%s INPUT %s -d %s -j ACCEPT", appendOrDelete(remove), interfaceFlag, destination.String(),
 	))
 }
 
 func (c *configurator) acceptOutputThroughInterface(ctx context.Context, intf string, remove bool) error {
-	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+	return c.runMixedIptablesInstruction(ctx, fmt.Sprintf(
 		"%s OUTPUT -o %s -j ACCEPT", appendOrDelete(remove), intf,
 	))
 }
 
 func (c *configurator) acceptEstablishedRelatedTraffic(ctx context.Context, remove bool) error {
-	return c.runIptablesInstructions(ctx, []string{
+	return c.runMixedIptablesInstructions(ctx, []string{
 		fmt.Sprintf("%s OUTPUT -m conntrack --ctstate ESTABLISHED,RELATED -j ACCEPT", appendOrDelete(remove)),
 		fmt.Sprintf("%s INPUT -m conntrack --ctstate ESTABLISHED,RELATED -j ACCEPT", appendOrDelete(remove)),
 	})
@@ -119,9 +186,31 @@ func (c *configurator) acceptEstablishedRelatedTraffic(ctx context.Context, remo
 
 func (c *configurator) acceptOutputTrafficToVPN(ctx context.Context,
 	defaultInterface string, connection models.OpenVPNConnection, remove bool) error {
-	return c.runIptablesInstruction(ctx,
-		fmt.Sprintf("%s OUTPUT -d %s -o %s -p %s -m %s --dport %d -j ACCEPT",
-			appendOrDelete(remove), connection.IP, defaultInterface, connection.Protocol, connection.Protocol, connection.Port))
+	instruction := fmt.Sprintf("%s OUTPUT -d %s -o %s -p %s -m %s --dport %d -j ACCEPT",
+		appendOrDelete(remove), connection.IP, defaultInterface, connection.Protocol, connection.Protocol, connection.Port)
+	return c.runIptablesInstructionForIP(ctx, connection.IP, instruction)
+}
+
+// acceptNeighborDiscovery accepts the ICMPv6 neighbor and router discovery
+// packets, without which IPv6 addresses on the local link are unreachable.
+func (c *configurator) acceptNeighborDiscovery(ctx context.Context, remove bool) error {
+	if !c.ip6tablesSupported(ctx) {
+		return nil
+	}
+	for _, rule := range []string{
+		"OUTPUT -p ipv6-icmp -m icmp6 --icmpv6-type router-solicitation",
+		"INPUT -p ipv6-icmp -m icmp6 --icmpv6-type router-advertisement",
+		"OUTPUT -p ipv6-icmp -m icmp6 --icmpv6-type neighbour-solicitation",
+		"INPUT -p ipv6-icmp -m icmp6 --icmpv6-type neighbour-solicitation",
+		"OUTPUT -p ipv6-icmp -m icmp6 --icmpv6-type neighbour-advertisement",
+		"INPUT -p ipv6-icmp -m icmp6 --icmpv6-type neighbour-advertisement",
+	} {
+		instruction := fmt.Sprintf("%s %s -j ACCEPT", appendOrDelete(remove), rule)
+		if err := c.runIP6tablesInstruction(ctx, instruction); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Thanks to @npawelek.
@@ -131,7 +220,7 @@ func (c *configurator) acceptOutputFromIPToSubnet(ctx context.Context,
 	if intf == "*" { // all interfaces
 		interfaceFlag = ""
 	}
-	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+	return c.runIptablesInstructionForIP(ctx, destinationSubnet.IP, fmt.Sprintf(
 		"%s OUTPUT %s -s %s -d %s -j ACCEPT",
 		appendOrDelete(remove), interfaceFlag, sourceIP.String(), destinationSubnet.String(),
 	))
@@ -149,6 +238,144 @@ func (c *configurator) acceptInputToPort(ctx context.Context, intf string, port
 	})
 }
 
+func (c *configurator) acceptInputICMPEcho(ctx context.Context, intf string, remove bool) error {
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"%s INPUT -i %s -p icmp --icmp-type echo-request -j ACCEPT", appendOrDelete(remove), intf,
+	))
+}
+
+func (c *configurator) dropInputICMPEcho(ctx context.Context, intf string, remove bool) error {
+	operation := "--insert"
+	if remove {
+		operation = "--delete"
+	}
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"%s INPUT -i %s -p icmp --icmp-type echo-request -j DROP", operation, intf,
+	))
+}
+
+func (c *configurator) redirectTCPToPort(ctx context.Context, intf string,
+	source, excludedDestination net.IPNet, port uint16, remove bool) error {
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"-t nat %s PREROUTING -i %s -s %s ! -d %s -p tcp -j REDIRECT --to-ports %d",
+		appendOrDelete(remove), intf, source.String(), excludedDestination.String(), port,
+	))
+}
+
+func (c *configurator) markBypassFromSubnet(ctx context.Context, intf string,
+	source, excludedDestination net.IPNet, remove bool) error {
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"-t mangle %s PREROUTING -i %s -s %s ! -d %s -j MARK --set-mark %d",
+		appendOrDelete(remove), intf, source.String(), excludedDestination.String(), constants.VPNBypassMark,
+	))
+}
+
+func (c *configurator) clampMSSToPMTU(ctx context.Context, intf string, remove bool) error {
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"-t mangle %s POSTROUTING -o %s -p tcp --tcp-flags SYN,RST SYN -j TCPMSS --clamp-mss-to-pmtu",
+		appendOrDelete(remove), intf,
+	))
+}
+
+// Marks locally generated packets matching the match given, for example
+// -m owner --uid-owner 1000, and masquerades them since their source address
+// was chosen before being routed through the default interface.
+func (c *configurator) markBypassOutput(ctx context.Context, intf, match string, remove bool) error {
+	return c.runIptablesInstructions(ctx, []string{
+		fmt.Sprintf("-t mangle %s OUTPUT %s -j MARK --set-mark %d",
+			appendOrDelete(remove), match, constants.VPNBypassMark),
+		fmt.Sprintf("-t nat %s POSTROUTING -o %s %s -j MASQUERADE",
+			appendOrDelete(remove), intf, match),
+	})
+}
+
+// Hashes the new connections of the locally generated packets leaving through
+// the VPN interface to one of the tunnels given, and marks the packets of each
+// connection with the mark of its tunnel so they are routed through it. The
+// packets leaving through another tunnel are masqueraded since their source
+// address was chosen for the VPN interface, and are returned before the rules
+// dropping the packets of the VPN forced processes outside the VPN interface.
+func (c *configurator) balanceOutput(ctx context.Context, vpnIntf string,
+	tunnels []BalancedTunnel, remove bool) error {
+	insertOrDelete := "--insert"
+	if remove {
+		insertOrDelete = "--delete"
+	}
+	instructions := []string{
+		fmt.Sprintf("-t mangle %s OUTPUT -o %s -m mark --mark 0 -j CONNMARK --restore-mark",
+			appendOrDelete(remove), vpnIntf),
+		fmt.Sprintf("-t mangle %s OUTPUT -o %s -m mark --mark 0 -m conntrack --ctstate NEW "+
+			"-j HMARK --hmark-tuple src,dst,sport,dport,proto --hmark-mod %d --hmark-offset %d",
+			appendOrDelete(remove), vpnIntf, len(tunnels), constants.TunnelHashMark),
+	}
+	for i, tunnel := range tunnels {
+		instructions = append(instructions, fmt.Sprintf(
+			"-t mangle %s OUTPUT -o %s -m mark --mark %d -j MARK --set-mark %d",
+			appendOrDelete(remove), vpnIntf, constants.TunnelHashMark+i, tunnel.Mark))
+	}
+	instructions = append(instructions, fmt.Sprintf(
+		"-t mangle %s OUTPUT -o %s -m mark --mark %d/%d -m conntrack --ctstate NEW -j CONNMARK --save-mark",
+		appendOrDelete(remove), vpnIntf, constants.TunnelMark, constants.TunnelMarkMask))
+	for _, tunnel := range tunnels {
+		if tunnel.Interface == vpnIntf {
+			continue
+		}
+		instructions = append(instructions,
+			fmt.Sprintf("-t nat %s POSTROUTING -o %s -m mark --mark %d -j MASQUERADE",
+				appendOrDelete(remove), tunnel.Interface, tunnel.Mark),
+			fmt.Sprintf("-t mangle %s POSTROUTING -o %s -m mark --mark %d -j RETURN",
+				insertOrDelete, tunnel.Interface, tunnel.Mark))
+	}
+	return c.runIptablesInstructions(ctx, instructions)
+}
+
+// Drops locally generated packets matching the match given if they leave through
+// another interface than the VPN interface and the loopback interface. The rules
+// are in the mangle table so they apply whether the firewall is enabled or not.
+func (c *configurator) dropOutputOutsideTunnel(ctx context.Context, vpnIntf, match string, remove bool) error {
+	return c.runMixedIptablesInstructions(ctx, []string{
+		fmt.Sprintf("-t mangle %s POSTROUTING -o lo %s -j RETURN",
+			appendOrDelete(remove), match),
+		fmt.Sprintf("-t mangle %s POSTROUTING ! -o %s %s -j DROP",
+			appendOrDelete(remove), vpnIntf, match),
+	})
+}
+
+func (c *configurator) acceptOutputMatching(ctx context.Context, intf, match string, remove bool) error {
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"%s OUTPUT -o %s %s -j ACCEPT", appendOrDelete(remove), intf, match,
+	))
+}
+
+func (c *configurator) acceptForwardFromSubnet(ctx context.Context, intf string,
+	source net.IPNet, remove bool) error {
+	return c.runIptablesInstructions(ctx, []string{
+		fmt.Sprintf("%s FORWARD -i %s -o %s -s %s -j ACCEPT",
+			appendOrDelete(remove), intf, intf, source.String()),
+		fmt.Sprintf("%s FORWARD -i %s -o %s -d %s -m conntrack --ctstate ESTABLISHED,RELATED -j ACCEPT",
+			appendOrDelete(remove), intf, intf, source.String()),
+	})
+}
+
+func (c *configurator) redirectUDPToPort(ctx context.Context, intf string,
+	source, excludedDestination net.IPNet, port uint16, remove bool) error {
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"-t mangle %s PREROUTING -i %s -s %s ! -d %s -p udp -j TPROXY --on-port %d --tproxy-mark %#x",
+		appendOrDelete(remove), intf, source.String(), excludedDestination.String(), port,
+		constants.TransparentProxyMark,
+	))
+}
+
+// acceptInputTransparentProxy accepts the UDP packets marked by the TPROXY
+// rule, since their destination is not an address of the local subnet.
+func (c *configurator) acceptInputTransparentProxy(ctx context.Context, intf string,
+	source net.IPNet, remove bool) error {
+	return c.runIptablesInstruction(ctx, fmt.Sprintf(
+		"%s INPUT -i %s -s %s -p udp -m mark --mark %#x -j ACCEPT",
+		appendOrDelete(remove), intf, source.String(), constants.TransparentProxyMark,
+	))
+}
+
 func (c *configurator) runUserPostRules(ctx context.Context, filepath string, remove bool) error {
 	exists, err := c.fileManager.FileExists(filepath)
 	if err != nil {
@@ -0,0 +1,106 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/command/mock_command"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_acceptOutputThroughInterface(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		ip6tablesErr error
+		ip6tables    bool
+	}{
+		"ip6tables supported": {
+			ip6tables: true,
+		},
+		"ip6tables not supported": {
+			ip6tablesErr: fmt.Errorf("exit status 3"),
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			calls := []*gomock.Call{
+				commander.EXPECT().Run(ctx, "iptables", "--append", "OUTPUT", "-o", "tun0", "-j", "ACCEPT").
+					Return("", nil),
+				commander.EXPECT().Run(ctx, "ip6tables", "-L").Return("", testCase.ip6tablesErr),
+			}
+			if testCase.ip6tables {
+				calls = append(calls, commander.EXPECT().
+					Run(ctx, "ip6tables", "--append", "OUTPUT", "-o", "tun0", "-j", "ACCEPT").Return("", nil))
+			} else {
+				logger.EXPECT().Info("ip6tables is not supported, IPv6 traffic rules are not set: %s",
+					testCase.ip6tablesErr)
+			}
+			gomock.InOrder(calls...)
+			c := &configurator{commander: commander, logger: logger}
+
+			err := c.acceptOutputThroughInterface(ctx, "tun0", false)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ip6tables, c.ip6tables)
+		})
+	}
+}
+
+func Test_acceptOutputFromIPToSubnet(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		sourceIP    net.IP
+		destination net.IPNet
+		command     string
+		flags       []string
+	}{
+		"IPv4 subnet": {
+			sourceIP: net.IP{192, 168, 1, 2},
+			destination: net.IPNet{
+				IP:   net.IP{192, 168, 1, 0},
+				Mask: net.IPv4Mask(255, 255, 255, 0),
+			},
+			command: "iptables",
+			flags: []string{"--append", "OUTPUT", "-o", "eth0",
+				"-s", "192.168.1.2", "-d", "192.168.1.0/24", "-j", "ACCEPT"},
+		},
+		"IPv6 subnet": {
+			sourceIP: net.ParseIP("fd00::2"),
+			destination: net.IPNet{
+				IP:   net.ParseIP("fd00::"),
+				Mask: net.CIDRMask(64, 128),
+			},
+			command: "ip6tables",
+			flags: []string{"--append", "OUTPUT", "-o", "eth0",
+				"-s", "fd00::2", "-d", "fd00::/64", "-j", "ACCEPT"},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			commander.EXPECT().Run(ctx, testCase.command, testCase.flags).Return("", nil)
+			c := &configurator{commander: commander}
+
+			err := c.acceptOutputFromIPToSubnet(ctx, "eth0", testCase.sourceIP, testCase.destination, false)
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+)
+
+// SetMSSClamping clamps the maximum segment size of TCP connections going
+// through the VPN tunnel to the path MTU, which prevents large transfers from
+// stalling when the path MTU is lower than expected, for example behind PPPoE.
+// Its rule is in the mangle table and is therefore kept whether the firewall
+// is enabled or not.
+func (c *configurator) SetMSSClamping(ctx context.Context, enabled bool) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	if enabled == c.mssClamping {
+		return nil
+	}
+
+	if enabled {
+		c.logger.Info("clamping TCP MSS to path MTU on %s...", c.vpnInterface)
+	} else {
+		c.logger.Info("removing TCP MSS clamping on %s...", c.vpnInterface)
+	}
+	remove := !enabled
+	if err := c.clampMSSToPMTU(ctx, c.vpnInterface, remove); err != nil {
+		return fmt.Errorf("cannot set TCP MSS clamping: %w", err)
+	}
+	c.mssClamping = enabled
+	return nil
+}
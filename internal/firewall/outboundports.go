@@ -0,0 +1,45 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// SetOutboundPorts allows traffic to the destination ports given through the
+// default interface outside the VPN tunnel, even with the firewall enabled.
+// Their packets are marked to be routed through the default gateway. Ports
+// to destinations in the local subnet are ignored since it is already allowed.
+func (c *configurator) SetOutboundPorts(ctx context.Context, ports []models.OutboundPort) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	matches := make([]string, 0, len(ports))
+	for _, port := range ports {
+		if port.Destination != nil && c.localSubnet.Contains(port.Destination.IP) {
+			c.logger.Info("outbound port %s is in the local subnet which is already allowed", port)
+			continue
+		}
+		matches = append(matches, c.outboundPortMatch(port))
+	}
+
+	if len(matches) == 0 && len(c.outboundPorts) == 0 {
+		return nil
+	}
+
+	c.logger.Info("setting outbound ports outside the VPN tunnel...")
+	c.outboundPorts, err = c.setBypassOutputMatches(ctx, c.outboundPorts, matches)
+	if err != nil {
+		return fmt.Errorf("cannot set outbound ports: %w", err)
+	}
+	return nil
+}
+
+func (c *configurator) outboundPortMatch(port models.OutboundPort) (match string) {
+	match = fmt.Sprintf("-p %s -m %s --dport %d", port.Protocol, port.Protocol, port.Port)
+	if port.Destination == nil {
+		return match + " ! -d " + c.localSubnet.String()
+	}
+	return match + " -d " + port.Destination.String()
+}
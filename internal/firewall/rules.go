@@ -0,0 +1,105 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Rules contains the iptables and ip6tables rules currently applied.
+type Rules struct {
+	IPv4 []Table `json:"ipv4"`
+	// IPv6 is left empty if ip6tables is not supported.
+	IPv6 []Table `json:"ipv6,omitempty"`
+}
+
+// Table is an iptables table, such as filter or nat, with its chains.
+type Table struct {
+	Name   string  `json:"name"`
+	Chains []Chain `json:"chains"`
+}
+
+// Chain is an iptables chain with its policy and its rules, in order.
+type Chain struct {
+	Name string `json:"name"`
+	// Policy is empty for user defined chains.
+	Policy string   `json:"policy,omitempty"`
+	Rules  []string `json:"rules"`
+}
+
+// Rules returns the rules currently applied for IPv4 and IPv6.
+func (c *configurator) Rules(ctx context.Context) (rules Rules, err error) {
+	c.iptablesMutex.Lock() // only one iptables command at once
+	defer c.iptablesMutex.Unlock()
+
+	output, err := c.commander.Run(ctx, "iptables-save")
+	if err != nil {
+		return rules, fmt.Errorf("cannot obtain iptables rules: %s: %w", output, err)
+	}
+	rules.IPv4, err = parseIptablesSave(output)
+	if err != nil {
+		return rules, fmt.Errorf("cannot obtain iptables rules: %w", err)
+	}
+
+	output, err = c.commander.Run(ctx, "ip6tables-save")
+	if err != nil { // ip6tables is not supported by the host kernel
+		return rules, nil
+	}
+	rules.IPv6, err = parseIptablesSave(output)
+	if err != nil {
+		return rules, fmt.Errorf("cannot obtain ip6tables rules: %w", err)
+	}
+	return rules, nil
+}
+
+// parseIptablesSave parses the output of iptables-save, which is in the form:
+//
+//	*filter
+//	:INPUT DROP [0:0]
+//	-A INPUT -i lo -j ACCEPT
+//	COMMIT
+func parseIptablesSave(output string) (tables []Table, err error) {
+	var table *Table
+	chainIndexes := make(map[string]int)
+	for i, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "", strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "*"):
+			tables = append(tables, Table{Name: strings.TrimPrefix(line, "*"), Chains: []Chain{}})
+			table = &tables[len(tables)-1]
+			chainIndexes = make(map[string]int)
+		case table == nil:
+			return nil, fmt.Errorf("line %d is outside of a table: %q", i+1, line)
+		case line == "COMMIT":
+			table = nil
+		case strings.HasPrefix(line, ":"):
+			fields := strings.Fields(strings.TrimPrefix(line, ":"))
+			const minFields = 2
+			if len(fields) < minFields {
+				return nil, fmt.Errorf("line %d has a malformed chain: %q", i+1, line)
+			}
+			chain := Chain{Name: fields[0], Rules: []string{}}
+			if fields[1] != "-" {
+				chain.Policy = fields[1]
+			}
+			chainIndexes[chain.Name] = len(table.Chains)
+			table.Chains = append(table.Chains, chain)
+		case strings.HasPrefix(line, "-A "):
+			const maxFields = 2
+			fields := strings.SplitN(strings.TrimPrefix(line, "-A "), " ", maxFields)
+			index, ok := chainIndexes[fields[0]]
+			if !ok {
+				return nil, fmt.Errorf("line %d has a rule for unknown chain %q", i+1, fields[0])
+			}
+			rule := ""
+			if len(fields) > 1 {
+				rule = fields[1]
+			}
+			table.Chains[index].Rules = append(table.Chains[index].Rules, rule)
+		default:
+			return nil, fmt.Errorf("line %d is not recognized: %q", i+1, line)
+		}
+	}
+	return tables, nil
+}
@@ -0,0 +1,74 @@
+package firewall
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseIptablesSave(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		output string
+		tables []Table
+		err    string
+	}{
+		"empty output": {},
+		"filter and nat tables": {
+			output: `# Generated by iptables-save v1.8.4 on Sat Oct 24 10:00:00 2020
+*nat
+:PREROUTING ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:DOCKER_OUTPUT - [0:0]
+-A OUTPUT -d 127.0.0.11/32 -j DOCKER_OUTPUT
+COMMIT
+*filter
+:INPUT DROP [0:0]
+:FORWARD DROP [0:0]
+:OUTPUT DROP [0:0]
+-A INPUT -i lo -j ACCEPT
+-A INPUT -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT
+-A OUTPUT -o tun0 -j ACCEPT
+COMMIT
+`,
+			tables: []Table{
+				{Name: "nat", Chains: []Chain{
+					{Name: "PREROUTING", Policy: "ACCEPT", Rules: []string{}},
+					{Name: "OUTPUT", Policy: "ACCEPT", Rules: []string{"-d 127.0.0.11/32 -j DOCKER_OUTPUT"}},
+					{Name: "DOCKER_OUTPUT", Rules: []string{}},
+				}},
+				{Name: "filter", Chains: []Chain{
+					{Name: "INPUT", Policy: "DROP", Rules: []string{
+						"-i lo -j ACCEPT",
+						"-m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT",
+					}},
+					{Name: "FORWARD", Policy: "DROP", Rules: []string{}},
+					{Name: "OUTPUT", Policy: "DROP", Rules: []string{"-o tun0 -j ACCEPT"}},
+				}},
+			},
+		},
+		"rule outside of table": {
+			output: "-A INPUT -i lo -j ACCEPT",
+			err:    `line 1 is outside of a table: "-A INPUT -i lo -j ACCEPT"`,
+		},
+		"rule for unknown chain": {
+			output: "*filter\n-A INPUT -i lo -j ACCEPT",
+			err:    `line 2 has a rule for unknown chain "INPUT"`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			tables, err := parseIptablesSave(testCase.output)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.tables, tables)
+		})
+	}
+}
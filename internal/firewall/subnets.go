@@ -2,6 +2,7 @@ package firewall
 
 import (
 	"net"
+	"strings"
 )
 
 func findSubnetsToAdd(oldSubnets, newSubnets []net.IPNet) (subnetsToAdd []net.IPNet) {
@@ -51,3 +52,11 @@ func removeSubnetFromSubnets(subnets []net.IPNet, subnet net.IPNet) []net.IPNet
 	}
 	return subnets
 }
+
+func subnetsToString(subnets []net.IPNet) string {
+	s := make([]string, len(subnets))
+	for i := range subnets {
+		s[i] = subnets[i].String()
+	}
+	return strings.Join(s, ", ")
+}
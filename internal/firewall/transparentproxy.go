@@ -0,0 +1,61 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// SetTransparentProxy redirects TCP and UDP traffic coming from the source
+// subnets through the default interface to the local port given, except
+// traffic destined to the local subnet. TCP traffic is redirected in the nat
+// table and UDP traffic is marked and sent to the port in the mangle table,
+// so these rules are kept whether the firewall is enabled or not. The marked
+// UDP packets are also accepted in the filter table if the firewall is enabled.
+// Setting port to 0 removes the redirection.
+func (c *configurator) SetTransparentProxy(ctx context.Context, port uint16, subnets []net.IPNet) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	if c.transparentProxyPort != 0 {
+		c.logger.Info("removing transparent proxy redirection to port %d...", c.transparentProxyPort)
+		const remove = true
+		for _, subnet := range c.transparentProxySubnets {
+			if err := c.redirectToTransparentProxy(ctx, subnet, c.transparentProxyPort, remove); err != nil {
+				return fmt.Errorf("cannot remove transparent proxy redirection: %w", err)
+			}
+		}
+		c.transparentProxyPort = 0
+		c.transparentProxySubnets = nil
+	}
+
+	if port == 0 {
+		return nil
+	}
+
+	c.logger.Info("redirecting TCP and UDP traffic from subnets %s to transparent proxy port %d...",
+		subnetsToString(subnets), port)
+	const remove = false
+	for _, subnet := range subnets {
+		if err := c.redirectToTransparentProxy(ctx, subnet, port, remove); err != nil {
+			return fmt.Errorf("cannot set transparent proxy redirection: %w", err)
+		}
+		c.transparentProxySubnets = append(c.transparentProxySubnets, subnet)
+		c.transparentProxyPort = port
+	}
+	return nil
+}
+
+func (c *configurator) redirectToTransparentProxy(ctx context.Context,
+	subnet net.IPNet, port uint16, remove bool) error {
+	if err := c.redirectTCPToPort(ctx, c.defaultInterface, subnet, c.localSubnet, port, remove); err != nil {
+		return err
+	}
+	if err := c.redirectUDPToPort(ctx, c.defaultInterface, subnet, c.localSubnet, port, remove); err != nil {
+		return err
+	}
+	if !c.enabled {
+		return nil
+	}
+	return c.acceptInputTransparentProxy(ctx, c.defaultInterface, subnet, remove)
+}
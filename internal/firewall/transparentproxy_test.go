@@ -0,0 +1,99 @@
+package firewall
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/command/mock_command"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_configurator_SetTransparentProxy(t *testing.T) {
+	t.Parallel()
+	localSubnet := net.IPNet{IP: net.IP{172, 17, 0, 0}, Mask: net.IPv4Mask(255, 255, 0, 0)}
+	lanSubnet := net.IPNet{IP: net.IP{192, 168, 1, 0}, Mask: net.IPv4Mask(255, 255, 255, 0)}
+	testCases := map[string]struct {
+		enabled      bool
+		previousPort uint16
+		port         uint16
+		log          []interface{}
+		instructions []string
+	}{
+		"firewall disabled": {
+			port: 8889,
+			log: []interface{}{"redirecting TCP and UDP traffic from subnets %s to transparent proxy port %d...",
+				"192.168.1.0/24", uint16(8889)},
+			instructions: []string{
+				"-t nat --append PREROUTING -i eth0 -s 192.168.1.0/24 ! -d 172.17.0.0/16 -p tcp -j REDIRECT --to-ports 8889",
+				"-t mangle --append PREROUTING -i eth0 -s 192.168.1.0/24 ! -d 172.17.0.0/16 -p udp -j TPROXY " +
+					"--on-port 8889 --tproxy-mark 0x7470",
+			},
+		},
+		"firewall enabled": {
+			enabled: true,
+			port:    8889,
+			log: []interface{}{"redirecting TCP and UDP traffic from subnets %s to transparent proxy port %d...",
+				"192.168.1.0/24", uint16(8889)},
+			instructions: []string{
+				"-t nat --append PREROUTING -i eth0 -s 192.168.1.0/24 ! -d 172.17.0.0/16 -p tcp -j REDIRECT --to-ports 8889",
+				"-t mangle --append PREROUTING -i eth0 -s 192.168.1.0/24 ! -d 172.17.0.0/16 -p udp -j TPROXY " +
+					"--on-port 8889 --tproxy-mark 0x7470",
+				"--append INPUT -i eth0 -s 192.168.1.0/24 -p udp -m mark --mark 0x7470 -j ACCEPT",
+			},
+		},
+		"remove redirection": {
+			enabled:      true,
+			previousPort: 8889,
+			log:          []interface{}{"removing transparent proxy redirection to port %d...", uint16(8889)},
+			instructions: []string{
+				"-t nat --delete PREROUTING -i eth0 -s 192.168.1.0/24 ! -d 172.17.0.0/16 -p tcp -j REDIRECT --to-ports 8889",
+				"-t mangle --delete PREROUTING -i eth0 -s 192.168.1.0/24 ! -d 172.17.0.0/16 -p udp -j TPROXY " +
+					"--on-port 8889 --tproxy-mark 0x7470",
+				"--delete INPUT -i eth0 -s 192.168.1.0/24 -p udp -m mark --mark 0x7470 -j ACCEPT",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			logger.EXPECT().Info(testCase.log...)
+			calls := make([]*gomock.Call, len(testCase.instructions))
+			for i, instruction := range testCase.instructions {
+				calls[i] = commander.EXPECT().Run(ctx, "iptables", strings.Fields(instruction)).Return("", nil)
+			}
+			gomock.InOrder(calls...)
+			c := &configurator{
+				commander:        commander,
+				logger:           logger,
+				enabled:          testCase.enabled,
+				defaultInterface: "eth0",
+				localSubnet:      localSubnet,
+			}
+			if testCase.previousPort != 0 {
+				c.transparentProxyPort = testCase.previousPort
+				c.transparentProxySubnets = []net.IPNet{lanSubnet}
+			}
+
+			err := c.SetTransparentProxy(ctx, testCase.port, []net.IPNet{lanSubnet})
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.port, c.transparentProxyPort)
+			if testCase.port == 0 {
+				assert.Empty(t, c.transparentProxySubnets)
+			} else {
+				assert.Equal(t, []net.IPNet{lanSubnet}, c.transparentProxySubnets)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// BalancedTunnel is a VPN tunnel the new connections are balanced across.
+type BalancedTunnel struct {
+	Interface string
+	// Mark is the firewall mark of the packets routed through the tunnel.
+	Mark int
+}
+
+// SetExtraVPNConnection allows the traffic to the VPN server of the extra
+// tunnel interface given through the default interface, replacing its
+// previous VPN server, and the traffic through the extra tunnel interface.
+func (c *configurator) SetExtraVPNConnection(ctx context.Context, intf string,
+	connection models.OpenVPNConnection) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	previous, exists := c.extraConnections[intf]
+	if !c.enabled {
+		c.logger.Info("firewall disabled, only updating internal VPN connection of %s", intf)
+		c.extraConnections[intf] = connection
+		return nil
+	}
+
+	if exists && previous.Equal(connection) {
+		return nil
+	}
+
+	c.logger.Info("setting VPN connection of %s through firewall...", intf)
+
+	remove := true
+	if previous.IP != nil {
+		if err := c.acceptOutputTrafficToVPN(ctx, c.defaultInterface, previous, remove); err != nil {
+			c.logger.Error("cannot remove outdated VPN connection of %s through firewall: %s", intf, err)
+		}
+	}
+	remove = false
+	if !exists {
+		if err := c.acceptOutputThroughInterface(ctx, intf, remove); err != nil {
+			return fmt.Errorf("cannot set VPN connection of %s through firewall: %w", intf, err)
+		}
+	}
+	c.extraConnections[intf] = models.OpenVPNConnection{}
+	if err := c.acceptOutputTrafficToVPN(ctx, c.defaultInterface, connection, remove); err != nil {
+		return fmt.Errorf("cannot set VPN connection of %s through firewall: %w", intf, err)
+	}
+	c.extraConnections[intf] = connection
+	return nil
+}
+
+// SetBalancedTunnels balances the new connections leaving through the VPN
+// interface across the tunnels given, which can include the VPN interface.
+// The connections are no longer balanced if no tunnel is given, and the
+// connections already established keep using their tunnel.
+// The balancing rules are in the mangle and nat tables and are therefore
+// kept whether the firewall is enabled or not.
+func (c *configurator) SetBalancedTunnels(ctx context.Context, tunnels []BalancedTunnel) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	if equalTunnels(c.balancedTunnels, tunnels) {
+		return nil
+	}
+
+	c.logger.Info("setting balanced tunnels...")
+	const remove = true
+	if len(c.balancedTunnels) > 0 {
+		if err := c.balanceOutput(ctx, c.vpnInterface, c.balancedTunnels, remove); err != nil {
+			c.logger.Error("cannot remove outdated balanced tunnels: %s", err)
+		}
+		c.balancedTunnels = nil
+	}
+	if len(tunnels) == 0 {
+		return nil
+	}
+	// the tunnels are recorded first, so the rules added
+	// before an error are removed by the next call.
+	c.balancedTunnels = make([]BalancedTunnel, len(tunnels))
+	copy(c.balancedTunnels, tunnels)
+	if err := c.balanceOutput(ctx, c.vpnInterface, tunnels, !remove); err != nil {
+		return fmt.Errorf("cannot set balanced tunnels: %w", err)
+	}
+	return nil
+}
+
+func equalTunnels(a, b []BalancedTunnel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
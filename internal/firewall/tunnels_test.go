@@ -0,0 +1,178 @@
+package firewall
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/command/mock_command"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_configurator_SetExtraVPNConnection(t *testing.T) {
+	t.Parallel()
+	previous := models.OpenVPNConnection{IP: net.IP{1, 2, 3, 4}, Port: 1194, Protocol: "udp"}
+	connection := models.OpenVPNConnection{IP: net.IP{5, 6, 7, 8}, Port: 443, Protocol: "tcp"}
+	testCases := map[string]struct {
+		enabled      bool
+		connections  map[string]models.OpenVPNConnection
+		logs         []string
+		instructions []string
+		ip6tablesErr error
+	}{
+		"firewall disabled": {
+			connections: map[string]models.OpenVPNConnection{},
+			logs:        []string{"firewall disabled, only updating internal VPN connection of %s"},
+		},
+		"unchanged connection": {
+			enabled:     true,
+			connections: map[string]models.OpenVPNConnection{"tun1": connection},
+		},
+		"new tunnel": {
+			enabled:     true,
+			connections: map[string]models.OpenVPNConnection{},
+			logs: []string{
+				"setting VPN connection of %s through firewall...",
+				"ip6tables is not supported, IPv6 traffic rules are not set: %s",
+			},
+			instructions: []string{
+				"--append OUTPUT -o tun1 -j ACCEPT",
+				"--append OUTPUT -d 5.6.7.8 -o eth0 -p tcp -m tcp --dport 443 -j ACCEPT",
+			},
+			ip6tablesErr: errors.New("exit status 3"),
+		},
+		"changed server": {
+			enabled:     true,
+			connections: map[string]models.OpenVPNConnection{"tun1": previous},
+			logs:        []string{"setting VPN connection of %s through firewall..."},
+			instructions: []string{
+				"--delete OUTPUT -d 1.2.3.4 -o eth0 -p udp -m udp --dport 1194 -j ACCEPT",
+				"--append OUTPUT -d 5.6.7.8 -o eth0 -p tcp -m tcp --dport 443 -j ACCEPT",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			for _, log := range testCase.logs {
+				logger.EXPECT().Info(log, gomock.Any())
+			}
+			var calls []*gomock.Call
+			for i, instruction := range testCase.instructions {
+				calls = append(calls, commander.EXPECT().
+					Run(ctx, "iptables", strings.Fields(instruction)).Return("", nil))
+				if i == 0 && testCase.ip6tablesErr != nil {
+					calls = append(calls, commander.EXPECT().
+						Run(ctx, "ip6tables", "-L").Return("", testCase.ip6tablesErr))
+				}
+			}
+			gomock.InOrder(calls...)
+			c := &configurator{
+				commander:        commander,
+				logger:           logger,
+				enabled:          testCase.enabled,
+				defaultInterface: "eth0",
+				extraConnections: testCase.connections,
+			}
+
+			err := c.SetExtraVPNConnection(ctx, "tun1", connection)
+
+			require.NoError(t, err)
+			assert.Equal(t, map[string]models.OpenVPNConnection{"tun1": connection}, c.extraConnections)
+		})
+	}
+}
+
+func Test_configurator_SetBalancedTunnels(t *testing.T) {
+	t.Parallel()
+	twoTunnels := []BalancedTunnel{
+		{Interface: "tun0", Mark: 29952},
+		{Interface: "tun1", Mark: 29953},
+	}
+	testCases := map[string]struct {
+		previous     []BalancedTunnel
+		tunnels      []BalancedTunnel
+		instructions []string
+	}{
+		"no tunnel": {},
+		"unchanged tunnels": {
+			previous: twoTunnels,
+			tunnels:  twoTunnels,
+		},
+		"balance two tunnels": {
+			tunnels: twoTunnels,
+			instructions: []string{
+				"-t mangle --append OUTPUT -o tun0 -m mark --mark 0 -j CONNMARK --restore-mark",
+				"-t mangle --append OUTPUT -o tun0 -m mark --mark 0 -m conntrack --ctstate NEW " +
+					"-j HMARK --hmark-tuple src,dst,sport,dport,proto --hmark-mod 2 --hmark-offset 30208",
+				"-t mangle --append OUTPUT -o tun0 -m mark --mark 30208 -j MARK --set-mark 29952",
+				"-t mangle --append OUTPUT -o tun0 -m mark --mark 30209 -j MARK --set-mark 29953",
+				"-t mangle --append OUTPUT -o tun0 -m mark --mark 29952/65520 -m conntrack --ctstate NEW " +
+					"-j CONNMARK --save-mark",
+				"-t nat --append POSTROUTING -o tun1 -m mark --mark 29953 -j MASQUERADE",
+				"-t mangle --insert POSTROUTING -o tun1 -m mark --mark 29953 -j RETURN",
+			},
+		},
+		"stop balancing": {
+			previous: []BalancedTunnel{
+				{Interface: "tun1", Mark: 29953},
+				{Interface: "tun2", Mark: 29954},
+			},
+			instructions: []string{
+				"-t mangle --delete OUTPUT -o tun0 -m mark --mark 0 -j CONNMARK --restore-mark",
+				"-t mangle --delete OUTPUT -o tun0 -m mark --mark 0 -m conntrack --ctstate NEW " +
+					"-j HMARK --hmark-tuple src,dst,sport,dport,proto --hmark-mod 2 --hmark-offset 30208",
+				"-t mangle --delete OUTPUT -o tun0 -m mark --mark 30208 -j MARK --set-mark 29953",
+				"-t mangle --delete OUTPUT -o tun0 -m mark --mark 30209 -j MARK --set-mark 29954",
+				"-t mangle --delete OUTPUT -o tun0 -m mark --mark 29952/65520 -m conntrack --ctstate NEW " +
+					"-j CONNMARK --save-mark",
+				"-t nat --delete POSTROUTING -o tun1 -m mark --mark 29953 -j MASQUERADE",
+				"-t mangle --delete POSTROUTING -o tun1 -m mark --mark 29953 -j RETURN",
+				"-t nat --delete POSTROUTING -o tun2 -m mark --mark 29954 -j MASQUERADE",
+				"-t mangle --delete POSTROUTING -o tun2 -m mark --mark 29954 -j RETURN",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			if len(testCase.instructions) > 0 {
+				logger.EXPECT().Info("setting balanced tunnels...")
+			}
+			calls := make([]*gomock.Call, len(testCase.instructions))
+			for i, instruction := range testCase.instructions {
+				calls[i] = commander.EXPECT().Run(ctx, "iptables", strings.Fields(instruction)).Return("", nil)
+			}
+			gomock.InOrder(calls...)
+			c := &configurator{
+				commander:       commander,
+				logger:          logger,
+				vpnInterface:    "tun0",
+				balancedTunnels: testCase.previous,
+			}
+
+			err := c.SetBalancedTunnels(ctx, testCase.tunnels)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.tunnels, c.balancedTunnels)
+		})
+	}
+}
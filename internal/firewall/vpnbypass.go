@@ -0,0 +1,162 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// SetVPNBypassSubnets marks packets coming from the source subnets given
+// through the default interface so they are routed through the default
+// gateway instead of the VPN tunnel, and allows them to be forwarded.
+// The marking rules are in the mangle table and are therefore kept whether
+// the firewall is enabled or not.
+func (c *configurator) SetVPNBypassSubnets(ctx context.Context, subnets []net.IPNet) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	subnetsToAdd := findSubnetsToAdd(c.vpnBypassSubnets, subnets)
+	subnetsToRemove := findSubnetsToRemove(c.vpnBypassSubnets, subnets)
+	if len(subnetsToAdd) == 0 && len(subnetsToRemove) == 0 {
+		return nil
+	}
+
+	c.logger.Info("setting VPN bypass subnets...")
+	c.removeVPNBypassSubnets(ctx, subnetsToRemove)
+	if err := c.addVPNBypassSubnets(ctx, subnetsToAdd); err != nil {
+		return fmt.Errorf("cannot set VPN bypass subnets: %w", err)
+	}
+	return nil
+}
+
+func (c *configurator) removeVPNBypassSubnets(ctx context.Context, subnets []net.IPNet) {
+	const remove = true
+	for _, subnet := range subnets {
+		if err := c.markBypassFromSubnet(ctx, c.defaultInterface, subnet, c.localSubnet, remove); err != nil {
+			c.logger.Error("cannot remove outdated VPN bypass subnet: %s", err)
+			continue
+		}
+		if c.enabled {
+			if err := c.acceptForwardFromSubnet(ctx, c.defaultInterface, subnet, remove); err != nil {
+				c.logger.Error("cannot remove outdated VPN bypass subnet through firewall: %s", err)
+				continue
+			}
+		}
+		c.vpnBypassSubnets = removeSubnetFromSubnets(c.vpnBypassSubnets, subnet)
+	}
+}
+
+func (c *configurator) addVPNBypassSubnets(ctx context.Context, subnets []net.IPNet) error {
+	const remove = false
+	for _, subnet := range subnets {
+		if err := c.markBypassFromSubnet(ctx, c.defaultInterface, subnet, c.localSubnet, remove); err != nil {
+			return err
+		}
+		if c.enabled {
+			if err := c.acceptForwardFromSubnet(ctx, c.defaultInterface, subnet, remove); err != nil {
+				return err
+			}
+		}
+		c.vpnBypassSubnets = append(c.vpnBypassSubnets, subnet)
+	}
+	return nil
+}
+
+// SetVPNBypassOwners marks packets from local processes running with the user
+// IDs or group IDs given so they are routed through the default gateway instead
+// of the VPN tunnel, and allows them through the default interface.
+// The marking rules are in the mangle and nat tables and are therefore
+// kept whether the firewall is enabled or not.
+func (c *configurator) SetVPNBypassOwners(ctx context.Context, uids, gids []uint32) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	matches := make([]string, 0, len(uids)+len(gids))
+	for _, uid := range uids {
+		matches = append(matches, fmt.Sprintf("-m owner --uid-owner %d ! -d %s", uid, c.localSubnet.String()))
+	}
+	for _, gid := range gids {
+		matches = append(matches, fmt.Sprintf("-m owner --gid-owner %d ! -d %s", gid, c.localSubnet.String()))
+	}
+
+	if len(matches) == 0 && len(c.vpnBypassOwners) == 0 {
+		return nil
+	}
+
+	c.logger.Info("setting VPN bypass processes...")
+	c.vpnBypassOwners, err = c.setBypassOutputMatches(ctx, c.vpnBypassOwners, matches)
+	if err != nil {
+		return fmt.Errorf("cannot set VPN bypass processes: %w", err)
+	}
+	return nil
+}
+
+// setBypassOutputMatches removes the bypass rules for the old matches and
+// adds the bypass rules for the new matches, and returns the matches set.
+func (c *configurator) setBypassOutputMatches(ctx context.Context,
+	oldMatches, newMatches []string) (matchesSet []string, err error) {
+	const remove = true
+	for i, match := range oldMatches {
+		if err := c.markBypassOutput(ctx, c.defaultInterface, match, remove); err != nil {
+			return oldMatches[i:], err
+		}
+		if c.enabled {
+			if err := c.acceptOutputMatching(ctx, c.defaultInterface, match, remove); err != nil {
+				return oldMatches[i:], err
+			}
+		}
+	}
+
+	for _, match := range newMatches {
+		if err := c.markBypassOutput(ctx, c.defaultInterface, match, !remove); err != nil {
+			return matchesSet, err
+		}
+		if c.enabled {
+			if err := c.acceptOutputMatching(ctx, c.defaultInterface, match, !remove); err != nil {
+				return matchesSet, err
+			}
+		}
+		matchesSet = append(matchesSet, match)
+	}
+	return matchesSet, nil
+}
+
+// SetVPNForcedOwners drops packets from local processes running with the user
+// IDs or group IDs given if they leave through another interface than the VPN
+// tunnel, so they cannot use the outbound subnets, the outbound ports or the
+// default gateway if the firewall is disabled.
+// The dropping rules are in the mangle table and are therefore
+// kept whether the firewall is enabled or not.
+func (c *configurator) SetVPNForcedOwners(ctx context.Context, uids, gids []uint32) (err error) {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+
+	matches := make([]string, 0, len(uids)+len(gids))
+	for _, uid := range uids {
+		matches = append(matches, fmt.Sprintf("-m owner --uid-owner %d", uid))
+	}
+	for _, gid := range gids {
+		matches = append(matches, fmt.Sprintf("-m owner --gid-owner %d", gid))
+	}
+
+	if len(matches) == 0 && len(c.vpnForcedOwners) == 0 {
+		return nil
+	}
+
+	c.logger.Info("setting VPN forced processes...")
+	const remove = true
+	for i, match := range c.vpnForcedOwners {
+		if err := c.dropOutputOutsideTunnel(ctx, c.vpnInterface, match, remove); err != nil {
+			c.vpnForcedOwners = c.vpnForcedOwners[i:]
+			return fmt.Errorf("cannot set VPN forced processes: %w", err)
+		}
+	}
+	c.vpnForcedOwners = nil
+	for _, match := range matches {
+		if err := c.dropOutputOutsideTunnel(ctx, c.vpnInterface, match, !remove); err != nil {
+			return fmt.Errorf("cannot set VPN forced processes: %w", err)
+		}
+		c.vpnForcedOwners = append(c.vpnForcedOwners, match)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package firewall
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/command/mock_command"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_configurator_SetVPNBypassOwners(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	commander := mock_command.NewMockCommander(mockCtrl)
+	logger := mock_logging.NewMockLogger(mockCtrl)
+	logger.EXPECT().Info("setting VPN bypass processes...")
+	instructions := []string{
+		"-t mangle --delete OUTPUT -m owner --uid-owner 1001 ! -d 172.17.0.0/16 -j MARK --set-mark 1735",
+		"-t nat --delete POSTROUTING -o eth0 -m owner --uid-owner 1001 ! -d 172.17.0.0/16 -j MASQUERADE",
+		"--delete OUTPUT -o eth0 -m owner --uid-owner 1001 ! -d 172.17.0.0/16 -j ACCEPT",
+		"-t mangle --append OUTPUT -m owner --uid-owner 1000 ! -d 172.17.0.0/16 -j MARK --set-mark 1735",
+		"-t nat --append POSTROUTING -o eth0 -m owner --uid-owner 1000 ! -d 172.17.0.0/16 -j MASQUERADE",
+		"--append OUTPUT -o eth0 -m owner --uid-owner 1000 ! -d 172.17.0.0/16 -j ACCEPT",
+	}
+	calls := make([]*gomock.Call, len(instructions))
+	for i, instruction := range instructions {
+		calls[i] = commander.EXPECT().Run(ctx, "iptables", strings.Fields(instruction)).Return("", nil)
+	}
+	gomock.InOrder(calls...)
+	c := &configurator{
+		commander:        commander,
+		logger:           logger,
+		enabled:          true,
+		defaultInterface: "eth0",
+		localSubnet:      net.IPNet{IP: net.IP{172, 17, 0, 0}, Mask: net.IPv4Mask(255, 255, 0, 0)},
+		vpnBypassOwners:  []string{"-m owner --uid-owner 1001 ! -d 172.17.0.0/16"},
+	}
+
+	err := c.SetVPNBypassOwners(ctx, []uint32{1000}, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"-m owner --uid-owner 1000 ! -d 172.17.0.0/16"}, c.vpnBypassOwners)
+}
+
+func Test_configurator_SetVPNForcedOwners(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		previous     []string
+		uids         []uint32
+		gids         []uint32
+		instructions []string
+		owners       []string
+	}{
+		"no owner": {},
+		"forced owners": {
+			uids: []uint32{1000},
+			gids: []uint32{100},
+			instructions: []string{
+				"-t mangle --append POSTROUTING -o lo -m owner --uid-owner 1000 -j RETURN",
+				"-t mangle --append POSTROUTING ! -o tun0 -m owner --uid-owner 1000 -j DROP",
+				"-t mangle --append POSTROUTING -o lo -m owner --gid-owner 100 -j RETURN",
+				"-t mangle --append POSTROUTING ! -o tun0 -m owner --gid-owner 100 -j DROP",
+			},
+			owners: []string{"-m owner --uid-owner 1000", "-m owner --gid-owner 100"},
+		},
+		"remove forced owners": {
+			previous: []string{"-m owner --uid-owner 1000"},
+			instructions: []string{
+				"-t mangle --delete POSTROUTING -o lo -m owner --uid-owner 1000 -j RETURN",
+				"-t mangle --delete POSTROUTING ! -o tun0 -m owner --uid-owner 1000 -j DROP",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			var calls []*gomock.Call
+			for i, instruction := range testCase.instructions {
+				flags := strings.Fields(instruction)
+				calls = append(calls, commander.EXPECT().Run(ctx, "iptables", flags).Return("", nil))
+				if i == 0 {
+					calls = append(calls, commander.EXPECT().Run(ctx, "ip6tables", "-L").Return("", nil))
+				}
+				calls = append(calls, commander.EXPECT().Run(ctx, "ip6tables", flags).Return("", nil))
+			}
+			if len(calls) > 0 {
+				logger.EXPECT().Info("setting VPN forced processes...")
+				gomock.InOrder(calls...)
+			}
+			c := &configurator{
+				commander:       commander,
+				logger:          logger,
+				vpnInterface:    "tun0",
+				vpnForcedOwners: testCase.previous,
+			}
+
+			err := c.SetVPNForcedOwners(ctx, testCase.uids, testCase.gids)
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.owners, c.vpnForcedOwners)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net"
+)
+
+// localDNSAddress is the address of the local DNS over TLS server.
+const localDNSAddress = "127.0.0.1:53"
+
+func newInternalResolver() *net.Resolver {
+	return &net.Resolver{}
+}
+
+// newLocalResolver returns a resolver querying the local
+// DNS over TLS server only.
+func newLocalResolver() *net.Resolver {
+	return newServerResolver(localDNSAddress)
+}
+
+// newServerResolver returns a resolver querying the DNS server at the
+// address given only, using the network chosen by the resolver, which
+// is TCP for truncated answers.
+func newServerResolver(address string) *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+func resolve(ctx context.Context, resolver *net.Resolver, domain string) error {
+	ips, err := resolver.LookupIP(ctx, "ip", domain)
+	switch {
+	case err != nil:
+		return fmt.Errorf("cannot resolve %s: %w", domain, err)
+	case len(ips) == 0:
+		return fmt.Errorf("resolved no IP addresses for %s", domain)
+	default:
+		return nil
+	}
+}
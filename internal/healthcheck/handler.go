@@ -1,21 +1,36 @@
 package healthcheck
 
 import (
-	"net"
+	"encoding/json"
+	"fmt"
 	"net/http"
 
+	"github.com/qdm12/gluetun/internal/dns"
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/publicip"
 	"github.com/qdm12/golibs/logging"
 )
 
 type handler struct {
-	logger   logging.Logger
-	resolver *net.Resolver
+	logger         logging.Logger
+	getVPNStatus   func() openvpn.ConnectionStatus
+	publicIPLooper publicip.Looper
+	dnsLooper      dns.Looper
+	monitor        Monitor
 }
 
-func newHandler(logger logging.Logger, resolver *net.Resolver) http.Handler {
+// NewHandler returns a handler serving the healthcheck on /, the liveness
+// on /healthz and the readiness on /readyz, so it can also be served
+// by the control server for Kubernetes probes. getVPNStatus returns the
+// status of the OpenVPN or Wireguard connection.
+func NewHandler(logger logging.Logger, getVPNStatus func() openvpn.ConnectionStatus,
+	publicIPLooper publicip.Looper, dnsLooper dns.Looper, monitor Monitor) http.Handler {
 	return &handler{
-		logger:   logger,
-		resolver: resolver,
+		logger:         logger,
+		getVPNStatus:   getVPNStatus,
+		publicIPLooper: publicIPLooper,
+		dnsLooper:      dnsLooper,
+		monitor:        monitor,
 	}
 }
 
@@ -24,11 +39,45 @@ func (h *handler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Re
 		http.Error(responseWriter, "method not supported for healthcheck", http.StatusBadRequest)
 		return
 	}
-	err := healthCheck(request.Context(), h.resolver)
+	switch request.RequestURI {
+	case "/":
+		h.getHealth(responseWriter)
+	case "/healthz":
+		// the process is alive as long as it responds, so the
+		// container is not restarted during a VPN reconnection.
+		responseWriter.WriteHeader(http.StatusOK)
+	case "/readyz":
+		err := readyCheck(h.getVPNStatus(), h.dnsLooper.Ready())
+		if err != nil {
+			http.Error(responseWriter, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		responseWriter.WriteHeader(http.StatusOK)
+	default:
+		errString := fmt.Sprintf("Nothing here for %s %s", request.Method, request.RequestURI)
+		http.Error(responseWriter, errString, http.StatusNotFound)
+	}
+}
+
+func (h *handler) getHealth(responseWriter http.ResponseWriter) {
+	probes := h.monitor.Probes()
+	err := healthCheck(h.getVPNStatus(),
+		h.publicIPLooper.GetStatus(), probes)
 	if err != nil {
 		h.logger.Error(err)
 		http.Error(responseWriter, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	responseWriter.WriteHeader(http.StatusOK)
+	data, err := json.Marshal(struct {
+		Probes []ProbeStatus `json:"probes"`
+	}{probes})
+	if err != nil {
+		h.logger.Warn(err)
+		responseWriter.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	responseWriter.Header().Set("Content-Type", "application/json")
+	if _, err := responseWriter.Write(data); err != nil {
+		h.logger.Warn(err)
+	}
 }
@@ -1,21 +1,35 @@
 package healthcheck
 
 import (
-	"context"
 	"fmt"
-	"net"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/publicip"
 )
 
-func healthCheck(ctx context.Context, resolver *net.Resolver) (err error) {
-	// TODO use mullvad API if current provider is Mullvad
-	const domainToResolve = "github.com"
-	ips, err := resolver.LookupIP(ctx, "ip", domainToResolve)
+func healthCheck(status openvpn.ConnectionStatus, publicIP publicip.Status,
+	probes []ProbeStatus) (err error) {
 	switch {
-	case err != nil:
-		return fmt.Errorf("cannot resolve github.com: %s", err)
-	case len(ips) == 0:
-		return fmt.Errorf("resolved no IP addresses for %s", domainToResolve)
-	default:
-		return nil
+	case publicIP.Leaking:
+		return fmt.Errorf("CRITICAL: public IP address %s is the ISP public IP address", publicIP.IP)
+	case publicIP.IPv6Bypass:
+		return fmt.Errorf("CRITICAL: public IPv6 address %s is reachable outside the VPN tunnel", publicIP.IPv6)
+	}
+	if status.State != openvpn.StateConnected {
+		return fmt.Errorf("OpenVPN is %s since %s", status.State, status.Since.Format(time.RFC3339))
+	}
+	return unhealthyProbesError(probes)
+}
+
+// readyCheck returns an error if the tunnel or the DNS are not up,
+// such as during a reconnection.
+func readyCheck(status openvpn.ConnectionStatus, dnsReady bool) (err error) {
+	if status.State != openvpn.StateConnected {
+		return fmt.Errorf("OpenVPN is %s since %s", status.State, status.Since.Format(time.RFC3339))
+	}
+	if !dnsReady {
+		return fmt.Errorf("DNS over TLS is not ready")
 	}
+	return nil
 }
@@ -0,0 +1,49 @@
+package healthcheck
+
+import (
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_readyCheck(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		status   openvpn.ConnectionStatus
+		dnsReady bool
+		err      string
+	}{
+		"ready": {
+			status:   openvpn.ConnectionStatus{State: openvpn.StateConnected},
+			dnsReady: true,
+		},
+		"reconnecting": {
+			status: openvpn.ConnectionStatus{
+				State: openvpn.StateConnecting,
+				Since: time.Unix(0, 0).UTC(),
+			},
+			dnsReady: true,
+			err:      "OpenVPN is connecting since 1970-01-01T00:00:00Z",
+		},
+		"DNS not ready": {
+			status: openvpn.ConnectionStatus{State: openvpn.StateConnected},
+			err:    "DNS over TLS is not ready",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := readyCheck(testCase.status, testCase.dnsReady)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
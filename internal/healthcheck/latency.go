@@ -0,0 +1,74 @@
+package healthcheck
+
+import (
+	"sort"
+	"time"
+)
+
+// latencyWindow is the number of latest latencies kept for each
+// probe to compute the average and percentiles.
+const latencyWindow = 100
+
+// Latency contains round-trip statistics of the successful checks of
+// a probe. The average and percentiles are over the latest checks only.
+type Latency struct {
+	CurrentMs float64 `json:"currentMs"`
+	AverageMs float64 `json:"averageMs"`
+	P50Ms     float64 `json:"p50Ms"`
+	P90Ms     float64 `json:"p90Ms"`
+	P99Ms     float64 `json:"p99Ms"`
+	// Count and SumMs are over all the successful checks.
+	Count int     `json:"count"`
+	SumMs float64 `json:"sumMs"`
+}
+
+// latencies records the latest round-trip durations of a probe.
+type latencies struct {
+	window []time.Duration
+	next   int
+	count  int
+	sum    time.Duration
+}
+
+func (l *latencies) add(latency time.Duration) {
+	if len(l.window) < latencyWindow {
+		l.window = append(l.window, latency)
+	} else {
+		l.window[l.next] = latency
+	}
+	l.next = (l.next + 1) % latencyWindow
+	l.count++
+	l.sum += latency
+}
+
+func (l *latencies) stats(current time.Duration) *Latency {
+	sorted := make([]time.Duration, len(l.window))
+	copy(sorted, l.window)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var windowSum time.Duration
+	for _, latency := range sorted {
+		windowSum += latency
+	}
+	return &Latency{
+		CurrentMs: milliseconds(current),
+		AverageMs: milliseconds(windowSum / time.Duration(len(sorted))),
+		P50Ms:     milliseconds(percentile(sorted, 50)), //nolint:gomnd
+		P90Ms:     milliseconds(percentile(sorted, 90)), //nolint:gomnd
+		P99Ms:     milliseconds(percentile(sorted, 99)), //nolint:gomnd
+		Count:     l.count,
+		SumMs:     milliseconds(l.sum),
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100 //nolint:gomnd
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,219 @@
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/logging"
+)
+
+// Monitor runs probes periodically while OpenVPN is connected.
+type Monitor interface {
+	Run(ctx context.Context, wg *sync.WaitGroup)
+	// Probes returns the state of each probe.
+	Probes() (probes []ProbeStatus)
+}
+
+// ProbeStatus is the state of a probe.
+type ProbeStatus struct {
+	Name                 string    `json:"name"`
+	Healthy              bool      `json:"healthy"`
+	ConsecutiveFailures  int       `json:"consecutiveFailures"`
+	ConsecutiveSuccesses int       `json:"consecutiveSuccesses"`
+	LastError            string    `json:"lastError,omitempty"`
+	LastCheck            time.Time `json:"lastCheck"`
+	// Latency is nil until the probe succeeds once.
+	Latency *Latency `json:"latency,omitempty"`
+}
+
+type probe struct {
+	status    ProbeStatus
+	latencies latencies
+	check     func(ctx context.Context) error
+	// onUnhealthy is called when the probe becomes unhealthy, if not nil,
+	// and again with an exponential backoff while it stays unhealthy.
+	onUnhealthy func()
+	// nextRecovery is the number of consecutive failures at which
+	// onUnhealthy is called next, and recoveryBackoff is the number
+	// of failures to wait for after that.
+	nextRecovery    int
+	recoveryBackoff int
+}
+
+// maxRecoveryBackoff is the maximum number of consecutive failures
+// between two calls to the onUnhealthy function of a probe.
+const maxRecoveryBackoff = 64
+
+type monitor struct {
+	settings  settings.Health
+	probes    []*probe
+	getStatus func() openvpn.ConnectionStatus
+	logger    logging.Logger
+	timeNow   func() time.Time
+	mutex     sync.RWMutex
+}
+
+// NewMonitor returns a monitor running the probes configured in the settings
+// given. The tunnel DNS probe queries the DNS server at dnsServer directly,
+// which is the local DNS over TLS server or the plaintext DNS server used.
+// restartDNS is called when the internal DNS probe becomes unhealthy
+// and while it stays unhealthy, if it is not nil. The internal DNS probe
+// then queries the local resolver directly, so that the plaintext DNS
+// fallback does not hide its failures.
+func NewMonitor(settings settings.Health, dnsServer net.IP, getStatus func() openvpn.ConnectionStatus,
+	restartDNS func(), logger logging.Logger) Monitor {
+	m := &monitor{
+		settings:  settings,
+		getStatus: getStatus,
+		logger:    logger.WithPrefix("healthcheck: "),
+		timeNow:   time.Now,
+	}
+	if settings.DNSDomain != "" {
+		internalResolver := newInternalResolver()
+		if restartDNS != nil {
+			internalResolver = newLocalResolver()
+		}
+		m.addProbe("dns internal", func(ctx context.Context) error {
+			return resolve(ctx, internalResolver, settings.DNSDomain)
+		}, restartDNS)
+		const dnsPort = "53"
+		tunnelResolver := newServerResolver(net.JoinHostPort(dnsServer.String(), dnsPort))
+		m.addProbe("dns tunnel", func(ctx context.Context) error {
+			return resolve(ctx, tunnelResolver, settings.DNSDomain)
+		}, nil)
+	}
+	for _, target := range settings.TCPTargets {
+		target := target
+		m.addProbe("tcp "+target, func(ctx context.Context) error {
+			return connectTCP(ctx, target)
+		}, nil)
+	}
+	return m
+}
+
+func (m *monitor) addProbe(name string, check func(ctx context.Context) error, onUnhealthy func()) {
+	m.probes = append(m.probes, &probe{
+		status:      ProbeStatus{Name: name, Healthy: true},
+		check:       check,
+		onUnhealthy: onUnhealthy,
+	})
+}
+
+func (m *monitor) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if len(m.probes) == 0 {
+		return
+	}
+	ticker := time.NewTicker(m.settings.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		if m.getStatus().State != openvpn.StateConnected {
+			continue // failures would be caused by the tunnel being down
+		}
+		for _, p := range m.probes {
+			m.runProbe(ctx, p)
+		}
+	}
+}
+
+func (m *monitor) runProbe(ctx context.Context, p *probe) {
+	probeCtx, cancel := context.WithTimeout(ctx, m.settings.Timeout)
+	start := m.timeNow()
+	err := p.check(probeCtx)
+	latency := m.timeNow().Sub(start)
+	cancel()
+	if ctx.Err() != nil {
+		return
+	}
+	// the health state only changes after several consecutive
+	// results, so that a single blip does not flap it.
+	m.mutex.Lock()
+	p.status.LastCheck = m.timeNow()
+	if err == nil {
+		p.status.ConsecutiveFailures = 0
+		p.status.ConsecutiveSuccesses++
+		p.status.LastError = ""
+		p.latencies.add(latency)
+		p.status.Latency = p.latencies.stats(latency)
+		becameHealthy := !p.status.Healthy && p.status.ConsecutiveSuccesses >= m.settings.SuccessThreshold
+		if becameHealthy {
+			p.status.Healthy = true
+			p.nextRecovery, p.recoveryBackoff = 0, 0
+		}
+		m.mutex.Unlock()
+		if becameHealthy {
+			m.logger.Info("%s probe is healthy again", p.status.Name)
+		}
+		return
+	}
+	p.status.ConsecutiveSuccesses = 0
+	p.status.ConsecutiveFailures++
+	p.status.LastError = err.Error()
+	becameUnhealthy := p.status.Healthy && p.status.ConsecutiveFailures >= m.settings.FailureThreshold
+	if becameUnhealthy {
+		p.status.Healthy = false
+		p.nextRecovery = p.status.ConsecutiveFailures
+		p.recoveryBackoff = m.settings.FailureThreshold
+	}
+	doRecovery := !p.status.Healthy && p.onUnhealthy != nil &&
+		p.status.ConsecutiveFailures == p.nextRecovery
+	if doRecovery {
+		p.nextRecovery += p.recoveryBackoff
+		p.recoveryBackoff *= 2
+		if p.recoveryBackoff > maxRecoveryBackoff {
+			p.recoveryBackoff = maxRecoveryBackoff
+		}
+	}
+	failures, nextRecovery := p.status.ConsecutiveFailures, p.nextRecovery
+	m.mutex.Unlock()
+	m.logger.Warn("%s probe failed: %s", p.status.Name, err)
+	if becameUnhealthy {
+		m.logger.Error("%s probe is unhealthy after %d consecutive failures",
+			p.status.Name, m.settings.FailureThreshold)
+	}
+	if doRecovery {
+		if !becameUnhealthy {
+			m.logger.Warn("%s probe is still unhealthy after %d consecutive failures, recovering again",
+				p.status.Name, failures)
+		}
+		p.onUnhealthy()
+		m.logger.Info("next recovery for the %s probe after %d consecutive failures",
+			p.status.Name, nextRecovery)
+	}
+}
+
+func (m *monitor) Probes() (probes []ProbeStatus) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	probes = make([]ProbeStatus, len(m.probes))
+	for i, p := range m.probes {
+		probes[i] = p.status
+	}
+	return probes
+}
+
+// unhealthyProbesError returns an error for the first unhealthy probe, if any.
+func unhealthyProbesError(probes []ProbeStatus) error {
+	for _, probe := range probes {
+		switch {
+		case probe.Healthy:
+		case probe.LastError == "": // recovering
+			return fmt.Errorf("%s probe is recovering with %d successes",
+				probe.Name, probe.ConsecutiveSuccesses)
+		default:
+			return fmt.Errorf("%s probe failed %d times: %s",
+				probe.Name, probe.ConsecutiveFailures, probe.LastError)
+		}
+	}
+	return nil
+}
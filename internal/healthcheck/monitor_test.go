@@ -0,0 +1,130 @@
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_monitor_runProbe(t *testing.T) {
+	t.Parallel()
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	m := &monitor{
+		settings: settings.Health{
+			FailureThreshold: 3,
+			SuccessThreshold: 2,
+		},
+		logger:  logger,
+		timeNow: func() time.Time { return now },
+	}
+	var checkErr error
+	unhealthyCalls := 0
+	m.addProbe("test", func(ctx context.Context) error { return checkErr },
+		func() { unhealthyCalls++ })
+	p := m.probes[0]
+	ctx := context.Background()
+
+	checkErr = errors.New("failed")
+	for i := 1; i < m.settings.FailureThreshold; i++ {
+		m.runProbe(ctx, p)
+		assert.True(t, m.Probes()[0].Healthy)
+	}
+	m.runProbe(ctx, p)
+	m.runProbe(ctx, p)
+	assert.Equal(t, []ProbeStatus{{
+		Name:                "test",
+		ConsecutiveFailures: 4,
+		LastError:           "failed",
+		LastCheck:           now,
+	}}, m.Probes())
+	assert.Equal(t, 1, unhealthyCalls)
+	assert.EqualError(t, unhealthyProbesError(m.Probes()), "test probe failed 4 times: failed")
+
+	checkErr = nil
+	m.runProbe(ctx, p)
+	assert.Equal(t, []ProbeStatus{{
+		Name:                 "test",
+		ConsecutiveSuccesses: 1,
+		LastCheck:            now,
+		Latency:              &Latency{Count: 1},
+	}}, m.Probes())
+	assert.EqualError(t, unhealthyProbesError(m.Probes()), "test probe is recovering with 1 successes")
+
+	// a single failure while healthy does not change the health state
+	m.runProbe(ctx, p)
+	checkErr = errors.New("blip")
+	m.runProbe(ctx, p)
+	checkErr = nil
+	m.runProbe(ctx, p)
+	assert.Equal(t, []ProbeStatus{{
+		Name:                 "test",
+		Healthy:              true,
+		ConsecutiveSuccesses: 1,
+		LastCheck:            now,
+		Latency:              &Latency{Count: 3},
+	}}, m.Probes())
+	assert.NoError(t, unhealthyProbesError(m.Probes()))
+	assert.Equal(t, 1, unhealthyCalls)
+}
+
+func Test_monitor_runProbe_recoveryBackoff(t *testing.T) {
+	t.Parallel()
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	m := &monitor{
+		settings: settings.Health{
+			FailureThreshold: 2,
+			SuccessThreshold: 1,
+		},
+		logger:  logger,
+		timeNow: time.Now,
+	}
+	checkErr := errors.New("failed")
+	var recoveries []int
+	m.addProbe("test", func(ctx context.Context) error { return checkErr },
+		func() { recoveries = append(recoveries, m.Probes()[0].ConsecutiveFailures) })
+	p := m.probes[0]
+	ctx := context.Background()
+
+	for i := 0; i < 30; i++ {
+		m.runProbe(ctx, p)
+	}
+	assert.Equal(t, []int{2, 4, 8, 16}, recoveries)
+
+	// the backoff is reset once the probe is healthy again
+	checkErr = nil
+	m.runProbe(ctx, p)
+	checkErr = errors.New("failed")
+	recoveries = nil
+	for i := 0; i < 4; i++ {
+		m.runProbe(ctx, p)
+	}
+	assert.Equal(t, []int{2, 4}, recoveries)
+}
+
+func Test_latencies(t *testing.T) {
+	t.Parallel()
+	var l latencies
+	for i := 1; i <= latencyWindow+10; i++ {
+		l.add(time.Duration(i) * time.Millisecond)
+	}
+	assert.Len(t, l.window, latencyWindow)
+	latency := l.stats(5 * time.Millisecond)
+	assert.Equal(t, &Latency{
+		CurrentMs: 5,
+		AverageMs: 60.5,
+		P50Ms:     60,
+		P90Ms:     100,
+		P99Ms:     109,
+		Count:     latencyWindow + 10,
+		SumMs:     6105,
+	}, latency)
+}
@@ -3,7 +3,6 @@ package healthcheck
 import (
 	"context"
 	"errors"
-	"net"
 	"net/http"
 	"sync"
 	"time"
@@ -21,11 +20,11 @@ type server struct {
 	handler http.Handler
 }
 
-func NewServer(address string, logger logging.Logger) Server {
+func NewServer(address string, logger logging.Logger, handler http.Handler) Server {
 	return &server{
 		address: address,
 		logger:  logger.WithPrefix("healthcheck: "),
-		handler: newHandler(logger, &net.Resolver{}),
+		handler: handler,
 	}
 }
 
@@ -0,0 +1,16 @@
+package healthcheck
+
+import (
+	"context"
+	"net"
+)
+
+// connectTCP opens and closes a TCP connection to the address given.
+func connectTCP(ctx context.Context, address string) error {
+	dialer := net.Dialer{}
+	connection, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	return connection.Close()
+}
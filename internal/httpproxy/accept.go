@@ -2,7 +2,9 @@ package httpproxy
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
 )
 
 func (h *handler) isAccepted(responseWriter http.ResponseWriter, request *http.Request) bool {
@@ -22,3 +24,48 @@ func (h *handler) isAccepted(responseWriter http.ResponseWriter, request *http.R
 	}
 	return true
 }
+
+func (h *handler) isAllowedClient(responseWriter http.ResponseWriter, request *http.Request) bool {
+	if len(h.allowed) == 0 {
+		return true
+	}
+	host, _, err := net.SplitHostPort(request.RemoteAddr)
+	if err != nil {
+		host = request.RemoteAddr
+	}
+	clientIP := net.ParseIP(host)
+	for _, subnet := range h.allowed {
+		if clientIP != nil && subnet.Contains(clientIP) {
+			return true
+		}
+	}
+	h.logger.Info("client %s is not in the allowed subnets", request.RemoteAddr)
+	responseWriter.WriteHeader(http.StatusForbidden)
+	return false
+}
+
+// isAllowedPort writes an error response and returns false if the
+// destination port of the CONNECT request is not allowed.
+func (h *handler) isAllowedPort(responseWriter http.ResponseWriter, request *http.Request) bool {
+	if len(h.ports) == 0 {
+		return true
+	}
+	_, portString, err := net.SplitHostPort(request.Host)
+	if err != nil {
+		http.Error(responseWriter, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil {
+		http.Error(responseWriter, fmt.Sprintf("port %q is not valid", portString), http.StatusBadRequest)
+		return false
+	}
+	for _, allowed := range h.ports {
+		if port == int(allowed) {
+			return true
+		}
+	}
+	h.logger.Info("client %s is not allowed to CONNECT to port %d", request.RemoteAddr, port)
+	http.Error(responseWriter, fmt.Sprintf("CONNECT to port %d is not allowed", port), http.StatusForbidden)
+	return false
+}
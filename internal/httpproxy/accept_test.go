@@ -0,0 +1,131 @@
+package httpproxy
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_handler_isAllowedClient(t *testing.T) {
+	t.Parallel()
+	lanSubnet := net.IPNet{IP: net.IP{192, 168, 1, 0}, Mask: net.IPv4Mask(255, 255, 255, 0)}
+	ipv6Subnet := net.IPNet{IP: net.ParseIP("fd00::"), Mask: net.CIDRMask(64, 128)}
+	testCases := map[string]struct {
+		allowed    []net.IPNet
+		remoteAddr string
+		ok         bool
+		status     int
+	}{
+		"all clients allowed": {
+			remoteAddr: "10.0.0.2:5000",
+			ok:         true,
+			status:     http.StatusOK,
+		},
+		"client in allowed subnet": {
+			allowed:    []net.IPNet{ipv6Subnet, lanSubnet},
+			remoteAddr: "192.168.1.5:5000",
+			ok:         true,
+			status:     http.StatusOK,
+		},
+		"IPv6 client in allowed subnet": {
+			allowed:    []net.IPNet{lanSubnet, ipv6Subnet},
+			remoteAddr: "[fd00::5]:5000",
+			ok:         true,
+			status:     http.StatusOK,
+		},
+		"client not in allowed subnets": {
+			allowed:    []net.IPNet{lanSubnet, ipv6Subnet},
+			remoteAddr: "10.0.0.2:5000",
+			status:     http.StatusForbidden,
+		},
+		"remote address without port": {
+			allowed:    []net.IPNet{lanSubnet},
+			remoteAddr: "192.168.1.5",
+			ok:         true,
+			status:     http.StatusOK,
+		},
+		"malformed remote address": {
+			allowed:    []net.IPNet{lanSubnet},
+			remoteAddr: "not an address",
+			status:     http.StatusForbidden,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			if !testCase.ok {
+				logger.EXPECT().Info("client %s is not in the allowed subnets", testCase.remoteAddr)
+			}
+			h := &handler{logger: logger, allowed: testCase.allowed}
+			request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+			request.RemoteAddr = testCase.remoteAddr
+			recorder := httptest.NewRecorder()
+			ok := h.isAllowedClient(recorder, request)
+			assert.Equal(t, testCase.ok, ok)
+			assert.Equal(t, testCase.status, recorder.Code)
+		})
+	}
+}
+
+func Test_handler_isAllowedPort(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		ports   []uint16
+		host    string
+		allowed bool
+		status  int
+		logged  bool
+	}{
+		"all ports allowed": {
+			host:    "example.com:22",
+			allowed: true,
+			status:  http.StatusOK,
+		},
+		"allowed port": {
+			ports:   []uint16{443, 80},
+			host:    "example.com:443",
+			allowed: true,
+			status:  http.StatusOK,
+		},
+		"port not allowed": {
+			ports:  []uint16{443, 80},
+			host:   "example.com:22",
+			status: http.StatusForbidden,
+			logged: true,
+		},
+		"missing port": {
+			ports:  []uint16{443},
+			host:   "example.com",
+			status: http.StatusBadRequest,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			if testCase.logged {
+				logger.EXPECT().Info("client %s is not allowed to CONNECT to port %d", "10.0.0.2:5000", 22)
+			}
+			h := &handler{logger: logger, ports: testCase.ports}
+			request := httptest.NewRequest(http.MethodConnect, "http://"+testCase.host, nil)
+			request.Host = testCase.host
+			request.RemoteAddr = "10.0.0.2:5000"
+			recorder := httptest.NewRecorder()
+			allowed := h.isAllowedPort(recorder, request)
+			assert.Equal(t, testCase.allowed, allowed)
+			assert.Equal(t, testCase.status, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package httpproxy
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// accessLog contains the information logged for each proxied request.
+type accessLog struct {
+	clientIP  string
+	method    string
+	host      string
+	status    int
+	bytesIn   int64 // from the client to the destination
+	bytesOut  int64 // from the destination to the client
+	startTime time.Time
+	duration  time.Duration
+}
+
+func newAccessLog(remoteAddr, method, host string, startTime time.Time) *accessLog {
+	clientIP, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		clientIP = remoteAddr
+	}
+	return &accessLog{
+		clientIP:  clientIP,
+		method:    method,
+		host:      host,
+		startTime: startTime,
+	}
+}
+
+// String returns the access log as a logfmt formatted line.
+func (a *accessLog) String() string {
+	fields := []string{
+		"client=" + a.clientIP,
+		"method=" + a.method,
+		"host=" + a.host,
+	}
+	if a.status != 0 {
+		fields = append(fields, fmt.Sprintf("status=%d", a.status))
+	}
+	fields = append(fields,
+		fmt.Sprintf("bytes_in=%d", a.bytesIn),
+		fmt.Sprintf("bytes_out=%d", a.bytesOut),
+		fmt.Sprintf("duration=%s", a.duration.Round(time.Millisecond)),
+	)
+	return strings.Join(fields, " ")
+}
+
+// countingReader counts the bytes read from the reader it wraps.
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (n int, err error) {
+	n, err = c.ReadCloser.Read(p)
+	atomic.AddInt64(&c.n, int64(n))
+	return n, err
+}
+
+func (c *countingReader) count() int64 {
+	return atomic.LoadInt64(&c.n)
+}
@@ -0,0 +1,48 @@
+package httpproxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_accessLog_String(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		accessLog accessLog
+		s         string
+	}{
+		"http request": {
+			accessLog: accessLog{
+				clientIP: "10.0.0.2",
+				method:   "GET",
+				host:     "github.com",
+				status:   200,
+				bytesIn:  0,
+				bytesOut: 1534,
+				duration: 123456789 * time.Nanosecond,
+			},
+			s: "client=10.0.0.2 method=GET host=github.com status=200 bytes_in=0 bytes_out=1534 duration=123ms",
+		},
+		"connect tunnel": {
+			accessLog: accessLog{
+				clientIP: "10.0.0.2",
+				method:   "CONNECT",
+				host:     "github.com:443",
+				bytesIn:  10,
+				bytesOut: 20,
+				duration: time.Second,
+			},
+			s: "client=10.0.0.2 method=CONNECT host=github.com:443 bytes_in=10 bytes_out=20 duration=1s",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			s := testCase.accessLog.String()
+			assert.Equal(t, testCase.s, s)
+		})
+	}
+}
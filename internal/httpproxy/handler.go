@@ -2,25 +2,36 @@ package httpproxy
 
 import (
 	"context"
+	"net"
 	"net/http"
+	"net/url"
 	"sync"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/golibs/logging"
 )
 
 func newHandler(ctx context.Context, wg *sync.WaitGroup, logger logging.Logger,
-	stealth, verbose bool, username, password string) http.Handler {
+	settings settings.HTTPProxy, stats *stats) http.Handler {
 	const httpTimeout = 24 * time.Hour
+	client := &http.Client{Timeout: httpTimeout}
+	if settings.Upstream != nil {
+		client.Transport = &http.Transport{Proxy: http.ProxyURL(settings.Upstream)}
+	}
 	return &handler{
 		ctx:      ctx,
 		wg:       wg,
-		client:   &http.Client{Timeout: httpTimeout},
+		client:   client,
 		logger:   logger,
-		verbose:  verbose,
-		stealth:  stealth,
-		username: username,
-		password: password,
+		verbose:  settings.Log,
+		stealth:  settings.Stealth,
+		username: settings.User,
+		password: settings.Password,
+		allowed:  settings.AllowedSubnets,
+		stats:    stats,
+		upstream: settings.Upstream,
+		ports:    settings.ConnectPorts,
 	}
 }
 
@@ -31,12 +42,19 @@ type handler struct {
 	logger             logging.Logger
 	verbose, stealth   bool
 	username, password string
+	allowed            []net.IPNet
+	stats              *stats
+	upstream           *url.URL
+	ports              []uint16 // allowed CONNECT destination ports
 }
 
 func (h *handler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
 	if !h.isAccepted(responseWriter, request) {
 		return
 	}
+	if !h.isAllowedClient(responseWriter, request) {
+		return
+	}
 	if !h.isAuthorized(responseWriter, request) {
 		return
 	}
@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 )
 
 func (h *handler) handleHTTP(responseWriter http.ResponseWriter, request *http.Request) {
@@ -17,6 +18,15 @@ func (h *handler) handleHTTP(responseWriter http.ResponseWriter, request *http.R
 		return
 	}
 
+	access := newAccessLog(request.RemoteAddr, request.Method, request.URL.Host, time.Now())
+	h.stats.connectionStarted(access.clientIP)
+	defer h.stats.connectionEnded(access.clientIP)
+	var requestBody *countingReader
+	if request.Body != nil {
+		requestBody = &countingReader{ReadCloser: request.Body}
+		request.Body = requestBody
+	}
+
 	request = request.WithContext(h.ctx)
 
 	request.RequestURI = ""
@@ -37,9 +47,6 @@ func (h *handler) handleHTTP(responseWriter http.ResponseWriter, request *http.R
 		return
 	}
 	defer response.Body.Close()
-	if h.verbose {
-		h.logger.Info("%s %s %s %s", request.RemoteAddr, response.Status, request.Method, request.URL)
-	}
 
 	for _, key := range hopHeaders {
 		response.Header.Del(key)
@@ -53,9 +60,21 @@ func (h *handler) handleHTTP(responseWriter http.ResponseWriter, request *http.R
 	}
 
 	responseWriter.WriteHeader(response.StatusCode)
-	if _, err := io.Copy(responseWriter, response.Body); err != nil {
+	written, err := io.Copy(responseWriter, response.Body)
+	if err != nil {
 		h.logger.Error("%s %s: body copy error: %s", request.RemoteAddr, request.URL, err)
 	}
+
+	access.status = response.StatusCode
+	if requestBody != nil {
+		access.bytesIn = requestBody.count()
+	}
+	access.bytesOut = written
+	h.stats.addBytes(access.clientIP, access.bytesIn, access.bytesOut)
+	if h.verbose {
+		access.duration = time.Since(access.startTime)
+		h.logger.Info(access.String())
+	}
 }
 
 func setForwardedHeaders(request *http.Request) {
@@ -3,14 +3,17 @@ package httpproxy
 import (
 	"context"
 	"io"
-	"net"
 	"net/http"
 	"sync"
+	"time"
 )
 
 func (h *handler) handleHTTPS(responseWriter http.ResponseWriter, request *http.Request) {
-	dialer := net.Dialer{}
-	destinationConn, err := dialer.DialContext(h.ctx, "tcp", request.Host)
+	if !h.isAllowedPort(responseWriter, request) {
+		return
+	}
+	access := newAccessLog(request.RemoteAddr, request.Method, request.Host, time.Now())
+	destinationConn, err := h.dial(h.ctx, request.Host)
 	if err != nil {
 		http.Error(responseWriter, err.Error(), http.StatusServiceUnavailable)
 		return
@@ -33,11 +36,8 @@ func (h *handler) handleHTTPS(responseWriter http.ResponseWriter, request *http.
 		return
 	}
 
-	if h.verbose {
-		h.logger.Info("%s <-> %s", request.RemoteAddr, request.Host)
-	}
-
 	h.wg.Add(1)
+	h.stats.connectionStarted(access.clientIP)
 	ctx, cancel := context.WithCancel(h.ctx)
 	const transferGoroutines = 2
 	wg := &sync.WaitGroup{}
@@ -45,6 +45,11 @@ func (h *handler) handleHTTPS(responseWriter http.ResponseWriter, request *http.
 	go func() { // trigger cleanup when done
 		wg.Wait()
 		cancel()
+		h.stats.connectionEnded(access.clientIP)
+		if h.verbose {
+			access.duration = time.Since(access.startTime)
+			h.logger.Info(access.String())
+		}
 	}()
 	go func() { // cleanup
 		<-ctx.Done()
@@ -52,12 +57,14 @@ func (h *handler) handleHTTPS(responseWriter http.ResponseWriter, request *http.
 		clientConnection.Close()
 		h.wg.Done()
 	}()
-	go transfer(destinationConn, clientConnection, wg)
-	go transfer(clientConnection, destinationConn, wg)
+	go transfer(&statsWriter{WriteCloser: destinationConn, stats: h.stats, clientIP: access.clientIP, outbound: true},
+		clientConnection, &access.bytesIn, wg)
+	go transfer(&statsWriter{WriteCloser: clientConnection, stats: h.stats, clientIP: access.clientIP},
+		destinationConn, &access.bytesOut, wg)
 }
 
-func transfer(destination io.WriteCloser, source io.ReadCloser, wg *sync.WaitGroup) {
-	_, _ = io.Copy(destination, source)
+func transfer(destination io.WriteCloser, source io.ReadCloser, written *int64, wg *sync.WaitGroup) {
+	*written, _ = io.Copy(destination, source)
 	_ = source.Close()
 	_ = destination.Close()
 	wg.Done()
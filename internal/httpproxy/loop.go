@@ -3,8 +3,10 @@ package httpproxy
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 
+	"github.com/qdm12/gluetun/internal/loopstate"
 	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/golibs/logging"
 )
@@ -16,21 +18,26 @@ type Looper interface {
 	Stop()
 	GetSettings() (settings settings.HTTPProxy)
 	SetSettings(settings settings.HTTPProxy)
+	GetStats() (clients map[string]ClientStats)
 }
 
 type looper struct {
 	settings      settings.HTTPProxy
 	settingsMutex sync.RWMutex
+	stats         *stats
 	logger        logging.Logger
+	state         loopstate.Reporter
 	restart       chan struct{}
 	start         chan struct{}
 	stop          chan struct{}
 }
 
-func NewLooper(logger logging.Logger, settings settings.HTTPProxy) Looper {
+func NewLooper(logger logging.Logger, state loopstate.Reporter, settings settings.HTTPProxy) Looper {
 	return &looper{
 		settings: settings,
+		stats:    newStats(),
 		logger:   logger.WithPrefix("http proxy: "),
+		state:    state,
 		restart:  make(chan struct{}),
 		start:    make(chan struct{}),
 		stop:     make(chan struct{}),
@@ -49,6 +56,11 @@ func (l *looper) SetSettings(settings settings.HTTPProxy) {
 	l.settings = settings
 }
 
+// GetStats returns the traffic statistics for each client IP address.
+func (l *looper) GetStats() (clients map[string]ClientStats) {
+	return l.stats.get()
+}
+
 func (l *looper) isEnabled() bool {
 	l.settingsMutex.RLock()
 	defer l.settingsMutex.RUnlock()
@@ -81,6 +93,9 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 		}
 	}
 	defer l.logger.Warn("loop exited")
+	defer l.state.SetState(loopstate.Stopped)
+
+	l.setEnabled(true)
 
 	for ctx.Err() == nil {
 		for !l.isEnabled() {
@@ -98,14 +113,19 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 		}
 
 		settings := l.GetSettings()
-		address := fmt.Sprintf("0.0.0.0:%d", settings.Port)
+		host := "0.0.0.0"
+		if settings.ListeningAddress != nil {
+			host = settings.ListeningAddress.String()
+		}
+		address := net.JoinHostPort(host, fmt.Sprint(settings.Port))
 
-		server := New(ctx, address, l.logger, settings.Stealth, settings.Log, settings.User, settings.Password)
+		server := newServer(ctx, address, l.logger, settings, l.stats)
 
 		runCtx, runCancel := context.WithCancel(context.Background())
 		runWg := &sync.WaitGroup{}
 		runWg.Add(1)
 		go server.Run(runCtx, runWg)
+		l.state.SetState(loopstate.Running)
 
 		stayHere := true
 		for stayHere {
@@ -127,6 +147,7 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 				runCancel()
 				runWg.Wait()
 				l.setEnabled(false)
+				l.state.SetState(loopstate.Stopped)
 				stayHere = false
 			}
 		}
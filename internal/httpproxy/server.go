@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/golibs/logging"
 )
 
@@ -15,17 +16,21 @@ type Server interface {
 
 type server struct {
 	address    string
+	tlsCert    string
+	tlsKey     string
 	handler    http.Handler
 	logger     logging.Logger
 	internalWG *sync.WaitGroup
 }
 
-func New(ctx context.Context, address string, logger logging.Logger,
-	stealth, verbose bool, username, password string) Server {
+func newServer(ctx context.Context, address string, logger logging.Logger,
+	settings settings.HTTPProxy, stats *stats) Server {
 	wg := &sync.WaitGroup{}
 	return &server{
 		address:    address,
-		handler:    newHandler(ctx, wg, logger, stealth, verbose, username, password),
+		tlsCert:    settings.TLSCertificate,
+		tlsKey:     settings.TLSKey,
+		handler:    newHandler(ctx, wg, logger, settings, stats),
 		logger:     logger,
 		internalWG: wg,
 	}
@@ -45,8 +50,14 @@ func (s *server) Run(ctx context.Context, wg *sync.WaitGroup) {
 			s.logger.Error("failed shutting down: %s", err)
 		}
 	}()
-	s.logger.Info("listening on %s", s.address)
-	err := server.ListenAndServe()
+	var err error
+	if s.tlsCert != "" {
+		s.logger.Info("listening on %s using TLS", s.address)
+		err = server.ListenAndServeTLS(s.tlsCert, s.tlsKey)
+	} else {
+		s.logger.Info("listening on %s", s.address)
+		err = server.ListenAndServe()
+	}
 	if err != nil && ctx.Err() != context.Canceled {
 		s.logger.Error(err)
 	}
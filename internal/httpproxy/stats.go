@@ -0,0 +1,86 @@
+package httpproxy
+
+import (
+	"io"
+	"sync"
+)
+
+// ClientStats contains traffic statistics for a client IP address of the proxy.
+type ClientStats struct {
+	BytesIn           int64 `json:"bytesIn"`
+	BytesOut          int64 `json:"bytesOut"`
+	ActiveConnections int   `json:"activeConnections"`
+	TotalConnections  int   `json:"totalConnections"`
+}
+
+type stats struct {
+	clients map[string]*ClientStats
+	mutex   sync.Mutex
+}
+
+func newStats() *stats {
+	return &stats{
+		clients: make(map[string]*ClientStats),
+	}
+}
+
+func (s *stats) getClient(clientIP string) *ClientStats {
+	client, ok := s.clients[clientIP]
+	if !ok {
+		client = &ClientStats{}
+		s.clients[clientIP] = client
+	}
+	return client
+}
+
+func (s *stats) connectionStarted(clientIP string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	client := s.getClient(clientIP)
+	client.ActiveConnections++
+	client.TotalConnections++
+}
+
+func (s *stats) connectionEnded(clientIP string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.getClient(clientIP).ActiveConnections--
+}
+
+func (s *stats) addBytes(clientIP string, bytesIn, bytesOut int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	client := s.getClient(clientIP)
+	client.BytesIn += bytesIn
+	client.BytesOut += bytesOut
+}
+
+// get returns a copy of the statistics for each client IP address.
+func (s *stats) get() (clients map[string]ClientStats) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	clients = make(map[string]ClientStats, len(s.clients))
+	for clientIP, client := range s.clients {
+		clients[clientIP] = *client
+	}
+	return clients
+}
+
+// statsWriter adds the bytes written to the statistics of a client
+// as they are written, so long lived tunnels are accounted for live.
+type statsWriter struct {
+	io.WriteCloser
+	stats    *stats
+	clientIP string
+	outbound bool // from the client to the destination
+}
+
+func (s *statsWriter) Write(p []byte) (n int, err error) {
+	n, err = s.WriteCloser.Write(p)
+	if s.outbound {
+		s.stats.addBytes(s.clientIP, int64(n), 0)
+	} else {
+		s.stats.addBytes(s.clientIP, 0, int64(n))
+	}
+	return n, err
+}
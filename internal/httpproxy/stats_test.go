@@ -0,0 +1,25 @@
+package httpproxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_stats(t *testing.T) {
+	t.Parallel()
+	s := newStats()
+	s.connectionStarted("10.0.0.2")
+	s.connectionStarted("10.0.0.2")
+	s.connectionStarted("10.0.0.3")
+	s.addBytes("10.0.0.2", 10, 20)
+	s.addBytes("10.0.0.2", 1, 2)
+	s.connectionEnded("10.0.0.2")
+
+	clients := s.get()
+
+	assert.Equal(t, map[string]ClientStats{
+		"10.0.0.2": {BytesIn: 11, BytesOut: 22, ActiveConnections: 1, TotalConnections: 2},
+		"10.0.0.3": {ActiveConnections: 1, TotalConnections: 1},
+	}, clients)
+}
@@ -0,0 +1,90 @@
+package httpproxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+// dial connects to the destination address, through the upstream proxy if one is set.
+func (h *handler) dial(ctx context.Context, address string) (conn net.Conn, err error) {
+	dialer := net.Dialer{}
+	if h.upstream == nil {
+		return dialer.DialContext(ctx, "tcp", address)
+	}
+
+	conn, err = dialer.DialContext(ctx, "tcp", upstreamAddress(h.upstream))
+	if err != nil {
+		return nil, fmt.Errorf("cannot dial upstream proxy: %w", err)
+	}
+	if h.upstream.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{ServerName: h.upstream.Hostname()}) //nolint:gosec
+	}
+
+	tunnel, err := connectThroughUpstream(conn, h.upstream, address)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return tunnel, nil
+}
+
+func upstreamAddress(upstream *url.URL) string {
+	if upstream.Port() != "" {
+		return upstream.Host
+	}
+	port := "80"
+	if upstream.Scheme == "https" {
+		port = "443"
+	}
+	return net.JoinHostPort(upstream.Hostname(), port)
+}
+
+// connectThroughUpstream sends a CONNECT request for the address
+// given to the upstream proxy and checks its response. It returns
+// the connection to use for the tunnel, which reads first the bytes
+// buffered after the response.
+func connectThroughUpstream(conn net.Conn, upstream *url.URL, address string) (tunnel net.Conn, err error) {
+	request := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: address},
+		Host:   address,
+		Header: make(http.Header),
+	}
+	if upstream.User != nil {
+		password, _ := upstream.User.Password()
+		credentials := upstream.User.Username() + ":" + password
+		request.Header.Set("Proxy-Authorization",
+			"Basic "+base64.StdEncoding.EncodeToString([]byte(credentials)))
+	}
+	if err := request.Write(conn); err != nil {
+		return nil, fmt.Errorf("cannot send CONNECT request to upstream proxy: %w", err)
+	}
+
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, request)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CONNECT response from upstream proxy: %w", err)
+	}
+	_ = response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("upstream proxy refused to connect to %s: %s", address, response.Status)
+	}
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// bufferedConn reads from its reader, which buffers the connection,
+// so the bytes already buffered are not lost.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (n int, err error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,60 @@
+package httpproxy
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_connectThroughUpstream(t *testing.T) {
+	t.Parallel()
+	client, upstream := net.Pipe()
+	defer client.Close()
+
+	requests := make(chan *http.Request, 1)
+	go func() {
+		defer upstream.Close()
+		request, err := http.ReadRequest(bufio.NewReader(upstream))
+		if err != nil {
+			close(requests)
+			return
+		}
+		requests <- request
+		// the tunnel data is sent together with the response
+		_, _ = upstream.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\ntunnel data"))
+	}()
+
+	upstreamURL := &url.URL{Scheme: "http", Host: "proxy:3128", User: url.UserPassword("user", "pass")}
+	tunnel, err := connectThroughUpstream(client, upstreamURL, "example.com:443")
+	require.NoError(t, err)
+	request := <-requests
+	require.NotNil(t, request)
+	assert.Equal(t, http.MethodConnect, request.Method)
+	assert.Equal(t, "example.com:443", request.Host)
+	assert.Equal(t, "Basic dXNlcjpwYXNz", request.Header.Get("Proxy-Authorization"))
+
+	data, err := ioutil.ReadAll(tunnel)
+	require.NoError(t, err)
+	assert.Equal(t, "tunnel data", string(data))
+}
+
+func Test_connectThroughUpstream_refused(t *testing.T) {
+	t.Parallel()
+	client, upstream := net.Pipe()
+	defer client.Close()
+	go func() {
+		defer upstream.Close()
+		_, _ = http.ReadRequest(bufio.NewReader(upstream))
+		_, _ = upstream.Write([]byte("HTTP/1.1 407 Proxy Authentication Required\r\n\r\n"))
+	}()
+
+	_, err := connectThroughUpstream(client, &url.URL{Scheme: "http", Host: "proxy:3128"}, "example.com:443")
+	require.Error(t, err)
+	assert.Equal(t, "upstream proxy refused to connect to example.com:443: 407 Proxy Authentication Required", err.Error())
+}
@@ -12,13 +12,121 @@ import (
 
 //nolint:lll
 var regularExpressions = struct { //nolint:gochecknoglobals
-	unboundPrefix *regexp.Regexp
+	unboundPrefix       *regexp.Regexp
+	openvpnTunnelPrefix *regexp.Regexp
 }{
-	unboundPrefix: regexp.MustCompile(`unbound: \[[0-9]{10}\] unbound\[[0-9]+:0\] `),
+	unboundPrefix:       regexp.MustCompile(`unbound: \[[0-9]{10}\] unbound\[[0-9]+:0\] `),
+	openvpnTunnelPrefix: regexp.MustCompile(`^openvpn ([^ :]+)( stderr)?: `),
 }
 
+// benignSubstrings are parts of OpenVPN and Unbound lines which look alarming
+// but require no action, and are logged at the debug level.
+var benignSubstrings = []string{ //nolint:gochecknoglobals
+	"WARNING: this configuration may cache passwords in memory -- use the auth-nocache option to prevent this",
+	"' is used inconsistently, local='",
+	"NOTE: the current --script-security setting may allow this configuration to call user-defined scripts",
+	"WARNING: --ping should normally be used with --ping-restart or --ping-exit",
+	"was not granted. Got ", // unbound so-rcvbuf and so-sndbuf
+}
+
+func isBenign(s string) bool {
+	for _, substring := range benignSubstrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+// knownSubstrings are parts of OpenVPN and Unbound lines logged at a level
+// different from the level given by their prefix: errors and failures
+// requiring attention at the error or warn level, and routine lines
+// showing the progress of the connection at the debug level.
+var knownSubstrings = []struct { //nolint:gochecknoglobals
+	substring string
+	level     logging.Level
+}{
+	{"Exiting due to fatal error", logging.ErrorLevel},
+	{"Cannot resolve host address", logging.ErrorLevel},
+	{"Cannot open TUN/TAP dev", logging.ErrorLevel},
+	{"TLS Error: ", logging.ErrorLevel},
+	{"Inactivity timeout", logging.WarnLevel},
+	{"SIGUSR1[soft,", logging.WarnLevel},
+	{"Network is unreachable", logging.WarnLevel},
+	{"Connection reset", logging.WarnLevel},
+	{"TUN/TAP device ", logging.DebugLevel},
+	{"TUN/TAP TX queue length", logging.DebugLevel},
+	{"net_route_v4_add", logging.DebugLevel},
+	{"net_route_v6_add", logging.DebugLevel},
+	{"net_addr_v4_add", logging.DebugLevel},
+	{"net_addr_v6_add", logging.DebugLevel},
+	{"net_iface_mtu_set", logging.DebugLevel},
+	{"net_iface_up", logging.DebugLevel},
+	{"Data Channel: ", logging.DebugLevel},
+	{"Outgoing Data Channel", logging.DebugLevel},
+	{"Incoming Data Channel", logging.DebugLevel},
+	{"Control Channel: ", logging.DebugLevel},
+	{"Outgoing Control Channel", logging.DebugLevel},
+	{"Incoming Control Channel", logging.DebugLevel},
+	{"VERIFY OK", logging.DebugLevel},
+	{"VERIFY KU OK", logging.DebugLevel},
+	{"VERIFY EKU OK", logging.DebugLevel},
+	{"Validating certificate", logging.DebugLevel},
+	{"++ Certificate has EKU", logging.DebugLevel},
+	{"Socket Buffers: ", logging.DebugLevel},
+	{"UDP link local", logging.DebugLevel},
+	{"UDP link remote", logging.DebugLevel},
+	{"TCP/UDP: Preserving recently used remote address", logging.DebugLevel},
+	{"TLS: Initial packet from", logging.DebugLevel},
+	{"OPTIONS IMPORT: ", logging.DebugLevel},
+	{"ROUTE_GATEWAY", logging.DebugLevel},
+	{"Timers: ", logging.DebugLevel},
+	{"Protocol options: ", logging.DebugLevel},
+	{"SENT CONTROL", logging.DebugLevel},
+	{"Using peer cipher", logging.DebugLevel},
+	{"start of service", logging.DebugLevel},
+	{"service stopped", logging.DebugLevel},
+	{"server stats for thread", logging.DebugLevel},
+	{"average recursion processing time", logging.DebugLevel},
+	{"histogram of recursion processing times", logging.DebugLevel},
+	{"lower(secs) upper(secs) recursions", logging.DebugLevel},
+	{"generate keytag query", logging.DebugLevel},
+}
+
+// knownLevel returns the level of the known substring found in s,
+// or the default level given if none is found.
+func knownLevel(s string, defaultLevel logging.Level) (level logging.Level) {
+	if isBenign(s) {
+		return logging.DebugLevel
+	}
+	for _, known := range knownSubstrings {
+		if strings.Contains(s, known.substring) {
+			return known.level
+		}
+	}
+	return defaultLevel
+}
+
+// PostProcessLine returns the line given cleaned of its program prefixes,
+// and the level to log it at. Lines written by OpenVPN on its standard error
+// are logged at the warn level, unless they are known errors.
 func PostProcessLine(s string) (filtered string, level logging.Level) {
+	// lines of the extra tunnels are processed as the main tunnel lines
+	// and are prefixed with their interface name, such as openvpn tun1:
+	if match := regularExpressions.openvpnTunnelPrefix.FindStringSubmatch(s); match != nil && match[1] != "stderr" {
+		mainPrefix := "openvpn" + match[2] + ": "
+		filtered, level = PostProcessLine(mainPrefix + s[len(match[0]):])
+		return strings.Replace(filtered, "openvpn: ", "openvpn "+match[1]+": ", 1), level
+	}
 	switch {
+	case strings.HasPrefix(s, "openvpn stderr: "):
+		filtered = "openvpn: " + strings.TrimPrefix(s, "openvpn stderr: ")
+		level = logging.WarnLevel
+		if knownLevel(filtered, level) == logging.ErrorLevel {
+			level = logging.ErrorLevel
+		}
+		filtered = constants.ColorOpenvpn().Sprintf(filtered)
+		return filtered, level
 	case strings.HasPrefix(s, "openvpn: "):
 		for _, ignored := range []string{
 			"openvpn: WARNING: you are using user/group/chroot/setcon without persist-tun -- this may cause restarts to fail",
@@ -48,7 +156,10 @@ func PostProcessLine(s string) (filtered string, level logging.Level) {
 			level = logging.ErrorLevel
 		default:
 			filtered = s
-			level = logging.InfoLevel
+			level = knownLevel(s, logging.InfoLevel)
+		}
+		if isBenign(s) {
+			level = logging.DebugLevel
 		}
 		filtered = constants.ColorOpenvpn().Sprintf(filtered)
 		return filtered, level
@@ -61,16 +172,28 @@ func PostProcessLine(s string) (filtered string, level logging.Level) {
 			level = logging.InfoLevel
 		case strings.HasPrefix(filtered, "info: "):
 			filtered = strings.TrimPrefix(filtered, "info: ")
-			level = logging.InfoLevel
+			level = knownLevel(filtered, logging.InfoLevel)
+		case strings.HasPrefix(filtered, "debug: "):
+			filtered = strings.TrimPrefix(filtered, "debug: ")
+			level = logging.DebugLevel
 		case strings.HasPrefix(filtered, "warn: "):
 			filtered = strings.TrimPrefix(filtered, "warn: ")
 			level = logging.WarnLevel
+		case strings.HasPrefix(filtered, "warning: "):
+			filtered = strings.TrimPrefix(filtered, "warning: ")
+			level = logging.WarnLevel
 		case strings.HasPrefix(filtered, "error: "):
 			filtered = strings.TrimPrefix(filtered, "error: ")
 			level = logging.ErrorLevel
+		case strings.HasPrefix(filtered, "fatal error: "):
+			filtered = strings.TrimPrefix(filtered, "fatal error: ")
+			level = logging.ErrorLevel
 		default:
 			level = logging.ErrorLevel
 		}
+		if isBenign(filtered) {
+			level = logging.DebugLevel
+		}
 		filtered = fmt.Sprintf("unbound: %s", filtered)
 		filtered = constants.ColorUnbound().Sprintf(filtered)
 		return filtered, level
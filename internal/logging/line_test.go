@@ -60,6 +60,54 @@ func Test_PostProcessLine(t *testing.T) {
 			"openvpn: Initialization Sequence Completed",
 			"openvpn: Initialization Sequence Completed",
 			logging.InfoLevel},
+		"openvpn benign warning": {
+			"openvpn: WARNING: 'link-mtu' is used inconsistently, local='link-mtu 1549', remote='link-mtu 1550'",
+			"openvpn: 'link-mtu' is used inconsistently, local='link-mtu 1549', remote='link-mtu 1550'",
+			logging.DebugLevel},
+		"unbound benign warning": {
+			"unbound: [1594595249] unbound[75:0] warn: so-rcvbuf 1048576 was not granted. Got 425984.",
+			"unbound: so-rcvbuf 1048576 was not granted. Got 425984.",
+			logging.DebugLevel},
+		"unbound warning": {
+			"unbound: [1594595249] unbound[75:0] warning: duplicate local-zone a.com.",
+			"unbound: duplicate local-zone a.com.",
+			logging.WarnLevel},
+		"unbound fatal error": {
+			"unbound: [1594595249] unbound[75:0] fatal error: could not open ports",
+			"unbound: could not open ports",
+			logging.ErrorLevel},
+		"unbound routine info": {
+			"unbound: [1594595249] unbound[75:0] info: start of service (unbound 1.13.1).",
+			"unbound: start of service (unbound 1.13.1).",
+			logging.DebugLevel},
+		"openvpn routine": {
+			"openvpn: TUN/TAP device tun0 opened",
+			"openvpn: TUN/TAP device tun0 opened",
+			logging.DebugLevel},
+		"openvpn known error": {
+			"openvpn: Exiting due to fatal error",
+			"openvpn: Exiting due to fatal error",
+			logging.ErrorLevel},
+		"openvpn known warning": {
+			"openvpn: SIGUSR1[soft,ping-restart] received, process restarting",
+			"openvpn: SIGUSR1[soft,ping-restart] received, process restarting",
+			logging.WarnLevel},
+		"openvpn stderr": {
+			"openvpn stderr: Note: cannot open openvpn.log",
+			"openvpn: Note: cannot open openvpn.log",
+			logging.WarnLevel},
+		"openvpn stderr known error": {
+			"openvpn stderr: Cannot open TUN/TAP dev /dev/net/tun: No such file or directory",
+			"openvpn: Cannot open TUN/TAP dev /dev/net/tun: No such file or directory",
+			logging.ErrorLevel},
+		"openvpn extra tunnel": {
+			"openvpn tun1: TUN/TAP device tun1 opened",
+			"openvpn tun1: TUN/TAP device tun1 opened",
+			logging.DebugLevel},
+		"openvpn extra tunnel stderr": {
+			"openvpn tun1 stderr: Note: cannot open openvpn.log",
+			"openvpn tun1: Note: cannot open openvpn.log",
+			logging.WarnLevel},
 		"openvpn auth failed": {
 			"openvpn: AUTH: Received control message: AUTH_FAILED",
 			"openvpn: AUTH: Received control message: AUTH_FAILED\n\n  (IF YOU ARE USING PIA servers, MAYBE CHECK OUT https://github.com/qdm12/gluetun/issues/265)\n", //nolint:lll
@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/golibs/format"
+	"github.com/qdm12/golibs/logging"
+)
+
+// Format configures how log lines are written by all the loggers
+// sharing the same output.
+type Format struct {
+	// TimeLayout is the Go time layout of the timestamp,
+	// and no timestamp is written if it is empty.
+	TimeLayout string
+	UTC        bool
+	// Color is one of constants.LogColorChoices().
+	Color string
+}
+
+//nolint:gochecknoglobals
+var levelRanks = map[logging.Level]int{
+	logging.DebugLevel: 0,
+	logging.InfoLevel:  1,
+	logging.WarnLevel:  2,
+	logging.ErrorLevel: 3,
+}
+
+// output is shared by a logger and all the loggers derived from it.
+type output struct {
+	writer  io.Writer
+	level   logging.Level
+	format  Format
+	timeNow func() time.Time
+	mutex   sync.Mutex
+	// isTerminal is whether colors were detected as supported
+	isTerminal bool
+}
+
+type logger struct {
+	prefix string
+	output *output
+}
+
+// NewLogger returns a logger writing lines of the form
+// `<timestamp>\t<LEVEL>\t<message>` to the writer given, for the
+// level given and above. The function returned changes the format
+// of the logger and of all the loggers derived from it.
+func NewLogger(writer io.Writer, level logging.Level) (l logging.Logger, setFormat func(format Format)) {
+	out := &output{
+		writer:     writer,
+		level:      level,
+		format:     Format{TimeLayout: constants.LogTimeLayoutISO8601, Color: constants.LogColorAuto},
+		timeNow:    time.Now,
+		isTerminal: !color.NoColor,
+	}
+	return &logger{output: out}, out.setFormat
+}
+
+func (o *output) setFormat(format Format) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.format = format
+	// fatih/color is used to color lines from all components
+	noColor := !o.isTerminal
+	switch format.Color {
+	case constants.LogColorOn:
+		noColor = false
+	case constants.LogColorOff:
+		noColor = true
+	}
+	if color.NoColor != noColor {
+		color.NoColor = noColor
+	}
+}
+
+func (o *output) write(level logging.Level, s string) {
+	if levelRanks[level] < levelRanks[o.level] {
+		return
+	}
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	line := ""
+	if o.format.TimeLayout != "" {
+		now := o.timeNow()
+		if o.format.UTC {
+			now = now.UTC()
+		}
+		line = now.Format(o.format.TimeLayout) + "\t"
+	}
+	line += levelString(level) + "\t" + s + "\n"
+	_, _ = io.WriteString(o.writer, line)
+}
+
+func levelString(level logging.Level) string {
+	switch level {
+	case logging.DebugLevel:
+		return "DEBUG"
+	case logging.InfoLevel:
+		return "INFO"
+	case logging.WarnLevel:
+		return "WARN"
+	case logging.ErrorLevel:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LEVEL(%s)", level)
+	}
+}
+
+func (l *logger) Sync() error { return nil }
+
+func (l *logger) Debug(args ...interface{}) { l.log(logging.DebugLevel, args...) }
+func (l *logger) Info(args ...interface{})  { l.log(logging.InfoLevel, args...) }
+func (l *logger) Warn(args ...interface{})  { l.log(logging.WarnLevel, args...) }
+func (l *logger) Error(args ...interface{}) { l.log(logging.ErrorLevel, args...) }
+
+func (l *logger) log(level logging.Level, args ...interface{}) {
+	l.output.write(level, l.prefix+format.ArgsToString(args...))
+}
+
+func (l *logger) SetPrefix(prefix string) logging.Logger {
+	return &logger{prefix: prefix, output: l.output}
+}
+
+func (l *logger) WithPrefix(prefix string) logging.Logger {
+	return &logger{prefix: l.prefix + prefix, output: l.output}
+}
@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_logger(t *testing.T) {
+	t.Parallel()
+	location := time.FixedZone("test", 2*60*60)
+	now := time.Date(2020, 10, 25, 22, 13, 46, 0, location)
+	tests := map[string]struct {
+		format Format
+		output string
+	}{
+		"default layout": {
+			format: Format{TimeLayout: constants.LogTimeLayoutISO8601},
+			output: "2020-10-25T22:13:46.000+0200\tINFO\topenvpn: a 1\n2020-10-25T22:13:46.000+0200\tWARN\tb\n",
+		},
+		"utc": {
+			format: Format{TimeLayout: time.RFC3339, UTC: true},
+			output: "2020-10-25T20:13:46Z\tINFO\topenvpn: a 1\n2020-10-25T20:13:46Z\tWARN\tb\n",
+		},
+		"no timestamp": {
+			output: "INFO\topenvpn: a 1\nWARN\tb\n",
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			buffer := bytes.NewBuffer(nil)
+			l, setFormat := NewLogger(buffer, logging.InfoLevel)
+			l.(*logger).output.timeNow = func() time.Time { return now }
+			setFormat(tc.format)
+			l.WithPrefix("openvpn: ").Info("a %d", 1)
+			l.Debug("not logged")
+			l.Warn("b")
+			assert.Equal(t, tc.output, buffer.String())
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package logging
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/qdm12/golibs/format"
+	"github.com/qdm12/golibs/logging"
+)
+
+const redacted = "[redacted]"
+
+// minSecretLength is the minimum length of a secret to be masked, to avoid
+// masking short values such as the Mullvad password "m" everywhere.
+const minSecretLength = 4
+
+//nolint:lll
+var secretPatterns = []*regexp.Regexp{ //nolint:gochecknoglobals
+	// WireGuard private, public and preshared keys
+	regexp.MustCompile(`\b[A-Za-z0-9+/]{42}[AEIMQUYcgkosw048]=`),
+	// OpenVPN session tokens such as in push replies
+	regexp.MustCompile(`(?i)(auth-token(?:-user)?\s+)[^\s,]+`),
+	// key value pairs such as in URL queries
+	regexp.MustCompile(`(?i)((?:password|passwd|token|secret|api_?key)=)[^\s&"',]+`),
+}
+
+// Redactor masks secrets such as credentials, tokens and keys in log lines.
+type Redactor interface {
+	// AddSecrets registers secret values to mask, ignoring empty and short ones.
+	AddSecrets(secrets ...string)
+	Redact(s string) (redactedString string)
+}
+
+type redactor struct {
+	secrets []string
+	mutex   sync.RWMutex
+}
+
+func NewRedactor() Redactor {
+	return &redactor{}
+}
+
+func (r *redactor) AddSecrets(secrets ...string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for _, secret := range secrets {
+		if len(secret) < minSecretLength {
+			continue
+		}
+		r.secrets = append(r.secrets, secret)
+	}
+}
+
+func (r *redactor) Redact(s string) (redactedString string) {
+	r.mutex.RLock()
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, redacted)
+	}
+	r.mutex.RUnlock()
+	for _, pattern := range secretPatterns {
+		if pattern.NumSubexp() == 0 {
+			s = pattern.ReplaceAllLiteralString(s, redacted)
+			continue
+		}
+		s = pattern.ReplaceAllString(s, "${1}"+redacted)
+	}
+	return s
+}
+
+type redactingLogger struct {
+	logger   logging.Logger
+	redactor Redactor
+}
+
+// NewRedactingLogger returns a logger masking secrets using the
+// redactor given before logging with the logger given.
+func NewRedactingLogger(logger logging.Logger, redactor Redactor) logging.Logger {
+	return &redactingLogger{
+		logger:   logger,
+		redactor: redactor,
+	}
+}
+
+func (r *redactingLogger) Sync() error { return r.logger.Sync() }
+
+func (r *redactingLogger) Debug(args ...interface{}) {
+	r.logger.Debug(r.redactor.Redact(format.ArgsToString(args...)))
+}
+
+func (r *redactingLogger) Info(args ...interface{}) {
+	r.logger.Info(r.redactor.Redact(format.ArgsToString(args...)))
+}
+
+func (r *redactingLogger) Warn(args ...interface{}) {
+	r.logger.Warn(r.redactor.Redact(format.ArgsToString(args...)))
+}
+
+func (r *redactingLogger) Error(args ...interface{}) {
+	r.logger.Error(r.redactor.Redact(format.ArgsToString(args...)))
+}
+
+func (r *redactingLogger) SetPrefix(prefix string) logging.Logger {
+	return NewRedactingLogger(r.logger.SetPrefix(prefix), r.redactor)
+}
+
+func (r *redactingLogger) WithPrefix(prefix string) logging.Logger {
+	return NewRedactingLogger(r.logger.WithPrefix(prefix), r.redactor)
+}
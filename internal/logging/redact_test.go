@@ -0,0 +1,47 @@
+package logging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_redactor_Redact(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		secrets  []string
+		s        string
+		redacted string
+	}{
+		"nothing to redact": {
+			s:        "openvpn: Initialization Sequence Completed",
+			redacted: "openvpn: Initialization Sequence Completed",
+		},
+		"known secrets": {
+			secrets:  []string{"p1234567", "hunter22", "m", ""},
+			s:        "user p1234567 with password hunter22 on mullvad",
+			redacted: "user [redacted] with password [redacted] on mullvad",
+		},
+		"wireguard key": {
+			s:        "private key yAnz5TF+lXXJte14tji3zlMNq+hd2rYUIgJBgB3fBmk= loaded",
+			redacted: "private key [redacted] loaded",
+		},
+		"auth token": {
+			s:        "openvpn: PUSH: Received control message: 'PUSH_REPLY,ping 10,auth-token SESS_ID_abc,ifconfig 10.0.0.2'",
+			redacted: "openvpn: PUSH: Received control message: 'PUSH_REPLY,ping 10,auth-token [redacted],ifconfig 10.0.0.2'",
+		},
+		"query parameters": {
+			s:        "GET https://ipinfo.io/1.2.3.4?token=abcdef&x=1",
+			redacted: "GET https://ipinfo.io/1.2.3.4?token=[redacted]&x=1",
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := NewRedactor()
+			r.AddSecrets(tc.secrets...)
+			assert.Equal(t, tc.redacted, r.Redact(tc.s))
+		})
+	}
+}
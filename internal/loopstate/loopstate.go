@@ -0,0 +1,118 @@
+// Package loopstate keeps the state of each looper in a central registry,
+// for the control server to return them.
+package loopstate
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// State is the state of a looper.
+type State string
+
+const (
+	// Stopped is the state of a looper not started yet or stopped.
+	Stopped State = "stopped"
+	// Starting is the state of a looper starting or restarting.
+	Starting State = "starting"
+	// Running is the state of a looper started successfully.
+	Running State = "running"
+	// Crashed is the state of a looper which failed, until it retries.
+	Crashed State = "crashed"
+)
+
+// Status is the status of a looper.
+type Status struct {
+	Name  string    `json:"name"`
+	State State     `json:"state"`
+	Since time.Time `json:"since"`
+	// LastError is the last error of the looper, kept after it recovers.
+	LastError     string     `json:"lastError,omitempty"`
+	LastErrorTime *time.Time `json:"lastErrorTime,omitempty"`
+}
+
+type Registry interface {
+	// Reporter registers the looper name given in the stopped state,
+	// and returns a reporter for the looper to report its state.
+	Reporter(name string) Reporter
+	// Statuses returns the status of each looper registered, sorted by name.
+	Statuses() (statuses []Status)
+}
+
+type Reporter interface {
+	// SetState sets the state of the looper, keeping its last error.
+	SetState(state State)
+	// SetError sets the state of the looper to crashed with the error given,
+	// and does nothing if the error is nil.
+	SetError(err error)
+}
+
+type registry struct {
+	statuses map[string]*Status
+	mutex    sync.RWMutex
+	timeNow  func() time.Time
+}
+
+func NewRegistry() Registry {
+	return &registry{
+		statuses: make(map[string]*Status),
+		timeNow:  time.Now,
+	}
+}
+
+func (r *registry) Reporter(name string) Reporter {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.statuses[name] = &Status{
+		Name:  name,
+		State: Stopped,
+		Since: r.timeNow(),
+	}
+	return &reporter{name: name, registry: r}
+}
+
+func (r *registry) Statuses() (statuses []Status) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	statuses = make([]Status, 0, len(r.statuses))
+	for _, status := range r.statuses {
+		statuses = append(statuses, *status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+type reporter struct {
+	name     string
+	registry *registry
+}
+
+func (r *reporter) SetState(state State) {
+	r.registry.mutex.Lock()
+	defer r.registry.mutex.Unlock()
+	status := r.registry.statuses[r.name]
+	if status.State == state {
+		return
+	}
+	status.State = state
+	status.Since = r.registry.timeNow()
+}
+
+func (r *reporter) SetError(err error) {
+	if err == nil {
+		return
+	}
+	r.registry.mutex.Lock()
+	defer r.registry.mutex.Unlock()
+	status := r.registry.statuses[r.name]
+	now := r.registry.timeNow()
+	if status.State != Crashed {
+		status.State = Crashed
+		status.Since = now
+	}
+	status.LastError = err.Error()
+	status.LastErrorTime = &now
+}
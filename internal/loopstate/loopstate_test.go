@@ -0,0 +1,45 @@
+package loopstate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_registry(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1000, 0)
+	r := NewRegistry().(*registry)
+	r.timeNow = func() time.Time { return now }
+
+	dns := r.Reporter("dns")
+	openvpn := r.Reporter("openvpn")
+	start := now
+
+	now = now.Add(time.Second)
+	openvpn.SetState(Starting)
+	now = now.Add(time.Second)
+	openvpn.SetError(errors.New("dummy"))
+	errorTime := now
+	now = now.Add(time.Second)
+	openvpn.SetError(nil)
+	openvpn.SetState(Running)
+	running := now
+	now = now.Add(time.Second)
+	openvpn.SetState(Running)
+	dns.SetState(Stopped)
+
+	expected := []Status{
+		{Name: "dns", State: Stopped, Since: start},
+		{
+			Name:          "openvpn",
+			State:         Running,
+			Since:         running,
+			LastError:     "dummy",
+			LastErrorTime: &errorTime,
+		},
+	}
+	assert.Equal(t, expected, r.Statuses())
+}
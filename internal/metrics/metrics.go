@@ -0,0 +1,261 @@
+// Package metrics collects tunnel metrics and writes them
+// in the Prometheus text exposition format.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/dns"
+	"github.com/qdm12/gluetun/internal/healthcheck"
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/golibs/files"
+	"github.com/qdm12/golibs/logging"
+)
+
+const samplePeriod = 10 * time.Second
+
+type Collector interface {
+	// Run samples the interfaces byte counters periodically
+	// to compute byte rates, until the context is canceled.
+	Run(ctx context.Context, wg *sync.WaitGroup)
+	// WritePrometheus writes the metrics in the Prometheus text format.
+	WritePrometheus(w io.Writer) (err error)
+}
+
+type collector struct {
+	interfaces  []string
+	fileManager files.FileManager
+	getStatus   func() openvpn.ConnectionStatus
+	getProbes   func() []healthcheck.ProbeStatus
+	getBlocks   func() dns.BlockStats
+	logger      logging.Logger
+	timeNow     func() time.Time
+	samples     map[string]sample
+	rates       map[string]rates
+	mutex       sync.RWMutex
+}
+
+type sample struct {
+	time    time.Time
+	counter counters
+}
+
+type counters struct {
+	rx, tx uint64
+}
+
+// rates are in bytes per second.
+type rates struct {
+	rx, tx float64
+}
+
+// NewCollector returns a collector for the network interfaces given,
+// such as tun0 and eth0, the connection status, the health probes
+// and the DNS queries blocked.
+func NewCollector(interfaces []string, fileManager files.FileManager,
+	getStatus func() openvpn.ConnectionStatus, getProbes func() []healthcheck.ProbeStatus,
+	getBlocks func() dns.BlockStats, logger logging.Logger) Collector {
+	return &collector{
+		interfaces:  interfaces,
+		fileManager: fileManager,
+		getStatus:   getStatus,
+		getProbes:   getProbes,
+		getBlocks:   getBlocks,
+		logger:      logger.WithPrefix("metrics: "),
+		timeNow:     time.Now,
+		samples:     make(map[string]sample, len(interfaces)),
+		rates:       make(map[string]rates, len(interfaces)),
+	}
+}
+
+func (c *collector) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(samplePeriod)
+	defer ticker.Stop()
+	for {
+		c.sample()
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *collector) sample() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := c.timeNow()
+	for _, name := range c.interfaces {
+		counter, err := c.readCounters(name)
+		if err != nil { // interface not up yet
+			delete(c.samples, name)
+			delete(c.rates, name)
+			continue
+		}
+		previous, ok := c.samples[name]
+		c.samples[name] = sample{time: now, counter: counter}
+		if !ok {
+			continue
+		}
+		seconds := now.Sub(previous.time).Seconds()
+		if seconds <= 0 || counter.rx < previous.counter.rx || counter.tx < previous.counter.tx {
+			// interface recreated, such as tun0 after a reconnection
+			c.rates[name] = rates{}
+			continue
+		}
+		c.rates[name] = rates{
+			rx: float64(counter.rx-previous.counter.rx) / seconds,
+			tx: float64(counter.tx-previous.counter.tx) / seconds,
+		}
+	}
+}
+
+func (c *collector) readCounters(name string) (counter counters, err error) {
+	counter.rx, err = c.readCounter(name, "rx_bytes")
+	if err != nil {
+		return counter, err
+	}
+	counter.tx, err = c.readCounter(name, "tx_bytes")
+	return counter, err
+}
+
+func (c *collector) readCounter(interfaceName, counterName string) (value uint64, err error) {
+	data, err := c.fileManager.ReadFile("/sys/class/net/" + interfaceName + "/statistics/" + counterName)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64) //nolint:gomnd
+}
+
+func (c *collector) WritePrometheus(w io.Writer) (err error) {
+	status := c.getStatus()
+	server := ""
+	if status.Server != nil {
+		server = status.Server.Hostname
+		if server == "" {
+			server = status.Server.IP.String()
+		}
+	}
+	vpnLabels := fmt.Sprintf(`provider="%s",server="%s"`,
+		escapeLabel(string(status.Provider)), escapeLabel(server))
+
+	m := &metricsWriter{w: w}
+	c.mutex.RLock()
+	var rxTotal, txTotal, rxRate, txRate []string
+	for _, name := range c.interfaces {
+		labels := fmt.Sprintf(`interface="%s",%s`, escapeLabel(name), vpnLabels)
+		counter, err := c.readCounters(name)
+		if err != nil {
+			continue
+		}
+		rxTotal = append(rxTotal, fmt.Sprintf("{%s} %d", labels, counter.rx))
+		txTotal = append(txTotal, fmt.Sprintf("{%s} %d", labels, counter.tx))
+		if rate, ok := c.rates[name]; ok {
+			rxRate = append(rxRate, fmt.Sprintf("{%s} %g", labels, rate.rx))
+			txRate = append(txRate, fmt.Sprintf("{%s} %g", labels, rate.tx))
+		}
+	}
+	c.mutex.RUnlock()
+	m.write("gluetun_interface_receive_bytes_total", "counter",
+		"Bytes received on the network interface.", rxTotal...)
+	m.write("gluetun_interface_transmit_bytes_total", "counter",
+		"Bytes transmitted on the network interface.", txTotal...)
+	m.write("gluetun_interface_receive_bytes_per_second", "gauge",
+		"Bytes received per second on the network interface over the last sample period.", rxRate...)
+	m.write("gluetun_interface_transmit_bytes_per_second", "gauge",
+		"Bytes transmitted per second on the network interface over the last sample period.", txRate...)
+
+	connected, uptime := 0, 0.
+	if status.State == openvpn.StateConnected {
+		connected = 1
+		uptime = c.timeNow().Sub(status.Since).Seconds()
+	}
+	m.write("gluetun_openvpn_connected", "gauge",
+		"1 if the OpenVPN tunnel is up, 0 otherwise.",
+		fmt.Sprintf("{%s} %d", vpnLabels, connected))
+	m.write("gluetun_openvpn_connection_uptime_seconds", "gauge",
+		"Duration since the OpenVPN tunnel is up, 0 if it is down.",
+		fmt.Sprintf("{%s} %g", vpnLabels, uptime))
+	m.write("gluetun_openvpn_reconnects_total", "counter",
+		"Number of OpenVPN reconnections.",
+		fmt.Sprintf("{%s} %d", vpnLabels, status.Restarts))
+	m.write("gluetun_openvpn_tls_errors_total", "counter",
+		"Number of OpenVPN TLS errors.",
+		fmt.Sprintf("{%s} %d", vpnLabels, status.TLSErrors))
+	m.write("gluetun_openvpn_auth_failures_total", "counter",
+		"Number of OpenVPN authentication failures.",
+		fmt.Sprintf("{%s} %d", vpnLabels, status.AuthFailures))
+
+	var healthy, latency []string
+	for _, probe := range c.getProbes() {
+		labels := fmt.Sprintf(`probe="%s",%s`, escapeLabel(probe.Name), vpnLabels)
+		value := 0
+		if probe.Healthy {
+			value = 1
+		}
+		healthy = append(healthy, fmt.Sprintf("{%s} %d", labels, value))
+		if probe.Latency == nil {
+			continue
+		}
+		const msPerSecond = 1000
+		latency = append(latency,
+			fmt.Sprintf(`{%s,quantile="0.5"} %g`, labels, probe.Latency.P50Ms/msPerSecond),
+			fmt.Sprintf(`{%s,quantile="0.9"} %g`, labels, probe.Latency.P90Ms/msPerSecond),
+			fmt.Sprintf(`{%s,quantile="0.99"} %g`, labels, probe.Latency.P99Ms/msPerSecond),
+			fmt.Sprintf("_sum{%s} %g", labels, probe.Latency.SumMs/msPerSecond),
+			fmt.Sprintf("_count{%s} %d", labels, probe.Latency.Count))
+	}
+	m.write("gluetun_health_probe_healthy", "gauge",
+		"1 if the health probe is healthy, 0 otherwise.", healthy...)
+	m.write("gluetun_health_probe_latency_seconds", "summary",
+		"Round-trip latency of the successful health probe checks.", latency...)
+
+	blocks := c.getBlocks()
+	categories := make([]string, 0, len(blocks.Categories))
+	for category := range blocks.Categories {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	categoryBlocks := make([]string, len(categories))
+	for i, category := range categories {
+		categoryBlocks[i] = fmt.Sprintf(`{category="%s"} %d`,
+			escapeLabel(category), blocks.Categories[category])
+	}
+	m.write("gluetun_dns_blocked_queries_total", "counter",
+		"Number of DNS queries blocked.", fmt.Sprintf(" %d", blocks.Total))
+	m.write("gluetun_dns_category_blocked_queries_total", "counter",
+		"Number of DNS queries blocked for each block list category.", categoryBlocks...)
+	return m.err
+}
+
+// metricsWriter writes metrics and keeps the first write error.
+type metricsWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (m *metricsWriter) write(name, kind, help string, samples ...string) {
+	if m.err != nil || len(samples) == 0 {
+		return
+	}
+	lines := make([]string, 0, len(samples)+2) //nolint:gomnd
+	lines = append(lines, "# HELP "+name+" "+help, "# TYPE "+name+" "+kind)
+	for _, sample := range samples {
+		lines = append(lines, name+sample)
+	}
+	_, m.err = io.WriteString(m.w, strings.Join(lines, "\n")+"\n")
+}
+
+func escapeLabel(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return strings.ReplaceAll(value, "\n", `\n`)
+}
@@ -0,0 +1,128 @@
+package metrics
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/dns"
+	"github.com/qdm12/gluetun/internal/healthcheck"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/golibs/files/mock_files"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_collector(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	files := map[string]string{
+		"/sys/class/net/tun0/statistics/rx_bytes": "1000\n",
+		"/sys/class/net/tun0/statistics/tx_bytes": "500\n",
+	}
+	fileManager := mock_files.NewMockFileManager(mockCtrl)
+	fileManager.EXPECT().ReadFile(gomock.Any()).DoAndReturn(func(path string) ([]byte, error) {
+		data, ok := files[path]
+		if !ok {
+			return nil, os.ErrNotExist
+		}
+		return []byte(data), nil
+	}).AnyTimes()
+
+	start := time.Unix(1000, 0)
+	status := openvpn.ConnectionStatus{
+		State:    openvpn.StateConnected,
+		Since:    start.Add(-time.Minute),
+		Restarts: 2,
+		Provider: constants.Mullvad,
+		Server:   &models.OpenVPNConnection{IP: net.IP{1, 2, 3, 4}},
+	}
+	probes := []healthcheck.ProbeStatus{
+		{Name: "dns tunnel", Healthy: true, Latency: &healthcheck.Latency{
+			P50Ms: 20, P90Ms: 50, P99Ms: 100, Count: 4, SumMs: 150,
+		}},
+		{Name: "tcp example.com:443"},
+	}
+	blocks := dns.BlockStats{
+		Total:      3,
+		Categories: map[string]uint64{"surveillance": 1, "ads": 3},
+	}
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	c := NewCollector([]string{"tun0", "eth0"}, fileManager,
+		func() openvpn.ConnectionStatus { return status }, func() []healthcheck.ProbeStatus { return probes },
+		func() dns.BlockStats { return blocks }, logger).(*collector)
+	now := start
+	c.timeNow = func() time.Time { return now }
+
+	c.sample()
+	now = now.Add(10 * time.Second)
+	files["/sys/class/net/tun0/statistics/rx_bytes"] = "3000"
+	files["/sys/class/net/tun0/statistics/tx_bytes"] = "1500"
+	c.sample()
+
+	buffer := bytes.NewBuffer(nil)
+	err = c.WritePrometheus(buffer)
+	require.NoError(t, err)
+	const labels = `provider="mullvad",server="1.2.3.4"`
+	expected := `# HELP gluetun_interface_receive_bytes_total Bytes received on the network interface.
+# TYPE gluetun_interface_receive_bytes_total counter
+gluetun_interface_receive_bytes_total{interface="tun0",` + labels + `} 3000
+# HELP gluetun_interface_transmit_bytes_total Bytes transmitted on the network interface.
+# TYPE gluetun_interface_transmit_bytes_total counter
+gluetun_interface_transmit_bytes_total{interface="tun0",` + labels + `} 1500
+# HELP gluetun_interface_receive_bytes_per_second Bytes received per second on the network interface over the last sample period.
+# TYPE gluetun_interface_receive_bytes_per_second gauge
+gluetun_interface_receive_bytes_per_second{interface="tun0",` + labels + `} 200
+# HELP gluetun_interface_transmit_bytes_per_second Bytes transmitted per second on the network interface over the last sample period.
+# TYPE gluetun_interface_transmit_bytes_per_second gauge
+gluetun_interface_transmit_bytes_per_second{interface="tun0",` + labels + `} 100
+# HELP gluetun_openvpn_connected 1 if the OpenVPN tunnel is up, 0 otherwise.
+# TYPE gluetun_openvpn_connected gauge
+gluetun_openvpn_connected{` + labels + `} 1
+# HELP gluetun_openvpn_connection_uptime_seconds Duration since the OpenVPN tunnel is up, 0 if it is down.
+# TYPE gluetun_openvpn_connection_uptime_seconds gauge
+gluetun_openvpn_connection_uptime_seconds{` + labels + `} 70
+# HELP gluetun_openvpn_reconnects_total Number of OpenVPN reconnections.
+# TYPE gluetun_openvpn_reconnects_total counter
+gluetun_openvpn_reconnects_total{` + labels + `} 2
+# HELP gluetun_openvpn_tls_errors_total Number of OpenVPN TLS errors.
+# TYPE gluetun_openvpn_tls_errors_total counter
+gluetun_openvpn_tls_errors_total{` + labels + `} 0
+# HELP gluetun_openvpn_auth_failures_total Number of OpenVPN authentication failures.
+# TYPE gluetun_openvpn_auth_failures_total counter
+gluetun_openvpn_auth_failures_total{` + labels + `} 0
+# HELP gluetun_health_probe_healthy 1 if the health probe is healthy, 0 otherwise.
+# TYPE gluetun_health_probe_healthy gauge
+gluetun_health_probe_healthy{probe="dns tunnel",` + labels + `} 1
+gluetun_health_probe_healthy{probe="tcp example.com:443",` + labels + `} 0
+# HELP gluetun_health_probe_latency_seconds Round-trip latency of the successful health probe checks.
+# TYPE gluetun_health_probe_latency_seconds summary
+gluetun_health_probe_latency_seconds{probe="dns tunnel",` + labels + `,quantile="0.5"} 0.02
+gluetun_health_probe_latency_seconds{probe="dns tunnel",` + labels + `,quantile="0.9"} 0.05
+gluetun_health_probe_latency_seconds{probe="dns tunnel",` + labels + `,quantile="0.99"} 0.1
+gluetun_health_probe_latency_seconds_sum{probe="dns tunnel",` + labels + `} 0.15
+gluetun_health_probe_latency_seconds_count{probe="dns tunnel",` + labels + `} 4
+# HELP gluetun_dns_blocked_queries_total Number of DNS queries blocked.
+# TYPE gluetun_dns_blocked_queries_total counter
+gluetun_dns_blocked_queries_total 3
+# HELP gluetun_dns_category_blocked_queries_total Number of DNS queries blocked for each block list category.
+# TYPE gluetun_dns_category_blocked_queries_total counter
+gluetun_dns_category_blocked_queries_total{category="ads"} 3
+gluetun_dns_category_blocked_queries_total{category="surveillance"} 1
+`
+	assert.Equal(t, expected, buffer.String())
+}
+
+func Test_escapeLabel(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, `a\"b\\c\nd`, escapeLabel("a\"b\\c\nd"))
+}
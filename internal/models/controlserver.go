@@ -0,0 +1,10 @@
+package models
+
+// ControlServerListener contains settings for a listener of the control server,
+// for example to serve local clients without API key.
+type ControlServerListener struct {
+	// Address is the host:port address to listen on.
+	Address string
+	// Auth is false to serve all the routes without API key.
+	Auth bool
+}
@@ -1,6 +1,9 @@
 package models
 
-import "net"
+import (
+	"net"
+	"regexp"
+)
 
 // DNSProviderData contains information for a DNS provider.
 type DNSProviderData struct {
@@ -8,4 +11,32 @@ type DNSProviderData struct {
 	SupportsTLS  bool
 	SupportsIPv6 bool
 	Host         DNSHost
+	// DNSCryptStamps are the sdns:// stamps of the DNSCrypt v2 servers
+	// of the provider, and is empty if it does not support DNSCrypt.
+	DNSCryptStamps []string
+}
+
+// DNSBlockPattern is a user defined pattern of hostnames to block.
+type DNSBlockPattern struct {
+	Pattern string
+	// Zone is set if the pattern matches a domain with all its subdomains,
+	// which is blocked by Unbound.
+	Zone string
+	// Regex is set if the pattern is a wildcard or a regular expression,
+	// matched against the hostnames of the block lists, since Unbound
+	// cannot match patterns.
+	Regex *regexp.Regexp
+}
+
+// DNSLocalRecord is a hostname answered locally with the IP address given.
+type DNSLocalRecord struct {
+	Hostname string
+	IP       net.IP
+}
+
+// DNSDomainProviders are the DNS over TLS providers to use to
+// resolve a domain and its subdomains.
+type DNSDomainProviders struct {
+	Domain    string
+	Providers []DNSProvider
 }
@@ -0,0 +1,22 @@
+package models
+
+import (
+	"fmt"
+	"net"
+)
+
+// OutboundPort is a destination port allowed through the default interface
+// outside the VPN tunnel, optionally only for a destination subnet.
+type OutboundPort struct {
+	Protocol    NetworkProtocol
+	Port        uint16
+	Destination *net.IPNet // nil for all destinations
+}
+
+func (o OutboundPort) String() string {
+	s := fmt.Sprintf("%s/%d", o.Protocol, o.Port)
+	if o.Destination != nil {
+		s += "@" + o.Destination.String()
+	}
+	return s
+}
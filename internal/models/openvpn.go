@@ -3,10 +3,14 @@ package models
 import "net"
 
 type OpenVPNConnection struct {
-	IP       net.IP
-	Port     uint16
-	Protocol NetworkProtocol
-	Hostname string // Privado for tls verification
+	IP       net.IP          `json:"ip"`
+	Port     uint16          `json:"port"`
+	Protocol NetworkProtocol `json:"protocol"`
+	Hostname string          `json:"hostname,omitempty"` // also used by Privado for TLS verification
+	// Informative fields about the server, empty if unknown
+	Country string `json:"country,omitempty"`
+	Region  string `json:"region,omitempty"`
+	City    string `json:"city,omitempty"`
 }
 
 func (o *OpenVPNConnection) Equal(other OpenVPNConnection) bool {
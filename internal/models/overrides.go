@@ -0,0 +1,44 @@
+package models
+
+import "strings"
+
+// Overrides contains the settings changed at runtime through
+// the control server, persisted to survive restarts.
+type Overrides struct {
+	OpenVPNProfile string `json:"openvpnProfile,omitempty"`
+	// Components maps control server component names, such as httpproxy
+	// or shadowsocks/{listener}, to their requested status, which is
+	// either running or stopped.
+	Components map[string]string `json:"components,omitempty"`
+}
+
+// SetComponentStatus records the status requested for the component given.
+// Setting the status of all the Shadowsocks listeners drops the statuses
+// previously set for each listener.
+func (o *Overrides) SetComponentStatus(component, status string) {
+	if o.Components == nil {
+		o.Components = make(map[string]string)
+	}
+	if component == "shadowsocks" {
+		for name := range o.Components {
+			if strings.HasPrefix(name, "shadowsocks/") {
+				delete(o.Components, name)
+			}
+		}
+	}
+	o.Components[component] = status
+}
+
+// ComponentRunning returns whether the component given should be running,
+// defaulting to the running value given if no status was requested for it.
+// A Shadowsocks listener defaults to the status of all the listeners.
+func (o *Overrides) ComponentRunning(component string, running bool) bool {
+	status, ok := o.Components[component]
+	if !ok && strings.HasPrefix(component, "shadowsocks/") {
+		status, ok = o.Components["shadowsocks"]
+	}
+	if !ok {
+		return running
+	}
+	return status == "running"
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Overrides_ComponentRunning(t *testing.T) {
+	t.Parallel()
+	overrides := Overrides{}
+	assert.True(t, overrides.ComponentRunning("httpproxy", true))
+	assert.False(t, overrides.ComponentRunning("httpproxy", false))
+
+	overrides.SetComponentStatus("httpproxy", "stopped")
+	overrides.SetComponentStatus("shadowsocks/a", "stopped")
+	assert.False(t, overrides.ComponentRunning("httpproxy", true))
+	assert.False(t, overrides.ComponentRunning("shadowsocks/a", true))
+	assert.True(t, overrides.ComponentRunning("shadowsocks/b", true))
+
+	// the status of all the listeners replaces the listeners statuses
+	overrides.SetComponentStatus("shadowsocks", "running")
+	overrides.SetComponentStatus("shadowsocks/b", "stopped")
+	assert.Equal(t, map[string]string{
+		"httpproxy":     "stopped",
+		"shadowsocks":   "running",
+		"shadowsocks/b": "stopped",
+	}, overrides.Components)
+	assert.True(t, overrides.ComponentRunning("shadowsocks/a", false))
+	assert.False(t, overrides.ComponentRunning("shadowsocks/b", true))
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"fmt"
+	"net"
+)
+
+// StaticRoute is a route to a destination subnet, through a gateway
+// and/or a network interface, added by the user.
+type StaticRoute struct {
+	Destination net.IPNet
+	Gateway     net.IP // can be nil
+	Interface   string // can be empty
+}
+
+func (s StaticRoute) String() string {
+	route := s.Destination.String()
+	if s.Gateway != nil {
+		route += fmt.Sprintf(" via %s", s.Gateway)
+	}
+	if s.Interface != "" {
+		route += " dev " + s.Interface
+	}
+	return route
+}
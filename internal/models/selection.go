@@ -19,48 +19,87 @@ type ServerSelection struct {
 	Protocol NetworkProtocol `json:"networkProtocol"`
 	TargetIP net.IP          `json:"targetIP,omitempty"`
 
-	// Cyberghost, PIA, Surfshark, Windscribe, Vyprvpn, NordVPN
+	// Cyberghost, PIA, Surfshark, Windscribe, Vyprvpn, NordVPN, HideMyAss
 	Regions []string `json:"regions"`
 
 	// Cyberghost
 	Group string `json:"group"`
 
-	Countries []string `json:"countries"` // Mullvad, PureVPN
-	Cities    []string `json:"cities"`    // Mullvad, PureVPN, Windscribe
-	Hostnames []string `json:"hostnames"` // Windscribe, Privado
+	Countries []string `json:"countries"` // Mullvad, PureVPN, Privado, VPN Unlimited, HideMyAss, WeVPN
+	Cities    []string `json:"cities"`    // Mullvad, PureVPN, Windscribe, Privado, VPN Unlimited, HideMyAss, WeVPN
+	Hostnames []string `json:"hostnames"` // All providers, using the CN for PIA
 
 	// Mullvad
-	ISPs  []string `json:"isps"`
-	Owned bool     `json:"owned"`
+	ISPs         []string `json:"isps"`
+	Owned        bool     `json:"owned"`
+	IPv6Endpoint bool     `json:"ipv6Endpoint"`
 
-	// Mullvad, Windscribe
+	// Mullvad, Windscribe, Surfshark, Vyprvpn, PureVPN, Privado, VPN Unlimited, HideMyAss, WeVPN
 	CustomPort uint16 `json:"customPort"`
 
 	// NordVPN
-	Numbers []uint16 `json:"numbers"`
+	Numbers    []uint16 `json:"numbers"`
+	Categories []string `json:"categories"`
 
 	// PIA
 	EncryptionPreset string `json:"encryptionPreset"`
+	// PortForwardOnly is set when port forwarding is enabled, to only select
+	// regions supporting it. If none of the regions chosen supports it, any
+	// region supporting it is selected if PortForwardReselect is set.
+	PortForwardOnly     bool `json:"portForwardOnly"`
+	PortForwardReselect bool `json:"portForwardReselect"`
+
+	// PickMode is how a connection is picked among the servers matching
+	// the selection, and PickSeed seeds the random mode if it is not 0.
+	PickMode string `json:"pickMode"`
+	PickSeed int64  `json:"pickSeed"`
 }
 
 type ExtraConfigOptions struct {
-	ClientCertificate string `json:"-"`                // Cyberghost
-	ClientKey         string `json:"-"`                // Cyberghost
-	EncryptionPreset  string `json:"encryptionPreset"` // PIA
-	OpenVPNIPv6       bool   `json:"openvpnIPv6"`      // Mullvad
+	ClientCertificate    string `json:"-"`                // Cyberghost, VPN Unlimited, HideMyAss
+	ClientKey            string `json:"-"`                // Cyberghost, VPN Unlimited, HideMyAss
+	CertificateAuthority string `json:"-"`                // VPN Unlimited, HideMyAss, WeVPN
+	TLSCryptKey          string `json:"-"`                // WeVPN
+	EncryptionPreset     string `json:"encryptionPreset"` // PIA
+	OpenVPNIPv6          bool   `json:"openvpnIPv6"`      // Mullvad
 }
 
 // PortForwarding contains settings for port forwarding.
 type PortForwarding struct {
 	Enabled  bool     `json:"enabled"`
 	Filepath Filepath `json:"filepath"`
+	Format   string   `json:"format"`
+	Template string   `json:"template"`
+	// Torrent clients to update with the forwarded port
+	Transmission TorrentClient `json:"transmission"`
+	Deluge       TorrentClient `json:"deluge"`
+	// Relay is the host:port address the traffic received on the
+	// forwarded port is relayed to, if not empty.
+	Relay string `json:"relay"`
+}
+
+// TorrentClient contains settings to reach the RPC API of a torrent client.
+type TorrentClient struct {
+	URL      string `json:"url"`
+	User     string `json:"user"`
+	Password string `json:"-"`
 }
 
 func (p *PortForwarding) String() string {
-	if p.Enabled {
-		return fmt.Sprintf("on, saved in %s", p.Filepath)
+	if !p.Enabled {
+		return "off"
+	}
+	s := fmt.Sprintf("on, saved in %s using format %s", p.Filepath, p.Format)
+	if p.Transmission.URL != "" {
+		s += ", updating Transmission at " + p.Transmission.URL
+	}
+	if p.Deluge.URL != "" {
+		s += ", updating Deluge at " + p.Deluge.URL
 	}
-	return "off"
+	if p.Relay != "" {
+		s += ", relaying to " + p.Relay
+	}
+	return s
 }
 
 func (p *ProviderSettings) String() string {
@@ -80,35 +119,50 @@ func (p *ProviderSettings) String() string {
 	if p.ExtraConfigOptions.OpenVPNIPv6 {
 		ipv6 = "on"
 	}
+	ipv6Endpoint := "off"
+	if p.ServerSelection.IPv6Endpoint {
+		ipv6Endpoint = "on"
+	}
 	switch strings.ToLower(string(p.Name)) {
 	case "private internet access old":
 		settingsList = append(settingsList,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
 			"Encryption preset: "+p.ExtraConfigOptions.EncryptionPreset,
 			"Port forwarding: "+p.PortForwarding.String(),
 		)
 	case "private internet access":
+		portForwarding := p.PortForwarding.String()
+		if p.ServerSelection.PortForwardReselect {
+			portForwarding += ", reselecting the region if it does not support it"
+		}
 		settingsList = append(settingsList,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
 			"Encryption preset: "+p.ExtraConfigOptions.EncryptionPreset,
-			"Port forwarding: "+p.PortForwarding.String(),
+			"Port forwarding: "+portForwarding,
 		)
 	case "mullvad":
 		settingsList = append(settingsList,
 			"Countries: "+commaJoin(p.ServerSelection.Countries),
 			"Cities: "+commaJoin(p.ServerSelection.Cities),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
 			"ISPs: "+commaJoin(p.ServerSelection.ISPs),
 			"Custom port: "+customPort,
 			"IPv6: "+ipv6,
+			"IPv6 endpoint: "+ipv6Endpoint,
 		)
 	case "windscribe":
 		settingsList = append(settingsList,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
 			"Custom port: "+customPort,
 		)
 	case "surfshark":
 		settingsList = append(settingsList,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
+			"Custom port: "+customPort,
 		)
 	case "cyberghost":
 		settingsList = append(settingsList,
@@ -116,32 +170,79 @@ func (p *ProviderSettings) String() string {
 			"Client certificate: [redacted]",
 			"Group: "+p.ServerSelection.Group,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
 		)
 	case "vyprvpn":
 		settingsList = append(settingsList,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
+			"Custom port: "+customPort,
 		)
 	case "nordvpn":
 		settingsList = append(settingsList,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
 			"Numbers: "+commaJoin(numbers),
+			"Categories: "+commaJoin(p.ServerSelection.Categories),
 		)
 	case "purevpn":
 		settingsList = append(settingsList,
 			"Regions: "+commaJoin(p.ServerSelection.Regions),
 			"Countries: "+commaJoin(p.ServerSelection.Countries),
 			"Cities: "+commaJoin(p.ServerSelection.Cities),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
+			"Custom port: "+customPort,
 		)
 	case "privado":
 		settingsList = append(settingsList,
+			"Countries: "+commaJoin(p.ServerSelection.Countries),
 			"Cities: "+commaJoin(p.ServerSelection.Cities),
 			"Server numbers: "+commaJoin(numbers),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
+			"Custom port: "+customPort,
+		)
+	case "hidemyass":
+		settingsList = append(settingsList,
+			"Client key: [redacted]",
+			"Client certificate: [redacted]",
+			"Certificate authority: [redacted]",
+			"Countries: "+commaJoin(p.ServerSelection.Countries),
+			"Regions: "+commaJoin(p.ServerSelection.Regions),
+			"Cities: "+commaJoin(p.ServerSelection.Cities),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
+			"Custom port: "+customPort,
+		)
+	case "vpn unlimited":
+		settingsList = append(settingsList,
+			"Client key: [redacted]",
+			"Client certificate: [redacted]",
+			"Certificate authority: [redacted]",
+			"Countries: "+commaJoin(p.ServerSelection.Countries),
+			"Cities: "+commaJoin(p.ServerSelection.Cities),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
+			"Custom port: "+customPort,
+		)
+	case "wevpn":
+		settingsList = append(settingsList,
+			"Certificate authority: [redacted]",
+			"TLS crypt key: [redacted]",
+			"Countries: "+commaJoin(p.ServerSelection.Countries),
+			"Cities: "+commaJoin(p.ServerSelection.Cities),
+			"Hostnames: "+commaJoin(p.ServerSelection.Hostnames),
+			"Custom port: "+customPort,
 		)
 	default:
 		settingsList = append(settingsList,
 			"<Missing String method, please implement me!>",
 		)
 	}
+	pickMode := p.ServerSelection.PickMode
+	if p.ServerSelection.PickSeed != 0 && pickMode == "random" {
+		pickMode += fmt.Sprintf(" with seed %d", p.ServerSelection.PickSeed)
+	}
+	if pickMode != "" {
+		settingsList = append(settingsList, "Server pick mode: "+pickMode)
+	}
 	if p.ServerSelection.TargetIP != nil {
 		settingsList = append(settingsList,
 			"Target IP address: "+string(p.ServerSelection.TargetIP),
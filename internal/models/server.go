@@ -7,11 +7,50 @@ import (
 	"strings"
 )
 
+// OpenVPNPorts contains the OpenVPN ports supported by a server for
+// each protocol. It is empty if they are unknown, in which case the
+// server is assumed to support the default ports of its provider.
+type OpenVPNPorts struct {
+	TCP []uint16 `json:"tcp,omitempty"`
+	UDP []uint16 `json:"udp,omitempty"`
+}
+
+func (p *OpenVPNPorts) String() string {
+	return fmt.Sprintf("models.OpenVPNPorts{TCP: %s, UDP: %s}",
+		goStringifyPorts(p.TCP), goStringifyPorts(p.UDP))
+}
+
+// WireguardEndpoint contains the data needed to connect to a server
+// using Wireguard. The public key is empty for providers exchanging
+// keys when connecting, which then use the common name CN to verify
+// the key exchange API server.
+type WireguardEndpoint struct {
+	PublicKey string   `json:"public_key,omitempty"`
+	CN        string   `json:"cn,omitempty"`
+	IPs       []net.IP `json:"ips"`
+	Port      uint16   `json:"port"`
+}
+
+func (w *WireguardEndpoint) String() string {
+	return fmt.Sprintf("&models.WireguardEndpoint{PublicKey: %q, CN: %q, IPs: %s, Port: %d}",
+		w.PublicKey, w.CN, goStringifyIPs(w.IPs), w.Port)
+}
+
+// goStringifyWireguard returns the Go representation of the Wireguard
+// endpoint given, or an empty string if it is nil.
+func goStringifyWireguard(w *WireguardEndpoint) string {
+	if w == nil {
+		return ""
+	}
+	return ", Wireguard: " + w.String()
+}
+
 type PIAServer struct {
-	Region      string           `json:"region"`
-	PortForward bool             `json:"port_forward"`
-	OpenvpnUDP  PIAServerOpenvpn `json:"openvpn_udp"`
-	OpenvpnTCP  PIAServerOpenvpn `json:"openvpn_tcp"`
+	Region      string             `json:"region"`
+	PortForward bool               `json:"port_forward"`
+	OpenvpnUDP  PIAServerOpenvpn   `json:"openvpn_udp"`
+	OpenvpnTCP  PIAServerOpenvpn   `json:"openvpn_tcp"`
+	Wireguard   *WireguardEndpoint `json:"wireguard,omitempty"`
 }
 
 type PIAServerOpenvpn struct {
@@ -24,22 +63,28 @@ func (p *PIAServerOpenvpn) String() string {
 }
 
 func (p *PIAServer) String() string {
-	return fmt.Sprintf("{Region: %q, PortForward: %t, OpenvpnUDP: %s, OpenvpnTCP: %s}",
-		p.Region, p.PortForward, p.OpenvpnUDP.String(), p.OpenvpnTCP.String())
+	return fmt.Sprintf("{Region: %q, PortForward: %t, OpenvpnUDP: %s, OpenvpnTCP: %s%s}",
+		p.Region, p.PortForward, p.OpenvpnUDP.String(), p.OpenvpnTCP.String(), goStringifyWireguard(p.Wireguard))
 }
 
 type MullvadServer struct {
-	IPs     []net.IP `json:"ips"`
-	IPsV6   []net.IP `json:"ipsv6"`
-	Country string   `json:"country"`
-	City    string   `json:"city"`
-	ISP     string   `json:"isp"`
-	Owned   bool     `json:"owned"`
+	IPs         []net.IP `json:"ips"`
+	IPsV6       []net.IP `json:"ipsv6"`
+	Country     string   `json:"country"`
+	CountryCode string   `json:"country_code"`
+	City        string   `json:"city"`
+	Hostname    string   `json:"hostname"`
+	ISP         string   `json:"isp"`
+	Owned       bool     `json:"owned"`
+	// Wireguard is only set for Wireguard relays, which have
+	// no OpenVPN IP addresses.
+	Wireguard *WireguardEndpoint `json:"wireguard,omitempty"`
 }
 
 func (s *MullvadServer) String() string {
-	return fmt.Sprintf("{Country: %q, City: %q, ISP: %q, Owned: %t, IPs: %s, IPsV6: %s}",
-		s.Country, s.City, s.ISP, s.Owned, goStringifyIPs(s.IPs), goStringifyIPs(s.IPsV6))
+	return fmt.Sprintf("{Country: %q, CountryCode: %q, City: %q, Hostname: %q, ISP: %q, Owned: %t, IPs: %s, IPsV6: %s%s}",
+		s.Country, s.CountryCode, s.City, s.Hostname, s.ISP, s.Owned, goStringifyIPs(s.IPs), goStringifyIPs(s.IPsV6),
+		goStringifyWireguard(s.Wireguard))
 }
 
 type WindscribeServer struct {
@@ -55,66 +100,133 @@ func (s *WindscribeServer) String() string {
 }
 
 type SurfsharkServer struct {
-	Region string   `json:"region"`
-	IPs    []net.IP `json:"ips"`
+	Region   string       `json:"region"`
+	Hostname string       `json:"hostname"`
+	IPs      []net.IP     `json:"ips"`
+	Ports    OpenVPNPorts `json:"ports"`
 }
 
 func (s *SurfsharkServer) String() string {
-	return fmt.Sprintf("{Region: %q, IPs: %s}", s.Region, goStringifyIPs(s.IPs))
+	return fmt.Sprintf("{Region: %q, Hostname: %q, IPs: %s, Ports: %s}",
+		s.Region, s.Hostname, goStringifyIPs(s.IPs), s.Ports.String())
 }
 
 type CyberghostServer struct {
-	Region string   `json:"region"`
-	Group  string   `json:"group"`
-	IPs    []net.IP `json:"ips"`
+	Region   string       `json:"region"`
+	Group    string       `json:"group"`
+	Hostname string       `json:"hostname"`
+	IPs      []net.IP     `json:"ips"`
+	Ports    OpenVPNPorts `json:"ports"`
 }
 
 func (s *CyberghostServer) String() string {
-	return fmt.Sprintf("{Region: %q, Group: %q, IPs: %s}", s.Region, s.Group, goStringifyIPs(s.IPs))
+	return fmt.Sprintf("{Region: %q, Group: %q, Hostname: %q, IPs: %s, Ports: %s}",
+		s.Region, s.Group, s.Hostname, goStringifyIPs(s.IPs), s.Ports.String())
 }
 
 type VyprvpnServer struct {
-	Region string   `json:"region"`
-	IPs    []net.IP `json:"ips"`
+	Region   string       `json:"region"`
+	Hostname string       `json:"hostname"`
+	IPs      []net.IP     `json:"ips"`
+	Ports    OpenVPNPorts `json:"ports"`
 }
 
 func (s *VyprvpnServer) String() string {
-	return fmt.Sprintf("{Region: %q, IPs: %s}", s.Region, goStringifyIPs(s.IPs))
+	return fmt.Sprintf("{Region: %q, Hostname: %q, IPs: %s, Ports: %s}",
+		s.Region, s.Hostname, goStringifyIPs(s.IPs), s.Ports.String())
 }
 
 type NordvpnServer struct { //nolint:maligned
-	Region string `json:"region"`
-	Number uint16 `json:"number"`
-	IP     net.IP `json:"ip"`
-	TCP    bool   `json:"tcp"`
-	UDP    bool   `json:"udp"`
+	Region    string             `json:"region"`
+	Number    uint16             `json:"number"`
+	Hostname  string             `json:"hostname"`
+	IP        net.IP             `json:"ip"`
+	TCP       bool               `json:"tcp"`
+	UDP       bool               `json:"udp"`
+	Wireguard *WireguardEndpoint `json:"wireguard,omitempty"`
+	// Categories are the NordVPN server groups, such as p2p.
+	Categories []string `json:"categories,omitempty"`
 }
 
 func (s *NordvpnServer) String() string {
-	return fmt.Sprintf("{Region: %q, Number: %d, TCP: %t, UDP: %t, IP: %s}",
-		s.Region, s.Number, s.TCP, s.UDP, goStringifyIP(s.IP))
+	categories := ""
+	if len(s.Categories) > 0 {
+		categories = ", Categories: " + goStringifyStrings(s.Categories)
+	}
+	return fmt.Sprintf("{Region: %q, Number: %d, Hostname: %q, TCP: %t, UDP: %t, IP: %s%s%s}",
+		s.Region, s.Number, s.Hostname, s.TCP, s.UDP, goStringifyIP(s.IP), goStringifyWireguard(s.Wireguard),
+		categories)
 }
 
 type PurevpnServer struct {
-	Region  string   `json:"region"`
-	Country string   `json:"country"`
-	City    string   `json:"city"`
-	IPs     []net.IP `json:"ips"`
+	Region      string       `json:"region"`
+	Country     string       `json:"country"`
+	CountryCode string       `json:"country_code"`
+	City        string       `json:"city"`
+	Hostname    string       `json:"hostname"`
+	IPs         []net.IP     `json:"ips"`
+	Ports       OpenVPNPorts `json:"ports"`
 }
 
 func (s *PurevpnServer) String() string {
-	return fmt.Sprintf("{Region: %q, Country: %q, City: %q, IPs: %s}",
-		s.Region, s.Country, s.City, goStringifyIPs(s.IPs))
+	return fmt.Sprintf("{Region: %q, Country: %q, CountryCode: %q, City: %q, Hostname: %q, IPs: %s, Ports: %s}",
+		s.Region, s.Country, s.CountryCode, s.City, s.Hostname, goStringifyIPs(s.IPs), s.Ports.String())
 }
 
 type PrivadoServer struct {
-	IP       net.IP `json:"ip"`
-	Hostname string `json:"hostname"`
+	Country     string       `json:"country"`
+	CountryCode string       `json:"country_code"`
+	City        string       `json:"city"`
+	IP          net.IP       `json:"ip"`
+	Hostname    string       `json:"hostname"`
+	Ports       OpenVPNPorts `json:"ports"`
 }
 
 func (s *PrivadoServer) String() string {
-	return fmt.Sprintf("{Hostname: %q, IP: %s}",
-		s.Hostname, goStringifyIP(s.IP))
+	return fmt.Sprintf("{Country: %q, CountryCode: %q, City: %q, Hostname: %q, IP: %s, Ports: %s}",
+		s.Country, s.CountryCode, s.City, s.Hostname, goStringifyIP(s.IP), s.Ports.String())
+}
+
+type HideMyAssServer struct {
+	Country     string       `json:"country"`
+	CountryCode string       `json:"country_code"`
+	Region      string       `json:"region"`
+	City        string       `json:"city"`
+	Hostname    string       `json:"hostname"`
+	IPs         []net.IP     `json:"ips"`
+	Ports       OpenVPNPorts `json:"ports"`
+}
+
+func (s *HideMyAssServer) String() string {
+	return fmt.Sprintf("{Country: %q, CountryCode: %q, Region: %q, City: %q, Hostname: %q, IPs: %s, Ports: %s}",
+		s.Country, s.CountryCode, s.Region, s.City, s.Hostname, goStringifyIPs(s.IPs), s.Ports.String())
+}
+
+type VPNUnlimitedServer struct {
+	Country     string   `json:"country"`
+	CountryCode string   `json:"country_code"`
+	City        string   `json:"city"`
+	Hostname    string   `json:"hostname"`
+	IPs         []net.IP `json:"ips"`
+}
+
+func (s *VPNUnlimitedServer) String() string {
+	return fmt.Sprintf("{Country: %q, CountryCode: %q, City: %q, Hostname: %q, IPs: %s}",
+		s.Country, s.CountryCode, s.City, s.Hostname, goStringifyIPs(s.IPs))
+}
+
+type WeVPNServer struct {
+	Country     string   `json:"country"`
+	CountryCode string   `json:"country_code"`
+	City        string   `json:"city"`
+	Hostname    string   `json:"hostname"`
+	PortForward bool     `json:"port_forward"`
+	IPs         []net.IP `json:"ips"`
+}
+
+func (s *WeVPNServer) String() string {
+	return fmt.Sprintf("{Country: %q, CountryCode: %q, City: %q, Hostname: %q, PortForward: %t, IPs: %s}",
+		s.Country, s.CountryCode, s.City, s.Hostname, s.PortForward, goStringifyIPs(s.IPs))
 }
 
 func goStringifyIP(ip net.IP) string {
@@ -162,3 +274,22 @@ func goStringifyIPs(ips []net.IP) string {
 	}
 	return "[]net.IP{" + strings.Join(ipStrings, ", ") + "}"
 }
+
+func goStringifyStrings(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+func goStringifyPorts(ports []uint16) string {
+	if ports == nil {
+		return "nil"
+	}
+	portStrings := make([]string, len(ports))
+	for i, port := range ports {
+		portStrings[i] = fmt.Sprintf("%d", port)
+	}
+	return "[]uint16{" + strings.Join(portStrings, ", ") + "}"
+}
@@ -15,15 +15,30 @@ func Test_MullvadServer_String(t *testing.T) {
 	}{
 		"example": {
 			server: MullvadServer{
-				IPs:     []net.IP{{1, 1, 1, 1}},
-				IPsV6:   []net.IP{{0x20, 0x1, 0xd, 0xb8, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2, 0x0, 0x1}},
-				Country: "That Country",
-				City:    "That City",
-				ISP:     "not spying on you",
-				Owned:   true,
+				IPs:         []net.IP{{1, 1, 1, 1}},
+				IPsV6:       []net.IP{{0x20, 0x1, 0xd, 0xb8, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2, 0x0, 0x1}},
+				Country:     "That Country",
+				CountryCode: "tc",
+				City:        "That City",
+				Hostname:    "tc-cit-001",
+				ISP:         "not spying on you",
+				Owned:       true,
 			},
 			//nolint:lll
-			s: `{Country: "That Country", City: "That City", ISP: "not spying on you", Owned: true, IPs: []net.IP{{1, 1, 1, 1}}, IPsV6: []net.IP{{0x20, 0x1, 0xd, 0xb8, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2, 0x0, 0x1}}}`,
+			s: `{Country: "That Country", CountryCode: "tc", City: "That City", Hostname: "tc-cit-001", ISP: "not spying on you", Owned: true, IPs: []net.IP{{1, 1, 1, 1}}, IPsV6: []net.IP{{0x20, 0x1, 0xd, 0xb8, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x2, 0x0, 0x1}}}`,
+		},
+		"wireguard relay": {
+			server: MullvadServer{
+				Country:  "That Country",
+				Hostname: "tc-cit-wg-001",
+				Wireguard: &WireguardEndpoint{
+					PublicKey: "key",
+					IPs:       []net.IP{{1, 1, 1, 1}},
+					Port:      51820,
+				},
+			},
+			//nolint:lll
+			s: `{Country: "That Country", CountryCode: "", City: "", Hostname: "tc-cit-wg-001", ISP: "", Owned: false, IPs: []net.IP{}, IPsV6: []net.IP{}, Wireguard: &models.WireguardEndpoint{PublicKey: "key", CN: "", IPs: []net.IP{{1, 1, 1, 1}}, Port: 51820}}`,
 		},
 	}
 	for name, testCase := range testCases {
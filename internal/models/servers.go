@@ -1,16 +1,19 @@
 package models
 
 type AllServers struct {
-	Version    uint16            `json:"version"`
-	Cyberghost CyberghostServers `json:"cyberghost"`
-	Mullvad    MullvadServers    `json:"mullvad"`
-	Nordvpn    NordvpnServers    `json:"nordvpn"`
-	Pia        PiaServers        `json:"pia"`
-	Privado    PrivadoServers    `json:"privado"`
-	Purevpn    PurevpnServers    `json:"purevpn"`
-	Surfshark  SurfsharkServers  `json:"surfshark"`
-	Vyprvpn    VyprvpnServers    `json:"vyprvpn"`
-	Windscribe WindscribeServers `json:"windscribe"`
+	Version      uint16              `json:"version"`
+	Cyberghost   CyberghostServers   `json:"cyberghost"`
+	HideMyAss    HideMyAssServers    `json:"hidemyass"`
+	Mullvad      MullvadServers      `json:"mullvad"`
+	Nordvpn      NordvpnServers      `json:"nordvpn"`
+	Pia          PiaServers          `json:"pia"`
+	Privado      PrivadoServers      `json:"privado"`
+	Purevpn      PurevpnServers      `json:"purevpn"`
+	Surfshark    SurfsharkServers    `json:"surfshark"`
+	VPNUnlimited VPNUnlimitedServers `json:"vpnunlimited"`
+	Vyprvpn      VyprvpnServers      `json:"vyprvpn"`
+	WeVPN        WeVPNServers        `json:"wevpn"`
+	Windscribe   WindscribeServers   `json:"windscribe"`
 }
 
 type CyberghostServers struct {
@@ -18,6 +21,11 @@ type CyberghostServers struct {
 	Timestamp int64              `json:"timestamp"`
 	Servers   []CyberghostServer `json:"servers"`
 }
+type HideMyAssServers struct {
+	Version   uint16            `json:"version"`
+	Timestamp int64             `json:"timestamp"`
+	Servers   []HideMyAssServer `json:"servers"`
+}
 type MullvadServers struct {
 	Version   uint16          `json:"version"`
 	Timestamp int64           `json:"timestamp"`
@@ -48,13 +56,32 @@ type SurfsharkServers struct {
 	Timestamp int64             `json:"timestamp"`
 	Servers   []SurfsharkServer `json:"servers"`
 }
+type VPNUnlimitedServers struct {
+	Version   uint16               `json:"version"`
+	Timestamp int64                `json:"timestamp"`
+	Servers   []VPNUnlimitedServer `json:"servers"`
+}
 type VyprvpnServers struct {
 	Version   uint16          `json:"version"`
 	Timestamp int64           `json:"timestamp"`
 	Servers   []VyprvpnServer `json:"servers"`
 }
+type WeVPNServers struct {
+	Version   uint16        `json:"version"`
+	Timestamp int64         `json:"timestamp"`
+	Servers   []WeVPNServer `json:"servers"`
+}
 type WindscribeServers struct {
 	Version   uint16             `json:"version"`
 	Timestamp int64              `json:"timestamp"`
 	Servers   []WindscribeServer `json:"servers"`
 }
+
+// ServerLocations contains the values which can be
+// used to filter the servers of a provider.
+type ServerLocations struct {
+	Countries []string `json:"countries,omitempty"`
+	Regions   []string `json:"regions,omitempty"`
+	Cities    []string `json:"cities,omitempty"`
+	Hostnames []string `json:"hostnames,omitempty"`
+}
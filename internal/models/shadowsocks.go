@@ -0,0 +1,10 @@
+package models
+
+// ShadowSocksListener contains settings for an additional Shadowsocks listener,
+// for example to give each device its own port and password.
+type ShadowSocksListener struct {
+	Name     string
+	Port     uint16
+	Method   string
+	Password string
+}
@@ -0,0 +1,31 @@
+package models
+
+import "net"
+
+// WireguardConnection contains the data to connect to a server using Wireguard.
+type WireguardConnection struct {
+	IP        net.IP `json:"ip"`
+	Port      uint16 `json:"port"`
+	PublicKey string `json:"publicKey"`
+	// Hostname is the server hostname, also used to verify
+	// the server for providers exchanging keys when connecting.
+	Hostname string `json:"hostname,omitempty"`
+	// Informative fields about the server, empty if unknown
+	Country string `json:"country,omitempty"`
+	Region  string `json:"region,omitempty"`
+	City    string `json:"city,omitempty"`
+}
+
+// Endpoint returns the endpoint of the connection as an OpenVPN connection
+// using UDP, for the firewall and the connection status.
+func (w *WireguardConnection) Endpoint() (connection OpenVPNConnection) {
+	return OpenVPNConnection{
+		IP:       w.IP,
+		Port:     w.Port,
+		Protocol: "udp",
+		Hostname: w.Hostname,
+		Country:  w.Country,
+		Region:   w.Region,
+		City:     w.City,
+	}
+}
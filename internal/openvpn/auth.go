@@ -9,11 +9,11 @@ import (
 
 // WriteAuthFile writes the OpenVPN auth file to disk with the right permissions.
 func (c *configurator) WriteAuthFile(user, password string, uid, gid int) error {
-	exists, err := c.fileManager.FileExists(string(constants.OpenVPNAuthConf))
+	exists, err := c.fileManager.FileExists(c.files.auth)
 	if err != nil {
 		return err
 	} else if exists {
-		data, err := c.fileManager.ReadFile(string(constants.OpenVPNAuthConf))
+		data, err := c.fileManager.ReadFile(c.files.auth)
 		if err != nil {
 			return err
 		}
@@ -21,10 +21,10 @@ func (c *configurator) WriteAuthFile(user, password string, uid, gid int) error
 		if len(lines) > 1 && lines[0] == user && lines[1] == password {
 			return nil
 		}
-		c.logger.Info("username and password changed", constants.OpenVPNAuthConf)
+		c.logger.Info("username and password changed", c.files.auth)
 	}
 	return c.fileManager.WriteLinesToFile(
-		string(constants.OpenVPNAuthConf),
+		c.files.auth,
 		[]string{user, password},
 		files.Ownership(uid, gid),
 		files.Permissions(constants.UserReadPermission))
@@ -0,0 +1,42 @@
+package openvpn
+
+import (
+	"time"
+)
+
+// backoff computes exponentially increasing wait durations between
+// OpenVPN restarts, with a random jitter to avoid restarting in lockstep.
+type backoff struct {
+	initial  time.Duration
+	max      time.Duration
+	failures int
+	// randInt63n returns a random number in [0, n)
+	randInt63n func(n int64) int64
+}
+
+func newBackoff(initial, max time.Duration, randInt63n func(n int64) int64) *backoff {
+	return &backoff{
+		initial:    initial,
+		max:        max,
+		randInt63n: randInt63n,
+	}
+}
+
+// next returns the duration to wait before the next attempt, between half
+// and the whole of the exponential wait duration capped to the maximum.
+func (b *backoff) next() (wait time.Duration) {
+	wait = b.initial
+	for i := 0; i < b.failures && wait < b.max; i++ {
+		wait *= 2
+	}
+	if wait > b.max {
+		wait = b.max
+	}
+	b.failures++
+	half := wait / 2 //nolint:gomnd
+	return half + time.Duration(b.randInt63n(int64(wait-half)+1))
+}
+
+func (b *backoff) reset() {
+	b.failures = 0
+}
@@ -0,0 +1,30 @@
+package openvpn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_backoff(t *testing.T) {
+	t.Parallel()
+	maxRand := func(n int64) int64 { return n - 1 }
+	minRand := func(n int64) int64 { return 0 }
+
+	b := newBackoff(time.Second, 10*time.Second, maxRand)
+	expected := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second,
+		8 * time.Second, 10 * time.Second, 10 * time.Second,
+	}
+	for _, expectedWait := range expected {
+		assert.Equal(t, expectedWait, b.next())
+	}
+
+	b.reset()
+	assert.Equal(t, time.Second, b.next())
+
+	b = newBackoff(time.Second, 10*time.Second, minRand)
+	assert.Equal(t, 500*time.Millisecond, b.next())
+	assert.Equal(t, time.Second, b.next())
+}
@@ -2,22 +2,21 @@ package openvpn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os/exec"
 	"strings"
-
-	"github.com/qdm12/gluetun/internal/constants"
 )
 
-func (c *configurator) Start(ctx context.Context) (stdout io.ReadCloser, waitFn func() error, err error) {
+func (c *configurator) Start(ctx context.Context) (stdout, stderr io.ReadCloser, waitFn func() error, err error) {
 	c.logger.Info("starting openvpn")
-	stdout, _, waitFn, err = c.commander.Start(ctx, "openvpn", "--config", string(constants.OpenVPNConf))
-	return stdout, waitFn, err
+	return c.commander.Start(ctx, "openvpn", "--config", c.files.conf)
 }
 
 func (c *configurator) Version(ctx context.Context) (string, error) {
 	output, err := c.commander.Run(ctx, "openvpn", "--version")
-	if err != nil && err.Error() != "exit status 1" {
+	if err != nil && !isExitCode1(err) {
 		return "", err
 	}
 	firstLine := strings.Split(output, "\n")[0]
@@ -28,3 +27,20 @@ func (c *configurator) Version(ctx context.Context) (string, error) {
 	}
 	return words[1], nil
 }
+
+// SupportsScramble returns true if the OpenVPN binary is built with the
+// XOR scramble patch, which documents the scramble option in its usage.
+func (c *configurator) SupportsScramble(ctx context.Context) (supported bool, err error) {
+	output, err := c.commander.Run(ctx, "openvpn", "--help")
+	if err != nil && !isExitCode1(err) {
+		return false, err
+	}
+	return strings.Contains(output, "--scramble"), nil
+}
+
+// isExitCode1 returns true if the error is from a command exiting with
+// the code 1, as openvpn does after printing its version or usage.
+func isExitCode1(err error) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() == 1
+}
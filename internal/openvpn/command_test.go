@@ -0,0 +1,66 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/command/mock_command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_configurator_SupportsScramble(t *testing.T) {
+	t.Parallel()
+	exitCode := func(code int) error {
+		return exec.Command("sh", "-c", fmt.Sprintf("exit %d", code)).Run()
+	}
+	testCases := map[string]struct {
+		output    string
+		runErr    error
+		supported bool
+		err       string
+	}{
+		"patched usage": {
+			output:    "--scramble xormask",
+			runErr:    exitCode(1),
+			supported: true,
+		},
+		"unpatched usage": {
+			output: "--config file",
+			runErr: exitCode(1),
+		},
+		"other exit code": {
+			runErr: exitCode(2),
+			err:    "exit status 2",
+		},
+		"command error": {
+			runErr: fmt.Errorf("exit status 1"),
+			err:    "exit status 1",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			ctx := context.Background()
+			commander := mock_command.NewMockCommander(mockCtrl)
+			commander.EXPECT().Run(ctx, "openvpn", "--help").Return(testCase.output, testCase.runErr)
+			c := &configurator{commander: commander}
+
+			supported, err := c.SupportsScramble(ctx)
+
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.supported, supported)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package openvpn
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/provider"
+)
+
+// failedEndpoints remembers the endpoints which recently failed to connect,
+// so they are avoided during the cooldown window when picking a server.
+type failedEndpoints struct {
+	cooldown time.Duration
+	failedAt map[string]time.Time
+	timeNow  func() time.Time
+}
+
+func newFailedEndpoints(timeNow func() time.Time) *failedEndpoints {
+	return &failedEndpoints{
+		failedAt: make(map[string]time.Time),
+		timeNow:  timeNow,
+	}
+}
+
+func endpointKey(connection models.OpenVPNConnection) string {
+	return fmt.Sprintf("%s:%d/%s", connection.IP, connection.Port, connection.Protocol)
+}
+
+func (f *failedEndpoints) add(connection models.OpenVPNConnection) {
+	if f.cooldown == 0 {
+		return
+	}
+	now := f.timeNow()
+	for key, failedAt := range f.failedAt {
+		if now.Sub(failedAt) >= f.cooldown {
+			delete(f.failedAt, key)
+		}
+	}
+	f.failedAt[endpointKey(connection)] = now
+}
+
+func (f *failedEndpoints) remove(connection models.OpenVPNConnection) {
+	delete(f.failedAt, endpointKey(connection))
+}
+
+// coolingDown returns true if the connection failed within the cooldown window.
+func (f *failedEndpoints) coolingDown(connection models.OpenVPNConnection) bool {
+	failedAt, ok := f.failedAt[endpointKey(connection)]
+	return ok && f.timeNow().Sub(failedAt) < f.cooldown
+}
+
+// avoidingPicker picks among the connections not cooling down using
+// its picker, falling back on all the connections if they all are.
+type avoidingPicker struct {
+	picker provider.Picker
+	failed *failedEndpoints
+}
+
+func (p *avoidingPicker) Pick(connections []models.OpenVPNConnection) (connection models.OpenVPNConnection) {
+	remaining := make([]models.OpenVPNConnection, 0, len(connections))
+	for _, connection := range connections {
+		if !p.failed.coolingDown(connection) {
+			remaining = append(remaining, connection)
+		}
+	}
+	if len(remaining) == 0 {
+		remaining = connections
+	}
+	return p.picker.Pick(remaining)
+}
@@ -0,0 +1,56 @@
+package openvpn
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_avoidingPicker(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1000, 0)
+	timeNow := func() time.Time { return now }
+	connections := []models.OpenVPNConnection{
+		{IP: net.IP{1, 1, 1, 1}, Port: 1194, Protocol: constants.UDP},
+		{IP: net.IP{2, 2, 2, 2}, Port: 1194, Protocol: constants.UDP},
+		{IP: net.IP{3, 3, 3, 3}, Port: 1194, Protocol: constants.UDP},
+	}
+	failed := newFailedEndpoints(timeNow)
+	failed.cooldown = time.Minute
+	picker := &avoidingPicker{
+		picker: provider.NewPicker(constants.ServerPickFirst, 0, timeNow),
+		failed: failed,
+	}
+
+	assert.Equal(t, connections[0], picker.Pick(connections))
+
+	failed.add(connections[0])
+	assert.Equal(t, connections[1], picker.Pick(connections))
+
+	failed.add(connections[1])
+	assert.Equal(t, connections[2], picker.Pick(connections))
+
+	failed.add(connections[2])
+	assert.Equal(t, connections[0], picker.Pick(connections), "all cooling down")
+
+	failed.remove(connections[0])
+	assert.Equal(t, connections[0], picker.Pick(connections))
+
+	now = now.Add(time.Minute)
+	failed.add(connections[0])
+	assert.Equal(t, connections[1], picker.Pick(connections), "cooldown expired")
+	assert.Len(t, failed.failedAt, 1)
+}
+
+func Test_failedEndpoints_noCooldown(t *testing.T) {
+	t.Parallel()
+	failed := newFailedEndpoints(time.Now)
+	connection := models.OpenVPNConnection{IP: net.IP{1, 1, 1, 1}}
+	failed.add(connection)
+	assert.False(t, failed.coolingDown(connection))
+}
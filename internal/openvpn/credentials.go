@@ -0,0 +1,56 @@
+package openvpn
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// ReadCredentials reads the user and password from the first two lines
+// of the file given.
+func (c *configurator) ReadCredentials(filepath string) (user, password string, err error) {
+	data, err := c.fileManager.ReadFile(filepath)
+	if err != nil {
+		return "", "", err
+	}
+	user, password, err = parseCredentials(string(data))
+	if err != nil {
+		return "", "", fmt.Errorf("credentials file %s: %w", filepath, err)
+	}
+	return user, password, nil
+}
+
+func parseCredentials(content string) (user, password string, err error) {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	user = strings.TrimSpace(lines[0])
+	if user == "" {
+		return "", "", fmt.Errorf("user is empty")
+	}
+	if len(lines) > 1 {
+		password = strings.TrimSpace(lines[1])
+	}
+	if password == "" {
+		return "", "", fmt.Errorf("password is empty")
+	}
+	return user, password, nil
+}
+
+// SoftRestart signals the running OpenVPN process to reconnect, re-reading
+// its auth file, without tearing down the tunnel if persist-tun is set.
+func (c *configurator) SoftRestart() error {
+	data, err := c.fileManager.ReadFile(c.files.pid)
+	if err != nil {
+		return err
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("invalid OpenVPN PID in %s: %w", c.files.pid, err)
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return process.Signal(syscall.SIGUSR1)
+}
@@ -0,0 +1,79 @@
+package openvpn
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/settings"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseCredentials(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		content  string
+		user     string
+		password string
+		err      string
+	}{
+		"valid": {
+			content:  "user\ntoken\n",
+			user:     "user",
+			password: "token",
+		},
+		"windows line endings": {
+			content:  "user\r\ntoken\r\n",
+			user:     "user",
+			password: "token",
+		},
+		"empty": {
+			err: "user is empty",
+		},
+		"missing password": {
+			content: "user\n",
+			err:     "password is empty",
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			user, password, err := parseCredentials(tc.content)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.user, user)
+			assert.Equal(t, tc.password, password)
+		})
+	}
+}
+
+func Test_looper_credentials(t *testing.T) {
+	t.Parallel()
+	var secrets []string
+	l := &looper{
+		conf:       &credentialsConfigurator{user: "user", password: "token"},
+		addSecrets: func(s ...string) { secrets = append(secrets, s...) },
+	}
+	settings := settings.OpenVPN{CredentialsFilepath: "/gluetun/credentials"}
+
+	user, password := l.credentials(settings)
+
+	assert.Equal(t, "user", user)
+	assert.Equal(t, "token", password)
+	assert.Equal(t, []string{"user", "token"}, secrets)
+}
+
+// credentialsConfigurator implements the Configurator methods used by the test.
+type credentialsConfigurator struct {
+	Configurator
+	user, password string
+}
+
+func (c *credentialsConfigurator) ReadCredentials(filepath string) (user, password string, err error) {
+	return c.user, c.password, nil
+}
@@ -0,0 +1,118 @@
+package openvpn
+
+import (
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// EventKind is the kind of a connection event parsed from the OpenVPN output.
+type EventKind string
+
+const (
+	EventConnected     EventKind = "connected"
+	EventRestarting    EventKind = "restarting"
+	EventTLSError      EventKind = "tls error"
+	EventAuthFailed    EventKind = "authentication failed"
+	EventPushedOptions EventKind = "pushed options"
+)
+
+// Event is a connection event parsed from an OpenVPN output line.
+type Event struct {
+	Kind    EventKind
+	Message string
+	// Options are the options pushed by the server, only for EventPushedOptions.
+	Options []string
+}
+
+// ParseLine parses an OpenVPN output line, prefixed with `openvpn: `, into
+// an event. ok is false if the line is not a known connection event.
+func ParseLine(line string) (event Event, ok bool) {
+	return ParseTunnelLine(line, "openvpn")
+}
+
+// ParseTunnelLine parses an OpenVPN output line prefixed with the stream
+// name given, such as `openvpn tun1: ` for an extra tunnel, into an event.
+// ok is false if the line is not a known connection event.
+func ParseTunnelLine(line, name string) (event Event, ok bool) {
+	prefix := name + ": "
+	if !strings.HasPrefix(line, prefix) {
+		return event, false
+	}
+	message := strings.TrimPrefix(line, prefix)
+	event.Message = message
+	switch {
+	case message == "Initialization Sequence Completed":
+		event.Kind = EventConnected
+	case strings.HasSuffix(message, "received, process restarting"):
+		event.Kind = EventRestarting
+	case strings.HasPrefix(message, "TLS Error: "):
+		event.Kind = EventTLSError
+	case strings.Contains(message, "AUTH_FAILED"):
+		event.Kind = EventAuthFailed
+	case strings.HasPrefix(message, "PUSH: Received control message: 'PUSH_REPLY,"):
+		event.Kind = EventPushedOptions
+		options := strings.TrimPrefix(message, "PUSH: Received control message: 'PUSH_REPLY,")
+		options = strings.TrimSuffix(options, "'")
+		event.Options = strings.Split(options, ",")
+	default:
+		return event, false
+	}
+	return event, true
+}
+
+// ConnectionState is the state of the OpenVPN connection.
+type ConnectionState string
+
+const (
+	StateDisconnected ConnectionState = "disconnected"
+	StateConnecting   ConnectionState = "connecting"
+	StateConnected    ConnectionState = "connected"
+	StateReconnecting ConnectionState = "reconnecting"
+	StateStopped      ConnectionState = "stopped"
+)
+
+// ConnectionStatus is the OpenVPN connection status built from
+// the OpenVPN connection events.
+type ConnectionStatus struct {
+	State         ConnectionState `json:"state"`
+	Since         time.Time       `json:"since"`
+	LastEvent     string          `json:"lastEvent"`
+	Restarts      int             `json:"restarts"`
+	TLSErrors     int             `json:"tlsErrors"`
+	AuthFailures  int             `json:"authFailures"`
+	PushedOptions []string        `json:"pushedOptions"`
+	// Provider and Server are the VPN provider and the server
+	// used by the current or last connection.
+	Provider models.VPNProvider        `json:"provider"`
+	Server   *models.OpenVPNConnection `json:"server"`
+	// Profile is the name of the profile last activated, if any.
+	Profile string `json:"profile,omitempty"`
+}
+
+// update updates the connection status with the event given.
+func (c *ConnectionStatus) update(event Event, now time.Time) {
+	c.LastEvent = event.Message
+	switch event.Kind {
+	case EventConnected:
+		c.setState(StateConnected, now)
+	case EventRestarting:
+		c.Restarts++
+		c.setState(StateReconnecting, now)
+	case EventTLSError:
+		c.TLSErrors++
+	case EventAuthFailed:
+		c.AuthFailures++
+	case EventPushedOptions:
+		c.PushedOptions = event.Options
+	}
+}
+
+func (c *ConnectionStatus) setState(state ConnectionState, now time.Time) {
+	if c.State == state {
+		return
+	}
+	c.State = state
+	c.Since = now
+}
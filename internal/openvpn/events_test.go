@@ -0,0 +1,116 @@
+package openvpn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseLine(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		line  string
+		event Event
+		ok    bool
+	}{
+		"empty line": {},
+		"not openvpn": {
+			line: "unbound: Initialization Sequence Completed",
+		},
+		"unknown openvpn line": {
+			line: "openvpn: TUN/TAP device tun0 opened",
+		},
+		"extra tunnel line": {
+			line: "openvpn tun1: Initialization Sequence Completed",
+		},
+		"connected": {
+			line:  "openvpn: Initialization Sequence Completed",
+			event: Event{Kind: EventConnected, Message: "Initialization Sequence Completed"},
+			ok:    true,
+		},
+		"restarting": {
+			line: "openvpn: SIGUSR1[soft,ping-restart] received, process restarting",
+			event: Event{
+				Kind:    EventRestarting,
+				Message: "SIGUSR1[soft,ping-restart] received, process restarting",
+			},
+			ok: true,
+		},
+		"TLS error": {
+			line: "openvpn: TLS Error: TLS handshake failed",
+			event: Event{
+				Kind:    EventTLSError,
+				Message: "TLS Error: TLS handshake failed",
+			},
+			ok: true,
+		},
+		"auth failed": {
+			line: "openvpn: AUTH: Received control message: AUTH_FAILED",
+			event: Event{
+				Kind:    EventAuthFailed,
+				Message: "AUTH: Received control message: AUTH_FAILED",
+			},
+			ok: true,
+		},
+		"pushed options": {
+			line: "openvpn: PUSH: Received control message: 'PUSH_REPLY,redirect-gateway def1,ping 10,cipher AES-256-GCM'",
+			event: Event{
+				Kind:    EventPushedOptions,
+				Message: "PUSH: Received control message: 'PUSH_REPLY,redirect-gateway def1,ping 10,cipher AES-256-GCM'",
+				Options: []string{"redirect-gateway def1", "ping 10", "cipher AES-256-GCM"},
+			},
+			ok: true,
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			event, ok := ParseLine(tc.line)
+			assert.Equal(t, tc.ok, ok)
+			if tc.ok {
+				assert.Equal(t, tc.event, event)
+			}
+		})
+	}
+}
+
+func Test_ParseTunnelLine(t *testing.T) {
+	t.Parallel()
+
+	event, ok := ParseTunnelLine("openvpn tun1: Initialization Sequence Completed", "openvpn tun1")
+	assert.True(t, ok)
+	assert.Equal(t, Event{Kind: EventConnected, Message: "Initialization Sequence Completed"}, event)
+
+	_, ok = ParseTunnelLine("openvpn: Initialization Sequence Completed", "openvpn tun1")
+	assert.False(t, ok)
+}
+
+func Test_ConnectionStatus_update(t *testing.T) {
+	t.Parallel()
+	t0 := time.Unix(0, 0)
+	t1 := time.Unix(1, 0)
+	t2 := time.Unix(2, 0)
+
+	status := ConnectionStatus{State: StateConnecting, Since: t0}
+	status.update(Event{Kind: EventPushedOptions, Message: "push", Options: []string{"ping 10"}}, t1)
+	status.update(Event{Kind: EventConnected, Message: "connected"}, t1)
+	status.update(Event{Kind: EventConnected, Message: "connected"}, t2)
+	status.update(Event{Kind: EventTLSError, Message: "tls"}, t2)
+	status.update(Event{Kind: EventAuthFailed, Message: "auth"}, t2)
+
+	assert.Equal(t, ConnectionStatus{
+		State:         StateConnected,
+		Since:         t1,
+		LastEvent:     "auth",
+		TLSErrors:     1,
+		AuthFailures:  1,
+		PushedOptions: []string{"ping 10"},
+	}, status)
+
+	status.update(Event{Kind: EventRestarting, Message: "restarting"}, t2)
+	assert.Equal(t, StateReconnecting, status.State)
+	assert.Equal(t, t2, status.Since)
+	assert.Equal(t, 1, status.Restarts)
+}
@@ -2,17 +2,24 @@ package openvpn
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math/rand"
 	"net"
 	"net/http"
+	"reflect"
 	"sync"
 	"time"
 
 	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/loopstate"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/provider"
+	"github.com/qdm12/gluetun/internal/relay"
 	"github.com/qdm12/gluetun/internal/routing"
 	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/gluetun/internal/tracing"
 	"github.com/qdm12/golibs/command"
 	"github.com/qdm12/golibs/files"
 	"github.com/qdm12/golibs/logging"
@@ -21,68 +28,248 @@ import (
 type Looper interface {
 	Run(ctx context.Context, wg *sync.WaitGroup)
 	Restart()
+	Start()
+	Stop()
+	// ProcessEvent updates the connection status with an event
+	// parsed from the OpenVPN output.
+	ProcessEvent(event Event)
+	GetConnectionStatus() (status ConnectionStatus)
 	PortForward(vpnGatewayIP net.IP)
+	// SetPortForwarding enables or disables port forwarding, and starts
+	// or stops it right away if the tunnel is up.
+	SetPortForwarding(enabled bool)
 	GetSettings() (settings settings.OpenVPN)
 	SetSettings(settings settings.OpenVPN)
 	GetPortForwarded() (portForwarded uint16)
 	SetAllServers(allServers models.AllServers)
+	GetAllServers() (allServers models.AllServers)
+	// GetProfiles returns the sorted names of the profiles and
+	// the name of the active profile, if any.
+	GetProfiles() (names []string, active string)
+	// ActivateProfile applies the profile to the settings and
+	// restarts OpenVPN to reconnect using it.
+	ActivateProfile(name string) (err error)
+	// SelectProfile applies the profile to the settings without
+	// restarting OpenVPN, and should be used before OpenVPN is started.
+	SelectProfile(name string) (err error)
 }
 
 type looper struct {
 	// Variable parameters
-	provider           models.VPNProvider
 	settings           settings.OpenVPN
+	activeProfile      string
 	settingsMutex      sync.RWMutex
 	portForwarded      uint16
 	portForwardedMutex sync.RWMutex
 	allServers         models.AllServers
 	allServersMutex    sync.RWMutex
+	status             ConnectionStatus
+	statusMutex        sync.RWMutex
+	// connectSpan is ended once the tunnel is up, using the statusMutex
+	connectSpan tracing.Span
 	// Fixed parameters
-	uid int
-	gid int
+	uid      int
+	gid      int
+	profiles map[string]Profile
+	// tunnel is the extra tunnel run by the looper, and is
+	// the zero value for the main tunnel.
+	tunnel Tunnel
+	files  tunnelFiles
+	// saveProfile persists the profile activated if it is not nil
+	saveProfile func(name string) error
+	// addSecrets registers the credentials read from the credentials file
+	// so they are masked in the logs.
+	addSecrets func(secrets ...string)
 	// Configurators
 	conf    Configurator
 	fw      firewall.Configurator
 	routing routing.Routing
 	// Other objects
 	logger, pfLogger logging.Logger
+	state            loopstate.Reporter
 	client           *http.Client
 	fileManager      files.FileManager
 	streamMerger     command.StreamMerger
+	tracer           tracing.Tracer
 	cancel           context.CancelFunc
 	// Internal channels
 	restart            chan struct{}
+	start              chan struct{}
+	stop               chan struct{}
+	authFailed         chan struct{}
 	portForwardSignals chan net.IP
+	portForwardToggle  chan struct{}
 }
 
-func NewLooper(provider models.VPNProvider, settings settings.OpenVPN,
-	uid, gid int, allServers models.AllServers,
-	conf Configurator, fw firewall.Configurator, routing routing.Routing,
-	logger logging.Logger, client *http.Client, fileManager files.FileManager,
-	streamMerger command.StreamMerger, cancel context.CancelFunc) Looper {
+func NewLooper(settings settings.OpenVPN, profiles map[string]Profile,
+	saveProfile func(name string) error, addSecrets func(secrets ...string),
+	uid, gid int, allServers models.AllServers, conf Configurator, fw firewall.Configurator, routing routing.Routing,
+	logger logging.Logger, state loopstate.Reporter, client *http.Client, fileManager files.FileManager,
+	streamMerger command.StreamMerger, tracer tracing.Tracer, cancel context.CancelFunc) Looper {
 	return &looper{
-		provider:           provider,
-		settings:           settings,
-		uid:                uid,
-		gid:                gid,
-		allServers:         allServers,
-		conf:               conf,
-		fw:                 fw,
-		routing:            routing,
-		logger:             logger.WithPrefix("openvpn: "),
-		pfLogger:           logger.WithPrefix("port forwarding: "),
-		client:             client,
-		fileManager:        fileManager,
-		streamMerger:       streamMerger,
-		cancel:             cancel,
-		restart:            make(chan struct{}),
+		settings:     settings,
+		uid:          uid,
+		gid:          gid,
+		profiles:     profiles,
+		saveProfile:  saveProfile,
+		addSecrets:   addSecrets,
+		allServers:   allServers,
+		conf:         conf,
+		fw:           fw,
+		routing:      routing,
+		logger:       logger.WithPrefix("openvpn: "),
+		pfLogger:     logger.WithPrefix("port forwarding: "),
+		state:        state,
+		client:       client,
+		fileManager:  fileManager,
+		streamMerger: streamMerger,
+		tracer:       tracer,
+		cancel:       cancel,
+		restart:      make(chan struct{}),
+		start:        make(chan struct{}),
+		stop:         make(chan struct{}),
+		authFailed:   make(chan struct{}),
+		status: ConnectionStatus{
+			State:    StateDisconnected,
+			Since:    time.Now(),
+			Provider: settings.Provider.Name,
+		},
 		portForwardSignals: make(chan net.IP),
+		portForwardToggle:  make(chan struct{}, 1),
+		files:              newTunnelFiles(0),
 	}
 }
 
+// NewTunnelLooper creates a looper for the extra tunnel given, connecting
+// with its profile applied to the settings given. The extra tunnel ignores
+// the routes pushed by its server, and port forwarding is disabled for it.
+func NewTunnelLooper(tunnel Tunnel, settings settings.OpenVPN, profiles map[string]Profile,
+	addSecrets func(secrets ...string), uid, gid int, allServers models.AllServers,
+	conf Configurator, fw firewall.Configurator, routing routing.Routing,
+	logger logging.Logger, state loopstate.Reporter, client *http.Client, fileManager files.FileManager,
+	streamMerger command.StreamMerger, tracer tracing.Tracer, cancel context.CancelFunc) (Looper, error) {
+	profile, ok := profiles[tunnel.Profile]
+	if !ok {
+		return nil, fmt.Errorf("extra tunnel %s: profile %q not found", tunnel.Interface, tunnel.Profile)
+	} else if profile.ServerSelection.IPv6Endpoint {
+		return nil, fmt.Errorf("extra tunnel %s: profile %q cannot use IPv6 server endpoints",
+			tunnel.Interface, tunnel.Profile)
+	}
+	settings.Interface = tunnel.Interface
+	settings.RouteNoPull = true
+	settings.Routes = nil
+	settings.Provider.PortForwarding.Enabled = false
+	settings.Provider.ServerSelection.PortForwardOnly = false
+	if profile.User != "" {
+		// the credentials file is for the provider of the main tunnel
+		settings.CredentialsFilepath = ""
+	}
+	tunnelLogger := logger.WithPrefix("openvpn " + tunnel.Interface + ": ")
+	l := NewLooper(settings, profiles, nil, addSecrets, uid, gid, allServers, conf, fw, routing,
+		logger, state, client, fileManager, streamMerger, tracer, cancel).(*looper)
+	l.logger = tunnelLogger
+	l.pfLogger = tunnelLogger
+	l.tunnel = tunnel
+	l.files = newTunnelFiles(tunnel.Number)
+	if err := l.SelectProfile(tunnel.Profile); err != nil {
+		return nil, fmt.Errorf("extra tunnel %s: %w", tunnel.Interface, err)
+	}
+	return l, nil
+}
+
+// streamName returns the name prefixing the OpenVPN output lines.
+func (l *looper) streamName() string {
+	if l.tunnel.Number == 0 {
+		return "openvpn"
+	}
+	return "openvpn " + l.tunnel.Interface
+}
+
 func (l *looper) Restart()                      { l.restart <- struct{}{} }
+func (l *looper) Start()                        { l.start <- struct{}{} }
+func (l *looper) Stop()                         { l.stop <- struct{}{} }
 func (l *looper) PortForward(vpnGateway net.IP) { l.portForwardSignals <- vpnGateway }
 
+func (l *looper) SetPortForwarding(enabled bool) {
+	l.settingsMutex.Lock()
+	l.settings.Provider.PortForwarding.Enabled = enabled
+	l.settings.Provider.ServerSelection.PortForwardOnly = enabled
+	l.settingsMutex.Unlock()
+	select {
+	case l.portForwardToggle <- struct{}{}:
+	default: // a toggle is already pending
+	}
+}
+
+func (l *looper) ProcessEvent(event Event) {
+	l.statusMutex.Lock()
+	l.status.update(event, time.Now())
+	l.state.SetState(loopState(l.status.State))
+	if event.Kind == EventConnected && l.connectSpan != nil {
+		l.connectSpan.End(nil)
+		l.connectSpan = nil
+	}
+	l.statusMutex.Unlock()
+	if event.Kind == EventAuthFailed {
+		select {
+		case l.authFailed <- struct{}{}:
+		default: // OpenVPN is not running
+		}
+	}
+}
+
+func (l *looper) GetConnectionStatus() (status ConnectionStatus) {
+	l.statusMutex.RLock()
+	defer l.statusMutex.RUnlock()
+	status = l.status
+	status.PushedOptions = make([]string, len(l.status.PushedOptions))
+	copy(status.PushedOptions, l.status.PushedOptions)
+	return status
+}
+
+func (l *looper) setState(state ConnectionState) {
+	l.statusMutex.Lock()
+	defer l.statusMutex.Unlock()
+	l.status.setState(state, time.Now())
+	l.state.SetState(loopState(state))
+}
+
+// loopState returns the looper state for the connection state given.
+func loopState(state ConnectionState) loopstate.State {
+	switch state {
+	case StateConnecting, StateReconnecting:
+		return loopstate.Starting
+	case StateConnected:
+		return loopstate.Running
+	default:
+		return loopstate.Stopped
+	}
+}
+
+// setConnectSpan sets the span to end once the tunnel is up.
+func (l *looper) setConnectSpan(span tracing.Span) {
+	l.statusMutex.Lock()
+	defer l.statusMutex.Unlock()
+	l.connectSpan = span
+}
+
+// endConnectSpan ends the connection span if the tunnel was not up.
+func (l *looper) endConnectSpan(err error) {
+	l.statusMutex.Lock()
+	defer l.statusMutex.Unlock()
+	if l.connectSpan != nil {
+		l.connectSpan.End(err)
+		l.connectSpan = nil
+	}
+}
+
+func (l *looper) setServer(provider models.VPNProvider, connection models.OpenVPNConnection) {
+	l.statusMutex.Lock()
+	defer l.statusMutex.Unlock()
+	l.status.Provider = provider
+	l.status.Server = &connection
+}
+
 func (l *looper) GetSettings() (settings settings.OpenVPN) {
 	l.settingsMutex.RLock()
 	defer l.settingsMutex.RUnlock()
@@ -95,32 +282,153 @@ func (l *looper) SetSettings(settings settings.OpenVPN) {
 	l.settings = settings
 }
 
+func (l *looper) GetAllServers() (allServers models.AllServers) {
+	l.allServersMutex.RLock()
+	defer l.allServersMutex.RUnlock()
+	return l.allServers
+}
+
 func (l *looper) SetAllServers(allServers models.AllServers) {
 	l.allServersMutex.Lock()
 	defer l.allServersMutex.Unlock()
 	l.allServers = allServers
 }
 
+func (l *looper) GetProfiles() (names []string, active string) {
+	l.settingsMutex.RLock()
+	defer l.settingsMutex.RUnlock()
+	return sortedProfileNames(l.profiles), l.activeProfile
+}
+
+func (l *looper) ActivateProfile(name string) (err error) {
+	if err := l.SelectProfile(name); err != nil {
+		return err
+	}
+	l.logger.Info("activating profile %s", name)
+	if l.saveProfile != nil {
+		if err := l.saveProfile(name); err != nil {
+			l.logger.Warn("cannot persist active profile: %s", err)
+		}
+	}
+	l.Restart()
+	return nil
+}
+
+func (l *looper) SelectProfile(name string) (err error) {
+	profile, ok := l.profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	l.settingsMutex.Lock()
+	settings, err := profile.apply(l.settings)
+	if err == nil {
+		// check a server matches before disconnecting
+		l.allServersMutex.RLock()
+		selection := settings.Provider.ServerSelection
+		picker := provider.NewPicker(selection.PickMode, selection.PickSeed, time.Now)
+		providerConf := provider.New(settings.Provider.Name, l.allServers, time.Now, picker)
+		_, err = providerConf.GetOpenVPNConnection(selection)
+		l.allServersMutex.RUnlock()
+	}
+	if err != nil {
+		l.settingsMutex.Unlock()
+		return fmt.Errorf("cannot activate profile %s: %w", name, err)
+	}
+	l.settings = settings
+	l.activeProfile = name
+	l.settingsMutex.Unlock()
+	l.statusMutex.Lock()
+	l.status.Profile = name
+	l.statusMutex.Unlock()
+	return nil
+}
+
+// waitForStart blocks until OpenVPN is started or restarted, and returns
+// false if the context is canceled.
+func (l *looper) waitForStart(ctx context.Context, stoppedMessage string) (started bool) {
+	for {
+		select {
+		case <-l.stop:
+			l.logger.Info(stoppedMessage)
+			l.setState(StateStopped)
+		case <-l.start:
+			return true
+		case <-l.restart:
+			l.logger.Info("restarting")
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
 func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
-	select {
-	case <-l.restart:
-	case <-ctx.Done():
+	if !l.waitForStart(ctx, "not started yet") {
 		return
 	}
 	defer l.logger.Warn("loop exited")
 
+	random := rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+	retryBackoff := newBackoff(0, 0, random.Int63n)
+	var connection models.OpenVPNConnection
+	pickServer := true
+	avoidServer := false // pick a server different from the current one
+	failedAttempts := 0  // with the current server
+	// the picker is kept across attempts for the round robin pick mode,
+	// and is only re-created if its settings change.
+	var picker provider.Picker
+	var pickMode string
+	var pickSeed int64
+	failed := newFailedEndpoints(time.Now)
+	var routedServer net.IP // only for an extra tunnel
+	// credentialsCheck is nil if the credentials are not read from a file
+	var credentialsCheck <-chan time.Time
+	if initialSettings := l.GetSettings(); initialSettings.CredentialsFilepath != "" {
+		ticker := time.NewTicker(initialSettings.CredentialsPeriod)
+		defer ticker.Stop()
+		credentialsCheck = ticker.C
+	}
+
 	for ctx.Err() == nil {
 		settings := l.GetSettings()
+		retryBackoff.initial = settings.Retry.InitialWait
+		retryBackoff.max = settings.Retry.MaxWait
+		selection := settings.Provider.ServerSelection
+		if picker == nil || selection.PickMode != pickMode || selection.PickSeed != pickSeed {
+			picker = provider.NewPicker(selection.PickMode, selection.PickSeed, time.Now)
+			pickMode, pickSeed = selection.PickMode, selection.PickSeed
+		}
+		failed.cooldown = settings.Retry.FailedServerCooldown
 		l.allServersMutex.RLock()
-		providerConf := provider.New(l.provider, l.allServers, time.Now)
+		providerConf := provider.New(settings.Provider.Name, l.allServers, time.Now,
+			&avoidingPicker{picker: picker, failed: failed})
 		l.allServersMutex.RUnlock()
-		connection, err := providerConf.GetOpenVPNConnection(settings.Provider.ServerSelection)
-		if err != nil {
-			l.logger.Error(err)
-			l.cancel()
-			return
+		connectCtx, connectSpan := l.tracer.Start(ctx, "openvpn.connect")
+		connectSpan.SetAttribute("provider", string(settings.Provider.Name))
+		switchServerAfter := settings.Retry.SwitchServerAfter
+		if pickServer || switchServerAfter == 0 || failedAttempts >= switchServerAfter {
+			if switchServerAfter > 0 && failedAttempts >= switchServerAfter {
+				l.logger.Info("switching to another server after %d failed attempts", failedAttempts)
+				avoidServer = true
+			}
+			_, pickSpan := l.tracer.Start(connectCtx, "openvpn.pick_server")
+			var err error
+			connection, err = pickConnection(providerConf, settings.Provider.ServerSelection,
+				connection, avoidServer)
+			pickSpan.End(err)
+			if err != nil {
+				connectSpan.End(err)
+				l.logger.Error(err)
+				l.state.SetError(err)
+				l.cancel()
+				return
+			}
+			pickServer, avoidServer = false, false
+			failedAttempts = 0
 		}
+		connectSpan.SetAttribute("server", connection.IP.String())
+		_, configSpan := l.tracer.Start(connectCtx, "openvpn.write_config")
 		lines := providerConf.BuildConf(
 			connection,
 			settings.Verbosity,
@@ -131,77 +439,248 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 			settings.Auth,
 			settings.Provider.ExtraConfigOptions,
 		)
-		if err := l.fileManager.WriteLinesToFile(string(constants.OpenVPNConf), lines,
-			files.Ownership(l.uid, l.gid), files.Permissions(constants.UserReadPermission)); err != nil {
-			l.logger.Error(err)
-			l.cancel()
-			return
+		lines = customizeConf(lines, settings)
+		if l.tunnel.Number > 0 {
+			lines = tunnelConf(lines, l.files)
 		}
-
-		if err := l.conf.WriteAuthFile(settings.User, settings.Password, l.uid, l.gid); err != nil {
+		err := l.fileManager.WriteLinesToFile(l.files.conf, lines,
+			files.Ownership(l.uid, l.gid), files.Permissions(constants.UserReadPermission))
+		user, password := l.credentials(settings)
+		if err == nil {
+			err = l.conf.WriteAuthFile(user, password, l.uid, l.gid)
+		}
+		configSpan.End(err)
+		if err != nil {
+			connectSpan.End(err)
 			l.logger.Error(err)
+			l.state.SetError(err)
 			l.cancel()
 			return
 		}
 
-		if err := l.fw.SetVPNConnection(ctx, connection); err != nil {
+		_, firewallSpan := l.tracer.Start(connectCtx, "openvpn.firewall")
+		if l.tunnel.Number > 0 {
+			err = l.setTunnelServer(ctx, connection, &routedServer)
+		} else {
+			err = l.fw.SetVPNConnection(ctx, connection)
+		}
+		firewallSpan.End(err)
+		if err != nil {
+			connectSpan.End(err)
 			l.logger.Error(err)
+			l.state.SetError(err)
 			l.cancel()
 			return
 		}
 
 		openvpnCtx, openvpnCancel := context.WithCancel(context.Background())
 
-		stream, waitFn, err := l.conf.Start(openvpnCtx)
+		l.setServer(settings.Provider.Name, connection)
+		l.setState(StateConnecting)
+		_, startSpan := l.tracer.Start(connectCtx, "openvpn.start")
+		stream, errStream, waitFn, err := l.conf.Start(openvpnCtx)
+		startSpan.End(err)
 		if err != nil {
+			connectSpan.End(err)
+			l.setState(StateDisconnected)
 			openvpnCancel()
-			l.logAndWait(ctx, err)
+			failedAttempts++
+			failed.add(connection)
+			l.logAndWait(ctx, err, retryBackoff.next())
 			continue
 		}
+		startTime := time.Now()
+		l.setConnectSpan(connectSpan)
 
 		// Needs the stream line from main.go to know when the tunnel is up
-		go func(ctx context.Context) {
-			for {
-				select {
-				// TODO have a way to disable pf with a context
-				case <-ctx.Done():
-					return
-				case gateway := <-l.portForwardSignals:
-					wg.Add(1)
-					go l.portForward(ctx, wg, providerConf, l.client, gateway)
-				}
-			}
-		}(openvpnCtx)
+		go l.runPortForwarding(openvpnCtx, wg, providerConf)
 
-		go l.streamMerger.Merge(openvpnCtx, stream, command.MergeName("openvpn"))
+		go l.streamMerger.Merge(openvpnCtx, stream, command.MergeName(l.streamName()))
+		go l.streamMerger.Merge(openvpnCtx, errStream, command.MergeName(l.streamName()+" stderr"))
 		waitError := make(chan error)
 		go func() {
 			err := waitFn() // blocking
 			waitError <- err
 		}()
-		select {
-		case <-ctx.Done():
-			l.logger.Warn("context canceled: exiting loop")
-			openvpnCancel()
-			<-waitError
-			close(waitError)
-			return
-		case <-l.restart: // triggered restart
-			l.logger.Info("restarting")
-			openvpnCancel()
-			<-waitError
-			close(waitError)
-		case err := <-waitError: // unexpected error
-			openvpnCancel()
-			close(waitError)
-			l.logAndWait(ctx, err)
+		authFailures := 0
+	waitLoop:
+		for {
+			select {
+			case <-ctx.Done():
+				l.logger.Warn("context canceled: exiting loop")
+				l.endConnectSpan(ctx.Err())
+				openvpnCancel()
+				<-waitError
+				close(waitError)
+				l.setState(StateDisconnected)
+				return
+			case <-l.restart: // triggered restart
+				if l.softRestart(settings) {
+					continue
+				}
+				l.logger.Info("restarting")
+				l.endConnectSpan(errors.New("restarted before the tunnel was up"))
+				openvpnCancel()
+				<-waitError
+				close(waitError)
+				l.setState(StateDisconnected)
+				retryBackoff.reset()
+				pickServer = true
+				break waitLoop
+			case <-l.start:
+				l.logger.Info("already started")
+			case <-credentialsCheck:
+				newUser, newPassword, err := l.conf.ReadCredentials(settings.CredentialsFilepath)
+				if err != nil {
+					l.logger.Warn(err)
+					continue
+				}
+				l.addSecrets(newUser, newPassword)
+				if newUser == user && newPassword == password {
+					continue
+				}
+				user, password = newUser, newPassword
+				if err := l.conf.WriteAuthFile(user, password, l.uid, l.gid); err != nil {
+					l.logger.Error(err)
+					continue
+				}
+				l.logger.Info("credentials changed, reconnecting without full restart")
+				if err := l.conf.SoftRestart(); err != nil {
+					l.logger.Warn("cannot signal OpenVPN, credentials are renewed on its next reconnection: %s", err)
+				}
+			case <-l.stop:
+				l.logger.Info("stopping")
+				l.endConnectSpan(errors.New("stopped before the tunnel was up"))
+				openvpnCancel()
+				<-waitError
+				close(waitError)
+				l.setState(StateStopped)
+				if !l.waitForStart(ctx, "already stopped") {
+					return
+				}
+				retryBackoff.reset()
+				pickServer = true
+				break waitLoop
+			case <-l.authFailed:
+				authFailures++
+				maxAuthFailures := settings.Retry.AuthFailedAttempts
+				if maxAuthFailures == 0 || authFailures < maxAuthFailures {
+					continue // OpenVPN retries by itself
+				}
+				l.endConnectSpan(errors.New("authentication failed"))
+				openvpnCancel()
+				<-waitError
+				close(waitError)
+				retryBackoff.reset()
+				pickServer = true
+				l.setState(StateDisconnected)
+				if settings.Retry.AuthFailedAction == constants.OpenVPNAuthFailedStop {
+					l.setState(StateStopped)
+					l.logger.Error("authentication failed %d times, please check your credentials: "+
+						"OpenVPN is stopped until it is restarted", authFailures)
+					l.state.SetError(fmt.Errorf("authentication failed %d times", authFailures))
+					if !l.waitForStart(ctx, "already stopped") {
+						return
+					}
+					break waitLoop
+				}
+				l.logger.Warn("authentication failed %d times, switching to another server", authFailures)
+				failed.add(connection)
+				avoidServer = true
+				break waitLoop
+			case err := <-waitError: // unexpected error
+				l.endConnectSpan(err)
+				openvpnCancel()
+				close(waitError)
+				l.setState(StateDisconnected)
+				if time.Since(startTime) > settings.Retry.MaxWait {
+					// OpenVPN ran fine for a while, start over
+					retryBackoff.reset()
+					failedAttempts = 0
+					failed.remove(connection)
+				} else {
+					failed.add(connection)
+				}
+				failedAttempts++
+				l.logAndWait(ctx, err, retryBackoff.next())
+				break waitLoop
+			}
 		}
 	}
 }
 
-func (l *looper) logAndWait(ctx context.Context, err error) {
+// setTunnelServer allows the connection to the server of the extra tunnel
+// through the firewall and routes it through the default gateway, replacing
+// the route to the routed server given, which is then set to the server.
+func (l *looper) setTunnelServer(ctx context.Context, connection models.OpenVPNConnection,
+	routedServer *net.IP) (err error) {
+	if err := l.fw.SetExtraVPNConnection(ctx, l.tunnel.Interface, connection); err != nil {
+		return err
+	}
+	if routedServer.Equal(connection.IP) {
+		return nil
+	}
+	if *routedServer != nil {
+		if err := l.routing.RemoveTunnelServerRoute(*routedServer); err != nil {
+			l.logger.Warn(err)
+		}
+		*routedServer = nil
+	}
+	if err := l.routing.SetTunnelServerRoute(connection.IP); err != nil {
+		return err
+	}
+	*routedServer = connection.IP
+	return nil
+}
+
+// softRestart signals OpenVPN to reconnect keeping its TUN device and routes,
+// and returns false if a full restart is needed instead, because persist-tun
+// is disabled or because the settings changed since OpenVPN was started.
+func (l *looper) softRestart(startSettings settings.OpenVPN) (ok bool) {
+	if !startSettings.PersistTun || !reflect.DeepEqual(l.GetSettings(), startSettings) {
+		return false
+	}
+	if err := l.conf.SoftRestart(); err != nil {
+		l.logger.Warn("cannot signal OpenVPN, restarting fully: %s", err)
+		return false
+	}
+	l.logger.Info("restarting keeping the TUN device")
+	l.setState(StateReconnecting)
+	return true
+}
+
+// credentials returns the user and password read from the credentials
+// file if it is set, defaulting to the user and password of the settings.
+func (l *looper) credentials(settings settings.OpenVPN) (user, password string) {
+	if settings.CredentialsFilepath == "" {
+		return settings.User, settings.Password
+	}
+	user, password, err := l.conf.ReadCredentials(settings.CredentialsFilepath)
+	if err != nil {
+		l.logger.Warn("%s: using USER and PASSWORD instead", err)
+		return settings.User, settings.Password
+	}
+	l.addSecrets(user, password)
+	return user, password
+}
+
+// pickConnection picks a connection for the server selection given, trying
+// to find a connection different from the previous one if avoidPrevious is true.
+func pickConnection(providerConf provider.Provider, selection models.ServerSelection,
+	previous models.OpenVPNConnection, avoidPrevious bool) (connection models.OpenVPNConnection, err error) {
+	const maxTries = 10
+	for i := 0; i < maxTries; i++ {
+		connection, err = providerConf.GetOpenVPNConnection(selection)
+		if err != nil || !avoidPrevious || !connection.IP.Equal(previous.IP) {
+			return connection, err
+		}
+	}
+	return connection, nil // most likely a single server matches the selection
+}
+
+func (l *looper) logAndWait(ctx context.Context, err error, waitTime time.Duration) {
 	l.logger.Error(err)
-	const waitTime = 30 * time.Second
+	l.state.SetError(err)
 	l.logger.Info("retrying in %s", waitTime)
 	timer := time.NewTimer(waitTime)
 	select {
@@ -213,6 +692,43 @@ func (l *looper) logAndWait(ctx context.Context, err error) {
 	}
 }
 
+// runPortForwarding starts port forwarding each time the tunnel is up, and
+// starts or stops it when it is toggled, until the context is canceled.
+func (l *looper) runPortForwarding(ctx context.Context, wg *sync.WaitGroup, providerConf provider.Provider) {
+	pfCancel := context.CancelFunc(func() {})
+	defer func() { pfCancel() }()
+	startPortForward := func(gateway net.IP) {
+		pfCancel()
+		var pfCtx context.Context
+		pfCtx, pfCancel = context.WithCancel(ctx)
+		wg.Add(1)
+		go l.portForward(pfCtx, wg, providerConf, l.client, gateway)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case gateway := <-l.portForwardSignals:
+			startPortForward(gateway)
+		case <-l.portForwardToggle:
+			pfCancel()
+			if !l.GetSettings().Provider.PortForwarding.Enabled {
+				l.pfLogger.Info("stopped")
+				l.portForwardedMutex.Lock()
+				l.portForwarded = 0
+				l.portForwardedMutex.Unlock()
+				continue
+			}
+			gateway, err := l.routing.VPNLocalGatewayIP()
+			if err != nil {
+				l.pfLogger.Error(err)
+				continue
+			}
+			startPortForward(gateway)
+		}
+	}
+}
+
 // portForward is a blocking operation which may or may not be infinite.
 // You should therefore always call it in a goroutine.
 func (l *looper) portForward(ctx context.Context, wg *sync.WaitGroup,
@@ -222,16 +738,39 @@ func (l *looper) portForward(ctx context.Context, wg *sync.WaitGroup,
 	if !settings.Provider.PortForwarding.Enabled {
 		return
 	}
-	syncState := func(port uint16) (pfFilepath models.Filepath) {
+	relayCancel := context.CancelFunc(func() {})
+	defer func() { relayCancel() }()
+	var relayPort uint16
+	syncState := func(port uint16) {
 		l.portForwardedMutex.Lock()
 		l.portForwarded = port
 		l.portForwardedMutex.Unlock()
 		settings := l.GetSettings()
-		return settings.Provider.PortForwarding.Filepath
+		pfSettings := settings.Provider.PortForwarding
+		l.pfLogger.Info("Writing port to %s", pfSettings.Filepath)
+		if err := writePortForwardedFile(l.fileManager, pfSettings, port); err != nil {
+			l.pfLogger.Error(err)
+		}
+		for _, portSetter := range torrentPortSetters(client, pfSettings) {
+			if err := portSetter.SetPeerPort(ctx, port); err != nil {
+				l.pfLogger.Error(err)
+			}
+		}
+		if pfSettings.Relay != "" && port != relayPort {
+			relayCancel()
+			var relayCtx context.Context
+			relayCtx, relayCancel = context.WithCancel(ctx)
+			relayPort = port
+			go func() {
+				if err := relay.New(pfSettings.Relay, l.pfLogger).Run(relayCtx, port); err != nil {
+					l.pfLogger.Error(err)
+				}
+			}()
+		}
 	}
 	providerConf.PortForward(ctx,
 		client, l.fileManager, l.pfLogger,
-		gateway, l.fw, syncState)
+		gateway, l.fw, settings.Interface, syncState)
 }
 
 func (l *looper) GetPortForwarded() (portForwarded uint16) {
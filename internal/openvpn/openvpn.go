@@ -13,10 +13,13 @@ import (
 
 type Configurator interface {
 	Version(ctx context.Context) (string, error)
+	SupportsScramble(ctx context.Context) (supported bool, err error)
 	WriteAuthFile(user, password string, uid, gid int) error
+	ReadCredentials(filepath string) (user, password string, err error)
+	SoftRestart() error
 	CheckTUN() error
 	CreateTUN() error
-	Start(ctx context.Context) (stdout io.ReadCloser, waitFn func() error, err error)
+	Start(ctx context.Context) (stdout, stderr io.ReadCloser, waitFn func() error, err error)
 }
 
 type configurator struct {
@@ -26,6 +29,7 @@ type configurator struct {
 	openFile    func(name string, flag int, perm os.FileMode) (*os.File, error)
 	mkDev       func(major uint32, minor uint32) uint64
 	mkNod       func(path string, mode uint32, dev int) error
+	files       tunnelFiles
 }
 
 func NewConfigurator(logger logging.Logger, fileManager files.FileManager) Configurator {
@@ -36,5 +40,20 @@ func NewConfigurator(logger logging.Logger, fileManager files.FileManager) Confi
 		openFile:    os.OpenFile,
 		mkDev:       unix.Mkdev,
 		mkNod:       unix.Mknod,
+		files:       newTunnelFiles(0),
+	}
+}
+
+// NewTunnelConfigurator creates a configurator for the extra
+// tunnel given, using the files of the tunnel.
+func NewTunnelConfigurator(logger logging.Logger, fileManager files.FileManager, tunnel Tunnel) Configurator {
+	return &configurator{
+		fileManager: fileManager,
+		logger:      logger.WithPrefix("openvpn " + tunnel.Interface + " configurator: "),
+		commander:   command.NewCommander(),
+		openFile:    os.OpenFile,
+		mkDev:       unix.Mkdev,
+		mkNod:       unix.Mknod,
+		files:       newTunnelFiles(tunnel.Number),
 	}
 }
@@ -0,0 +1,62 @@
+package openvpn
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"text/template"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/torrent"
+	"github.com/qdm12/golibs/files"
+)
+
+type portForwardedData struct {
+	Port uint16 `json:"port"`
+}
+
+func writePortForwardedFile(fileManager files.FileManager,
+	settings models.PortForwarding, port uint16) (err error) {
+	data, err := formatPortForwarded(settings.Format, settings.Template, port)
+	if err != nil {
+		return err
+	}
+	return fileManager.WriteToFile(string(settings.Filepath), data,
+		files.Permissions(constants.AllReadWritePermissions))
+}
+
+func formatPortForwarded(format, templateString string, port uint16) (data []byte, err error) {
+	switch format {
+	case constants.PortForwardingFormatPlain, "":
+		return []byte(fmt.Sprintf("%d", port)), nil
+	case constants.PortForwardingFormatJSON:
+		return json.Marshal(portForwardedData{Port: port})
+	case constants.PortForwardingFormatTemplate:
+		t, err := template.New("port forwarded").Parse(templateString)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse port forwarded template: %w", err)
+		}
+		buffer := bytes.NewBuffer(nil)
+		if err := t.Execute(buffer, portForwardedData{Port: port}); err != nil {
+			return nil, fmt.Errorf("cannot execute port forwarded template: %w", err)
+		}
+		return buffer.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("port forwarded file format %q is not supported", format)
+	}
+}
+
+func torrentPortSetters(client *http.Client, settings models.PortForwarding) (
+	portSetters []torrent.PortSetter) {
+	if settings.Transmission.URL != "" {
+		portSetters = append(portSetters, torrent.NewTransmission(client,
+			settings.Transmission.URL, settings.Transmission.User, settings.Transmission.Password))
+	}
+	if settings.Deluge.URL != "" {
+		portSetters = append(portSetters, torrent.NewDeluge(client,
+			settings.Deluge.URL, settings.Deluge.Password))
+	}
+	return portSetters
+}
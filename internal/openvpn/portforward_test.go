@@ -0,0 +1,65 @@
+package openvpn
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_formatPortForwarded(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		format   string
+		template string
+		port     uint16
+		data     []byte
+		err      error
+	}{
+		"empty format": {
+			port: 1000,
+			data: []byte("1000"),
+		},
+		"plain": {
+			format: constants.PortForwardingFormatPlain,
+			port:   1000,
+			data:   []byte("1000"),
+		},
+		"json": {
+			format: constants.PortForwardingFormatJSON,
+			port:   1000,
+			data:   []byte(`{"port":1000}`),
+		},
+		"template": {
+			format:   constants.PortForwardingFormatTemplate,
+			template: "PORT={{.Port}}\n",
+			port:     1000,
+			data:     []byte("PORT=1000\n"),
+		},
+		"bad template": {
+			format:   constants.PortForwardingFormatTemplate,
+			template: "{{.Port",
+			err:      fmt.Errorf("cannot parse port forwarded template: template: port forwarded:1: unclosed action"),
+		},
+		"unknown format": {
+			format: "xml",
+			err:    fmt.Errorf(`port forwarded file format "xml" is not supported`),
+		},
+	}
+	for name, testCase := range tests {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			data, err := formatPortForwarded(testCase.format, testCase.template, testCase.port)
+			if testCase.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.data, data)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package openvpn
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/files"
+)
+
+// Profile is a named set of provider, server selection and options
+// which can be activated at runtime through the control server.
+type Profile struct {
+	// Provider defaults to the provider currently used if left empty.
+	Provider           models.VPNProvider        `json:"provider"`
+	ServerSelection    models.ServerSelection    `json:"serverSelection"`
+	ExtraConfigOptions models.ExtraConfigOptions `json:"extraConfig"`
+	// User and Password default to the current credentials if left empty.
+	User     string `json:"user"`
+	Password string `json:"password"`
+	// providerExtra contains the certificates, keys and options
+	// of the provider of the profile, if it is not the current provider.
+	providerExtra models.ExtraConfigOptions
+}
+
+// ReadProfiles reads the profiles from the JSON file given, which maps
+// profile names to profiles. The profiles switching from the provider of
+// the current settings are checked against them, and the certificates and
+// keys of their provider are obtained with the getProviderExtra function.
+func ReadProfiles(fileManager files.FileManager, filepath string, current settings.OpenVPN,
+	getProviderExtra func(provider models.VPNProvider) (extra models.ExtraConfigOptions, err error)) (
+	profiles map[string]Profile, err error) {
+	data, err := fileManager.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("cannot decode profiles file %s: %w", filepath, err)
+	}
+	for name, profile := range profiles {
+		if name == "" {
+			return nil, fmt.Errorf("profile name cannot be empty in %s", filepath)
+		}
+		if profile.Provider == "" || profile.Provider == current.Provider.Name {
+			continue
+		}
+		if !isKnownProvider(profile.Provider) {
+			return nil, fmt.Errorf("profile %q: VPN service provider %q is not valid", name, profile.Provider)
+		}
+		if err := profile.checkProvider(current); err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+		profile.providerExtra, err = getProviderExtra(profile.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("profile %q: %w", name, err)
+		}
+		profiles[name] = profile
+	}
+	return profiles, nil
+}
+
+func isKnownProvider(provider models.VPNProvider) bool {
+	switch provider {
+	case constants.PrivateInternetAccess, constants.Mullvad, constants.Windscribe,
+		constants.Surfshark, constants.Cyberghost, constants.Vyprvpn,
+		constants.Nordvpn, constants.Purevpn, constants.Privado, constants.VPNUnlimited,
+		constants.HideMyAss, constants.WeVPN:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkProvider verifies the current OpenVPN settings can be used
+// with the provider of the profile.
+func (p Profile) checkProvider(current settings.OpenVPN) error {
+	if err := current.CheckProvider(p.Provider); err != nil {
+		return fmt.Errorf("cannot switch to provider %s: %w", p.Provider, err)
+	}
+	if current.Provider.Name == constants.Mullvad && p.Password == "" {
+		return fmt.Errorf("cannot switch to provider %s: password must be set in the profile", p.Provider)
+	}
+	return nil
+}
+
+// apply returns the OpenVPN settings given with the profile applied.
+func (p Profile) apply(current settings.OpenVPN) (updated settings.OpenVPN, err error) {
+	updated = current
+	providerExtra := current.Provider.ExtraConfigOptions
+	if p.Provider != "" && p.Provider != current.Provider.Name {
+		if current.Provider.PortForwarding.Enabled {
+			return updated, fmt.Errorf("cannot switch to provider %s with port forwarding enabled", p.Provider)
+		}
+		if err := p.checkProvider(current); err != nil {
+			return updated, err
+		}
+		updated.Provider.Name = p.Provider
+		providerExtra = p.providerExtra
+	}
+	updated.Provider.ServerSelection = p.ServerSelection
+	if updated.Provider.ServerSelection.Protocol == "" {
+		updated.Provider.ServerSelection.Protocol = current.Provider.ServerSelection.Protocol
+	}
+	if updated.Provider.ServerSelection.PickMode == "" {
+		updated.Provider.ServerSelection.PickMode = current.Provider.ServerSelection.PickMode
+		updated.Provider.ServerSelection.PickSeed = current.Provider.ServerSelection.PickSeed
+	}
+	extra := p.ExtraConfigOptions
+	// certificates and key cannot be set in the profiles file
+	extra.ClientCertificate = providerExtra.ClientCertificate
+	extra.ClientKey = providerExtra.ClientKey
+	extra.CertificateAuthority = providerExtra.CertificateAuthority
+	extra.TLSCryptKey = providerExtra.TLSCryptKey
+	if updated.Provider.Name == constants.PrivateInternetAccess {
+		selection := &updated.Provider.ServerSelection
+		if selection.EncryptionPreset == "" {
+			selection.EncryptionPreset = providerExtra.EncryptionPreset
+		}
+		if extra.EncryptionPreset == "" {
+			extra.EncryptionPreset = selection.EncryptionPreset
+		}
+	}
+	updated.Provider.ExtraConfigOptions = extra
+	if p.User != "" {
+		updated.User = p.User
+	}
+	switch {
+	case p.Password != "":
+		updated.Password = p.Password
+	case updated.Provider.Name == constants.Mullvad:
+		updated.Password = "m"
+	}
+	return updated, nil
+}
+
+func sortedProfileNames(profiles map[string]Profile) (names []string) {
+	names = make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,246 @@
+package openvpn
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/files/mock_files"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Profile_apply(t *testing.T) {
+	t.Parallel()
+	current := settings.OpenVPN{
+		User:     "user",
+		Password: "password",
+		Provider: models.ProviderSettings{
+			Name: constants.PrivateInternetAccess,
+			ServerSelection: models.ServerSelection{
+				Protocol: constants.UDP,
+				Regions:  []string{"germany"},
+			},
+			ExtraConfigOptions: models.ExtraConfigOptions{
+				ClientKey: "key",
+			},
+		},
+	}
+	tests := map[string]struct {
+		profile  Profile
+		current  settings.OpenVPN
+		settings settings.OpenVPN
+		err      string
+	}{
+		"same provider": {
+			profile: Profile{
+				ServerSelection: models.ServerSelection{Regions: []string{"us east"}},
+			},
+			current: current,
+			settings: settings.OpenVPN{
+				User:     "user",
+				Password: "password",
+				Provider: models.ProviderSettings{
+					Name: constants.PrivateInternetAccess,
+					ServerSelection: models.ServerSelection{
+						Protocol: constants.UDP,
+						Regions:  []string{"us east"},
+					},
+					ExtraConfigOptions: models.ExtraConfigOptions{
+						ClientKey: "key",
+					},
+				},
+			},
+		},
+		"other provider": {
+			profile: Profile{
+				Provider: constants.Mullvad,
+				ServerSelection: models.ServerSelection{
+					Protocol:  constants.TCP,
+					Countries: []string{"sweden"},
+				},
+				User: "1234",
+			},
+			current: current,
+			settings: settings.OpenVPN{
+				User:     "1234",
+				Password: "m",
+				Provider: models.ProviderSettings{
+					Name: constants.Mullvad,
+					ServerSelection: models.ServerSelection{
+						Protocol:  constants.TCP,
+						Countries: []string{"sweden"},
+					},
+				},
+			},
+		},
+		"other provider certificates": {
+			profile: Profile{
+				Provider:      constants.Cyberghost,
+				providerExtra: models.ExtraConfigOptions{ClientKey: "cyberghost key", ClientCertificate: "cert"},
+			},
+			current: current,
+			settings: settings.OpenVPN{
+				User:     "user",
+				Password: "password",
+				Provider: models.ProviderSettings{
+					Name:            constants.Cyberghost,
+					ServerSelection: models.ServerSelection{Protocol: constants.UDP},
+					ExtraConfigOptions: models.ExtraConfigOptions{
+						ClientKey:         "cyberghost key",
+						ClientCertificate: "cert",
+					},
+				},
+			},
+		},
+		"PIA encryption preset": {
+			profile: Profile{
+				Provider:      constants.PrivateInternetAccess,
+				Password:      "password",
+				providerExtra: models.ExtraConfigOptions{EncryptionPreset: constants.PIAEncryptionPresetStrong},
+			},
+			current: settings.OpenVPN{
+				User:     "1234",
+				Password: "m",
+				Provider: models.ProviderSettings{Name: constants.Mullvad},
+			},
+			settings: settings.OpenVPN{
+				User:     "1234",
+				Password: "password",
+				Provider: models.ProviderSettings{
+					Name: constants.PrivateInternetAccess,
+					ServerSelection: models.ServerSelection{
+						EncryptionPreset: constants.PIAEncryptionPresetStrong,
+					},
+					ExtraConfigOptions: models.ExtraConfigOptions{
+						EncryptionPreset: constants.PIAEncryptionPresetStrong,
+					},
+				},
+			},
+		},
+		"from mullvad without password": {
+			profile: Profile{Provider: constants.PrivateInternetAccess},
+			current: settings.OpenVPN{
+				User:     "1234",
+				Password: "m",
+				Provider: models.ProviderSettings{Name: constants.Mullvad},
+			},
+			err: "cannot switch to provider private internet access: password must be set in the profile",
+		},
+		"incompatible data ciphers": {
+			profile: Profile{Provider: constants.Cyberghost},
+			current: settings.OpenVPN{
+				DataCiphers: []string{"aes-256-gcm"},
+				Provider:    models.ProviderSettings{Name: constants.Mullvad},
+			},
+			err: "cannot switch to provider cyberghost: data ciphers cannot be set for cyberghost " +
+				"since its configuration disables cipher negotiation",
+		},
+		"incompatible compression": {
+			profile: Profile{Provider: constants.Nordvpn, Password: "password"},
+			current: settings.OpenVPN{
+				Compression: constants.OpenVPNCompressionLZ4V2,
+				Provider:    models.ProviderSettings{Name: constants.Mullvad},
+			},
+			err: "cannot switch to provider nordvpn: compression lz4-v2 cannot be used with nordvpn " +
+				"whose servers use LZO compression, use lzo or stub",
+		},
+		"other provider with port forwarding": {
+			profile: Profile{Provider: constants.Mullvad},
+			current: settings.OpenVPN{
+				Provider: models.ProviderSettings{
+					Name:           constants.PrivateInternetAccess,
+					PortForwarding: models.PortForwarding{Enabled: true},
+				},
+			},
+			err: "cannot switch to provider mullvad with port forwarding enabled",
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			updated, err := tc.profile.apply(tc.current)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.settings, updated)
+		})
+	}
+}
+
+func Test_ReadProfiles(t *testing.T) {
+	t.Parallel()
+	current := settings.OpenVPN{
+		Compression: constants.OpenVPNCompressionLZ4V2,
+		Provider:    models.ProviderSettings{Name: constants.Mullvad},
+	}
+	errKeyNotFound := errors.New("key not found")
+	testCases := map[string]struct {
+		data          string
+		profiles      map[string]Profile
+		providerExtra models.ExtraConfigOptions
+		providerErr   error
+		err           string
+	}{
+		"current provider": {
+			data: `{"sweden": {"provider": "mullvad", "serverSelection": {"countries": ["sweden"]}}}`,
+			profiles: map[string]Profile{"sweden": {
+				Provider:        constants.Mullvad,
+				ServerSelection: models.ServerSelection{Countries: []string{"sweden"}},
+			}},
+		},
+		"other provider": {
+			data:          `{"cyberghost": {"provider": "cyberghost", "password": "password"}}`,
+			providerExtra: models.ExtraConfigOptions{ClientKey: "key", ClientCertificate: "cert"},
+			profiles: map[string]Profile{"cyberghost": {
+				Provider:      constants.Cyberghost,
+				Password:      "password",
+				providerExtra: models.ExtraConfigOptions{ClientKey: "key", ClientCertificate: "cert"},
+			}},
+		},
+		"other provider files error": {
+			data:        `{"cyberghost": {"provider": "cyberghost", "password": "password"}}`,
+			providerErr: errKeyNotFound,
+			err:         `profile "cyberghost": key not found`,
+		},
+		"incompatible provider": {
+			data: `{"nordvpn": {"provider": "nordvpn", "password": "password"}}`,
+			err: `profile "nordvpn": cannot switch to provider nordvpn: compression lz4-v2 cannot be used ` +
+				`with nordvpn whose servers use LZO compression, use lzo or stub`,
+		},
+		"unknown provider": {
+			data: `{"unknown": {"provider": "unknown"}}`,
+			err:  `profile "unknown": VPN service provider "unknown" is not valid`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			fileManager := mock_files.NewMockFileManager(mockCtrl)
+			fileManager.EXPECT().ReadFile("profiles.json").Return([]byte(testCase.data), nil)
+			getProviderExtra := func(provider models.VPNProvider) (models.ExtraConfigOptions, error) {
+				return testCase.providerExtra, testCase.providerErr
+			}
+
+			profiles, err := ReadProfiles(fileManager, "profiles.json", current, getProviderExtra)
+
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.profiles, profiles)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package openvpn
+
+import (
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/settings"
+)
+
+// customizeConf replaces or adds the TLS, cipher negotiation, scramble,
+// compression and routing options set by the user in the configuration lines built by the provider.
+func customizeConf(lines []string, settings settings.OpenVPN) (customized []string) {
+	var options []string
+	if settings.Interface != "" {
+		// the device type can no longer be deduced from a custom name
+		options = append(options, "dev "+settings.Interface, "dev-type tun")
+	}
+	if settings.TLSVersionMin != "" {
+		options = append(options, "tls-version-min "+settings.TLSVersionMin)
+	}
+	if len(settings.DataCiphers) > 0 {
+		// ncp-ciphers is understood by both OpenVPN 2.4 and 2.5,
+		// where it is an alias for data-ciphers.
+		options = append(options, "ncp-ciphers "+strings.Join(settings.DataCiphers, ":"))
+	}
+	if settings.TLSCipher != "" {
+		options = append(options, "tls-cipher "+settings.TLSCipher)
+	}
+	if settings.Scramble != "" {
+		options = append(options, scrambleOption(settings.Scramble, settings.ScrambleKey))
+	}
+	var removed []string // directives removed from the provider configuration
+	if settings.Compression != "" {
+		options = append(options, compressionOptions(settings.Compression)...)
+		removed = append(removed, "comp-lzo", "compress")
+	}
+	if settings.RouteNoPull {
+		// only the routes set by the user go through the tunnel
+		options = append(options, "route-nopull")
+		removed = append(removed, "redirect-gateway")
+	}
+	if settings.CredentialsFilepath != "" {
+		// OpenVPN re-reads the auth file on reconnection
+		options = append(options, "auth-nocache")
+	}
+	if settings.PersistTun {
+		options = append(options, "persist-tun")
+	}
+	if settings.CredentialsFilepath != "" || settings.PersistTun {
+		// OpenVPN is signaled using its PID to reconnect without full restart.
+		options = append(options, "writepid "+string(constants.OpenVPNPID))
+	}
+	if len(options) == 0 && len(removed) == 0 {
+		return lines
+	}
+
+	customized = make([]string, 0, len(lines)+len(options))
+	inserted := false
+	for _, line := range lines {
+		if isOverridden(line, options) || isOverridden(line, removed) {
+			continue
+		}
+		if !inserted && strings.HasPrefix(line, "<") { // before inline files
+			customized = append(customized, options...)
+			inserted = true
+		}
+		customized = append(customized, line)
+	}
+	if !inserted {
+		customized = append(customized, options...)
+	}
+	return customized
+}
+
+// isOverridden returns true if the line sets one of the options given.
+func isOverridden(line string, options []string) bool {
+	for _, option := range options {
+		name := strings.Fields(option)[0]
+		if line == name || strings.HasPrefix(line, name+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// scrambleOption returns the scramble directive of the XOR patch, where
+// only the xormask and obfuscate methods take a key.
+func scrambleOption(method, key string) string {
+	switch method {
+	case constants.OpenVPNScrambleXormask, constants.OpenVPNScrambleObfuscate:
+		return "scramble " + method + " " + key
+	default:
+		return "scramble " + method
+	}
+}
+
+// compressionOptions returns the directives for the compression setting,
+// which replace the compression directives of the provider.
+func compressionOptions(compression string) (options []string) {
+	switch compression {
+	case constants.OpenVPNCompressionLZO:
+		return []string{"comp-lzo yes"}
+	case constants.OpenVPNCompressionLZ4V2:
+		return []string{"compress lz4-v2"}
+	case constants.OpenVPNCompressionStub:
+		return []string{"compress stub"}
+	default: // off or not set
+		return nil
+	}
+}
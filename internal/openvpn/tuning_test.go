@@ -0,0 +1,109 @@
+package openvpn
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_customizeConf(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		lines      []string
+		settings   settings.OpenVPN
+		customized []string
+	}{
+		"no customization": {
+			lines:      []string{"client", "tls-cipher TLS-A"},
+			customized: []string{"client", "tls-cipher TLS-A"},
+		},
+		"replace and add before inline files": {
+			lines: []string{"client", "tls-cipher TLS-A", "ncp-ciphers AES-256-GCM", "<ca>", "x", "</ca>"},
+			settings: settings.OpenVPN{
+				TLSVersionMin: "1.2",
+				DataCiphers:   []string{"aes-256-gcm", "aes-128-gcm"},
+				TLSCipher:     "TLS-B:TLS-C",
+			},
+			customized: []string{
+				"client",
+				"tls-version-min 1.2",
+				"ncp-ciphers aes-256-gcm:aes-128-gcm",
+				"tls-cipher TLS-B:TLS-C",
+				"<ca>", "x", "</ca>",
+			},
+		},
+		"add at the end without inline files": {
+			lines: []string{"client"},
+			settings: settings.OpenVPN{
+				TLSVersionMin: "1.3",
+			},
+			customized: []string{"client", "tls-version-min 1.3"},
+		},
+		"interface name": {
+			lines: []string{"client", "dev tun", "nobind", "<ca>"},
+			settings: settings.OpenVPN{
+				Interface: "vpn0",
+			},
+			customized: []string{"client", "nobind", "dev vpn0", "dev-type tun", "<ca>"},
+		},
+		"scramble with key": {
+			lines: []string{"client", "scramble reverse", "<ca>"},
+			settings: settings.OpenVPN{
+				Scramble:    "obfuscate",
+				ScrambleKey: "secret",
+			},
+			customized: []string{"client", "scramble obfuscate secret", "<ca>"},
+		},
+		"scramble without key": {
+			lines: []string{"client"},
+			settings: settings.OpenVPN{
+				Scramble: "xorptrpos",
+			},
+			customized: []string{"client", "scramble xorptrpos"},
+		},
+		"credentials file": {
+			lines: []string{"client", "auth-nocache", "<ca>"},
+			settings: settings.OpenVPN{
+				CredentialsFilepath: "/gluetun/credentials",
+			},
+			customized: []string{"client", "auth-nocache", "writepid /etc/openvpn/openvpn.pid", "<ca>"},
+		},
+		"persist tun": {
+			lines: []string{"client", "persist-tun", "persist-key", "<ca>"},
+			settings: settings.OpenVPN{
+				PersistTun: true,
+			},
+			customized: []string{"client", "persist-key", "persist-tun", "writepid /etc/openvpn/openvpn.pid", "<ca>"},
+		},
+		"compression replaced": {
+			lines: []string{"client", "comp-lzo", "<ca>"},
+			settings: settings.OpenVPN{
+				Compression: "lz4-v2",
+			},
+			customized: []string{"client", "compress lz4-v2", "<ca>"},
+		},
+		"route-nopull": {
+			lines: []string{"client", "redirect-gateway def1", "<ca>"},
+			settings: settings.OpenVPN{
+				RouteNoPull: true,
+			},
+			customized: []string{"client", "route-nopull", "<ca>"},
+		},
+		"compression off": {
+			lines: []string{"client", "comp-lzo no", "compress", "<ca>"},
+			settings: settings.OpenVPN{
+				Compression: "off",
+			},
+			customized: []string{"client", "<ca>"},
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			customized := customizeConf(tc.lines, tc.settings)
+			assert.Equal(t, tc.customized, customized)
+		})
+	}
+}
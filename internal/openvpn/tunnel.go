@@ -0,0 +1,70 @@
+package openvpn
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// Tunnel is an extra OpenVPN tunnel, connected alongside the main tunnel
+// using one of the profiles.
+type Tunnel struct {
+	// Number is the number of the tunnel, starting at 1
+	// for the first extra tunnel.
+	Number int
+	// Interface is the name of the tunnel interface.
+	Interface string
+	// Profile is the name of the profile used to connect the tunnel.
+	Profile string
+}
+
+// tunnelFiles are the file paths used by an OpenVPN process,
+// each extra tunnel using files of its own.
+type tunnelFiles struct {
+	conf string
+	auth string
+	pid  string
+}
+
+// newTunnelFiles returns the file paths of the tunnel number given,
+// where 0 is the main tunnel and the extra tunnels start at 1.
+func newTunnelFiles(number int) tunnelFiles {
+	if number == 0 {
+		return tunnelFiles{
+			conf: string(constants.OpenVPNConf),
+			auth: string(constants.OpenVPNAuthConf),
+			pid:  string(constants.OpenVPNPID),
+		}
+	}
+	return tunnelFiles{
+		conf: numberedPath(constants.OpenVPNConf, number),
+		auth: numberedPath(constants.OpenVPNAuthConf, number),
+		pid:  numberedPath(constants.OpenVPNPID, number),
+	}
+}
+
+// numberedPath returns the path given with the number inserted
+// before its extension, such as /etc/openvpn/target1.ovpn.
+func numberedPath(path models.Filepath, number int) string {
+	extension := filepath.Ext(string(path))
+	return strings.TrimSuffix(string(path), extension) + strconv.Itoa(number) + extension
+}
+
+// tunnelConf adapts the OpenVPN configuration lines of an extra
+// tunnel so it uses its own auth and PID files.
+func tunnelConf(lines []string, files tunnelFiles) (customized []string) {
+	customized = make([]string, len(lines))
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "auth-user-pass "):
+			line = "auth-user-pass " + files.auth
+		case strings.HasPrefix(line, "writepid "):
+			line = "writepid " + files.pid
+		}
+		customized[i] = line
+	}
+	return customized
+}
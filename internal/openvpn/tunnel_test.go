@@ -0,0 +1,59 @@
+package openvpn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newTunnelFiles(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		number int
+		files  tunnelFiles
+	}{
+		"main tunnel": {
+			files: tunnelFiles{
+				conf: "/etc/openvpn/target.ovpn",
+				auth: "/etc/openvpn/auth.conf",
+				pid:  "/etc/openvpn/openvpn.pid",
+			},
+		},
+		"extra tunnel": {
+			number: 2,
+			files: tunnelFiles{
+				conf: "/etc/openvpn/target2.ovpn",
+				auth: "/etc/openvpn/auth2.conf",
+				pid:  "/etc/openvpn/openvpn2.pid",
+			},
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			files := newTunnelFiles(tc.number)
+			assert.Equal(t, tc.files, files)
+		})
+	}
+}
+
+func Test_tunnelConf(t *testing.T) {
+	t.Parallel()
+	lines := []string{
+		"client",
+		"auth-user-pass /etc/openvpn/auth.conf",
+		"writepid /etc/openvpn/openvpn.pid",
+		"<ca>", "x", "</ca>",
+	}
+	expected := []string{
+		"client",
+		"auth-user-pass /etc/openvpn/auth1.conf",
+		"writepid /etc/openvpn/openvpn1.pid",
+		"<ca>", "x", "</ca>",
+	}
+
+	customized := tunnelConf(lines, newTunnelFiles(1))
+
+	assert.Equal(t, expected, customized)
+}
@@ -0,0 +1,51 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_extractOpenVPNStaticKey(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		b   []byte
+		key string
+		err string
+	}{
+		"no key": {
+			b:   []byte("abc"),
+			err: "cannot find OpenVPN static key",
+		},
+		"key with comments": {
+			b: []byte(`#
+# 2048 bit OpenVPN static key
+#
+-----BEGIN OpenVPN Static key V1-----
+e30af995f56d0742
+6d9ba1f824730521
+-----END OpenVPN Static key V1-----
+`),
+			key: "e30af995f56d07426d9ba1f824730521",
+		},
+		"key with carriage returns": {
+			b:   []byte("-----BEGIN OpenVPN Static key V1-----\r\nab\r\ncd\r\n-----END OpenVPN Static key V1-----"),
+			key: "abcd",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			key, err := extractOpenVPNStaticKey(testCase.b)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.key, key)
+		})
+	}
+}
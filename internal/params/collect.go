@@ -0,0 +1,159 @@
+package params
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+func (r *reader) CollectErrors() (stop func() (errs []error)) {
+	collector := &errorsCollector{
+		EnvParams: r.envParams,
+		lookupEnv: r.lookupEnv,
+	}
+	r.envParams = collector
+	r.collector = collector
+	return func() (errs []error) {
+		r.envParams = collector.EnvParams
+		r.collector = nil
+		return collector.errs
+	}
+}
+
+func (r *reader) CollectError(err error) error {
+	if err == nil || r.collector == nil {
+		return err
+	}
+	r.collector.record(err)
+	return nil
+}
+
+// errorsCollector records the error of each invalid environment variable
+// and returns its default value instead, so all the invalid variables
+// are reported at once.
+type errorsCollector struct {
+	libparams.EnvParams
+	lookupEnv func(key string) (value string, ok bool)
+	errs      []error
+	// seen contains the error messages recorded, for variables read
+	// more than once to be reported once.
+	seen map[string]struct{}
+}
+
+func (c *errorsCollector) record(err error) {
+	if c.seen == nil {
+		c.seen = make(map[string]struct{})
+	}
+	if _, seen := c.seen[err.Error()]; !seen {
+		c.seen[err.Error()] = struct{}{}
+		c.errs = append(c.errs, err)
+	}
+}
+
+// collect records the error for the environment variable key and calls
+// get, which reads defaultKey instead of key, to obtain the default value
+// without modifying the environment.
+func (c *errorsCollector) collect(key string, err error, get func()) {
+	value, ok := c.lookupEnv(key)
+	if ok && value != "" && !strings.Contains(err.Error(), value) {
+		err = fmt.Errorf("%w (value is %q)", err, value)
+	}
+	c.record(err)
+	get()
+}
+
+// defaultKey is the name of an environment variable which cannot be set,
+// to read the default value of a getter.
+const defaultKey = ""
+
+func (c *errorsCollector) GetEnv(key string, setters ...libparams.GetEnvSetter) (
+	value string, err error) {
+	value, err = c.EnvParams.GetEnv(key, setters...)
+	if err != nil {
+		c.collect(key, err, func() { value, _ = c.EnvParams.GetEnv(defaultKey, setters...) })
+	}
+	return value, nil
+}
+
+func (c *errorsCollector) GetEnvInt(key string, setters ...libparams.GetEnvSetter) (
+	n int, err error) {
+	n, err = c.EnvParams.GetEnvInt(key, setters...)
+	if err != nil {
+		c.collect(key, err, func() { n, _ = c.EnvParams.GetEnvInt(defaultKey, setters...) })
+	}
+	return n, nil
+}
+
+func (c *errorsCollector) GetEnvIntRange(key string, lower, upper int, setters ...libparams.GetEnvSetter) (
+	n int, err error) {
+	n, err = c.EnvParams.GetEnvIntRange(key, lower, upper, setters...)
+	if err != nil {
+		c.collect(key, err, func() { n, _ = c.EnvParams.GetEnvIntRange(defaultKey, lower, upper, setters...) })
+	}
+	return n, nil
+}
+
+func (c *errorsCollector) GetYesNo(key string, setters ...libparams.GetEnvSetter) (
+	yes bool, err error) {
+	yes, err = c.EnvParams.GetYesNo(key, setters...)
+	if err != nil {
+		c.collect(key, err, func() { yes, _ = c.EnvParams.GetYesNo(defaultKey, setters...) })
+	}
+	return yes, nil
+}
+
+func (c *errorsCollector) GetOnOff(key string, setters ...libparams.GetEnvSetter) (
+	on bool, err error) {
+	on, err = c.EnvParams.GetOnOff(key, setters...)
+	if err != nil {
+		c.collect(key, err, func() { on, _ = c.EnvParams.GetOnOff(defaultKey, setters...) })
+	}
+	return on, nil
+}
+
+func (c *errorsCollector) GetValueIfInside(key string, possibilities []string,
+	setters ...libparams.GetEnvSetter) (value string, err error) {
+	value, err = c.EnvParams.GetValueIfInside(key, possibilities, setters...)
+	if err != nil {
+		c.collect(key, err, func() { value, _ = c.EnvParams.GetValueIfInside(defaultKey, possibilities, setters...) })
+	}
+	return value, nil
+}
+
+func (c *errorsCollector) GetCSVInPossibilities(key string, possibilities []string,
+	setters ...libparams.GetEnvSetter) (values []string, err error) {
+	values, err = c.EnvParams.GetCSVInPossibilities(key, possibilities, setters...)
+	if err != nil {
+		c.collect(key, err, func() { values, _ = c.EnvParams.GetCSVInPossibilities(defaultKey, possibilities, setters...) })
+	}
+	return values, nil
+}
+
+func (c *errorsCollector) GetDuration(key string, setters ...libparams.GetEnvSetter) (
+	duration time.Duration, err error) {
+	duration, err = c.EnvParams.GetDuration(key, setters...)
+	if err != nil {
+		c.collect(key, err, func() { duration, _ = c.EnvParams.GetDuration(defaultKey, setters...) })
+	}
+	return duration, nil
+}
+
+func (c *errorsCollector) GetPort(key string, setters ...libparams.GetEnvSetter) (
+	port uint16, err error) {
+	port, err = c.EnvParams.GetPort(key, setters...)
+	if err != nil {
+		c.collect(key, err, func() { port, _ = c.EnvParams.GetPort(defaultKey, setters...) })
+	}
+	return port, nil
+}
+
+func (c *errorsCollector) GetPath(key string, setters ...libparams.GetEnvSetter) (
+	path string, err error) {
+	path, err = c.EnvParams.GetPath(key, setters...)
+	if err != nil {
+		c.collect(key, err, func() { path, _ = c.EnvParams.GetPath(defaultKey, setters...) })
+	}
+	return path, nil
+}
@@ -0,0 +1,85 @@
+package params
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	libparams "github.com/qdm12/golibs/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_reader_CollectErrors(t *testing.T) {
+	t.Parallel()
+	env := map[string]string{
+		"FIREWALL": "yes",
+		"PERIOD":   "abc",
+	}
+	envParams := &fakeEnvParams{env: env}
+	r := &reader{
+		envParams: envParams,
+		lookupEnv: func(key string) (value string, ok bool) {
+			value, ok = env[key]
+			return value, ok
+		},
+	}
+
+	stop := r.CollectErrors()
+
+	// invalid variables are read with their default value
+	for i := 0; i < 2; i++ { // errors are reported once
+		enabled, err := r.GetFirewall()
+		assert.NoError(t, err)
+		assert.True(t, enabled)
+	}
+	period, err := r.envParams.GetDuration("PERIOD", libparams.Default("1h"))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, period)
+	user, err := r.envParams.GetEnv("USER", libparams.Compulsory())
+	assert.NoError(t, err)
+	assert.Empty(t, user)
+	err = r.CollectError(errors.New("settings are not consistent"))
+	assert.NoError(t, err)
+
+	errs := stop()
+	require.Len(t, errs, 4)
+	assert.Equal(t, `environment variable "FIREWALL" value is "yes" and can only be "on" or "off"`, errs[0].Error())
+	assert.Equal(t, `environment variable "PERIOD" duration value cannot be 0 (value is "abc")`, errs[1].Error())
+	assert.Equal(t, `no value found for environment variable "USER"`, errs[2].Error())
+	assert.Equal(t, "settings are not consistent", errs[3].Error())
+	assert.Equal(t, map[string]string{"FIREWALL": "yes", "PERIOD": "abc"}, env)
+	assert.Equal(t, envParams, r.envParams)
+	err = r.CollectError(errors.New("settings are not consistent"))
+	assert.EqualError(t, err, "settings are not consistent")
+}
+
+// fakeEnvParams implements the EnvParams methods used by the test.
+type fakeEnvParams struct {
+	libparams.EnvParams
+	env map[string]string
+}
+
+func (f *fakeEnvParams) GetEnv(key string, setters ...libparams.GetEnvSetter) (string, error) {
+	value, ok := f.env[key]
+	if !ok {
+		return "", fmt.Errorf("no value found for environment variable %q", key)
+	}
+	return value, nil
+}
+
+func (f *fakeEnvParams) GetOnOff(key string, setters ...libparams.GetEnvSetter) (bool, error) {
+	value, ok := f.env[key]
+	if !ok {
+		return true, nil // default
+	}
+	return false, fmt.Errorf(`environment variable %q value is %q and can only be "on" or "off"`, key, value)
+}
+
+func (f *fakeEnvParams) GetDuration(key string, setters ...libparams.GetEnvSetter) (time.Duration, error) {
+	if _, ok := f.env[key]; !ok {
+		return time.Hour, nil // default
+	}
+	return 0, fmt.Errorf("environment variable %q duration value cannot be 0", key)
+}
@@ -0,0 +1,161 @@
+package params
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/qdm12/golibs/logging"
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// deprecatedKey is an old environment variable name replaced by newKey.
+type deprecatedKey struct {
+	oldKey string
+	newKey string
+	// toOnOff converts the old value of an on/off key, returning ok as
+	// false if the value is not converted, and is nil to keep the value
+	// as it is.
+	toOnOff func(oldValue string) (on, ok bool)
+}
+
+// deprecatedKeys are ordered by precedence for the same new key.
+var deprecatedKeys = []deprecatedKey{ //nolint:gochecknoglobals
+	{oldKey: "BLOCK_NSA", newKey: "BLOCK_SURVEILLANCE"},
+	{oldKey: "ENCRYPTION", newKey: "PIA_ENCRYPTION"},
+	{oldKey: "PORT_FORWARDING", newKey: "PORT_FORWARDING", toOnOff: convertBoolToOnOff},
+	{oldKey: "EXTRA_SUBNETS", newKey: "FIREWALL_OUTBOUND_SUBNETS"},
+	{oldKey: "TINYPROXY", newKey: "HTTPPROXY"},
+	{oldKey: "PROXY", newKey: "HTTPPROXY"},
+	{oldKey: "PROXY_LOG_LEVEL", newKey: "HTTPPROXY_LOG", toOnOff: convertProxyLogLevel},
+	{oldKey: "TINYPROXY_LOG", newKey: "HTTPPROXY_LOG", toOnOff: convertProxyLogLevel},
+	{oldKey: "TINYPROXY_PORT", newKey: "HTTPPROXY_PORT"},
+	{oldKey: "PROXY_PORT", newKey: "HTTPPROXY_PORT"},
+	{oldKey: "TINYPROXY_USER", newKey: "HTTPPROXY_USER"},
+	{oldKey: "PROXY_USER", newKey: "HTTPPROXY_USER"},
+	{oldKey: "TINYPROXY_PASSWORD", newKey: "HTTPPROXY_PASSWORD"},
+	{oldKey: "PROXY_PASSWORD", newKey: "HTTPPROXY_PASSWORD"},
+}
+
+// deprecatedEnvParams reads the first deprecated environment variable set
+// instead of its new key if the new key is not set, so getters only use the
+// current names. The new key takes precedence over its deprecated keys.
+// The deprecated keys are only resolved for the methods overridden below.
+type deprecatedEnvParams struct {
+	libparams.EnvParams
+	lookupEnv      func(key string) (value string, ok bool)
+	logger         logging.Logger
+	deprecatedKeys []deprecatedKey
+	// warned contains the warnings logged, for keys read
+	// more than once to be warned about once.
+	warned      map[string]struct{}
+	warnedMutex sync.Mutex
+}
+
+func newDeprecatedEnvParams(envParams libparams.EnvParams, lookupEnv func(key string) (value string, ok bool),
+	logger logging.Logger, deprecatedKeys []deprecatedKey) *deprecatedEnvParams {
+	return &deprecatedEnvParams{
+		EnvParams:      envParams,
+		lookupEnv:      lookupEnv,
+		logger:         logger,
+		deprecatedKeys: deprecatedKeys,
+		warned:         make(map[string]struct{}),
+	}
+}
+
+func (d *deprecatedEnvParams) warnOnce(format string, args ...interface{}) {
+	d.warnedMutex.Lock()
+	defer d.warnedMutex.Unlock()
+	message := fmt.Sprintf(format, args...)
+	if _, ok := d.warned[message]; ok {
+		return
+	}
+	d.warned[message] = struct{}{}
+	d.logger.Warn(message)
+}
+
+// resolve returns the key to read for the key given, which is the first
+// deprecated key set if the key is not set, and the conversion of its value
+// to on or off, which is nil if its value is kept as it is.
+func (d *deprecatedEnvParams) resolve(key string) (keyToRead string, toOnOff func(value string) (on, ok bool)) {
+	keyToRead = key
+	value, _ := d.lookupEnv(key)
+	for _, deprecated := range d.deprecatedKeys {
+		if deprecated.newKey != key {
+			continue
+		}
+		if deprecated.oldKey == key { // only the value is deprecated
+			if value != "" && keyToRead == key {
+				toOnOff = deprecated.toOnOff
+			}
+			continue
+		}
+		if oldValue, _ := d.lookupEnv(deprecated.oldKey); oldValue == "" {
+			continue
+		}
+		switch {
+		case value != "":
+			d.warnOnce("deprecated environment variable: old=%s new=%s ignored=true reason=%q",
+				deprecated.oldKey, key, key+" is set")
+		case keyToRead != key:
+			d.warnOnce("deprecated environment variable: old=%s new=%s ignored=true reason=%q",
+				deprecated.oldKey, key, keyToRead+" is already set")
+		default:
+			d.warnOnce("deprecated environment variable: old=%s new=%s", deprecated.oldKey, key)
+			keyToRead = deprecated.oldKey
+			toOnOff = deprecated.toOnOff
+		}
+	}
+	return keyToRead, toOnOff
+}
+
+func (d *deprecatedEnvParams) GetOnOff(key string, setters ...libparams.GetEnvSetter) (on bool, err error) {
+	keyToRead, toOnOff := d.resolve(key)
+	if toOnOff != nil {
+		value, _ := d.lookupEnv(keyToRead)
+		var ok bool
+		if on, ok = toOnOff(value); ok {
+			if keyToRead == key {
+				d.warnOnce("deprecated value: key=%s value=%q", key, value)
+			}
+			return on, nil
+		}
+	}
+	return d.EnvParams.GetOnOff(keyToRead, setters...)
+}
+
+func (d *deprecatedEnvParams) GetEnv(key string, setters ...libparams.GetEnvSetter) (value string, err error) {
+	key, _ = d.resolve(key)
+	return d.EnvParams.GetEnv(key, setters...)
+}
+
+func (d *deprecatedEnvParams) GetPort(key string, setters ...libparams.GetEnvSetter) (port uint16, err error) {
+	key, _ = d.resolve(key)
+	return d.EnvParams.GetPort(key, setters...)
+}
+
+func (d *deprecatedEnvParams) GetValueIfInside(key string, possibilities []string,
+	setters ...libparams.GetEnvSetter) (value string, err error) {
+	key, _ = d.resolve(key)
+	return d.EnvParams.GetValueIfInside(key, possibilities, setters...)
+}
+
+func convertBoolToOnOff(value string) (on, ok bool) {
+	switch strings.ToLower(value) {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func convertProxyLogLevel(value string) (on, ok bool) {
+	switch strings.ToLower(value) {
+	case "info", "connect", "notice":
+		return true, true
+	default:
+		return false, true
+	}
+}
@@ -0,0 +1,148 @@
+package params
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	libparams "github.com/qdm12/golibs/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_deprecatedEnvParams(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		env      map[string]string
+		key      string
+		onOff    bool
+		value    string
+		on       bool
+		err      string
+		warnings []string
+	}{
+		"new key only": {
+			env:   map[string]string{"HTTPPROXY_PORT": "8000"},
+			key:   "HTTPPROXY_PORT",
+			value: "8000",
+		},
+		"deprecated key only": {
+			env:      map[string]string{"HTTPPROXY_PORT": "", "TINYPROXY_PORT": "8000"},
+			key:      "HTTPPROXY_PORT",
+			value:    "8000",
+			warnings: []string{"deprecated environment variable: old=TINYPROXY_PORT new=HTTPPROXY_PORT"},
+		},
+		"new key takes precedence": {
+			env:   map[string]string{"HTTPPROXY_PORT": "8000", "TINYPROXY_PORT": "9000"},
+			key:   "HTTPPROXY_PORT",
+			value: "8000",
+			warnings: []string{`deprecated environment variable: old=TINYPROXY_PORT new=HTTPPROXY_PORT ` +
+				`ignored=true reason="HTTPPROXY_PORT is set"`},
+		},
+		"first deprecated key takes precedence": {
+			env:   map[string]string{"TINYPROXY_PORT": "8000", "PROXY_PORT": "9000"},
+			key:   "HTTPPROXY_PORT",
+			value: "8000",
+			warnings: []string{
+				"deprecated environment variable: old=TINYPROXY_PORT new=HTTPPROXY_PORT",
+				`deprecated environment variable: old=PROXY_PORT new=HTTPPROXY_PORT ` +
+					`ignored=true reason="TINYPROXY_PORT is already set"`,
+			},
+		},
+		"invalid deprecated value": {
+			env:      map[string]string{"BLOCK_NSA": "yes"},
+			key:      "BLOCK_SURVEILLANCE",
+			onOff:    true,
+			err:      `environment variable "BLOCK_NSA" value is "yes" and can only be "on" or "off"`,
+			warnings: []string{"deprecated environment variable: old=BLOCK_NSA new=BLOCK_SURVEILLANCE"},
+		},
+		"deprecated value": {
+			env:      map[string]string{"PORT_FORWARDING": "true"},
+			key:      "PORT_FORWARDING",
+			onOff:    true,
+			on:       true,
+			warnings: []string{`deprecated value: key=PORT_FORWARDING value="true"`},
+		},
+		"current value": {
+			env:   map[string]string{"PORT_FORWARDING": "on"},
+			key:   "PORT_FORWARDING",
+			onOff: true,
+			on:    true,
+		},
+		"converted deprecated key": {
+			env:   map[string]string{"TINYPROXY_LOG": "", "PROXY_LOG_LEVEL": "Info"},
+			key:   "HTTPPROXY_LOG",
+			onOff: true,
+			on:    true,
+			warnings: []string{
+				"deprecated environment variable: old=PROXY_LOG_LEVEL new=HTTPPROXY_LOG",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			for _, warning := range testCase.warnings {
+				logger.EXPECT().Warn(warning)
+			}
+			lookupEnv := func(key string) (value string, ok bool) {
+				value, ok = testCase.env[key]
+				return value, ok
+			}
+			d := newDeprecatedEnvParams(&onOffEnvParams{env: testCase.env}, lookupEnv, logger, deprecatedKeys)
+
+			var value string
+			var on bool
+			var err error
+			for i := 0; i < 2; i++ { // warnings are logged once
+				if testCase.onOff {
+					on, err = d.GetOnOff(testCase.key)
+				} else {
+					var port uint16
+					port, err = d.GetPort(testCase.key)
+					value = strconv.Itoa(int(port))
+				}
+			}
+
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			if testCase.onOff {
+				assert.Equal(t, testCase.on, on)
+			} else {
+				assert.Equal(t, testCase.value, value)
+			}
+		})
+	}
+}
+
+// onOffEnvParams implements the EnvParams methods used by the test.
+type onOffEnvParams struct {
+	libparams.EnvParams
+	env map[string]string
+}
+
+func (o *onOffEnvParams) GetOnOff(key string, setters ...libparams.GetEnvSetter) (bool, error) {
+	switch value := o.env[key]; value {
+	case "on":
+		return true, nil
+	case "off", "":
+		return false, nil
+	default:
+		return false, fmt.Errorf(`environment variable %q value is %q and can only be "on" or "off"`, key, value)
+	}
+}
+
+func (o *onOffEnvParams) GetPort(key string, setters ...libparams.GetEnvSetter) (uint16, error) {
+	port, err := strconv.Atoi(o.env[key])
+	return uint16(port), err
+}
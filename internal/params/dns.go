@@ -3,6 +3,7 @@ package params
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"strings"
 	"time"
 
@@ -25,19 +26,68 @@ func (r *reader) GetDNSOverTLSProviders() (providers []models.DNSProvider, err e
 		return nil, err
 	}
 	for _, word := range strings.Split(s, ",") {
-		provider := models.DNSProvider(word)
-		switch provider {
-		case constants.Cloudflare, constants.Google, constants.Quad9,
-			constants.Quadrant, constants.CleanBrowsing, constants.SecureDNS,
-			constants.LibreDNS:
-			providers = append(providers, provider)
-		default:
-			return nil, fmt.Errorf("DNS over TLS provider %q is not valid", provider)
+		provider, err := parseDNSProvider(word)
+		if err != nil {
+			return nil, r.CollectError(err)
 		}
+		providers = append(providers, provider)
 	}
 	return providers, nil
 }
 
+// GetDNSOverTLSDomainProviders obtains the DNS over TLS providers to use for
+// specific domains and their subdomains, instead of the DOT_PROVIDERS providers,
+// from the environment variable DOT_DOMAIN_PROVIDERS.
+func (r *reader) GetDNSOverTLSDomainProviders() (domainProviders []models.DNSDomainProviders, err error) {
+	s, err := r.envParams.GetEnv("DOT_DOMAIN_PROVIDERS")
+	if err != nil || s == "" {
+		return nil, err
+	}
+	domainProviders, err = parseDomainProviders(s)
+	if err != nil {
+		return nil, r.CollectError(err)
+	}
+	return domainProviders, nil
+}
+
+// parseDomainProviders parses comma separated entries of the form
+// domain=provider, where a domain can be repeated to use several providers.
+func parseDomainProviders(s string) (domainProviders []models.DNSDomainProviders, err error) {
+	indexes := make(map[string]int)
+	for _, entry := range strings.Split(s, ",") {
+		const expectedParts = 2
+		parts := strings.Split(entry, "=")
+		if len(parts) != expectedParts || parts[0] == "" {
+			return nil, fmt.Errorf("DNS domain provider %q is not of the form domain=provider", entry)
+		}
+		domain := strings.TrimSuffix(strings.ToLower(parts[0]), ".")
+		provider, err := parseDNSProvider(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		i, ok := indexes[domain]
+		if !ok {
+			i = len(domainProviders)
+			indexes[domain] = i
+			domainProviders = append(domainProviders, models.DNSDomainProviders{Domain: domain})
+		}
+		domainProviders[i].Providers = append(domainProviders[i].Providers, provider)
+	}
+	return domainProviders, nil
+}
+
+func parseDNSProvider(s string) (provider models.DNSProvider, err error) {
+	provider = models.DNSProvider(s)
+	switch provider {
+	case constants.Cloudflare, constants.Google, constants.Quad9,
+		constants.Quadrant, constants.CleanBrowsing, constants.SecureDNS,
+		constants.LibreDNS:
+		return provider, nil
+	default:
+		return "", fmt.Errorf("DNS over TLS provider %q is not valid", provider)
+	}
+}
+
 // GetDNSOverTLSVerbosity obtains the verbosity level to use for Unbound
 // from the environment variable DOT_VERBOSITY.
 func (r *reader) GetDNSOverTLSVerbosity() (verbosityLevel uint8, err error) {
@@ -66,17 +116,8 @@ func (r *reader) GetDNSMaliciousBlocking() (blocking bool, err error) {
 }
 
 // GetDNSSurveillanceBlocking obtains if surveillance hostnames/IPs should be blocked
-// from being resolved by Unbound, using the environment variable BLOCK_SURVEILLANCE
-// and BLOCK_NSA for retrocompatibility.
+// from being resolved by Unbound, using the environment variable BLOCK_SURVEILLANCE.
 func (r *reader) GetDNSSurveillanceBlocking() (blocking bool, err error) {
-	// Retro-compatibility
-	s, err := r.envParams.GetEnv("BLOCK_NSA")
-	if err != nil {
-		return false, err
-	} else if len(s) != 0 {
-		r.logger.Warn("You are using the old environment variable BLOCK_NSA, please consider changing it to BLOCK_SURVEILLANCE") //nolint:lll
-		return r.envParams.GetOnOff("BLOCK_NSA", libparams.Compulsory())
-	}
 	return r.envParams.GetOnOff("BLOCK_SURVEILLANCE", libparams.Default("off"))
 }
 
@@ -98,18 +139,216 @@ func (r *reader) GetDNSUnblockedHostnames() (hostnames []string, err error) {
 	hostnames = strings.Split(s, ",")
 	for _, hostname := range hostnames {
 		if !r.verifier.MatchHostname(hostname) {
-			return nil, fmt.Errorf("hostname %q does not seem valid", hostname)
+			return nil, r.CollectError(fmt.Errorf("hostname %q does not seem valid", hostname))
+		}
+	}
+	return hostnames, nil
+}
+
+// GetDNSBlockPatterns obtains a list of hostname patterns to block in addition
+// to the block lists from the comma separated list for the environment variable
+// BLOCK_PATTERNS. A pattern is a domain, a domain prefixed with *., a wildcard
+// pattern or a regular expression between slashes.
+func (r *reader) GetDNSBlockPatterns() (patterns []models.DNSBlockPattern, err error) {
+	s, err := r.envParams.GetEnv("BLOCK_PATTERNS")
+	if err != nil {
+		return nil, err
+	} else if len(s) == 0 {
+		return nil, nil
+	}
+	for _, word := range strings.Split(s, ",") {
+		pattern, err := parseBlockPattern(word)
+		if err != nil {
+			return nil, r.CollectError(err)
+		}
+		if pattern.Zone != "" && !r.verifier.MatchHostname(pattern.Zone) {
+			if pattern.Regex == nil {
+				return nil, r.CollectError(fmt.Errorf("block pattern %q: hostname %q does not seem valid", word, pattern.Zone))
+			}
+			pattern.Zone = "" // only matched against the block lists hostnames
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+func parseBlockPattern(s string) (pattern models.DNSBlockPattern, err error) {
+	pattern.Pattern = s
+	const minRegexLength = 3
+	switch {
+	case len(s) >= minRegexLength && strings.HasPrefix(s, "/") && strings.HasSuffix(s, "/"):
+		pattern.Regex, err = regexp.Compile(s[1 : len(s)-1])
+		if err != nil {
+			return pattern, fmt.Errorf("block pattern %q: %w", s, err)
+		}
+	case strings.HasPrefix(s, "*.") && !strings.Contains(s[2:], "*"):
+		// Unbound local zones already block subdomains
+		pattern.Zone = strings.ToLower(s[2:])
+	case strings.Contains(s, "*"):
+		parts := strings.Split(strings.ToLower(s), "*")
+		for i := range parts {
+			parts[i] = regexp.QuoteMeta(parts[i])
+		}
+		pattern.Regex = regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+		// a domain following a single leading wildcard, such as tracker.com
+		// for *tracker.com, is matched with all its subdomains, so it is
+		// blocked by Unbound as well.
+		if len(parts) == 2 && parts[0] == "" && !strings.HasPrefix(s, "*.") {
+			pattern.Zone = strings.ToLower(s[1:])
+		}
+	case s == "":
+		return pattern, fmt.Errorf("block pattern is empty")
+	default:
+		pattern.Zone = strings.ToLower(s)
+	}
+	return pattern, nil
+}
+
+// GetDNSDockerHostnames obtains a list of hostnames, such as container names,
+// to resolve using the Docker embedded DNS from the comma separated list for
+// the environment variable DNS_DOCKER_HOSTNAMES.
+func (r *reader) GetDNSDockerHostnames() (hostnames []string, err error) {
+	s, err := r.envParams.GetEnv("DNS_DOCKER_HOSTNAMES")
+	if err != nil {
+		return nil, err
+	} else if len(s) == 0 {
+		return nil, nil
+	}
+	hostnames = strings.Split(s, ",")
+	for _, hostname := range hostnames {
+		if !r.verifier.MatchHostname(hostname) {
+			return nil, r.CollectError(fmt.Errorf("hostname %q does not seem valid", hostname))
 		}
 	}
 	return hostnames, nil
 }
 
+// GetDNSLocalTLDs obtains the local top level domains which are never
+// resolved with the DNS over TLS providers, from the comma separated list
+// for the environment variable DNS_LOCAL_TLDS.
+func (r *reader) GetDNSLocalTLDs() (tlds []string, err error) {
+	s, err := r.envParams.GetEnv("DNS_LOCAL_TLDS")
+	if err != nil {
+		return nil, err
+	} else if len(s) == 0 {
+		return nil, nil
+	}
+	for _, tld := range strings.Split(s, ",") {
+		tld = strings.Trim(strings.ToLower(tld), ".")
+		if !r.verifier.MatchHostname(tld) {
+			return nil, r.CollectError(fmt.Errorf("local TLD %q does not seem valid", tld))
+		}
+		tlds = append(tlds, tld)
+	}
+	return tlds, nil
+}
+
+// GetDNSLocalRecords obtains the hostnames to answer locally from the
+// comma separated list of hostname=ip for the environment variable DNS_LOCAL_RECORDS.
+func (r *reader) GetDNSLocalRecords() (records []models.DNSLocalRecord, err error) {
+	s, err := r.envParams.GetEnv("DNS_LOCAL_RECORDS")
+	if err != nil {
+		return nil, err
+	} else if len(s) == 0 {
+		return nil, nil
+	}
+	for _, entry := range strings.Split(s, ",") {
+		record, err := parseLocalRecord(entry)
+		if err != nil {
+			return nil, r.CollectError(err)
+		}
+		if !r.verifier.MatchHostname(record.Hostname) {
+			return nil, r.CollectError(fmt.Errorf("local record %q: hostname %q does not seem valid", entry, record.Hostname))
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func parseLocalRecord(s string) (record models.DNSLocalRecord, err error) {
+	const expectedParts = 2
+	parts := strings.Split(s, "=")
+	if len(parts) != expectedParts || parts[0] == "" {
+		return record, fmt.Errorf("local record %q is not of the form hostname=ip", s)
+	}
+	record.Hostname = strings.TrimSuffix(strings.ToLower(parts[0]), ".")
+	record.IP = net.ParseIP(parts[1])
+	if record.IP == nil {
+		return record, fmt.Errorf("local record %q: %q is not a valid IP address", s, parts[1])
+	}
+	return record, nil
+}
+
+// GetDNSLocalResolver obtains the LAN DNS resolver to forward queries for
+// the local top level domains to, from the environment variable DNS_LOCAL_RESOLVER,
+// or nil if queries for local top level domains are answered only from local records.
+func (r *reader) GetDNSLocalResolver() (ip net.IP, err error) {
+	s, err := r.envParams.GetEnv("DNS_LOCAL_RESOLVER")
+	if err != nil || s == "" {
+		return nil, err
+	}
+	ip = net.ParseIP(s)
+	if ip == nil || ip.To4() == nil {
+		return nil, r.CollectError(fmt.Errorf("DNS local resolver %q is not a valid IPv4 address", s))
+	}
+	return ip.To4(), nil
+}
+
 // GetDNSOverTLSCaching obtains if Unbound caching should be enable or not
 // from the environment variable DOT_CACHING.
 func (r *reader) GetDNSOverTLSCaching() (caching bool, err error) {
 	return r.envParams.GetOnOff("DOT_CACHING", libparams.Default("on"))
 }
 
+// GetDNSOverTLSPrefetch obtains if Unbound should refresh popular cached
+// records before they expire, from the environment variable DOT_PREFETCH.
+func (r *reader) GetDNSOverTLSPrefetch() (prefetch bool, err error) {
+	return r.envParams.GetOnOff("DOT_PREFETCH", libparams.Default("on"))
+}
+
+// GetDNSOverTLSServeExpired obtains if Unbound should answer with expired
+// cached records when the upstream servers do not answer in time,
+// from the environment variable DOT_SERVE_EXPIRED.
+func (r *reader) GetDNSOverTLSServeExpired() (serveExpired bool, err error) {
+	return r.envParams.GetOnOff("DOT_SERVE_EXPIRED", libparams.Default("off"))
+}
+
+// GetDNSOverTLSServeExpiredTTL obtains for how long after their expiry
+// cached records can be served, from the environment variable
+// DOT_SERVE_EXPIRED_TTL, where 0 means without limit.
+func (r *reader) GetDNSOverTLSServeExpiredTTL() (ttl time.Duration, err error) {
+	return r.getDurationAllowZero("DOT_SERVE_EXPIRED_TTL", "24h")
+}
+
+// GetDNSOverTLSConnectionReuse obtains if Unbound should send several queries
+// over each TLS connection to the upstream servers, from the environment
+// variable DOT_CONNECTION_REUSE.
+func (r *reader) GetDNSOverTLSConnectionReuse() (reuse bool, err error) {
+	return r.envParams.GetOnOff("DOT_CONNECTION_REUSE", libparams.Default("on"))
+}
+
+// GetDNSOverTLSConnectionIdleTimeout obtains for how long an idle TLS connection
+// to an upstream server is kept open for reuse, from the environment variable
+// DOT_CONNECTION_IDLE_TIMEOUT.
+func (r *reader) GetDNSOverTLSConnectionIdleTimeout() (timeout time.Duration, err error) {
+	return r.envParams.GetDuration("DOT_CONNECTION_IDLE_TIMEOUT", libparams.Default("1m"))
+}
+
+// GetDNSOverTLSMaxConnections obtains the maximum number of simultaneous TLS
+// connections to the upstream servers per Unbound thread, including the ones
+// kept open for reuse, from the environment variable DOT_MAX_CONNECTIONS.
+func (r *reader) GetDNSOverTLSMaxConnections() (maxConnections int, err error) {
+	const maxValue = 1000
+	return r.envParams.GetEnvIntRange("DOT_MAX_CONNECTIONS", 1, maxValue, libparams.Default("10"))
+}
+
+// GetDNSOverTLSQueryTimeout obtains the timeout of a query over TLS to an
+// upstream server, including establishing the connection, from the environment
+// variable DOT_QUERY_TIMEOUT.
+func (r *reader) GetDNSOverTLSQueryTimeout() (timeout time.Duration, err error) {
+	return r.envParams.GetDuration("DOT_QUERY_TIMEOUT", libparams.Default("3s"))
+}
+
 // GetDNSOverTLSPrivateAddresses obtains if Unbound caching should be enable or not
 // from the environment variable DOT_PRIVATE_ADDRESS.
 func (r *reader) GetDNSOverTLSPrivateAddresses() (privateAddresses []string, err error) {
@@ -124,7 +363,7 @@ func (r *reader) GetDNSOverTLSPrivateAddresses() (privateAddresses []string, err
 		ip := net.ParseIP(address)
 		_, _, err := net.ParseCIDR(address)
 		if ip == nil && err != nil {
-			return nil, fmt.Errorf("private address %q is not a valid IP or CIDR range", address)
+			return nil, r.CollectError(fmt.Errorf("private address %q is not a valid IP or CIDR range", address))
 		}
 	}
 	return privateAddresses, nil
@@ -155,7 +394,7 @@ func (r *reader) GetDNSPlaintext() (ip net.IP, err error) {
 	}
 	ip = net.ParseIP(s)
 	if ip == nil {
-		return nil, fmt.Errorf("DNS plaintext address %q is not a valid IP address", s)
+		return nil, r.CollectError(fmt.Errorf("DNS plaintext address %q is not a valid IP address", s))
 	}
 	return ip, nil
 }
@@ -0,0 +1,168 @@
+package params
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseDomainProviders(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s               string
+		domainProviders []models.DNSDomainProviders
+		err             error
+	}{
+		"missing provider": {
+			s:   "bank.com",
+			err: fmt.Errorf(`DNS domain provider "bank.com" is not of the form domain=provider`),
+		},
+		"empty domain": {
+			s:   "=quad9",
+			err: fmt.Errorf(`DNS domain provider "=quad9" is not of the form domain=provider`),
+		},
+		"bad provider": {
+			s:   "bank.com=x",
+			err: fmt.Errorf(`DNS over TLS provider "x" is not valid`),
+		},
+		"domains and providers": {
+			s: "Bank.com.=quad9,corp.example=cloudflare,bank.com=cleanbrowsing",
+			domainProviders: []models.DNSDomainProviders{
+				{Domain: "bank.com", Providers: []models.DNSProvider{constants.Quad9, constants.CleanBrowsing}},
+				{Domain: "corp.example", Providers: []models.DNSProvider{constants.Cloudflare}},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			domainProviders, err := parseDomainProviders(testCase.s)
+			if testCase.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.domainProviders, domainProviders)
+		})
+	}
+}
+
+func Test_parseBlockPattern(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s     string
+		zone  string
+		regex string
+		err   error
+	}{
+		"empty": {
+			err: fmt.Errorf("block pattern is empty"),
+		},
+		"domain": {
+			s:    "Tracker.com",
+			zone: "tracker.com",
+		},
+		"subdomains": {
+			s:    "*.tracker.com",
+			zone: "tracker.com",
+		},
+		"wildcard": {
+			s:     "*.doubleclick.*",
+			regex: `^.*\.doubleclick\..*$`,
+		},
+		"leading wildcard": {
+			s:     "*Tracker.com",
+			zone:  "tracker.com",
+			regex: `^.*tracker\.com$`,
+		},
+		"leading wildcard and other wildcard": {
+			s:     "*tracker.*",
+			regex: `^.*tracker\..*$`,
+		},
+		"regex": {
+			s:     `/^ads[0-9]+\./`,
+			regex: `^ads[0-9]+\.`,
+		},
+		"bad regex": {
+			s:   "/(/",
+			err: fmt.Errorf("block pattern \"/(/\": error parsing regexp: missing closing ): `(`"),
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			pattern, err := parseBlockPattern(testCase.s)
+			if testCase.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.s, pattern.Pattern)
+			assert.Equal(t, testCase.zone, pattern.Zone)
+			var regex string
+			if pattern.Regex != nil {
+				regex = pattern.Regex.String()
+			}
+			assert.Equal(t, testCase.regex, regex)
+		})
+	}
+}
+
+func Test_reader_GetDNSOverTLSServeExpiredTTL(t *testing.T) {
+	t.Parallel()
+	r := &reader{envParams: &fakeEnvParams{
+		env: map[string]string{"DOT_SERVE_EXPIRED_TTL": "0"},
+	}}
+	ttl, err := r.GetDNSOverTLSServeExpiredTTL()
+	require.NoError(t, err)
+	assert.Zero(t, ttl)
+}
+
+func Test_parseLocalRecord(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s      string
+		record models.DNSLocalRecord
+		err    error
+	}{
+		"missing IP": {
+			s:   "nas.lan",
+			err: fmt.Errorf(`local record "nas.lan" is not of the form hostname=ip`),
+		},
+		"bad IP": {
+			s:   "nas.lan=x",
+			err: fmt.Errorf(`local record "nas.lan=x": "x" is not a valid IP address`),
+		},
+		"record": {
+			s: "NAS.lan.=192.168.1.10",
+			record: models.DNSLocalRecord{
+				Hostname: "nas.lan",
+				IP:       net.IP{192, 168, 1, 10},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			record, err := parseLocalRecord(testCase.s)
+			if testCase.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.record.Hostname, record.Hostname)
+			assert.True(t, testCase.record.IP.Equal(record.IP))
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package params
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetDNSCrypt obtains if Unbound should forward queries using DNSCrypt
+// instead of DNS over TLS, from the environment variable DNS_TRANSPORT.
+func (r *reader) GetDNSCrypt() (dnscrypt bool, err error) {
+	s, err := r.envParams.GetValueIfInside("DNS_TRANSPORT", []string{"tls", "dnscrypt"}, libparams.Default("tls"))
+	return s == "dnscrypt", err
+}
+
+// GetDNSCryptStamps obtains the comma separated sdns:// stamps of DNSCrypt
+// servers to use instead of the DNS providers servers, from the environment
+// variable DNSCRYPT_STAMPS.
+func (r *reader) GetDNSCryptStamps() (stamps []string, err error) {
+	const key = "DNSCRYPT_STAMPS"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, stamp := range strings.Split(s, ",") {
+		stamp = strings.TrimSpace(stamp)
+		if err := checkDNSCryptStamp(stamp); err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+		}
+		stamps = append(stamps, stamp)
+	}
+	return stamps, nil
+}
+
+// checkDNSCryptStamp verifies the stamp given is a DNSCrypt v2 server stamp,
+// made of the protocol identifier, the properties, the address, the provider
+// public key and the provider name.
+func checkDNSCryptStamp(stamp string) error {
+	const prefix = "sdns://"
+	if !strings.HasPrefix(stamp, prefix) {
+		return fmt.Errorf("stamp %q does not start with %s", stamp, prefix)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(stamp, prefix))
+	if err != nil {
+		return fmt.Errorf("stamp %q cannot be decoded: %w", stamp, err)
+	}
+	const (
+		dnscryptProtocol = 0x01
+		propertiesLength = 8
+		publicKeyLength  = 32
+	)
+	if len(data) == 0 || data[0] != dnscryptProtocol {
+		return fmt.Errorf("stamp %q is not a DNSCrypt server stamp", stamp)
+	}
+	data = data[1:]
+	if len(data) < propertiesLength {
+		return fmt.Errorf("stamp %q is too short", stamp)
+	}
+	data = data[propertiesLength:]
+	var fields [][]byte
+	for _, name := range []string{"address", "public key", "provider name"} {
+		if len(data) == 0 || len(data) < 1+int(data[0]) {
+			return fmt.Errorf("stamp %q has a truncated %s", stamp, name)
+		}
+		length := int(data[0])
+		if length == 0 {
+			return fmt.Errorf("stamp %q has an empty %s", stamp, name)
+		}
+		fields = append(fields, data[1:1+length])
+		data = data[1+length:]
+	}
+	if len(fields[1]) != publicKeyLength {
+		return fmt.Errorf("stamp %q has a public key of %d bytes instead of %d", stamp, len(fields[1]), publicKeyLength)
+	}
+	if len(data) > 0 {
+		return fmt.Errorf("stamp %q has %d unexpected trailing bytes", stamp, len(data))
+	}
+	return nil
+}
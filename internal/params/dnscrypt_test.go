@@ -0,0 +1,53 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkDNSCryptStamp(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		stamp string
+		err   string
+	}{
+		"valid stamp": {
+			stamp: "sdns://AQMAAAAAAAAADDkuOS45Ljk6ODQ0MyBnyEe4yHWM0SAkVUO-dWdG3zTfHYTAC4xHA2jfgh2GPhkyLmRuc2NyeXB0LWNlcnQucXVhZDkubmV0", //nolint:lll
+		},
+		"no prefix": {
+			stamp: "AQMAAAAAAAAA",
+			err:   `stamp "AQMAAAAAAAAA" does not start with sdns://`,
+		},
+		"bad base64": {
+			stamp: "sdns://%",
+			err:   `stamp "sdns://%" cannot be decoded: illegal base64 data at input byte 0`,
+		},
+		"DNS over HTTPS stamp": {
+			stamp: "sdns://AgAAAAAAAAAA",
+			err:   `stamp "sdns://AgAAAAAAAAAA" is not a DNSCrypt server stamp`,
+		},
+		"truncated address": {
+			stamp: "sdns://AQAAAAAAAAAADDk",
+			err:   `stamp "sdns://AQAAAAAAAAAADDk" has a truncated address`,
+		},
+		"short public key": {
+			stamp: "sdns://AQAAAAAAAAAAAWEBYgFj",
+			err:   `stamp "sdns://AQAAAAAAAAAAAWEBYgFj" has a public key of 1 bytes instead of 32`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := checkDNSCryptStamp(testCase.stamp)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
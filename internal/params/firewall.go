@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	libparams "github.com/qdm12/golibs/params"
 )
 
@@ -28,9 +29,9 @@ func (r *reader) GetVPNInputPorts() (ports []uint16, err error) {
 	for i := range portsStr {
 		portInt, err := strconv.Atoi(portsStr[i])
 		if err != nil {
-			return nil, fmt.Errorf("VPN input port %q is not valid (%s)", portInt, err)
+			return nil, r.CollectError(fmt.Errorf("VPN input port %q is not valid (%s)", portInt, err))
 		} else if portInt <= 0 || portInt > 65535 {
-			return nil, fmt.Errorf("VPN input port %d must be between 1 and 65535", portInt)
+			return nil, r.CollectError(fmt.Errorf("VPN input port %d must be between 1 and 65535", portInt))
 		}
 		ports[i] = uint16(portInt)
 	}
@@ -52,17 +53,79 @@ func (r *reader) GetInputPorts() (ports []uint16, err error) {
 	for i := range portsStr {
 		portInt, err := strconv.Atoi(portsStr[i])
 		if err != nil {
-			return nil, fmt.Errorf("Input port %q is not valid (%s)", portInt, err)
+			return nil, r.CollectError(fmt.Errorf("Input port %q is not valid (%s)", portInt, err))
 		} else if portInt <= 0 || portInt > 65535 {
-			return nil, fmt.Errorf("Input port %d must be between 1 and 65535", portInt)
+			return nil, r.CollectError(fmt.Errorf("Input port %d must be between 1 and 65535", portInt))
 		}
 		ports[i] = uint16(portInt)
 	}
 	return ports, nil
 }
 
+// GetFirewallLocalInterfaces obtains the host interfaces whose local networks are
+// allowed through the firewall in addition to the default interface, from the
+// comma separated list of the environment variable FIREWALL_LOCAL_INTERFACES.
+// An empty list means only the default interface local network is allowed.
+func (r *reader) GetFirewallLocalInterfaces() (interfaces []string, err error) {
+	const key = "FIREWALL_LOCAL_INTERFACES"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil {
+		return nil, err
+	} else if s == "" {
+		return nil, nil
+	}
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if !isValidInterfaceName(name) {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: interface name %q is not valid", key, name))
+		}
+		interfaces = append(interfaces, name)
+	}
+	return interfaces, nil
+}
+
+// isValidInterfaceName returns true if the name can be the name
+// of a Linux network interface.
+func isValidInterfaceName(name string) bool {
+	const maxLength = 15
+	return name != "" && name != "." && name != ".." &&
+		len(name) <= maxLength && !strings.ContainsAny(name, " /:")
+}
+
 // GetFirewallDebug obtains if the firewall should run in debug verbose mode
 // from the environment variable FIREWALL_DEBUG.
 func (r *reader) GetFirewallDebug() (debug bool, err error) {
 	return r.envParams.GetOnOff("FIREWALL_DEBUG", libparams.Default("off"))
 }
+
+// GetFirewallConntrackFlush obtains if the connection tracking entries of the
+// previous VPN connection should be flushed once the tunnel is up again,
+// from the environment variable FIREWALL_CONNTRACK_FLUSH.
+func (r *reader) GetFirewallConntrackFlush() (flush bool, err error) {
+	return r.envParams.GetOnOff("FIREWALL_CONNTRACK_FLUSH", libparams.Default("on"))
+}
+
+// GetFirewallMSSClamping obtains if the TCP MSS should be clamped to the path
+// MTU on the tunnel interface, from the environment variable FIREWALL_MSS_CLAMPING.
+func (r *reader) GetFirewallMSSClamping() (clamping bool, err error) {
+	return r.envParams.GetOnOff("FIREWALL_MSS_CLAMPING", libparams.Default("off"))
+}
+
+// GetFirewallInputICMPEcho obtains how ICMP echo requests are answered through
+// the default interface, from the environment variable FIREWALL_INPUT_ICMP_ECHO.
+func (r *reader) GetFirewallInputICMPEcho() (mode string, err error) {
+	return r.envParams.GetValueIfInside("FIREWALL_INPUT_ICMP_ECHO",
+		constants.ICMPEchoChoices(), libparams.Default(constants.ICMPEchoLocal))
+}
+
+// GetFirewallVPNInputICMPEcho obtains if ICMP echo requests are answered through
+// the VPN tunnel, from the environment variable FIREWALL_VPN_INPUT_ICMP_ECHO.
+func (r *reader) GetFirewallVPNInputICMPEcho() (enabled bool, err error) {
+	return r.envParams.GetOnOff("FIREWALL_VPN_INPUT_ICMP_ECHO", libparams.Default("off"))
+}
+
+// GetFirewallDryRun obtains if the firewall should only print the iptables
+// commands instead of running them, from the environment variable FIREWALL_DRY_RUN.
+func (r *reader) GetFirewallDryRun() (dryRun bool, err error) {
+	return r.envParams.GetOnOff("FIREWALL_DRY_RUN", libparams.Default("off"))
+}
@@ -0,0 +1,87 @@
+package params
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetHealthDNSDomain obtains the domain to resolve periodically to check
+// the DNS health from the environment variable HEALTH_DNS_DOMAIN.
+// It returns an empty string if it is set to off to disable the DNS probes.
+func (r *reader) GetHealthDNSDomain() (domain string, err error) {
+	domain, err = r.envParams.GetEnv("HEALTH_DNS_DOMAIN", libparams.Default("github.com"))
+	if domain == "off" {
+		return "", err
+	}
+	return domain, err
+}
+
+// GetHealthDNSRestart obtains if the DNS over TLS server should be restarted
+// when resolving through it fails repeatedly, from the environment
+// variable HEALTH_DNS_RESTART.
+func (r *reader) GetHealthDNSRestart() (restart bool, err error) {
+	return r.envParams.GetOnOff("HEALTH_DNS_RESTART", libparams.Default("on"))
+}
+
+// GetHealthTCPTargets obtains the host:port addresses which must be reachable
+// through the tunnel from the comma separated list of the environment
+// variable HEALTH_TCP_TARGETS.
+func (r *reader) GetHealthTCPTargets() (targets []string, err error) {
+	const key = "HEALTH_TCP_TARGETS"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, target := range strings.Split(s, ",") {
+		target, err = parseTCPTarget(target)
+		if err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func parseTCPTarget(s string) (target string, err error) {
+	target = strings.TrimSpace(s)
+	host, portString, err := net.SplitHostPort(target)
+	if err != nil {
+		return "", err
+	}
+	port, err := strconv.Atoi(portString)
+	if host == "" || err != nil || port < 1 || port > 65535 {
+		return "", fmt.Errorf("target %q is not a valid host:port", target)
+	}
+	return target, nil
+}
+
+// GetHealthInterval obtains the period between health probes
+// from the environment variable HEALTH_INTERVAL.
+func (r *reader) GetHealthInterval() (interval time.Duration, err error) {
+	return r.envParams.GetDuration("HEALTH_INTERVAL", libparams.Default("1m"))
+}
+
+// GetHealthTimeout obtains the timeout of each health probe
+// from the environment variable HEALTH_TIMEOUT.
+func (r *reader) GetHealthTimeout() (timeout time.Duration, err error) {
+	return r.envParams.GetDuration("HEALTH_TIMEOUT", libparams.Default("10s"))
+}
+
+// GetHealthFailureThreshold obtains the number of consecutive failures for
+// a probe to become unhealthy from the environment variable HEALTH_FAILURE_THRESHOLD.
+func (r *reader) GetHealthFailureThreshold() (threshold int, err error) {
+	const max = 100
+	return r.envParams.GetEnvIntRange("HEALTH_FAILURE_THRESHOLD", 1, max, libparams.Default("3"))
+}
+
+// GetHealthSuccessThreshold obtains the number of consecutive successes for an
+// unhealthy probe to become healthy from the environment variable HEALTH_SUCCESS_THRESHOLD.
+func (r *reader) GetHealthSuccessThreshold() (threshold int, err error) {
+	const max = 100
+	return r.envParams.GetEnvIntRange("HEALTH_SUCCESS_THRESHOLD", 1, max, libparams.Default("2"))
+}
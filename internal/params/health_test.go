@@ -0,0 +1,52 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTCPTarget(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s      string
+		target string
+		err    string
+	}{
+		"hostname": {
+			s:      " example.com:443 ",
+			target: "example.com:443",
+		},
+		"IPv6": {
+			s:      "[::1]:22",
+			target: "[::1]:22",
+		},
+		"missing port": {
+			s:   "example.com",
+			err: "address example.com: missing port in address",
+		},
+		"missing host": {
+			s:   ":443",
+			err: `target ":443" is not a valid host:port`,
+		},
+		"bad port": {
+			s:   "example.com:70000",
+			err: `target "example.com:70000" is not a valid host:port`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			target, err := parseTCPTarget(testCase.s)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.target, target)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package params
+
+import (
+	"github.com/qdm12/gluetun/internal/constants"
+)
+
+// GetHideMyAssCountries obtains the countries for the HideMyAss servers from the
+// environment variable COUNTRY.
+func (r *reader) GetHideMyAssCountries() (countries []string, err error) {
+	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.CountryChoices(constants.HideMyAssCountryChoices()))
+}
+
+// GetHideMyAssRegions obtains the regions for the HideMyAss servers from the
+// environment variable REGION.
+func (r *reader) GetHideMyAssRegions() (regions []string, err error) {
+	return r.envParams.GetCSVInPossibilities("REGION", constants.HideMyAssRegionChoices())
+}
+
+// GetHideMyAssCities obtains the cities for the HideMyAss servers from the
+// environment variable CITY.
+func (r *reader) GetHideMyAssCities() (cities []string, err error) {
+	return r.envParams.GetCSVInPossibilities("CITY", constants.HideMyAssCityChoices())
+}
+
+// GetHideMyAssClientKey obtains the one line client key to use for openvpn from the
+// environment variable CLIENT_KEY or from the file at /gluetun/client.key.
+func (r *reader) GetHideMyAssClientKey() (clientKey string, err error) {
+	return r.getClientKey()
+}
+
+// GetHideMyAssClientCertificate obtains the client certificate to use for openvpn from the
+// file at /gluetun/client.crt.
+func (r *reader) GetHideMyAssClientCertificate() (clientCertificate string, err error) {
+	return r.getClientCertificate()
+}
+
+// GetHideMyAssCertificateAuthority obtains the certificate authority to use for openvpn
+// from the file at /gluetun/ca.crt.
+func (r *reader) GetHideMyAssCertificateAuthority() (certificateAuthority string, err error) {
+	return r.getCertificateAuthority()
+}
@@ -0,0 +1,47 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseConnectPorts(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s     string
+		ports []uint16
+		err   string
+	}{
+		"all ports": {
+			s: "all",
+		},
+		"ports": {
+			s:     "443, 80,8443",
+			ports: []uint16{443, 80, 8443},
+		},
+		"bad port": {
+			s:   "443,x",
+			err: `port "x" is not valid: strconv.Atoi: parsing "x": invalid syntax`,
+		},
+		"port out of range": {
+			s:   "0",
+			err: "port 0 must be between 1 and 65535",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ports, err := parseConnectPorts(testCase.s)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ports, ports)
+		})
+	}
+}
@@ -8,7 +8,7 @@ import (
 // GetMullvadCountries obtains the countries for the Mullvad servers from the
 // environment variable COUNTRY.
 func (r *reader) GetMullvadCountries() (countries []string, err error) {
-	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.MullvadCountryChoices())
+	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.CountryChoices(constants.MullvadCountryChoices()))
 }
 
 // GetMullvadCity obtains the cities for the Mullvad servers from the
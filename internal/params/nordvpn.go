@@ -13,6 +13,12 @@ func (r *reader) GetNordvpnRegions() (regions []string, err error) {
 	return r.envParams.GetCSVInPossibilities("REGION", constants.NordvpnRegionChoices())
 }
 
+// GetNordvpnCategories obtains the server categories (optional) for the NordVPN
+// servers from the comma separated list of the environment variable SERVER_CATEGORIES.
+func (r *reader) GetNordvpnCategories() (categories []string, err error) {
+	return r.envParams.GetCSVInPossibilities("SERVER_CATEGORIES", constants.NordvpnCategoryChoices())
+}
+
 // GetNordvpnRegion obtains the server numbers (optional) for the NordVPN servers from the
 // environment variable SERVER_NUMBER.
 func (r *reader) GetNordvpnNumbers() (numbers []uint16, err error) {
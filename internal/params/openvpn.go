@@ -3,20 +3,27 @@ package params
 import (
 	"fmt"
 	"net"
+	"strings"
+	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	libparams "github.com/qdm12/golibs/params"
 )
 
 // GetUser obtains the user to use to connect to the VPN servers.
-func (r *reader) GetUser() (s string, err error) {
+func (r *reader) GetUser(required bool) (s string, err error) {
 	defer func() {
 		unsetenvErr := r.unsetEnv("USER")
 		if err == nil {
 			err = unsetenvErr
 		}
 	}()
-	return r.envParams.GetEnv("USER", libparams.CaseSensitiveValue(), libparams.Compulsory())
+	options := []libparams.GetEnvSetter{libparams.CaseSensitiveValue()}
+	if required {
+		options = append(options, libparams.Compulsory())
+	}
+	return r.envParams.GetEnv("USER", options...)
 }
 
 // GetPassword obtains the password to use to connect to the VPN servers.
@@ -41,6 +48,45 @@ func (r *reader) GetNetworkProtocol() (protocol models.NetworkProtocol, err erro
 	return models.NetworkProtocol(s), err
 }
 
+// GetCustomPort obtains the port to connect to the VPN server on from the
+// environment variable PORT, or 0 to use the default port of the server.
+func (r *reader) GetCustomPort() (port uint16, err error) {
+	n, err := r.envParams.GetEnvIntRange("PORT", 0, 65535, libparams.Default("0"))
+	return uint16(n), err
+}
+
+// GetServerHostnames obtains the hostnames to filter the VPN servers with
+// from the comma separated list for the environment variable SERVER_HOSTNAME.
+func (r *reader) GetServerHostnames() (hostnames []string, err error) {
+	s, err := r.envParams.GetEnv("SERVER_HOSTNAME")
+	if err != nil {
+		return nil, err
+	} else if len(s) == 0 {
+		return nil, nil
+	}
+	hostnames = strings.Split(s, ",")
+	for _, hostname := range hostnames {
+		if !r.verifier.MatchHostname(hostname) {
+			return nil, r.CollectError(fmt.Errorf("server hostname %q does not seem valid", hostname))
+		}
+	}
+	return hostnames, nil
+}
+
+// GetServerPickMode obtains how to pick a connection among the servers matching
+// the selection, from the environment variable SERVER_PICK.
+func (r *reader) GetServerPickMode() (mode string, err error) {
+	return r.envParams.GetValueIfInside("SERVER_PICK",
+		constants.ServerPickChoices(), libparams.Default(constants.ServerPickRandom))
+}
+
+// GetServerPickSeed obtains the seed to pick a server randomly from the
+// environment variable SERVER_PICK_SEED, or 0 to use a time based seed.
+func (r *reader) GetServerPickSeed() (seed int64, err error) {
+	n, err := r.envParams.GetEnvInt("SERVER_PICK_SEED", libparams.Default("0"))
+	return int64(n), err
+}
+
 // GetOpenVPNVerbosity obtains the verbosity level for verbosity between 0 and 6
 // from the environment variable OPENVPN_VERBOSITY.
 func (r *reader) GetOpenVPNVerbosity() (verbosity int, err error) {
@@ -64,7 +110,7 @@ func (r *reader) GetTargetIP() (ip net.IP, err error) {
 	}
 	ip = net.ParseIP(s)
 	if ip == nil {
-		return nil, fmt.Errorf("target IP address %q is not valid", s)
+		return nil, r.CollectError(fmt.Errorf("target IP address %q is not valid", s))
 	}
 	return ip, nil
 }
@@ -81,8 +127,183 @@ func (r *reader) GetOpenVPNAuth() (auth string, err error) {
 	return r.envParams.GetEnv("OPENVPN_AUTH")
 }
 
+// GetOpenVPNTLSVersionMin obtains the minimum TLS version to use from the
+// environment variable OPENVPN_TLS_VERSION_MIN.
+func (r *reader) GetOpenVPNTLSVersionMin() (version string, err error) {
+	return r.envParams.GetValueIfInside("OPENVPN_TLS_VERSION_MIN",
+		append(constants.OpenVPNTLSVersionChoices(), ""))
+}
+
+// GetOpenVPNDataCiphers obtains the data channel ciphers to negotiate from the
+// comma separated list of the environment variable OPENVPN_DATA_CIPHERS.
+func (r *reader) GetOpenVPNDataCiphers() (ciphers []string, err error) {
+	return r.envParams.GetCSVInPossibilities("OPENVPN_DATA_CIPHERS", constants.OpenVPNDataCipherChoices())
+}
+
+// GetOpenVPNTLSCipher obtains the colon separated list of TLS ciphers allowed
+// for the control channel from the environment variable OPENVPN_TLS_CIPHER.
+func (r *reader) GetOpenVPNTLSCipher() (tlsCipher string, err error) {
+	tlsCipher, err = r.envParams.GetEnv("OPENVPN_TLS_CIPHER", libparams.CaseSensitiveValue())
+	if err != nil || tlsCipher == "" {
+		return tlsCipher, err
+	}
+	for _, name := range strings.Split(tlsCipher, ":") {
+		if !strings.HasPrefix(name, "TLS-") {
+			return "", r.CollectError(fmt.Errorf(
+				"TLS cipher %q from environment variable OPENVPN_TLS_CIPHER must start with TLS-", name))
+		}
+	}
+	return tlsCipher, nil
+}
+
+// GetOpenVPNScramble obtains the XOR scramble method to use from the
+// environment variable OPENVPN_SCRAMBLE.
+func (r *reader) GetOpenVPNScramble() (method string, err error) {
+	return r.envParams.GetValueIfInside("OPENVPN_SCRAMBLE",
+		append(constants.OpenVPNScrambleChoices(), ""))
+}
+
+// GetOpenVPNScrambleKey obtains the XOR scramble key from the
+// environment variable OPENVPN_SCRAMBLE_KEY.
+func (r *reader) GetOpenVPNScrambleKey() (key string, err error) {
+	return r.envParams.GetEnv("OPENVPN_SCRAMBLE_KEY",
+		libparams.CaseSensitiveValue(), libparams.Unset())
+}
+
+// GetOpenVPNCompression obtains the compression setting replacing the
+// provider compression directives from the environment variable OPENVPN_COMPRESSION.
+func (r *reader) GetOpenVPNCompression() (compression string, err error) {
+	return r.envParams.GetValueIfInside("OPENVPN_COMPRESSION",
+		append(constants.OpenVPNCompressionChoices(), ""))
+}
+
+// GetProfilesFilepath obtains the path of the JSON file defining named
+// connection profiles from the environment variable PROFILES_FILE,
+// or an empty string if profiles are not used.
+func (r *reader) GetProfilesFilepath() (filepath string, err error) {
+	return r.getOptionalPath("PROFILES_FILE")
+}
+
+// GetOpenVPNExtraTunnels obtains the names of the profiles used to connect
+// extra OpenVPN tunnels, balancing the connections across the tunnels, from
+// the comma separated list of the environment variable OPENVPN_EXTRA_TUNNELS.
+func (r *reader) GetOpenVPNExtraTunnels() (profiles []string, err error) {
+	const key = "OPENVPN_EXTRA_TUNNELS"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, profile := range strings.Split(s, ",") {
+		profile = strings.TrimSpace(profile)
+		if profile == "" {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: profile name cannot be empty", key))
+		}
+		profiles = append(profiles, profile)
+	}
+	if len(profiles) > constants.MaxExtraTunnels {
+		return nil, r.CollectError(fmt.Errorf("environment variable %s: %d extra tunnels exceed the maximum of %d",
+			key, len(profiles), constants.MaxExtraTunnels))
+	}
+	return profiles, nil
+}
+
+// GetOpenVPNCredentialsFilepath obtains the path of the file containing
+// the user and password on its first two lines, kept up to date by another
+// program for providers issuing expiring tokens, from the environment
+// variable OPENVPN_CREDENTIALS_FILE, or an empty string if it is not used.
+func (r *reader) GetOpenVPNCredentialsFilepath() (filepath string, err error) {
+	return r.getOptionalPath("OPENVPN_CREDENTIALS_FILE")
+}
+
+// GetOpenVPNCredentialsPeriod obtains the period to check the credentials
+// file for changes, from the environment variable OPENVPN_CREDENTIALS_PERIOD.
+func (r *reader) GetOpenVPNCredentialsPeriod() (period time.Duration, err error) {
+	return r.envParams.GetDuration("OPENVPN_CREDENTIALS_PERIOD", libparams.Default("1m"))
+}
+
+// GetOpenVPNPersistTun obtains if the TUN device and its routes should be kept
+// across OpenVPN restarts, from the environment variable OPENVPN_PERSIST_TUN.
+func (r *reader) GetOpenVPNPersistTun() (persist bool, err error) {
+	return r.envParams.GetOnOff("OPENVPN_PERSIST_TUN", libparams.Default("off"))
+}
+
+// GetVPNInterface obtains the name of the VPN tunnel interface,
+// from the environment variable VPN_INTERFACE, defaulting to the name given.
+func (r *reader) GetVPNInterface(defaultName string) (name string, err error) {
+	const key = "VPN_INTERFACE"
+	name, err = r.envParams.GetEnv(key, libparams.CaseSensitiveValue(), libparams.Default(defaultName))
+	if err != nil {
+		return "", err
+	} else if !isValidInterfaceName(name) {
+		return "", r.CollectError(fmt.Errorf("environment variable %s: interface name %q is not valid", key, name))
+	}
+	return name, nil
+}
+
+// GetOpenVPNRouteNoPull obtains if the routes pushed by the VPN server should be
+// ignored, to only route the OPENVPN_ROUTES subnets through the tunnel, from the
+// environment variable OPENVPN_ROUTE_NOPULL.
+func (r *reader) GetOpenVPNRouteNoPull() (noPull bool, err error) {
+	return r.envParams.GetOnOff("OPENVPN_ROUTE_NOPULL", libparams.Default("off"))
+}
+
+// GetOpenVPNRoutes obtains the destination CIDR subnets to route through the
+// tunnel in route-nopull mode, from the comma separated list of the environment
+// variable OPENVPN_ROUTES.
+func (r *reader) GetOpenVPNRoutes() (subnets []net.IPNet, err error) {
+	return r.getSubnets("OPENVPN_ROUTES")
+}
+
+// GetOpenVPNRetryInitialWait obtains the duration to wait before restarting
+// OpenVPN after a first failure, from the environment variable OPENVPN_RETRY_INITIAL_WAIT.
+func (r *reader) GetOpenVPNRetryInitialWait() (wait time.Duration, err error) {
+	return r.envParams.GetDuration("OPENVPN_RETRY_INITIAL_WAIT", libparams.Default("5s"))
+}
+
+// GetOpenVPNRetryMaxWait obtains the maximum duration to wait before restarting
+// OpenVPN after consecutive failures, from the environment variable OPENVPN_RETRY_MAX_WAIT.
+func (r *reader) GetOpenVPNRetryMaxWait() (wait time.Duration, err error) {
+	return r.envParams.GetDuration("OPENVPN_RETRY_MAX_WAIT", libparams.Default("5m"))
+}
+
+// GetOpenVPNRetrySwitchServer obtains the number of failed attempts with the same
+// server after which another server is picked, from the environment variable
+// OPENVPN_RETRY_SWITCH_SERVER. 0 means a server is picked for every attempt.
+func (r *reader) GetOpenVPNRetrySwitchServer() (attempts int, err error) {
+	const maxAttempts = 100
+	return r.envParams.GetEnvIntRange("OPENVPN_RETRY_SWITCH_SERVER", 0, maxAttempts, libparams.Default("0"))
+}
+
+// GetOpenVPNRetryCooldown obtains the duration during which a server IP address
+// failing to connect is avoided when picking a server, from the environment
+// variable OPENVPN_RETRY_COOLDOWN. 0 disables it.
+func (r *reader) GetOpenVPNRetryCooldown() (cooldown time.Duration, err error) {
+	return r.getDurationAllowZero("OPENVPN_RETRY_COOLDOWN", "10m")
+}
+
+// GetOpenVPNAuthFailedAttempts obtains the number of authentication failures
+// after which the action from OPENVPN_AUTH_FAILED_ACTION is taken, from the
+// environment variable OPENVPN_AUTH_FAILED_ATTEMPTS. 0 means OpenVPN keeps on retrying.
+func (r *reader) GetOpenVPNAuthFailedAttempts() (attempts int, err error) {
+	const maxAttempts = 100
+	return r.envParams.GetEnvIntRange("OPENVPN_AUTH_FAILED_ATTEMPTS", 0, maxAttempts, libparams.Default("3"))
+}
+
+// GetOpenVPNAuthFailedAction obtains the action to take after repeated authentication
+// failures, from the environment variable OPENVPN_AUTH_FAILED_ACTION.
+func (r *reader) GetOpenVPNAuthFailedAction() (action string, err error) {
+	return r.envParams.GetValueIfInside("OPENVPN_AUTH_FAILED_ACTION",
+		constants.OpenVPNAuthFailedActionChoices(), libparams.Default(constants.OpenVPNAuthFailedSwitch))
+}
+
 // GetOpenVPNIPv6 obtains if ipv6 should be tunneled through the
 // openvpn tunnel from the environment variable OPENVPN_IPV6.
 func (r *reader) GetOpenVPNIPv6() (ipv6 bool, err error) {
 	return r.envParams.GetOnOff("OPENVPN_IPV6", libparams.Default("off"))
 }
+
+// GetOpenVPNIPv6Endpoint obtains if the VPN server should be reached using
+// its IPv6 address from the environment variable OPENVPN_IPV6_ENDPOINT.
+func (r *reader) GetOpenVPNIPv6Endpoint() (ipv6 bool, err error) {
+	return r.envParams.GetOnOff("OPENVPN_IPV6_ENDPOINT", libparams.Default("off"))
+}
@@ -1,10 +1,15 @@
 package params
 
 import (
+	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/files"
 	"github.com/qdm12/golibs/logging"
@@ -15,11 +20,26 @@ import (
 // Reader contains methods to obtain parameters.
 type Reader interface {
 	GetVPNSP() (vpnServiceProvider models.VPNProvider, err error)
+	GetVPNType() (vpnType string, err error)
+
+	// Wireguard getters
+	GetWireguardPrivateKey() (privateKey string, err error)
+	GetWireguardAddresses() (addresses []net.IPNet, err error)
 
 	// DNS over TLS getters
 	GetDNSOverTLS() (DNSOverTLS bool, err error)
+	GetDNSCrypt() (dnscrypt bool, err error)
+	GetDNSCryptStamps() (stamps []string, err error)
 	GetDNSOverTLSProviders() (providers []models.DNSProvider, err error)
+	GetDNSOverTLSDomainProviders() (domainProviders []models.DNSDomainProviders, err error)
 	GetDNSOverTLSCaching() (caching bool, err error)
+	GetDNSOverTLSPrefetch() (prefetch bool, err error)
+	GetDNSOverTLSServeExpired() (serveExpired bool, err error)
+	GetDNSOverTLSServeExpiredTTL() (ttl time.Duration, err error)
+	GetDNSOverTLSConnectionReuse() (reuse bool, err error)
+	GetDNSOverTLSConnectionIdleTimeout() (timeout time.Duration, err error)
+	GetDNSOverTLSMaxConnections() (maxConnections int, err error)
+	GetDNSOverTLSQueryTimeout() (timeout time.Duration, err error)
 	GetDNSOverTLSVerbosity() (verbosityLevel uint8, err error)
 	GetDNSOverTLSVerbosityDetails() (verbosityDetailsLevel uint8, err error)
 	GetDNSOverTLSValidationLogLevel() (validationLogLevel uint8, err error)
@@ -27,6 +47,12 @@ type Reader interface {
 	GetDNSSurveillanceBlocking() (blocking bool, err error)
 	GetDNSAdsBlocking() (blocking bool, err error)
 	GetDNSUnblockedHostnames() (hostnames []string, err error)
+	GetDNSBlockPatterns() (patterns []models.DNSBlockPattern, err error)
+	GetDNSRPZ() (sources []string, err error)
+	GetDNSLocalTLDs() (tlds []string, err error)
+	GetDNSLocalRecords() (records []models.DNSLocalRecord, err error)
+	GetDNSLocalResolver() (ip net.IP, err error)
+	GetDNSDockerHostnames() (hostnames []string, err error)
 	GetDNSOverTLSPrivateAddresses() (privateAddresses []string, err error)
 	GetDNSOverTLSIPv6() (ipv6 bool, err error)
 	GetDNSUpdatePeriod() (period time.Duration, err error)
@@ -38,31 +64,88 @@ type Reader interface {
 	GetGID() (gid int, err error)
 	GetTimezone() (timezone string, err error)
 	GetIPStatusFilepath() (filepath models.Filepath, err error)
+	GetOverridesFilepath() (filepath string, err error)
+	GetLogTimeLayout() (layout string, err error)
+	GetLogTimeUTC() (utc bool, err error)
+	GetLogColor() (mode string, err error)
+	GetLogFilter() (filter *regexp.Regexp, err error)
 
 	// Firewall getters
 	GetFirewall() (enabled bool, err error)
 	GetVPNInputPorts() (ports []uint16, err error)
 	GetInputPorts() (ports []uint16, err error)
 	GetOutboundSubnets() (outboundSubnets []net.IPNet, err error)
+	GetVPNBypassSubnets() (subnets []net.IPNet, err error)
+	GetVPNBypassUIDs() (uids []uint32, err error)
+	GetOutboundPorts() (ports []models.OutboundPort, err error)
+	GetVPNBypassGIDs() (gids []uint32, err error)
+	GetVPNForcedUIDs() (uids []uint32, err error)
+	GetVPNForcedGIDs() (gids []uint32, err error)
+	GetStaticRoutes() (routes []models.StaticRoute, err error)
+	GetRoutesProtection() (protection bool, err error)
+	GetRoutesProtectionPeriod() (period time.Duration, err error)
+	GetRoutesMetric() (metric int, err error)
+	GetFirewallLocalInterfaces() (interfaces []string, err error)
 	GetFirewallDebug() (debug bool, err error)
+	GetFirewallDryRun() (dryRun bool, err error)
+	GetFirewallConntrackFlush() (flush bool, err error)
+	GetFirewallMSSClamping() (clamping bool, err error)
+	GetFirewallInputICMPEcho() (mode string, err error)
+	GetFirewallVPNInputICMPEcho() (enabled bool, err error)
 
 	// VPN getters
-	GetUser() (s string, err error)
+	GetUser(required bool) (s string, err error)
 	GetPassword(required bool) (s string, err error)
 	GetNetworkProtocol() (protocol models.NetworkProtocol, err error)
+	GetCustomPort() (port uint16, err error)
+	GetServerHostnames() (hostnames []string, err error)
+	GetServerPickMode() (mode string, err error)
+	GetServerPickSeed() (seed int64, err error)
 	GetOpenVPNVerbosity() (verbosity int, err error)
 	GetOpenVPNRoot() (root bool, err error)
 	GetTargetIP() (ip net.IP, err error)
 	GetOpenVPNCipher() (cipher string, err error)
 	GetOpenVPNAuth() (auth string, err error)
 	GetOpenVPNIPv6() (tunnel bool, err error)
+	GetOpenVPNIPv6Endpoint() (ipv6 bool, err error)
+	GetOpenVPNTLSVersionMin() (version string, err error)
+	GetOpenVPNDataCiphers() (ciphers []string, err error)
+	GetOpenVPNTLSCipher() (tlsCipher string, err error)
+	GetOpenVPNScramble() (method string, err error)
+	GetOpenVPNScrambleKey() (key string, err error)
+	GetOpenVPNCompression() (compression string, err error)
+	GetOpenVPNRetryInitialWait() (wait time.Duration, err error)
+	GetOpenVPNRetryMaxWait() (wait time.Duration, err error)
+	GetOpenVPNRetrySwitchServer() (attempts int, err error)
+	GetOpenVPNRetryCooldown() (cooldown time.Duration, err error)
+	GetOpenVPNAuthFailedAttempts() (attempts int, err error)
+	GetOpenVPNAuthFailedAction() (action string, err error)
+	GetProfilesFilepath() (filepath string, err error)
+	GetOpenVPNExtraTunnels() (profiles []string, err error)
+	GetOpenVPNCredentialsFilepath() (filepath string, err error)
+	GetOpenVPNCredentialsPeriod() (period time.Duration, err error)
+	GetOpenVPNPersistTun() (persist bool, err error)
+	GetVPNInterface(defaultName string) (name string, err error)
+	GetOpenVPNRouteNoPull() (noPull bool, err error)
+	GetOpenVPNRoutes() (subnets []net.IPNet, err error)
 
 	// PIA getters
 	GetPortForwarding() (activated bool, err error)
+	GetPortForwardingReselect() (reselect bool, err error)
 	GetPortForwardingStatusFilepath() (filepath models.Filepath, err error)
+	GetPortForwardingStatusFileFormat() (format string, err error)
+	GetPortForwardingStatusFileTemplate() (template string, err error)
+	GetPortForwardingRelay() (target string, err error)
 	GetPIAEncryptionPreset() (preset string, err error)
 	GetPIARegions() (regions []string, err error)
 
+	// Torrent clients getters, to set their peer port to the forwarded port
+	GetTransmissionURL() (url string, err error)
+	GetTransmissionUser() (user string, err error)
+	GetTransmissionPassword() (password string, err error)
+	GetDelugeURL() (url string, err error)
+	GetDelugePassword() (password string, err error)
+
 	// Mullvad getters
 	GetMullvadCountries() (countries []string, err error)
 	GetMullvadCities() (cities []string, err error)
@@ -91,8 +174,11 @@ type Reader interface {
 	// NordVPN getters
 	GetNordvpnRegions() (regions []string, err error)
 	GetNordvpnNumbers() (numbers []uint16, err error)
+	GetNordvpnCategories() (categories []string, err error)
 
 	// Privado getters
+	GetPrivadoCountries() (countries []string, err error)
+	GetPrivadoCities() (cities []string, err error)
 	GetPrivadoHostnames() (hostnames []string, err error)
 
 	// PureVPN getters
@@ -100,31 +186,109 @@ type Reader interface {
 	GetPurevpnCountries() (countries []string, err error)
 	GetPurevpnCities() (cities []string, err error)
 
+	// HideMyAss getters
+	GetHideMyAssCountries() (countries []string, err error)
+	GetHideMyAssRegions() (regions []string, err error)
+	GetHideMyAssCities() (cities []string, err error)
+	GetHideMyAssClientKey() (clientKey string, err error)
+	GetHideMyAssClientCertificate() (clientCertificate string, err error)
+	GetHideMyAssCertificateAuthority() (certificateAuthority string, err error)
+
+	// WeVPN getters
+	GetWeVPNCountries() (countries []string, err error)
+	GetWeVPNCities() (cities []string, err error)
+	GetWeVPNCertificateAuthority() (certificateAuthority string, err error)
+	GetWeVPNTLSCryptKey() (key string, err error)
+
+	// VPN Unlimited getters
+	GetVPNUnlimitedCountries() (countries []string, err error)
+	GetVPNUnlimitedCities() (cities []string, err error)
+	GetVPNUnlimitedClientKey() (clientKey string, err error)
+	GetVPNUnlimitedClientCertificate() (clientCertificate string, err error)
+	GetVPNUnlimitedCertificateAuthority() (certificateAuthority string, err error)
+
 	// Shadowsocks getters
 	GetShadowSocks() (activated bool, err error)
 	GetShadowSocksLog() (activated bool, err error)
 	GetShadowSocksPort() (port uint16, err error)
+	GetShadowSocksListeningAddress() (ip net.IP, err error)
 	GetShadowSocksPassword() (password string, err error)
 	GetShadowSocksMethod() (method string, err error)
+	GetShadowSocksUDP() (enabled bool, err error)
+	GetShadowSocksExtraListeners() (listeners []models.ShadowSocksListener, err error)
 
 	// HTTP proxy getters
 	GetHTTPProxy() (activated bool, err error)
 	GetHTTPProxyLog() (log bool, err error)
+	GetHTTPProxyAllowedSubnets() (subnets []net.IPNet, err error)
+	GetHTTPProxyTLSCertificate() (filepath string, err error)
+	GetHTTPProxyTLSKey() (filepath string, err error)
+	GetHTTPProxyPACBypass() (bypass []string, err error)
+	GetHTTPProxyUpstream() (upstream *url.URL, err error)
+	GetHTTPProxyConnectPorts() (ports []uint16, err error)
 	GetHTTPProxyPort() (port uint16, err error)
+	GetHTTPProxyListeningAddress() (ip net.IP, err error)
 	GetHTTPProxyUser() (user string, err error)
 	GetHTTPProxyPassword() (password string, err error)
 	GetHTTPProxyStealth() (stealth bool, err error)
 
+	// Transparent proxy getters
+	GetTransparentProxy() (enabled bool, err error)
+	GetTransparentProxyPort() (port uint16, err error)
+	GetTransparentProxySubnets() (subnets []net.IPNet, err error)
+
 	// Public IP getters
 	GetPublicIPPeriod() (period time.Duration, err error)
+	GetPublicIPMethods() (methods []string, err error)
+	GetPublicIPLeakCheck() (enabled bool, err error)
+	GetPublicIPLeakStopProxies() (enabled bool, err error)
+	GetPublicIPEgressCheck() (enabled bool, err error)
+	GetPublicIPJSONFilepath() (filepath string, err error)
+	GetPublicIPJSONHistory() (enabled bool, err error)
+	GetPublicIPGeolocation() (apis []string, err error)
+	GetPublicIPGeolocationToken(api string) (token string, err error)
 
 	// Control server
 	GetControlServerPort() (port uint16, err error)
 	GetControlServerLog() (enabled bool, err error)
+	GetControlServerAuthFilepath() (filepath string, err error)
+	GetControlServerListeningAddress() (ip net.IP, err error)
+	GetControlServerExtraListeners() (listeners []models.ControlServerListener, err error)
+
+	// Health
+	GetHealthDNSDomain() (domain string, err error)
+	GetHealthDNSRestart() (restart bool, err error)
+	GetHealthTCPTargets() (targets []string, err error)
+	GetHealthInterval() (interval time.Duration, err error)
+	GetHealthTimeout() (timeout time.Duration, err error)
+	GetHealthFailureThreshold() (threshold int, err error)
+	GetHealthSuccessThreshold() (threshold int, err error)
+
+	// Sidecar
+	GetSidecarTarget() (kind, target string, err error)
+	GetSidecarPeriod() (period time.Duration, err error)
+
+	// Telegram
+	GetTelegramToken() (token string, err error)
+	GetTelegramChatIDs() (chatIDs []int64, err error)
+
+	// Tracing
+	GetTracingEndpoint() (endpoint string, err error)
+	GetTracingServiceName() (name string, err error)
 
 	GetVersionInformation() (enabled bool, err error)
 
 	GetUpdaterPeriod() (period time.Duration, err error)
+
+	// CollectErrors makes the getters record the error of each invalid
+	// environment variable and use its default value instead of returning
+	// the error, until the stop function returned is called, which returns
+	// the errors recorded.
+	CollectErrors() (stop func() (errs []error))
+	// CollectError records the error given and returns nil if errors are
+	// collected, for the caller to carry on with a zero value and the
+	// next settings to be checked, or returns the error given otherwise.
+	CollectError(err error) error
 }
 
 type reader struct {
@@ -132,17 +296,22 @@ type reader struct {
 	logger      logging.Logger
 	verifier    verification.Verifier
 	unsetEnv    func(key string) error
+	lookupEnv   func(key string) (value string, ok bool)
 	fileManager files.FileManager
+	// collector is set while errors are collected, see CollectErrors.
+	collector *errorsCollector
 }
 
 // Newreader returns a paramsReadeer object to read parameters from
-// environment variables.
+// environment variables. Deprecated environment variables are read if
+// their current names are not set, logging a warning for each of them.
 func NewReader(logger logging.Logger, fileManager files.FileManager) Reader {
 	return &reader{
-		envParams:   libparams.NewEnvParams(),
+		envParams:   newDeprecatedEnvParams(libparams.NewEnvParams(), os.LookupEnv, logger, deprecatedKeys),
 		logger:      logger,
 		verifier:    verification.NewVerifier(),
 		unsetEnv:    os.Unsetenv,
+		lookupEnv:   os.LookupEnv,
 		fileManager: fileManager,
 	}
 }
@@ -150,25 +319,85 @@ func NewReader(logger logging.Logger, fileManager files.FileManager) Reader {
 // GetVPNSP obtains the VPN service provider to use from the environment variable VPNSP.
 func (r *reader) GetVPNSP() (vpnServiceProvider models.VPNProvider, err error) {
 	s, err := r.envParams.GetValueIfInside(
-		"VPNSP",
-		[]string{
-			"pia", "private internet access",
-			"mullvad", "windscribe", "surfshark", "cyberghost",
-			"vyprvpn", "nordvpn", "purevpn", "privado",
-		}, libparams.Default("private internet access"))
+		"VPNSP", append([]string{"pia"}, constants.VPNProviderChoices()...),
+		libparams.Default("private internet access"))
 	if s == "pia" {
 		s = "private internet access"
 	}
 	return models.VPNProvider(s), err
 }
 
+// GetVPNType obtains the VPN type to connect with, openvpn or wireguard,
+// from the environment variable VPN_TYPE.
+func (r *reader) GetVPNType() (vpnType string, err error) {
+	return r.envParams.GetValueIfInside("VPN_TYPE", constants.VPNTypeChoices(),
+		libparams.Default(constants.OpenVPN))
+}
+
 func (r *reader) GetVersionInformation() (enabled bool, err error) {
 	return r.envParams.GetOnOff("VERSION_INFORMATION", libparams.Default("on"))
 }
 
-func (r *reader) onRetroActive(oldKey, newKey string) {
-	r.logger.Warn(
-		"You are using the old environment variable %s, please consider changing it to %s",
-		oldKey, newKey,
-	)
+// getOptionalPath obtains an absolute file path from the environment variable
+// with the key given, or an empty string if the variable is not set.
+func (r *reader) getOptionalPath(key string) (path string, err error) {
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return "", err
+	}
+	return r.envParams.GetPath(key, libparams.CaseSensitiveValue())
+}
+
+// getOptionalIP obtains an IP address from the environment variable
+// with the key given, or nil if the variable is not set.
+func (r *reader) getOptionalIP(key string) (ip net.IP, err error) {
+	s, err := r.envParams.GetEnv(key)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	ip = net.ParseIP(s)
+	if ip == nil {
+		return nil, r.CollectError(fmt.Errorf("environment variable %s: IP address %q is not valid", key, s))
+	}
+	return ip, nil
+}
+
+// getDurationAllowZero obtains a duration from the environment variable with
+// the key given, which can be 0 unlike with the GetDuration method of envParams.
+func (r *reader) getDurationAllowZero(key, defaultValue string) (duration time.Duration, err error) {
+	s, err := r.envParams.GetEnv(key, libparams.Default(defaultValue))
+	if err != nil {
+		return 0, err
+	}
+	duration, err = time.ParseDuration(s)
+	switch {
+	case err != nil:
+		return 0, r.CollectError(fmt.Errorf("environment variable %q duration value is malformed: %w", key, err))
+	case duration < 0:
+		return 0, r.CollectError(fmt.Errorf("environment variable %q duration value cannot be lower than 0", key))
+	default:
+		return duration, nil
+	}
+}
+
+// getSubnets obtains CIDR subnets from the comma separated list of
+// the environment variable with the key given.
+func (r *reader) getSubnets(key string, optionSetters ...libparams.GetEnvSetter) (subnets []net.IPNet, err error) {
+	s, err := r.envParams.GetEnv(key, optionSetters...)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, subnet := range strings.Split(s, ",") {
+		subnet = strings.TrimSpace(subnet)
+		if subnet == "" { // trailing comma
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(subnet)
+		if err != nil {
+			return nil, r.CollectError(fmt.Errorf("cannot parse subnet %q from environment variable with key %s: %w",
+				subnet, key, err))
+		}
+		subnets = append(subnets, *cidr)
+	}
+	return subnets, nil
 }
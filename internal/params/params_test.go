@@ -0,0 +1,137 @@
+package params
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_reader_GetOpenVPNRetryCooldown(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		value    string
+		cooldown time.Duration
+		err      string
+	}{
+		"disabled": {
+			value: "0",
+		},
+		"duration": {
+			value:    "5m",
+			cooldown: 5 * time.Minute,
+		},
+		"negative duration": {
+			value: "-1s",
+			err:   `environment variable "OPENVPN_RETRY_COOLDOWN" duration value cannot be lower than 0`,
+		},
+		"malformed duration": {
+			value: "abc",
+			err: `environment variable "OPENVPN_RETRY_COOLDOWN" duration value is malformed: ` +
+				`time: invalid duration "abc"`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := &reader{envParams: &fakeEnvParams{
+				env: map[string]string{"OPENVPN_RETRY_COOLDOWN": testCase.value},
+			}}
+			cooldown, err := r.GetOpenVPNRetryCooldown()
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.cooldown, cooldown)
+		})
+	}
+}
+
+func Test_reader_GetOpenVPNExtraTunnels(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		value    string
+		profiles []string
+		err      string
+	}{
+		"no extra tunnel": {},
+		"profiles with spaces": {
+			value:    "nl, se ,ch",
+			profiles: []string{"nl", "se", "ch"},
+		},
+		"empty profile name": {
+			value: "nl,,se",
+			err:   "environment variable OPENVPN_EXTRA_TUNNELS: profile name cannot be empty",
+		},
+		"too many extra tunnels": {
+			value: "a,b,c,d,e,f,g,h,i,j,k,l,m,n,o,p",
+			err:   "environment variable OPENVPN_EXTRA_TUNNELS: 16 extra tunnels exceed the maximum of 15",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := &reader{envParams: &fakeEnvParams{
+				env: map[string]string{"OPENVPN_EXTRA_TUNNELS": testCase.value},
+			}}
+			profiles, err := r.GetOpenVPNExtraTunnels()
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.profiles, profiles)
+		})
+	}
+}
+
+func Test_reader_getSubnets(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		value   string
+		subnets []net.IPNet
+		err     string
+	}{
+		"empty": {},
+		"single subnet": {
+			value:   "192.168.1.0/24",
+			subnets: []net.IPNet{{IP: net.IP{192, 168, 1, 0}, Mask: net.IPv4Mask(255, 255, 255, 0)}},
+		},
+		"subnets with spaces": {
+			value: " 192.168.1.0/24 , 10.0.0.0/8,",
+			subnets: []net.IPNet{
+				{IP: net.IP{192, 168, 1, 0}, Mask: net.IPv4Mask(255, 255, 255, 0)},
+				{IP: net.IP{10, 0, 0, 0}, Mask: net.IPv4Mask(255, 0, 0, 0)},
+			},
+		},
+		"bad subnet": {
+			value: "192.168.1.0/24, 10.0.0.1",
+			err: `cannot parse subnet "10.0.0.1" from environment variable with key SUBNETS: ` +
+				`invalid CIDR address: 10.0.0.1`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := &reader{envParams: &fakeEnvParams{
+				env: map[string]string{"SUBNETS": testCase.value},
+			}}
+			subnets, err := r.getSubnets("SUBNETS")
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.subnets, subnets)
+		})
+	}
+}
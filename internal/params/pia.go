@@ -12,17 +12,14 @@ import (
 // side is enabled or not from the environment variable PORT_FORWARDING
 // Only valid for older PIA servers for now.
 func (r *reader) GetPortForwarding() (activated bool, err error) {
-	s, err := r.envParams.GetEnv("PORT_FORWARDING", libparams.Default("off"))
-	if err != nil {
-		return false, err
-	}
-	// Custom for retro-compatibility
-	if s == "false" || s == "off" {
-		return false, nil
-	} else if s == "true" || s == "on" {
-		return true, nil
-	}
-	return false, fmt.Errorf("PORT_FORWARDING can only be \"on\" or \"off\"")
+	return r.envParams.GetOnOff("PORT_FORWARDING", libparams.Default("off"))
+}
+
+// GetPortForwardingReselect obtains if a region supporting port forwarding
+// should be selected instead of the regions chosen if none of them support it,
+// from the environment variable PORT_FORWARDING_RESELECT.
+func (r *reader) GetPortForwardingReselect() (reselect bool, err error) {
+	return r.envParams.GetOnOff("PORT_FORWARDING_RESELECT", libparams.Default("off"))
 }
 
 // GetPortForwardingStatusFilepath obtains the port forwarding status file path
@@ -35,20 +32,42 @@ func (r *reader) GetPortForwardingStatusFilepath() (filepath models.Filepath, er
 	return models.Filepath(filepathStr), err
 }
 
-// GetPIAEncryptionPreset obtains the encryption level for the PIA connection
-// from the environment variable PIA_ENCRYPTION, and using ENCRYPTION for
-// retro compatibility.
-func (r *reader) GetPIAEncryptionPreset() (preset string, err error) {
-	// Retro-compatibility
-	s, err := r.envParams.GetValueIfInside("ENCRYPTION", []string{
-		constants.PIAEncryptionPresetNormal,
-		constants.PIAEncryptionPresetStrong})
-	if err != nil {
+// GetPortForwardingStatusFileFormat obtains the format of the port forwarding
+// status file from the environment variable PORT_FORWARDING_STATUS_FILE_FORMAT.
+func (r *reader) GetPortForwardingStatusFileFormat() (format string, err error) {
+	return r.envParams.GetValueIfInside(
+		"PORT_FORWARDING_STATUS_FILE_FORMAT",
+		constants.PortForwardingFormatChoices(),
+		libparams.Default(constants.PortForwardingFormatPlain))
+}
+
+// GetPortForwardingStatusFileTemplate obtains the Go template used to write
+// the port forwarding status file from the environment variable
+// PORT_FORWARDING_STATUS_FILE_TEMPLATE.
+func (r *reader) GetPortForwardingStatusFileTemplate() (template string, err error) {
+	return r.envParams.GetEnv("PORT_FORWARDING_STATUS_FILE_TEMPLATE",
+		libparams.CaseSensitiveValue(), libparams.Compulsory())
+}
+
+// GetPortForwardingRelay obtains the host:port address to relay the traffic
+// received on the forwarded port to, from the environment variable
+// PORT_FORWARDING_RELAY, or an empty string if it is not set.
+func (r *reader) GetPortForwardingRelay() (target string, err error) {
+	const key = "PORT_FORWARDING_RELAY"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
 		return "", err
-	} else if len(s) != 0 {
-		r.logger.Warn("You are using the old environment variable ENCRYPTION, please consider changing it to PIA_ENCRYPTION")
-		return s, nil
 	}
+	target, err = parseTCPTarget(s)
+	if err != nil {
+		return "", r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+	}
+	return target, nil
+}
+
+// GetPIAEncryptionPreset obtains the encryption level for the PIA connection
+// from the environment variable PIA_ENCRYPTION.
+func (r *reader) GetPIAEncryptionPreset() (preset string, err error) {
 	return r.envParams.GetValueIfInside(
 		"PIA_ENCRYPTION",
 		[]string{
@@ -4,8 +4,25 @@ import (
 	"github.com/qdm12/gluetun/internal/constants"
 )
 
+// GetPrivadoCountries obtains the countries for the Privado servers from the
+// environment variable COUNTRY.
+func (r *reader) GetPrivadoCountries() (countries []string, err error) {
+	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.CountryChoices(constants.PrivadoCountryChoices()))
+}
+
+// GetPrivadoCities obtains the cities for the Privado servers from the
+// environment variable CITY.
+func (r *reader) GetPrivadoCities() (cities []string, err error) {
+	return r.envParams.GetCSVInPossibilities("CITY", constants.PrivadoCityChoices())
+}
+
 // GetPrivadoHostnames obtains the hostnames for the Privado server from the
-// environment variable HOSTNAME.
+// environment variable SERVER_HOSTNAME, or from the environment variable
+// HOSTNAME for retro-compatibility.
 func (r *reader) GetPrivadoHostnames() (hosts []string, err error) {
+	hosts, err = r.GetServerHostnames()
+	if err != nil || len(hosts) > 0 {
+		return hosts, err
+	}
 	return r.envParams.GetCSVInPossibilities("HOSTNAME", constants.PrivadoHostnameChoices())
 }
@@ -1,8 +1,10 @@
 package params
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	libparams "github.com/qdm12/golibs/params"
 )
 
@@ -15,3 +17,73 @@ func (r *reader) GetPublicIPPeriod() (period time.Duration, err error) {
 	}
 	return time.ParseDuration(s)
 }
+
+// GetPublicIPMethods obtains the methods to try in order to obtain the public IP
+// address from the comma separated list of the environment variable PUBLICIP_METHODS.
+func (r *reader) GetPublicIPMethods() (methods []string, err error) {
+	return r.envParams.GetCSVInPossibilities("PUBLICIP_METHODS",
+		constants.PublicIPMethodChoices(), libparams.Default(constants.PublicIPMethodHTTP))
+}
+
+// GetPublicIPLeakCheck obtains if the public IP address should be checked
+// against the ISP public IP address, from the environment variable PUBLICIP_LEAK_CHECK.
+func (r *reader) GetPublicIPLeakCheck() (enabled bool, err error) {
+	return r.envParams.GetOnOff("PUBLICIP_LEAK_CHECK", libparams.Default("off"))
+}
+
+// GetPublicIPLeakStopProxies obtains if the proxies should be stopped when
+// the ISP public IP address is detected, from the environment variable PUBLICIP_LEAK_STOP_PROXIES.
+func (r *reader) GetPublicIPLeakStopProxies() (enabled bool, err error) {
+	return r.envParams.GetOnOff("PUBLICIP_LEAK_STOP_PROXIES", libparams.Default("off"))
+}
+
+// GetPublicIPEgressCheck obtains if the public IP address should be checked
+// to belong to the VPN provider, from the environment variable PUBLICIP_EGRESS_CHECK.
+func (r *reader) GetPublicIPEgressCheck() (enabled bool, err error) {
+	return r.envParams.GetOnOff("PUBLICIP_EGRESS_CHECK", libparams.Default("off"))
+}
+
+// GetPublicIPJSONFilepath obtains the optional filepath of the JSON file to write
+// the public IP information to, from the environment variable PUBLICIP_JSON_FILE.
+func (r *reader) GetPublicIPJSONFilepath() (filepath string, err error) {
+	return r.getOptionalPath("PUBLICIP_JSON_FILE")
+}
+
+// GetPublicIPJSONHistory obtains if the public IP information should be appended
+// to the JSON file, from the environment variable PUBLICIP_JSON_HISTORY.
+func (r *reader) GetPublicIPJSONHistory() (enabled bool, err error) {
+	return r.envParams.GetOnOff("PUBLICIP_JSON_HISTORY", libparams.Default("off"))
+}
+
+// GetPublicIPGeolocation obtains the geolocation APIs to try in order from the
+// comma separated list of the environment variable PUBLICIP_GEOLOCATION.
+func (r *reader) GetPublicIPGeolocation() (apis []string, err error) {
+	s, err := r.envParams.GetEnv("PUBLICIP_GEOLOCATION")
+	if err != nil || s == "" {
+		return nil, err
+	}
+	return r.envParams.GetCSVInPossibilities("PUBLICIP_GEOLOCATION", constants.PublicIPGeolocationChoices())
+}
+
+// GetPublicIPGeolocationToken obtains the token or key of the geolocation API given
+// from the environment variable PUBLICIP_IPINFO_TOKEN or PUBLICIP_IPDATA_KEY.
+// The ip-api API has no token, since it only accepts it in the URL query.
+func (r *reader) GetPublicIPGeolocationToken(api string) (token string, err error) {
+	var key string
+	switch api {
+	case constants.IPInfo:
+		key = "PUBLICIP_IPINFO_TOKEN"
+	case constants.IPAPI:
+		if value, err := r.envParams.GetEnv("PUBLICIP_IPAPI_KEY", libparams.CaseSensitiveValue()); err != nil {
+			return "", err
+		} else if value != "" {
+			return "", fmt.Errorf("PUBLICIP_IPAPI_KEY is not supported since ip-api only accepts its key in the URL")
+		}
+		return "", nil
+	case constants.IPData:
+		key = "PUBLICIP_IPDATA_KEY"
+	default:
+		return "", r.CollectError(fmt.Errorf("geolocation API %q is unknown", api))
+	}
+	return r.envParams.GetEnv(key, libparams.CaseSensitiveValue(), libparams.Unset())
+}
@@ -13,7 +13,7 @@ func (r *reader) GetPurevpnRegions() (regions []string, err error) {
 // GetPurevpnCountries obtains the countries for the PureVPN servers from the
 // environment variable COUNTRY.
 func (r *reader) GetPurevpnCountries() (countries []string, err error) {
-	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.PurevpnCountryChoices())
+	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.CountryChoices(constants.PurevpnCountryChoices()))
 }
 
 // GetPurevpnCities obtains the cities for the PureVPN servers from the
@@ -3,8 +3,11 @@ package params
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/qdm12/gluetun/internal/models"
 	libparams "github.com/qdm12/golibs/params"
 )
 
@@ -12,11 +15,7 @@ import (
 // environment variable FIREWALL_OUTBOUND_SUBNETS.
 func (r *reader) GetOutboundSubnets() (outboundSubnets []net.IPNet, err error) {
 	const key = "FIREWALL_OUTBOUND_SUBNETS"
-	retroOption := libparams.RetroKeys(
-		[]string{"EXTRA_SUBNETS"},
-		r.onRetroActive,
-	)
-	s, err := r.envParams.GetEnv(key, retroOption)
+	s, err := r.envParams.GetEnv(key)
 	if err != nil {
 		return nil, err
 	} else if s == "" {
@@ -26,12 +25,201 @@ func (r *reader) GetOutboundSubnets() (outboundSubnets []net.IPNet, err error) {
 	for _, subnet := range subnets {
 		_, cidr, err := net.ParseCIDR(subnet)
 		if err != nil {
-			return nil, fmt.Errorf("cannot parse outbound subnet %q from environment variable with key %s: %w", subnet, key, err)
+			return nil, r.CollectError(fmt.Errorf(
+				"cannot parse outbound subnet %q from environment variable with key %s: %w", subnet, key, err))
 		} else if cidr == nil {
-			return nil, fmt.Errorf("cannot parse outbound subnet %q from environment variable with key %s: subnet is nil",
-				subnet, key)
+			return nil, r.CollectError(fmt.Errorf(
+				"cannot parse outbound subnet %q from environment variable with key %s: subnet is nil", subnet, key))
 		}
 		outboundSubnets = append(outboundSubnets, *cidr)
 	}
 	return outboundSubnets, nil
 }
+
+// GetVPNBypassSubnets obtains the source CIDR subnets, from the comma separated
+// list of the environment variable FIREWALL_VPN_BYPASS_SUBNETS, whose traffic
+// should be routed through the default gateway instead of the VPN tunnel.
+func (r *reader) GetVPNBypassSubnets() (subnets []net.IPNet, err error) {
+	return r.getSubnets("FIREWALL_VPN_BYPASS_SUBNETS")
+}
+
+// GetVPNBypassUIDs obtains the user IDs, from the comma separated list of the
+// environment variable FIREWALL_VPN_BYPASS_UIDS, whose processes traffic
+// should be routed through the default gateway instead of the VPN tunnel.
+func (r *reader) GetVPNBypassUIDs() (uids []uint32, err error) {
+	return r.getIDs("FIREWALL_VPN_BYPASS_UIDS")
+}
+
+// GetVPNBypassGIDs obtains the group IDs, from the comma separated list of the
+// environment variable FIREWALL_VPN_BYPASS_GIDS, whose processes traffic
+// should be routed through the default gateway instead of the VPN tunnel.
+func (r *reader) GetVPNBypassGIDs() (gids []uint32, err error) {
+	return r.getIDs("FIREWALL_VPN_BYPASS_GIDS")
+}
+
+// GetVPNForcedUIDs obtains the user IDs, from the comma separated list of the
+// environment variable FIREWALL_VPN_FORCED_UIDS, whose processes traffic
+// can only go through the VPN tunnel, even if the firewall is disabled.
+func (r *reader) GetVPNForcedUIDs() (uids []uint32, err error) {
+	return r.getIDs("FIREWALL_VPN_FORCED_UIDS")
+}
+
+// GetVPNForcedGIDs obtains the group IDs, from the comma separated list of the
+// environment variable FIREWALL_VPN_FORCED_GIDS, whose processes traffic
+// can only go through the VPN tunnel, even if the firewall is disabled.
+func (r *reader) GetVPNForcedGIDs() (gids []uint32, err error) {
+	return r.getIDs("FIREWALL_VPN_FORCED_GIDS")
+}
+
+func (r *reader) getIDs(key string) (ids []uint32, err error) {
+	s, err := r.envParams.GetEnv(key)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, idString := range strings.Split(s, ",") {
+		idString = strings.TrimSpace(idString)
+		if idString == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(idString, 10, 32)
+		if err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: ID %q is not valid: %w", key, idString, err))
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids, nil
+}
+
+// GetOutboundPorts obtains the destination ports allowed outside the VPN tunnel
+// from the comma separated list of the environment variable FIREWALL_OUTBOUND_PORTS,
+// where each port is in the form `protocol/port[@destination]`.
+func (r *reader) GetOutboundPorts() (ports []models.OutboundPort, err error) {
+	const key = "FIREWALL_OUTBOUND_PORTS"
+	s, err := r.envParams.GetEnv(key)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, portString := range strings.Split(s, ",") {
+		port, err := parseOutboundPort(portString)
+		if err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// GetStaticRoutes obtains the static routes from the comma separated list of the
+// environment variable ROUTES, where each route is in the form
+// `subnet [via gateway] [dev interface]`.
+func (r *reader) GetStaticRoutes() (routes []models.StaticRoute, err error) {
+	const key = "ROUTES"
+	s, err := r.envParams.GetEnv(key)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, routeString := range strings.Split(s, ",") {
+		route, err := parseStaticRoute(routeString)
+		if err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+		}
+		routes = append(routes, route)
+	}
+	return routes, nil
+}
+
+func parseStaticRoute(s string) (route models.StaticRoute, err error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return route, fmt.Errorf("route is empty")
+	}
+	_, destination, err := net.ParseCIDR(fields[0])
+	if err != nil {
+		return route, fmt.Errorf("cannot parse route %q: %w", s, err)
+	}
+	route.Destination = *destination
+	fields = fields[1:]
+	const pairLength = 2
+	for len(fields) > 0 {
+		if len(fields) < pairLength {
+			return route, fmt.Errorf("cannot parse route %q: missing value for %q", s, fields[0])
+		}
+		keyword, value := fields[0], fields[1]
+		switch keyword {
+		case "via":
+			route.Gateway = net.ParseIP(value)
+			if route.Gateway == nil {
+				return route, fmt.Errorf("cannot parse route %q: gateway %q is not a valid IP address", s, value)
+			}
+		case "dev":
+			route.Interface = value
+		default:
+			return route, fmt.Errorf("cannot parse route %q: unknown keyword %q", s, keyword)
+		}
+		fields = fields[pairLength:]
+	}
+	if route.Gateway == nil && route.Interface == "" {
+		return route, fmt.Errorf("cannot parse route %q: no gateway or interface specified", s)
+	}
+	return route, nil
+}
+
+func parseOutboundPort(s string) (port models.OutboundPort, err error) {
+	s = strings.TrimSpace(s)
+	const maxParts = 2
+	parts := strings.SplitN(s, "@", maxParts)
+	protocolAndPort := strings.Split(parts[0], "/")
+	if len(protocolAndPort) != maxParts {
+		return port, fmt.Errorf("cannot parse outbound port %q: it must be in the form protocol/port[@destination]", s)
+	}
+	switch protocol := models.NetworkProtocol(strings.ToLower(protocolAndPort[0])); protocol {
+	case "tcp", "udp":
+		port.Protocol = protocol
+	default:
+		return port, fmt.Errorf("cannot parse outbound port %q: protocol %q is not tcp or udp", s, protocolAndPort[0])
+	}
+	portValue, err := strconv.ParseUint(protocolAndPort[1], 10, 16)
+	if err != nil || portValue == 0 {
+		return port, fmt.Errorf("cannot parse outbound port %q: port %q is not valid", s, protocolAndPort[1])
+	}
+	port.Port = uint16(portValue)
+	if len(parts) == 1 {
+		return port, nil
+	}
+	destination := parts[1]
+	if !strings.Contains(destination, "/") {
+		ip := net.ParseIP(destination)
+		if ip == nil {
+			return port, fmt.Errorf("cannot parse outbound port %q: destination %q is not a valid IP address or subnet",
+				s, destination)
+		}
+		destination += "/32"
+	}
+	_, port.Destination, err = net.ParseCIDR(destination)
+	if err != nil {
+		return port, fmt.Errorf("cannot parse outbound port %q: %w", s, err)
+	} else if port.Destination.IP.To4() == nil {
+		return port, fmt.Errorf("cannot parse outbound port %q: destination %q is not IPv4", s, parts[1])
+	}
+	return port, nil
+}
+
+// GetRoutesProtection obtains if the routes of gluetun should be restored when
+// another program removes or replaces them, from the environment variable
+// ROUTES_PROTECTION.
+func (r *reader) GetRoutesProtection() (protection bool, err error) {
+	return r.envParams.GetOnOff("ROUTES_PROTECTION", libparams.Default("on"))
+}
+
+// GetRoutesProtectionPeriod obtains the period to check the routes of gluetun,
+// from the environment variable ROUTES_PROTECTION_PERIOD.
+func (r *reader) GetRoutesProtectionPeriod() (period time.Duration, err error) {
+	return r.envParams.GetDuration("ROUTES_PROTECTION_PERIOD", libparams.Default("10s"))
+}
+
+// GetRoutesMetric obtains the metric of the routes added by gluetun,
+// from the environment variable ROUTES_METRIC.
+func (r *reader) GetRoutesMetric() (metric int, err error) {
+	const max = 65535
+	return r.envParams.GetEnvIntRange("ROUTES_METRIC", 0, max, libparams.Default("0"))
+}
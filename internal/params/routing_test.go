@@ -0,0 +1,198 @@
+package params
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseStaticRoute(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s     string
+		route models.StaticRoute
+		err   error
+	}{
+		"empty": {
+			err: fmt.Errorf("route is empty"),
+		},
+		"bad subnet": {
+			s:   "10.0.0.0 via 192.168.1.1",
+			err: fmt.Errorf(`cannot parse route "10.0.0.0 via 192.168.1.1": invalid CIDR address: 10.0.0.0`),
+		},
+		"no gateway or interface": {
+			s:   "10.0.0.0/8",
+			err: fmt.Errorf(`cannot parse route "10.0.0.0/8": no gateway or interface specified`),
+		},
+		"missing value": {
+			s:   "10.0.0.0/8 via",
+			err: fmt.Errorf(`cannot parse route "10.0.0.0/8 via": missing value for "via"`),
+		},
+		"bad gateway": {
+			s:   "10.0.0.0/8 via x",
+			err: fmt.Errorf(`cannot parse route "10.0.0.0/8 via x": gateway "x" is not a valid IP address`),
+		},
+		"unknown keyword": {
+			s:   "10.0.0.0/8 metric 5",
+			err: fmt.Errorf(`cannot parse route "10.0.0.0/8 metric 5": unknown keyword "metric"`),
+		},
+		"gateway": {
+			s: " 10.0.0.0/8 via 192.168.1.1 ",
+			route: models.StaticRoute{
+				Destination: net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.IPMask{255, 0, 0, 0}},
+				Gateway:     net.ParseIP("192.168.1.1"),
+			},
+		},
+		"gateway and interface": {
+			s: "10.0.0.0/8 via 192.168.1.1 dev eth1",
+			route: models.StaticRoute{
+				Destination: net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.IPMask{255, 0, 0, 0}},
+				Gateway:     net.ParseIP("192.168.1.1"),
+				Interface:   "eth1",
+			},
+		},
+		"interface only": {
+			s: "10.0.0.0/8 dev eth1",
+			route: models.StaticRoute{
+				Destination: net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.IPMask{255, 0, 0, 0}},
+				Interface:   "eth1",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			route, err := parseStaticRoute(testCase.s)
+			if testCase.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.route, route)
+		})
+	}
+}
+
+func Test_parseOutboundPort(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s    string
+		port models.OutboundPort
+		err  error
+	}{
+		"no protocol": {
+			s:   "123",
+			err: fmt.Errorf(`cannot parse outbound port "123": it must be in the form protocol/port[@destination]`),
+		},
+		"bad protocol": {
+			s:   "icmp/123",
+			err: fmt.Errorf(`cannot parse outbound port "icmp/123": protocol "icmp" is not tcp or udp`),
+		},
+		"bad port": {
+			s:   "udp/0",
+			err: fmt.Errorf(`cannot parse outbound port "udp/0": port "0" is not valid`),
+		},
+		"bad destination": {
+			s:   "tcp/25@relay",
+			err: fmt.Errorf(`cannot parse outbound port "tcp/25@relay": destination "relay" is not a valid IP address or subnet`),
+		},
+		"IPv6 destination": {
+			s:   "tcp/25@::1",
+			err: fmt.Errorf(`cannot parse outbound port "tcp/25@::1": destination "::1" is not IPv4`),
+		},
+		"all destinations": {
+			s: " UDP/123 ",
+			port: models.OutboundPort{
+				Protocol: "udp",
+				Port:     123,
+			},
+		},
+		"destination IP address": {
+			s: "tcp/587@203.0.113.5",
+			port: models.OutboundPort{
+				Protocol:    "tcp",
+				Port:        587,
+				Destination: &net.IPNet{IP: net.IP{203, 0, 113, 5}, Mask: net.IPMask{255, 255, 255, 255}},
+			},
+		},
+		"destination subnet": {
+			s: "tcp/25@10.0.0.0/8",
+			port: models.OutboundPort{
+				Protocol:    "tcp",
+				Port:        25,
+				Destination: &net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.IPMask{255, 0, 0, 0}},
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			port, err := parseOutboundPort(testCase.s)
+			if testCase.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.port, port)
+		})
+	}
+}
+
+func Test_reader_getIDs(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		value string
+		ids   []uint32
+		err   string
+	}{
+		"empty": {},
+		"single ID": {
+			value: "1000",
+			ids:   []uint32{1000},
+		},
+		"IDs with spaces": {
+			value: " 0, 1000 ,4294967295,",
+			ids:   []uint32{0, 1000, 4294967295},
+		},
+		"bad ID": {
+			value: "1000,user",
+			err: `environment variable IDS: ID "user" is not valid: ` +
+				`strconv.ParseUint: parsing "user": invalid syntax`,
+		},
+		"negative ID": {
+			value: "-1",
+			err: `environment variable IDS: ID "-1" is not valid: ` +
+				`strconv.ParseUint: parsing "-1": invalid syntax`,
+		},
+		"ID out of range": {
+			value: "4294967296",
+			err: `environment variable IDS: ID "4294967296" is not valid: ` +
+				`strconv.ParseUint: parsing "4294967296": value out of range`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := &reader{envParams: &fakeEnvParams{
+				env: map[string]string{"IDS": testCase.value},
+			}}
+			ids, err := r.getIDs("IDS")
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.ids, ids)
+		})
+	}
+}
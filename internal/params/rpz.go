@@ -0,0 +1,50 @@
+package params
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetDNSRPZ obtains the comma separated zone file paths and URLs of the
+// response policy zones for Unbound to apply, from the environment
+// variable DNS_RPZ.
+func (r *reader) GetDNSRPZ() (sources []string, err error) {
+	const key = "DNS_RPZ"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, source := range strings.Split(s, ",") {
+		source = strings.TrimSpace(source)
+		if err := checkRPZSource(source); err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+		}
+		sources = append(sources, source)
+	}
+	return sources, nil
+}
+
+// checkRPZSource verifies the source given is an absolute file path
+// or an HTTP(S) URL.
+func checkRPZSource(source string) error {
+	if !strings.Contains(source, "://") {
+		if !filepath.IsAbs(source) {
+			return fmt.Errorf("response policy zone file path %q is not absolute", source)
+		}
+		return nil
+	}
+	u, err := url.Parse(source)
+	switch {
+	case err != nil:
+		return fmt.Errorf("response policy zone URL %q is not valid: %w", source, err)
+	case u.Scheme != "http" && u.Scheme != "https":
+		return fmt.Errorf("response policy zone URL %q must use http or https", source)
+	case u.Host == "":
+		return fmt.Errorf("response policy zone URL %q has no host", source)
+	}
+	return nil
+}
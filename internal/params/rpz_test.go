@@ -0,0 +1,48 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkRPZSource(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		source string
+		err    string
+	}{
+		"file path": {
+			source: "/gluetun/rpz.zone",
+		},
+		"relative file path": {
+			source: "rpz.zone",
+			err:    `response policy zone file path "rpz.zone" is not absolute`,
+		},
+		"URL": {
+			source: "https://example.com/rpz.txt",
+		},
+		"bad scheme": {
+			source: "ftp://example.com/rpz.txt",
+			err:    `response policy zone URL "ftp://example.com/rpz.txt" must use http or https`,
+		},
+		"no host": {
+			source: "https:///rpz.txt",
+			err:    `response policy zone URL "https:///rpz.txt" has no host`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := checkRPZSource(testCase.source)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
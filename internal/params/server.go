@@ -1,6 +1,12 @@
 package params
 
 import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/models"
 	libparams "github.com/qdm12/golibs/params"
 )
 
@@ -15,3 +21,61 @@ func (r *reader) GetControlServerPort() (port uint16, err error) {
 func (r *reader) GetControlServerLog() (enabled bool, err error) {
 	return r.envParams.GetOnOff("HTTP_CONTROL_SERVER_LOG", libparams.Default("on"))
 }
+
+// GetControlServerAuthFilepath obtains the path of the JSON file defining
+// the API keys of the control server from the environment variable
+// HTTP_CONTROL_SERVER_AUTH_FILE, or an empty string if authentication is disabled.
+func (r *reader) GetControlServerAuthFilepath() (filepath string, err error) {
+	return r.getOptionalPath("HTTP_CONTROL_SERVER_AUTH_FILE")
+}
+
+// GetControlServerListeningAddress obtains the IP address the control server
+// listens on, from the environment variable HTTP_CONTROL_SERVER_LISTENING_ADDRESS,
+// and returns nil to listen on all interfaces if it is empty.
+func (r *reader) GetControlServerListeningAddress() (ip net.IP, err error) {
+	return r.getOptionalIP("HTTP_CONTROL_SERVER_LISTENING_ADDRESS")
+}
+
+// GetControlServerExtraListeners obtains additional control server listeners
+// from the comma separated list of the environment variable
+// HTTP_CONTROL_SERVER_EXTRA_LISTENERS, where each listener has the format
+// host:port, optionally followed by :noauth to serve it without API key.
+// Listeners without API key must listen on a loopback address.
+func (r *reader) GetControlServerExtraListeners() (listeners []models.ControlServerListener, err error) {
+	const key = "HTTP_CONTROL_SERVER_EXTRA_LISTENERS"
+	s, err := r.envParams.GetEnv(key)
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, listenerString := range strings.Split(s, ",") {
+		listener, err := parseControlServerListener(listenerString)
+		if err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+func parseControlServerListener(s string) (listener models.ControlServerListener, err error) {
+	const noAuthSuffix = ":noauth"
+	listener.Auth = !strings.HasSuffix(s, noAuthSuffix)
+	address := strings.TrimSuffix(s, noAuthSuffix)
+	host, portStr, err := net.SplitHostPort(address)
+	if err != nil {
+		return listener, fmt.Errorf("listener %q: %w", s, err)
+	}
+	ip := net.ParseIP(host)
+	if host != "" && ip == nil {
+		return listener, fmt.Errorf("listener %q: host %q is not a valid IP address", s, host)
+	} else if !listener.Auth && (ip == nil || !ip.IsLoopback()) {
+		// without API key, anyone reaching the listener could control the VPN
+		return listener, fmt.Errorf("listener %q: listeners without API key can only listen on a loopback address", s)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 1 || port > 65535 {
+		return listener, fmt.Errorf("listener %q: port %q is not between 1 and 65535", s, portStr)
+	}
+	listener.Address = address
+	return listener, nil
+}
@@ -0,0 +1,65 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseControlServerListener(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s        string
+		listener models.ControlServerListener
+		err      string
+	}{
+		"IPv4 with auth": {
+			s:        "192.168.1.10:8001",
+			listener: models.ControlServerListener{Address: "192.168.1.10:8001", Auth: true},
+		},
+		"IPv6 without auth": {
+			s:        "[::1]:8002:noauth",
+			listener: models.ControlServerListener{Address: "[::1]:8002"},
+		},
+		"all interfaces": {
+			s:        ":8003",
+			listener: models.ControlServerListener{Address: ":8003", Auth: true},
+		},
+		"missing port": {
+			s:   "127.0.0.1",
+			err: `listener "127.0.0.1": address 127.0.0.1: missing port in address`,
+		},
+		"hostname": {
+			s:   "localhost:8001",
+			err: `listener "localhost:8001": host "localhost" is not a valid IP address`,
+		},
+		"no auth on all interfaces": {
+			s:   ":8003:noauth",
+			err: `listener ":8003:noauth": listeners without API key can only listen on a loopback address`,
+		},
+		"no auth on private address": {
+			s:   "192.168.1.10:8001:noauth",
+			err: `listener "192.168.1.10:8001:noauth": listeners without API key can only listen on a loopback address`,
+		},
+		"port out of range": {
+			s:   "127.0.0.1:0:noauth",
+			err: `listener "127.0.0.1:0:noauth": port "0" is not between 1 and 65535`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			listener, err := parseControlServerListener(testCase.s)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.listener, listener)
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package params
 
 import (
+	"fmt"
+	"net"
 	"strconv"
+	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
 	libparams "github.com/qdm12/golibs/params"
 )
 
@@ -26,12 +31,19 @@ func (r *reader) GetShadowSocksPort() (port uint16, err error) {
 		return 0, err
 	}
 	if err := r.verifier.VerifyPort(portStr); err != nil {
-		return 0, err
+		return 0, r.CollectError(err)
 	}
 	portUint64, err := strconv.ParseUint(portStr, 10, 16)
 	return uint16(portUint64), err
 }
 
+// GetShadowSocksListeningAddress obtains the IP address the ShadowSocks listeners
+// listen on, from the environment variable SHADOWSOCKS_LISTENING_ADDRESS, and
+// returns nil to listen on all interfaces if it is empty.
+func (r *reader) GetShadowSocksListeningAddress() (ip net.IP, err error) {
+	return r.getOptionalIP("SHADOWSOCKS_LISTENING_ADDRESS")
+}
+
 // GetShadowSocksPassword obtains the ShadowSocks server password from the environment variable
 // SHADOWSOCKS_PASSWORD.
 func (r *reader) GetShadowSocksPassword() (password string, err error) {
@@ -44,8 +56,64 @@ func (r *reader) GetShadowSocksPassword() (password string, err error) {
 	return r.envParams.GetEnv("SHADOWSOCKS_PASSWORD", libparams.CaseSensitiveValue())
 }
 
-// GetShadowSocksMethod obtains the ShadowSocks method to use from the environment variable
+// GetShadowSocksMethod obtains the ShadowSocks AEAD cipher to use from the environment variable
 // SHADOWSOCKS_METHOD.
 func (r *reader) GetShadowSocksMethod() (method string, err error) {
-	return r.envParams.GetEnv("SHADOWSOCKS_METHOD", libparams.Default("chacha20-ietf-poly1305"))
+	return r.envParams.GetValueIfInside("SHADOWSOCKS_METHOD",
+		constants.ShadowsocksCipherChoices(),
+		libparams.Default(constants.ShadowsocksChacha20IetfPoly1305))
+}
+
+// GetShadowSocksUDP obtains if the ShadowSocks server should relay UDP traffic
+// from the environment variable SHADOWSOCKS_UDP.
+func (r *reader) GetShadowSocksUDP() (enabled bool, err error) {
+	return r.envParams.GetOnOff("SHADOWSOCKS_UDP", libparams.Default("on"))
+}
+
+// GetShadowSocksExtraListeners obtains additional ShadowSocks listeners from the
+// comma separated list of the environment variable SHADOWSOCKS_EXTRA_LISTENERS,
+// where each listener has the format name:port:method:password.
+func (r *reader) GetShadowSocksExtraListeners() (listeners []models.ShadowSocksListener, err error) {
+	const key = "SHADOWSOCKS_EXTRA_LISTENERS"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue(), libparams.Unset())
+	if err != nil || s == "" {
+		return nil, err
+	}
+	for _, listenerString := range strings.Split(s, ",") {
+		const expectedFields = 4
+		fields := strings.SplitN(listenerString, ":", expectedFields)
+		if len(fields) != expectedFields {
+			return nil, r.CollectError(fmt.Errorf(
+				"environment variable %s: listener %q does not have the format name:port:method:password",
+				key, strings.SplitN(listenerString, ":", 2)[0]))
+		}
+		listener := models.ShadowSocksListener{
+			Name:     fields[0],
+			Method:   strings.ToLower(fields[2]),
+			Password: fields[3],
+		}
+		if err := r.verifier.VerifyPort(fields[1]); err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: listener %s: %w", key, listener.Name, err))
+		}
+		port, err := strconv.ParseUint(fields[1], 10, 16)
+		if err != nil {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: listener %s: %w", key, listener.Name, err))
+		}
+		listener.Port = uint16(port)
+		if !isInside(listener.Method, constants.ShadowsocksCipherChoices()) {
+			return nil, r.CollectError(fmt.Errorf("environment variable %s: listener %s: method %q is not supported",
+				key, listener.Name, listener.Method))
+		}
+		listeners = append(listeners, listener)
+	}
+	return listeners, nil
+}
+
+func isInside(s string, possibilities []string) bool {
+	for _, possibility := range possibilities {
+		if s == possibility {
+			return true
+		}
+	}
+	return false
 }
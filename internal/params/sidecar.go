@@ -0,0 +1,61 @@
+package params
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetSidecarTarget obtains the kind and target to watch to shut down
+// from the environment variable SIDECAR_TARGET, which is in the form
+// process:name, file:/path or an http(s) URL. The kind returned is
+// empty if the sidecar mode is disabled.
+func (r *reader) GetSidecarTarget() (kind, target string, err error) {
+	const key = "SIDECAR_TARGET"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return "", "", err
+	}
+	kind, target, err = parseSidecarTarget(s)
+	if err != nil {
+		return "", "", r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+	}
+	return kind, target, nil
+}
+
+func parseSidecarTarget(s string) (kind, target string, err error) {
+	switch {
+	case strings.HasPrefix(s, "http://"), strings.HasPrefix(s, "https://"):
+		u, err := url.Parse(s)
+		if err != nil {
+			return "", "", err
+		}
+		if u.Host == "" {
+			return "", "", fmt.Errorf("host is missing in URL %q", s)
+		}
+		return constants.SidecarHTTP, s, nil
+	case strings.HasPrefix(s, constants.SidecarProcess+":"):
+		kind, target = constants.SidecarProcess, strings.TrimPrefix(s, constants.SidecarProcess+":")
+	case strings.HasPrefix(s, constants.SidecarFile+":"):
+		kind, target = constants.SidecarFile, strings.TrimPrefix(s, constants.SidecarFile+":")
+		if !strings.HasPrefix(target, "/") {
+			return "", "", fmt.Errorf("file path %q is not absolute", target)
+		}
+	default:
+		return "", "", fmt.Errorf("target %q must start with process:, file:, http:// or https://", s)
+	}
+	if target == "" {
+		return "", "", fmt.Errorf("%s target cannot be empty", kind)
+	}
+	return kind, target, nil
+}
+
+// GetSidecarPeriod obtains the period to check the sidecar target
+// from the environment variable SIDECAR_PERIOD.
+func (r *reader) GetSidecarPeriod() (period time.Duration, err error) {
+	return r.envParams.GetDuration("SIDECAR_PERIOD", libparams.Default("5s"))
+}
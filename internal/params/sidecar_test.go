@@ -0,0 +1,66 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseSidecarTarget(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s      string
+		kind   string
+		target string
+		err    string
+	}{
+		"process": {
+			s:      "process:myapp",
+			kind:   constants.SidecarProcess,
+			target: "myapp",
+		},
+		"file": {
+			s:      "file:/shared/exit-code",
+			kind:   constants.SidecarFile,
+			target: "/shared/exit-code",
+		},
+		"http": {
+			s:      "http://localhost:8080/health",
+			kind:   constants.SidecarHTTP,
+			target: "http://localhost:8080/health",
+		},
+		"relative file": {
+			s:   "file:exit-code",
+			err: `file path "exit-code" is not absolute`,
+		},
+		"empty process": {
+			s:   "process:",
+			err: "process target cannot be empty",
+		},
+		"missing host": {
+			s:   "http:///health",
+			err: `host is missing in URL "http:///health"`,
+		},
+		"unknown kind": {
+			s:   "pid:1",
+			err: `target "pid:1" must start with process:, file:, http:// or https://`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			kind, target, err := parseSidecarTarget(testCase.s)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.kind, kind)
+			assert.Equal(t, testCase.target, target)
+		})
+	}
+}
@@ -1,6 +1,12 @@
 package params
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	libparams "github.com/qdm12/golibs/params"
 )
@@ -27,3 +33,58 @@ func (r *reader) GetIPStatusFilepath() (filepath models.Filepath, err error) {
 		libparams.Default("/tmp/gluetun/ip"), libparams.CaseSensitiveValue())
 	return models.Filepath(filepathStr), err
 }
+
+// GetLogTimeLayout obtains the Go time layout of the log timestamps from the
+// environment variable LOG_TIME_FORMAT, which can also be iso8601, rfc3339
+// or none to return an empty layout.
+func (r *reader) GetLogTimeLayout() (layout string, err error) {
+	s, err := r.envParams.GetEnv("LOG_TIME_FORMAT",
+		libparams.Default(constants.LogTimeISO8601), libparams.CaseSensitiveValue())
+	if err != nil {
+		return "", err
+	}
+	switch strings.ToLower(s) {
+	case constants.LogTimeISO8601:
+		return constants.LogTimeLayoutISO8601, nil
+	case constants.LogTimeRFC3339:
+		return time.RFC3339, nil
+	case constants.LogTimeNone:
+		return "", nil
+	default:
+		return s, nil
+	}
+}
+
+// GetLogTimeUTC obtains if log timestamps are in UTC instead of the
+// local time from the environment variable LOG_TIME_UTC.
+func (r *reader) GetLogTimeUTC() (utc bool, err error) {
+	return r.envParams.GetOnOff("LOG_TIME_UTC", libparams.Default("off"))
+}
+
+// GetLogColor obtains whether to color the logs from the
+// environment variable LOG_COLOR.
+func (r *reader) GetLogColor() (mode string, err error) {
+	return r.envParams.GetValueIfInside("LOG_COLOR",
+		constants.LogColorChoices(), libparams.Default(constants.LogColorAuto))
+}
+
+// GetOverridesFilepath obtains the path of the JSON file to persist settings
+// changed through the control server from the environment variable
+// SETTINGS_OVERRIDES_FILE, or an empty string if they are not persisted.
+func (r *reader) GetOverridesFilepath() (filepath string, err error) {
+	return r.getOptionalPath("SETTINGS_OVERRIDES_FILE")
+}
+
+// GetLogFilter obtains the regular expression matching OpenVPN and Unbound
+// lines not to log from the environment variable LOG_FILTER.
+func (r *reader) GetLogFilter() (filter *regexp.Regexp, err error) {
+	s, err := r.envParams.GetEnv("LOG_FILTER", libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return nil, err
+	}
+	filter, err = regexp.Compile(s)
+	if err != nil {
+		return nil, r.CollectError(fmt.Errorf("environment variable LOG_FILTER: %w", err))
+	}
+	return filter, nil
+}
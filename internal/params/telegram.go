@@ -0,0 +1,44 @@
+package params
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetTelegramToken obtains the Telegram bot token from the environment
+// variable TELEGRAM_TOKEN, and the Telegram bot is disabled if it is empty.
+func (r *reader) GetTelegramToken() (token string, err error) {
+	return r.envParams.GetEnv("TELEGRAM_TOKEN",
+		libparams.CaseSensitiveValue(), libparams.Unset())
+}
+
+// GetTelegramChatIDs obtains the IDs of the Telegram chats allowed to use
+// the bot and notified of the VPN events, from the comma separated list
+// of the environment variable TELEGRAM_CHAT_IDS.
+func (r *reader) GetTelegramChatIDs() (chatIDs []int64, err error) {
+	const key = "TELEGRAM_CHAT_IDS"
+	s, err := r.envParams.GetEnv(key, libparams.Compulsory())
+	if err != nil || s == "" { // empty if the error is collected
+		return nil, err
+	}
+	chatIDs, err = parseTelegramChatIDs(s)
+	if err != nil {
+		return nil, r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+	}
+	return chatIDs, nil
+}
+
+func parseTelegramChatIDs(s string) (chatIDs []int64, err error) {
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		chatID, err := strconv.ParseInt(field, 10, 64) //nolint:gomnd
+		if err != nil || chatID == 0 {
+			return nil, fmt.Errorf("chat ID %q is not a valid integer", field)
+		}
+		chatIDs = append(chatIDs, chatID)
+	}
+	return chatIDs, nil
+}
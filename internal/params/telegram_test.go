@@ -0,0 +1,48 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTelegramChatIDs(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s       string
+		chatIDs []int64
+		err     string
+	}{
+		"single": {
+			s:       "123456",
+			chatIDs: []int64{123456},
+		},
+		"group and user": {
+			s:       "-1001234567890, 42",
+			chatIDs: []int64{-1001234567890, 42},
+		},
+		"not a number": {
+			s:   "42,abc",
+			err: `chat ID "abc" is not a valid integer`,
+		},
+		"zero": {
+			s:   "0",
+			err: `chat ID "0" is not a valid integer`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			chatIDs, err := parseTelegramChatIDs(testCase.s)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.chatIDs, chatIDs)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package params
+
+import (
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetTransmissionURL obtains the Transmission RPC URL to update with the
+// forwarded port from the environment variable PORT_FORWARDING_TRANSMISSION_URL.
+func (r *reader) GetTransmissionURL() (url string, err error) {
+	return r.envParams.GetEnv("PORT_FORWARDING_TRANSMISSION_URL", libparams.CaseSensitiveValue())
+}
+
+// GetTransmissionUser obtains the Transmission RPC user from the environment
+// variable PORT_FORWARDING_TRANSMISSION_USER.
+func (r *reader) GetTransmissionUser() (user string, err error) {
+	return r.envParams.GetEnv("PORT_FORWARDING_TRANSMISSION_USER",
+		libparams.CaseSensitiveValue(), libparams.Unset())
+}
+
+// GetTransmissionPassword obtains the Transmission RPC password from the environment
+// variable PORT_FORWARDING_TRANSMISSION_PASSWORD.
+func (r *reader) GetTransmissionPassword() (password string, err error) {
+	return r.envParams.GetEnv("PORT_FORWARDING_TRANSMISSION_PASSWORD",
+		libparams.CaseSensitiveValue(), libparams.Unset())
+}
+
+// GetDelugeURL obtains the Deluge web JSON RPC URL to update with the
+// forwarded port from the environment variable PORT_FORWARDING_DELUGE_URL.
+func (r *reader) GetDelugeURL() (url string, err error) {
+	return r.envParams.GetEnv("PORT_FORWARDING_DELUGE_URL", libparams.CaseSensitiveValue())
+}
+
+// GetDelugePassword obtains the Deluge web UI password from the environment
+// variable PORT_FORWARDING_DELUGE_PASSWORD.
+func (r *reader) GetDelugePassword() (password string, err error) {
+	return r.envParams.GetEnv("PORT_FORWARDING_DELUGE_PASSWORD",
+		libparams.CaseSensitiveValue(), libparams.Unset())
+}
@@ -0,0 +1,39 @@
+package params
+
+import (
+	"fmt"
+	"net/url"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetTracingEndpoint obtains the OTLP HTTP endpoint to export spans to
+// from the environment variable OTEL_EXPORTER_OTLP_ENDPOINT, for example
+// http://collector:4318, or an empty string if tracing is disabled.
+func (r *reader) GetTracingEndpoint() (endpoint string, err error) {
+	const key = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	s, err := r.envParams.GetEnv(key, libparams.CaseSensitiveValue())
+	if err != nil || s == "" {
+		return "", err
+	}
+	endpointURL, err := url.Parse(s)
+	if err != nil {
+		return "", r.CollectError(fmt.Errorf("environment variable %s: %w", key, err))
+	}
+	switch endpointURL.Scheme {
+	case "http", "https":
+	default:
+		return "", r.CollectError(fmt.Errorf("environment variable %s: scheme %q is not supported", key, endpointURL.Scheme))
+	}
+	if endpointURL.Host == "" {
+		return "", r.CollectError(fmt.Errorf("environment variable %s: host is missing", key))
+	}
+	return s, nil
+}
+
+// GetTracingServiceName obtains the service name of the exported spans
+// from the environment variable OTEL_SERVICE_NAME.
+func (r *reader) GetTracingServiceName() (name string, err error) {
+	return r.envParams.GetEnv("OTEL_SERVICE_NAME",
+		libparams.Default("gluetun"), libparams.CaseSensitiveValue())
+}
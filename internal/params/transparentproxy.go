@@ -0,0 +1,26 @@
+package params
+
+import (
+	"net"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetTransparentProxy obtains if the transparent proxy is enabled from the
+// environment variable TRANSPARENT_PROXY.
+func (r *reader) GetTransparentProxy() (enabled bool, err error) {
+	return r.envParams.GetOnOff("TRANSPARENT_PROXY", libparams.Default("off"))
+}
+
+// GetTransparentProxyPort obtains the port the transparent proxy listens on
+// for redirected TCP connections and UDP packets, from the environment variable TRANSPARENT_PROXY_PORT.
+func (r *reader) GetTransparentProxyPort() (port uint16, err error) {
+	return r.envParams.GetPort("TRANSPARENT_PROXY_PORT", libparams.Default("8889"))
+}
+
+// GetTransparentProxySubnets obtains the source subnets of the TCP and UDP traffic
+// to redirect to the transparent proxy, from the comma separated list of the
+// environment variable TRANSPARENT_PROXY_SUBNETS.
+func (r *reader) GetTransparentProxySubnets() (subnets []net.IPNet, err error) {
+	return r.getSubnets("TRANSPARENT_PROXY_SUBNETS", libparams.Compulsory())
+}
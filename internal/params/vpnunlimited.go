@@ -0,0 +1,35 @@
+package params
+
+import (
+	"github.com/qdm12/gluetun/internal/constants"
+)
+
+// GetVPNUnlimitedCountries obtains the countries for the VPN Unlimited servers from the
+// environment variable COUNTRY.
+func (r *reader) GetVPNUnlimitedCountries() (countries []string, err error) {
+	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.CountryChoices(constants.VPNUnlimitedCountryChoices()))
+}
+
+// GetVPNUnlimitedCities obtains the cities for the VPN Unlimited servers from the
+// environment variable CITY.
+func (r *reader) GetVPNUnlimitedCities() (cities []string, err error) {
+	return r.envParams.GetCSVInPossibilities("CITY", constants.VPNUnlimitedCityChoices())
+}
+
+// GetVPNUnlimitedClientKey obtains the one line client key to use for openvpn from the
+// environment variable CLIENT_KEY or from the file at /gluetun/client.key.
+func (r *reader) GetVPNUnlimitedClientKey() (clientKey string, err error) {
+	return r.getClientKey()
+}
+
+// GetVPNUnlimitedClientCertificate obtains the client certificate to use for openvpn from the
+// file at /gluetun/client.crt.
+func (r *reader) GetVPNUnlimitedClientCertificate() (clientCertificate string, err error) {
+	return r.getClientCertificate()
+}
+
+// GetVPNUnlimitedCertificateAuthority obtains the certificate authority to use for openvpn
+// from the file at /gluetun/ca.crt.
+func (r *reader) GetVPNUnlimitedCertificateAuthority() (certificateAuthority string, err error) {
+	return r.getCertificateAuthority()
+}
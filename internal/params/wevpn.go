@@ -0,0 +1,29 @@
+package params
+
+import (
+	"github.com/qdm12/gluetun/internal/constants"
+)
+
+// GetWeVPNCountries obtains the countries for the WeVPN servers from the
+// environment variable COUNTRY.
+func (r *reader) GetWeVPNCountries() (countries []string, err error) {
+	return r.envParams.GetCSVInPossibilities("COUNTRY", constants.CountryChoices(constants.WeVPNCountryChoices()))
+}
+
+// GetWeVPNCities obtains the cities for the WeVPN servers from the
+// environment variable CITY.
+func (r *reader) GetWeVPNCities() (cities []string, err error) {
+	return r.envParams.GetCSVInPossibilities("CITY", constants.WeVPNCityChoices())
+}
+
+// GetWeVPNCertificateAuthority obtains the certificate authority to use for openvpn
+// from the file at /gluetun/ca.crt.
+func (r *reader) GetWeVPNCertificateAuthority() (certificateAuthority string, err error) {
+	return r.getCertificateAuthority()
+}
+
+// GetWeVPNTLSCryptKey obtains the OpenVPN static key to use for tls-crypt
+// from the file at /gluetun/tls-crypt.key.
+func (r *reader) GetWeVPNTLSCryptKey() (key string, err error) {
+	return r.getTLSCryptKey()
+}
@@ -21,8 +21,13 @@ func (r *reader) GetWindscribeCities() (cities []string, err error) {
 }
 
 // GetWindscribeHostnames obtains the hostnames for the Windscribe servers from the
-// environment variable HOSTNAME.
+// environment variable SERVER_HOSTNAME, or from the environment variable
+// HOSTNAME for retro-compatibility.
 func (r *reader) GetWindscribeHostnames() (hostnames []string, err error) {
+	hostnames, err = r.GetServerHostnames()
+	if err != nil || len(hostnames) > 0 {
+		return hostnames, err
+	}
 	return r.envParams.GetCSVInPossibilities("HOSTNAME", constants.WindscribeHostnameChoices())
 }
 
@@ -42,13 +47,13 @@ func (r *reader) GetWindscribePort(protocol models.NetworkProtocol) (port uint16
 		switch n {
 		case 21, 22, 80, 123, 143, 443, 587, 1194, 3306, 8080, 54783:
 		default:
-			return 0, fmt.Errorf("port %d is not valid for protocol %s", n, protocol)
+			return 0, r.CollectError(fmt.Errorf("port %d is not valid for protocol %s", n, protocol))
 		}
 	case constants.UDP:
 		switch n {
 		case 53, 80, 123, 443, 1194, 54783:
 		default:
-			return 0, fmt.Errorf("port %d is not valid for protocol %s", n, protocol)
+			return 0, r.CollectError(fmt.Errorf("port %d is not valid for protocol %s", n, protocol))
 		}
 	}
 	return uint16(n), nil
@@ -0,0 +1,50 @@
+package params
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	libparams "github.com/qdm12/golibs/params"
+)
+
+// GetWireguardPrivateKey obtains the base64 encoded Wireguard private key
+// from the environment variable WIREGUARD_PRIVATE_KEY.
+func (r *reader) GetWireguardPrivateKey() (privateKey string, err error) {
+	defer func() {
+		unsetErr := r.unsetEnv("WIREGUARD_PRIVATE_KEY")
+		if err == nil {
+			err = unsetErr
+		}
+	}()
+	return r.envParams.GetEnv("WIREGUARD_PRIVATE_KEY", libparams.CaseSensitiveValue(), libparams.Compulsory())
+}
+
+// GetWireguardAddresses obtains the addresses assigned to the Wireguard
+// interface from the comma separated list of CIDR addresses of the
+// environment variable WIREGUARD_ADDRESS.
+func (r *reader) GetWireguardAddresses() (addresses []net.IPNet, err error) {
+	const key = "WIREGUARD_ADDRESS"
+	s, err := r.envParams.GetEnv(key, libparams.Compulsory())
+	if err != nil || s == "" { // empty if the error is collected
+		return nil, err
+	}
+	addresses, err = parseWireguardAddresses(key, s)
+	if err != nil {
+		return nil, r.CollectError(err)
+	}
+	return addresses, nil
+}
+
+func parseWireguardAddresses(key, s string) (addresses []net.IPNet, err error) {
+	for _, field := range strings.Split(s, ",") {
+		field = strings.TrimSpace(field)
+		ip, network, err := net.ParseCIDR(field)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: address %q is not valid: %w", key, field, err)
+		}
+		// keep the IP address assigned instead of the network address
+		addresses = append(addresses, net.IPNet{IP: ip, Mask: network.Mask})
+	}
+	return addresses, nil
+}
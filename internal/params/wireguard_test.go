@@ -0,0 +1,51 @@
+package params
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseWireguardAddresses(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		s         string
+		addresses []net.IPNet
+		err       string
+	}{
+		"IPv4": {
+			s: "10.64.222.21/32",
+			addresses: []net.IPNet{
+				{IP: net.ParseIP("10.64.222.21"), Mask: net.CIDRMask(32, 32)},
+			},
+		},
+		"IPv4 and IPv6": {
+			s: "10.5.0.2/16, fc00:bbbb:bbbb:bb01::1/128",
+			addresses: []net.IPNet{
+				{IP: net.ParseIP("10.5.0.2"), Mask: net.CIDRMask(16, 32)},
+				{IP: net.ParseIP("fc00:bbbb:bbbb:bb01::1"), Mask: net.CIDRMask(128, 128)},
+			},
+		},
+		"missing mask": {
+			s: "10.64.222.21",
+			err: `environment variable WIREGUARD_ADDRESS: address "10.64.222.21" is not valid: ` +
+				`invalid CIDR address: 10.64.222.21`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			addresses, err := parseWireguardAddresses("WIREGUARD_ADDRESS", testCase.s)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.addresses, addresses)
+		})
+	}
+}
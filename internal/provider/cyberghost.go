@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 	"strings"
@@ -16,22 +15,23 @@ import (
 )
 
 type cyberghost struct {
-	servers    []models.CyberghostServer
-	randSource rand.Source
+	servers []models.CyberghostServer
+	picker  Picker
 }
 
-func newCyberghost(servers []models.CyberghostServer, timeNow timeNowFunc) *cyberghost {
+func newCyberghost(servers []models.CyberghostServer, picker Picker) *cyberghost {
 	return &cyberghost{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
-func (c *cyberghost) filterServers(regions []string, group string) (servers []models.CyberghostServer) {
+func (c *cyberghost) filterServers(regions, hostnames []string, group string) (servers []models.CyberghostServer) {
 	for _, server := range c.servers {
 		switch {
 		case len(group) > 0 && !strings.EqualFold(group, server.Group),
-			filterByPossibilities(server.Region, regions):
+			filterByPossibilities(server.Region, regions),
+			filterByPossibilities(server.Hostname, hostnames):
 		default:
 			servers = append(servers, server)
 		}
@@ -46,20 +46,39 @@ func (c *cyberghost) GetOpenVPNConnection(selection models.ServerSelection) (
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: httpsPort, Protocol: selection.Protocol}, nil
 	}
 
-	servers := c.filterServers(selection.Regions, selection.Group)
+	servers := c.filterServers(selection.Regions, selection.Hostnames, selection.Group)
 	if len(servers) == 0 {
-		return connection,
-			fmt.Errorf("no server found for regions %s and group %q", commaJoin(selection.Regions), selection.Group)
+		return connection, fmt.Errorf("no server found for regions %s, hostnames %s and group %q",
+			commaJoin(selection.Regions), commaJoin(selection.Hostnames), selection.Group)
 	}
 
 	var connections []models.OpenVPNConnection
 	for _, server := range servers {
+		port, ok := pickPort(server.Ports, selection.Protocol, selection.CustomPort, httpsPort)
+		if !ok {
+			continue
+		}
 		for _, IP := range server.IPs {
-			connections = append(connections, models.OpenVPNConnection{IP: IP, Port: httpsPort, Protocol: selection.Protocol})
+			connections = append(connections, models.OpenVPNConnection{
+				IP:       IP,
+				Port:     port,
+				Protocol: selection.Protocol,
+				Hostname: server.Hostname,
+				Region:   server.Region,
+			})
 		}
 	}
 
-	return pickRandomConnection(connections, c.randSource), nil
+	if len(connections) == 0 {
+		return connection, noPortServerError(selection.Protocol, selection.CustomPort)
+	}
+
+	return c.picker.Pick(connections), nil
+}
+
+func (c *cyberghost) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	return connection, wireguardNotSupportedError(constants.Cyberghost)
 }
 
 func (c *cyberghost) BuildConf(connection models.OpenVPNConnection, verbosity,
@@ -134,6 +153,6 @@ func (c *cyberghost) BuildConf(connection models.OpenVPNConnection, verbosity,
 
 func (c *cyberghost) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for cyberghost")
 }
@@ -12,6 +12,7 @@ func Test_cyberghost_filterServers(t *testing.T) {
 	testCases := map[string]struct {
 		servers         []models.CyberghostServer
 		regions         []string
+		hostnames       []string
 		group           string
 		filteredServers []models.CyberghostServer
 	}{
@@ -69,13 +70,24 @@ func Test_cyberghost_filterServers(t *testing.T) {
 				{Region: "a", Group: "1"},
 			},
 		},
+		"servers with hostnames filter": {
+			servers: []models.CyberghostServer{
+				{Region: "a", Group: "1", Hostname: "87-1-a.cg-dialup.net"},
+				{Region: "b", Group: "1", Hostname: "87-1-b.cg-dialup.net"},
+				{Region: "c", Group: "2"},
+			},
+			hostnames: []string{"87-1-B.cg-dialup.net"},
+			filteredServers: []models.CyberghostServer{
+				{Region: "b", Group: "1", Hostname: "87-1-b.cg-dialup.net"},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		testCase := testCase
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			c := &cyberghost{servers: testCase.servers}
-			filteredServers := c.filterServers(testCase.regions, testCase.group)
+			filteredServers := c.filterServers(testCase.regions, testCase.hostnames, testCase.group)
 			assert.Equal(t, testCase.filteredServers, filteredServers)
 		})
 	}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_hideMyAss_GetOpenVPNConnection(t *testing.T) {
+	t.Parallel()
+	servers := []models.HideMyAssServer{
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "nl.hma.rocks",
+			Ports: models.OpenVPNPorts{TCP: []uint16{8080}, UDP: []uint16{553}}},
+		{Country: "USA", CountryCode: "us", Region: "New York", City: "New York City", Hostname: "us-ny.hma.rocks",
+			IPs: []net.IP{{2, 2, 2, 2}}, Ports: models.OpenVPNPorts{UDP: []uint16{553}}},
+	}
+	testCases := map[string]struct {
+		selection  models.ServerSelection
+		connection models.OpenVPNConnection
+		err        string
+	}{
+		"region with UDP": {
+			selection: models.ServerSelection{Protocol: constants.UDP, Regions: []string{"new york"}},
+			connection: models.OpenVPNConnection{
+				IP: net.IP{2, 2, 2, 2}, Port: 553, Protocol: constants.UDP, Hostname: "us-ny.hma.rocks",
+				Country: "USA", Region: "New York", City: "New York City",
+			},
+		},
+		"unsupported TCP": {
+			selection: models.ServerSelection{Protocol: constants.TCP, Countries: []string{"us"}},
+			err:       "no server found supporting tcp",
+		},
+		"no IP address known": {
+			selection: models.ServerSelection{Protocol: constants.UDP, Cities: []string{"amsterdam"}},
+			err: "no IP address known for the servers matching the selection, " +
+				"HideMyAss servers must be updated first",
+		},
+		"no server matching": {
+			selection: models.ServerSelection{Protocol: constants.UDP, Countries: []string{"fr"}},
+			err:       "no server found for countries fr, regions , cities  and hostnames ",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			h := newHideMyAss(servers, NewPicker(constants.ServerPickFirst, 0, nil))
+			connection, err := h.GetOpenVPNConnection(testCase.selection)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.connection, connection)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"net"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// GetIPs returns the IP addresses of all the servers of the provider
+// given, or false if the provider is unknown.
+func GetIPs(provider models.VPNProvider, allServers models.AllServers) (ips []net.IP, ok bool) {
+	switch provider {
+	case constants.PrivateInternetAccess:
+		for _, server := range allServers.Pia.Servers {
+			ips = append(ips, server.OpenvpnUDP.IPs...)
+			ips = append(ips, server.OpenvpnTCP.IPs...)
+			ips = appendWireguardIPs(ips, server.Wireguard)
+		}
+	case constants.Mullvad:
+		for _, server := range allServers.Mullvad.Servers {
+			ips = append(ips, server.IPs...)
+			ips = append(ips, server.IPsV6...)
+			ips = appendWireguardIPs(ips, server.Wireguard)
+		}
+	case constants.Windscribe:
+		for _, server := range allServers.Windscribe.Servers {
+			ips = append(ips, server.IP)
+		}
+	case constants.Surfshark:
+		for _, server := range allServers.Surfshark.Servers {
+			ips = append(ips, server.IPs...)
+		}
+	case constants.Cyberghost:
+		for _, server := range allServers.Cyberghost.Servers {
+			ips = append(ips, server.IPs...)
+		}
+	case constants.Vyprvpn:
+		for _, server := range allServers.Vyprvpn.Servers {
+			ips = append(ips, server.IPs...)
+		}
+	case constants.Nordvpn:
+		for _, server := range allServers.Nordvpn.Servers {
+			ips = append(ips, server.IP)
+			ips = appendWireguardIPs(ips, server.Wireguard)
+		}
+	case constants.Purevpn:
+		for _, server := range allServers.Purevpn.Servers {
+			ips = append(ips, server.IPs...)
+		}
+	case constants.Privado:
+		for _, server := range allServers.Privado.Servers {
+			ips = append(ips, server.IP)
+		}
+	case constants.HideMyAss:
+		for _, server := range allServers.HideMyAss.Servers {
+			ips = append(ips, server.IPs...)
+		}
+	case constants.VPNUnlimited:
+		for _, server := range allServers.VPNUnlimited.Servers {
+			ips = append(ips, server.IPs...)
+		}
+	case constants.WeVPN:
+		for _, server := range allServers.WeVPN.Servers {
+			ips = append(ips, server.IPs...)
+		}
+	default:
+		return nil, false
+	}
+	return ips, true
+}
+
+func appendWireguardIPs(ips []net.IP, endpoint *models.WireguardEndpoint) []net.IP {
+	if endpoint == nil {
+		return ips
+	}
+	return append(ips, endpoint.IPs...)
+}
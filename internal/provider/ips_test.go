@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetIPs(t *testing.T) {
+	t.Parallel()
+	allServers := models.AllServers{
+		Mullvad: models.MullvadServers{
+			Servers: []models.MullvadServer{
+				{IPs: []net.IP{{1, 1, 1, 1}}},
+				{Wireguard: &models.WireguardEndpoint{IPs: []net.IP{{2, 2, 2, 2}}}},
+			},
+		},
+	}
+
+	ips, ok := GetIPs(constants.Mullvad, allServers)
+	assert.True(t, ok)
+	assert.Equal(t, []net.IP{{1, 1, 1, 1}, {2, 2, 2, 2}}, ips)
+
+	_, ok = GetIPs("unknown", allServers)
+	assert.False(t, ok)
+}
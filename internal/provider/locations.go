@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// GetLocations returns the values which can be used to filter the servers
+// of the provider given, or false if the provider is unknown.
+func GetLocations(provider models.VPNProvider, allServers models.AllServers) (
+	locations models.ServerLocations, ok bool) {
+	var countries, regions, cities, hostnames []string
+	switch provider {
+	case constants.PrivateInternetAccess:
+		for _, server := range allServers.Pia.Servers {
+			regions = append(regions, server.Region)
+			hostnames = append(hostnames, server.OpenvpnUDP.CN, server.OpenvpnTCP.CN)
+		}
+	case constants.Mullvad:
+		for _, server := range allServers.Mullvad.Servers {
+			if server.Wireguard != nil {
+				continue
+			}
+			countries = append(countries, server.Country)
+			cities = append(cities, server.City)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.Windscribe:
+		for _, server := range allServers.Windscribe.Servers {
+			regions = append(regions, server.Region)
+			cities = append(cities, server.City)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.Surfshark:
+		for _, server := range allServers.Surfshark.Servers {
+			regions = append(regions, server.Region)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.Cyberghost:
+		for _, server := range allServers.Cyberghost.Servers {
+			regions = append(regions, server.Region)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.Vyprvpn:
+		for _, server := range allServers.Vyprvpn.Servers {
+			regions = append(regions, server.Region)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.Nordvpn:
+		for _, server := range allServers.Nordvpn.Servers {
+			regions = append(regions, server.Region)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.Purevpn:
+		for _, server := range allServers.Purevpn.Servers {
+			regions = append(regions, server.Region)
+			countries = append(countries, server.Country)
+			cities = append(cities, server.City)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.Privado:
+		for _, server := range allServers.Privado.Servers {
+			countries = append(countries, server.Country)
+			cities = append(cities, server.City)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.HideMyAss:
+		for _, server := range allServers.HideMyAss.Servers {
+			countries = append(countries, server.Country)
+			regions = append(regions, server.Region)
+			cities = append(cities, server.City)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.VPNUnlimited:
+		for _, server := range allServers.VPNUnlimited.Servers {
+			countries = append(countries, server.Country)
+			cities = append(cities, server.City)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	case constants.WeVPN:
+		for _, server := range allServers.WeVPN.Servers {
+			countries = append(countries, server.Country)
+			cities = append(cities, server.City)
+			hostnames = append(hostnames, server.Hostname)
+		}
+	default:
+		return locations, false
+	}
+	return models.ServerLocations{
+		Countries: uniqueSorted(countries),
+		Regions:   uniqueSorted(regions),
+		Cities:    uniqueSorted(cities),
+		Hostnames: uniqueSorted(hostnames),
+	}, true
+}
+
+// uniqueSorted returns the non empty values deduplicated case
+// insensitively, since the filters are case insensitive.
+func uniqueSorted(values []string) (unique []string) {
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		key := strings.ToLower(value)
+		if _, ok := seen[key]; ok || value == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		unique = append(unique, value)
+	}
+	sort.Strings(unique)
+	return unique
+}
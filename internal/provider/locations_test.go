@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GetLocations(t *testing.T) {
+	t.Parallel()
+	allServers := models.AllServers{
+		Purevpn: models.PurevpnServers{
+			Servers: []models.PurevpnServer{
+				{Region: "Europe", Country: "Germany", City: "Frankfurt"},
+				{Region: "Europe", Country: "Germany", City: "Berlin"},
+				{Region: "Asia", Country: "Japan", City: "tokyo"},
+				{Region: "Asia", Country: "japan", City: "Tokyo"},
+			},
+		},
+	}
+
+	locations, ok := GetLocations(constants.Purevpn, allServers)
+	assert.True(t, ok)
+	assert.Equal(t, models.ServerLocations{
+		Countries: []string{"Germany", "Japan"},
+		Regions:   []string{"Asia", "Europe"},
+		Cities:    []string{"Berlin", "Frankfurt", "tokyo"},
+	}, locations)
+
+	_, ok = GetLocations("unknown", allServers)
+	assert.False(t, ok)
+}
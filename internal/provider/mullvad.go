@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 
@@ -15,23 +14,27 @@ import (
 )
 
 type mullvad struct {
-	servers    []models.MullvadServer
-	randSource rand.Source
+	servers []models.MullvadServer
+	picker  Picker
 }
 
-func newMullvad(servers []models.MullvadServer, timeNow timeNowFunc) *mullvad {
+func newMullvad(servers []models.MullvadServer, picker Picker) *mullvad {
 	return &mullvad{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
-func (m *mullvad) filterServers(countries, cities, isps []string, owned bool) (servers []models.MullvadServer) {
+func (m *mullvad) filterServers(countries, cities, hostnames, isps []string, owned, wireguard bool) (
+	servers []models.MullvadServer) {
 	for _, server := range m.servers {
 		switch {
 		case
-			filterByPossibilities(server.Country, countries),
+			// Wireguard relays only support Wireguard
+			wireguard != (server.Wireguard != nil),
+			filterByCountry(server.Country, server.CountryCode, countries),
 			filterByPossibilities(server.City, cities),
+			filterByPossibilities(server.Hostname, hostnames),
 			filterByPossibilities(server.ISP, isps),
 			owned && !server.Owned:
 		default:
@@ -56,20 +59,70 @@ func (m *mullvad) GetOpenVPNConnection(selection models.ServerSelection) (
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
 	}
 
-	servers := m.filterServers(selection.Countries, selection.Cities, selection.ISPs, selection.Owned)
+	servers := m.filterServers(selection.Countries, selection.Cities, selection.Hostnames,
+		selection.ISPs, selection.Owned, false)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for countries %s, cities %s, ISPs %s and owned %t",
-			commaJoin(selection.Countries), commaJoin(selection.Cities), commaJoin(selection.ISPs), selection.Owned)
+		return connection, fmt.Errorf("no server found for countries %s, cities %s, hostnames %s, ISPs %s and owned %t",
+			commaJoin(selection.Countries), commaJoin(selection.Cities), commaJoin(selection.Hostnames),
+			commaJoin(selection.ISPs), selection.Owned)
 	}
 
 	var connections []models.OpenVPNConnection
 	for _, server := range servers {
-		for _, IP := range server.IPs {
-			connections = append(connections, models.OpenVPNConnection{IP: IP, Port: port, Protocol: selection.Protocol})
+		IPs := server.IPs
+		if selection.IPv6Endpoint {
+			IPs = server.IPsV6
+		}
+		for _, IP := range IPs {
+			connections = append(connections, models.OpenVPNConnection{
+				IP:       IP,
+				Port:     port,
+				Protocol: selection.Protocol,
+				Hostname: server.Hostname,
+				Country:  server.Country,
+				City:     server.City,
+			})
+		}
+	}
+
+	if len(connections) == 0 {
+		return connection, fmt.Errorf("no IP address found for the %d servers selected", len(servers))
+	}
+
+	return m.picker.Pick(connections), nil
+}
+
+func (m *mullvad) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	servers := m.filterServers(selection.Countries, selection.Cities, selection.Hostnames,
+		selection.ISPs, selection.Owned, true)
+	if len(servers) == 0 {
+		return connection, fmt.Errorf("no Wireguard server found for countries %s, cities %s, hostnames %s, "+
+			"ISPs %s and owned %t", commaJoin(selection.Countries), commaJoin(selection.Cities),
+			commaJoin(selection.Hostnames), commaJoin(selection.ISPs), selection.Owned)
+	}
+
+	var connections []models.WireguardConnection
+	for _, server := range servers {
+		port := server.Wireguard.Port
+		if selection.CustomPort > 0 {
+			port = selection.CustomPort
+		}
+		for _, IP := range server.Wireguard.IPs {
+			if isIPv6 := IP.To4() == nil; isIPv6 != selection.IPv6Endpoint {
+				continue
+			}
+			connections = append(connections, models.WireguardConnection{
+				IP:        IP,
+				Port:      port,
+				PublicKey: server.Wireguard.PublicKey,
+				Country:   server.Country,
+				City:      server.City,
+			})
 		}
 	}
 
-	return pickRandomConnection(connections, m.randSource), nil
+	return pickWireguardConnection(m.picker, connections, selection)
 }
 
 func (m *mullvad) BuildConf(connection models.OpenVPNConnection,
@@ -129,6 +182,6 @@ func (m *mullvad) BuildConf(connection models.OpenVPNConnection,
 
 func (m *mullvad) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for mullvad")
 }
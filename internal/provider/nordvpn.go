@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 
@@ -15,19 +14,19 @@ import (
 )
 
 type nordvpn struct {
-	servers    []models.NordvpnServer
-	randSource rand.Source
+	servers []models.NordvpnServer
+	picker  Picker
 }
 
-func newNordvpn(servers []models.NordvpnServer, timeNow timeNowFunc) *nordvpn {
+func newNordvpn(servers []models.NordvpnServer, picker Picker) *nordvpn {
 	return &nordvpn{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
-func (n *nordvpn) filterServers(regions []string, protocol models.NetworkProtocol, numbers []uint16) (
-	servers []models.NordvpnServer) {
+func (n *nordvpn) filterServers(regions, hostnames []string, protocol models.NetworkProtocol,
+	numbers []uint16, categories []string) (servers []models.NordvpnServer) {
 	numbersStr := make([]string, len(numbers))
 	for i := range numbers {
 		numbersStr[i] = fmt.Sprintf("%d", numbers[i])
@@ -39,7 +38,9 @@ func (n *nordvpn) filterServers(regions []string, protocol models.NetworkProtoco
 			protocol == constants.TCP && !server.TCP,
 			protocol == constants.UDP && !server.UDP,
 			filterByPossibilities(server.Region, regions),
-			filterByPossibilities(numberStr, numbersStr):
+			filterByPossibilities(server.Hostname, hostnames),
+			filterByPossibilities(numberStr, numbersStr),
+			filterByCategories(server.Categories, categories):
 		default:
 			servers = append(servers, server)
 		}
@@ -63,19 +64,51 @@ func (n *nordvpn) GetOpenVPNConnection(selection models.ServerSelection) (
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
 	}
 
-	servers := n.filterServers(selection.Regions, selection.Protocol, selection.Numbers)
+	servers := n.filterServers(selection.Regions, selection.Hostnames, selection.Protocol,
+		selection.Numbers, selection.Categories)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for region %s, protocol %s and numbers %v",
-			commaJoin(selection.Regions), selection.Protocol, selection.Numbers)
+		return connection, fmt.Errorf("no server found for region %s, hostnames %s, protocol %s, numbers %v and categories %s",
+			commaJoin(selection.Regions), commaJoin(selection.Hostnames), selection.Protocol, selection.Numbers,
+			commaJoin(selection.Categories))
 	}
 
-	connections := make([]models.OpenVPNConnection, len(servers))
+	connections := make([]models.OpenVPNConnection, 0, len(servers))
 	for i := range servers {
-		connection := models.OpenVPNConnection{IP: servers[i].IP, Port: port, Protocol: selection.Protocol}
+		connection := models.OpenVPNConnection{
+			IP:       servers[i].IP,
+			Port:     port,
+			Protocol: selection.Protocol,
+			Hostname: servers[i].Hostname,
+			Region:   servers[i].Region,
+		}
 		connections = append(connections, connection)
 	}
 
-	return pickRandomConnection(connections, n.randSource), nil
+	return n.picker.Pick(connections), nil
+}
+
+func (n *nordvpn) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	// the protocol is left empty since NordLynx servers use UDP
+	servers := n.filterServers(selection.Regions, selection.Hostnames, "",
+		selection.Numbers, selection.Categories)
+
+	var connections []models.WireguardConnection
+	for _, server := range servers {
+		if server.Wireguard == nil {
+			continue
+		}
+		for _, IP := range server.Wireguard.IPs {
+			connections = append(connections, models.WireguardConnection{
+				IP:        IP,
+				Port:      server.Wireguard.Port,
+				PublicKey: server.Wireguard.PublicKey,
+				Region:    server.Region,
+			})
+		}
+	}
+
+	return pickWireguardConnection(n.picker, connections, selection)
 }
 
 func (n *nordvpn) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int, root bool,
@@ -143,6 +176,6 @@ func (n *nordvpn) BuildConf(connection models.OpenVPNConnection, verbosity, uid,
 
 func (n *nordvpn) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for nordvpn")
 }
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_nordvpn_GetOpenVPNConnection(t *testing.T) {
+	t.Parallel()
+	servers := []models.NordvpnServer{
+		{Region: "Germany", Hostname: "de1.nordvpn.com", UDP: true, IP: net.IP{1, 1, 1, 1}},
+		{Region: "Germany", Hostname: "de2.nordvpn.com", UDP: true, IP: net.IP{2, 2, 2, 2}},
+	}
+	n := newNordvpn(servers, NewPicker(constants.ServerPickFirst, 0, nil))
+
+	connection, err := n.GetOpenVPNConnection(models.ServerSelection{Protocol: constants.UDP})
+
+	// the connections slice must not start with zero value connections
+	require.NoError(t, err)
+	assert.Equal(t, models.OpenVPNConnection{
+		IP: net.IP{1, 1, 1, 1}, Port: 1194, Protocol: constants.UDP,
+		Hostname: "de1.nordvpn.com", Region: "Germany",
+	}, connection)
+}
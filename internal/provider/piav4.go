@@ -8,11 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"math/rand"
 	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/qdm12/gluetun/internal/constants"
@@ -24,18 +24,19 @@ import (
 )
 
 type pia struct {
-	servers        []models.PIAServer
-	timeNow        timeNowFunc
-	randSource     rand.Source
-	activeServer   models.PIAServer
-	activeProtocol models.NetworkProtocol
+	servers           []models.PIAServer
+	timeNow           timeNowFunc
+	picker            Picker
+	activeServer      models.PIAServer
+	activeProtocol    models.NetworkProtocol
+	activeServerMutex sync.RWMutex
 }
 
-func newPrivateInternetAccess(servers []models.PIAServer, timeNow timeNowFunc) *pia {
+func newPrivateInternetAccess(servers []models.PIAServer, timeNow timeNowFunc, picker Picker) *pia {
 	return &pia{
-		servers:    servers,
-		timeNow:    timeNow,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		timeNow: timeNow,
+		picker:  picker,
 	}
 }
 
@@ -68,49 +69,103 @@ func (p *pia) GetOpenVPNConnection(selection models.ServerSelection) (
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
 	}
 
-	servers := filterPIAServers(p.servers, selection.Regions)
+	servers := filterPIAServers(p.servers, selection.Regions, selection.Hostnames)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for region %s", commaJoin(selection.Regions))
+		return connection, fmt.Errorf("no server found for region %s and hostnames %s",
+			commaJoin(selection.Regions), commaJoin(selection.Hostnames))
+	}
+	if selection.PortForwardOnly {
+		servers, err = filterPIAPortForwardServers(p.servers, servers, selection.PortForwardReselect)
+		if err != nil {
+			return connection, err
+		}
 	}
 
 	var connections []models.OpenVPNConnection
 	for _, server := range servers {
-		IPs := server.OpenvpnUDP.IPs
+		openvpnServer := server.OpenvpnUDP
 		if selection.Protocol == constants.TCP {
-			IPs = server.OpenvpnTCP.IPs
+			openvpnServer = server.OpenvpnTCP
 		}
-		for _, IP := range IPs {
-			connections = append(connections, models.OpenVPNConnection{IP: IP, Port: port, Protocol: selection.Protocol})
+		for _, IP := range openvpnServer.IPs {
+			connections = append(connections, models.OpenVPNConnection{
+				IP:       IP,
+				Port:     port,
+				Protocol: selection.Protocol,
+				Hostname: openvpnServer.CN,
+				Region:   server.Region,
+			})
 		}
 	}
 
-	connection = pickRandomConnection(connections, p.randSource)
+	if len(connections) == 0 {
+		// the servers found have no IP address for the protocol
+		return connection, noPortServerError(selection.Protocol, 0)
+	}
 
-	// Reverse lookup server from picked connection
-	found := false
-	for _, server := range servers {
+	return p.picker.Pick(connections), nil
+}
+
+// setActiveServer sets the active server and protocol to the ones of the
+// connection given, the server being found by reverse lookup of its IP address.
+func (p *pia) setActiveServer(connection models.OpenVPNConnection) {
+	p.activeServerMutex.Lock()
+	defer p.activeServerMutex.Unlock()
+	p.activeServer = models.PIAServer{}
+	p.activeProtocol = connection.Protocol
+	for _, server := range p.servers {
 		IPs := server.OpenvpnUDP.IPs
-		if selection.Protocol == constants.TCP {
+		if connection.Protocol == constants.TCP {
 			IPs = server.OpenvpnTCP.IPs
 		}
 		for _, IP := range IPs {
 			if connection.IP.Equal(IP) {
 				p.activeServer = server
-				found = true
-				break
+				return
 			}
 		}
-		if found {
-			break
+	}
+}
+
+func (p *pia) getActiveServer() (server models.PIAServer, protocol models.NetworkProtocol) {
+	p.activeServerMutex.RLock()
+	defer p.activeServerMutex.RUnlock()
+	return p.activeServer, p.activeProtocol
+}
+
+// GetWireguardConnection picks a Wireguard connection without public key,
+// since the server public key is only known once the client key is added
+// to the server with AddPIAWireguardKey when connecting.
+func (p *pia) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	servers := filterPIAServers(p.servers, selection.Regions, nil)
+	if len(servers) == 0 {
+		return connection, fmt.Errorf("no server found for region %s", commaJoin(selection.Regions))
+	}
+
+	var connections []models.WireguardConnection
+	for _, server := range servers {
+		if server.Wireguard == nil || filterByPossibilities(server.Wireguard.CN, selection.Hostnames) {
+			continue
+		}
+		for _, IP := range server.Wireguard.IPs {
+			connections = append(connections, models.WireguardConnection{
+				IP:       IP,
+				Port:     server.Wireguard.Port,
+				Hostname: server.Wireguard.CN,
+				Region:   server.Region,
+			})
 		}
 	}
-	p.activeProtocol = selection.Protocol
 
-	return connection, nil
+	return pickWireguardConnection(p.picker, connections, selection)
 }
 
 func (p *pia) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int, root bool,
 	cipher, auth string, extras models.ExtraConfigOptions) (lines []string) {
+	// the connection may have been picked by another instance, so the active
+	// server is set for the connection the configuration is built for.
+	p.setActiveServer(connection)
 	var X509CRL, certificate string
 	var defaultCipher, defaultAuth string
 	if extras.EncryptionPreset == constants.PIAEncryptionPresetNormal {
@@ -184,18 +239,19 @@ func (p *pia) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid
 //nolint:gocognit
 func (p *pia) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
-	if !p.activeServer.PortForward {
-		pfLogger.Error("The server %s does not support port forwarding", p.activeServer.Region)
+	vpnInterface string, syncState func(port uint16)) {
+	activeServer, activeProtocol := p.getActiveServer()
+	if !activeServer.PortForward {
+		pfLogger.Error("The server %s does not support port forwarding", activeServer.Region)
 		return
 	}
 	if gateway == nil {
 		pfLogger.Error("aborting because: VPN gateway IP address was not found")
 		return
 	}
-	commonName := p.activeServer.OpenvpnUDP.CN
-	if p.activeProtocol == constants.TCP {
-		commonName = p.activeServer.OpenvpnTCP.CN
+	commonName := activeServer.OpenvpnUDP.CN
+	if activeProtocol == constants.TCP {
+		commonName = activeServer.OpenvpnTCP.CN
 	}
 	client, err := newPIAHTTPClient(commonName)
 	if err != nil {
@@ -240,16 +296,9 @@ func (p *pia) PortForward(ctx context.Context, client *http.Client,
 		return
 	}
 
-	filepath := syncState(data.Port)
-	pfLogger.Info("Writing port to %s", filepath)
-	if err := fileManager.WriteToFile(
-		string(filepath), []byte(fmt.Sprintf("%d", data.Port)),
-		files.Permissions(constants.AllReadWritePermissions),
-	); err != nil {
-		pfLogger.Error(err)
-	}
+	syncState(data.Port)
 
-	if err := fw.SetAllowedPort(ctx, data.Port, string(constants.TUN)); err != nil {
+	if err := fw.SetAllowedPort(ctx, data.Port, vpnInterface); err != nil {
 		pfLogger.Error(err)
 	}
 
@@ -293,17 +342,10 @@ func (p *pia) PortForward(ctx context.Context, client *http.Client,
 			if err := fw.RemoveAllowedPort(ctx, oldPort); err != nil {
 				pfLogger.Error(err)
 			}
-			if err := fw.SetAllowedPort(ctx, data.Port, string(constants.TUN)); err != nil {
-				pfLogger.Error(err)
-			}
-			filepath := syncState(data.Port)
-			pfLogger.Info("Writing port to %s", filepath)
-			if err := fileManager.WriteToFile(
-				string(filepath), []byte(fmt.Sprintf("%d", data.Port)),
-				files.Permissions(constants.AllReadWritePermissions),
-			); err != nil {
+			if err := fw.SetAllowedPort(ctx, data.Port, vpnInterface); err != nil {
 				pfLogger.Error(err)
 			}
+			syncState(data.Port)
 			if err := bindPIAPort(ctx, client, gateway, data); err != nil {
 				pfLogger.Error(err)
 			}
@@ -316,10 +358,14 @@ func (p *pia) PortForward(ctx context.Context, client *http.Client,
 	}
 }
 
-func filterPIAServers(servers []models.PIAServer, regions []string) (filtered []models.PIAServer) {
+// filterPIAServers filters the servers by region and by hostname,
+// matching the hostnames against the UDP or TCP common names.
+func filterPIAServers(servers []models.PIAServer, regions, hostnames []string) (filtered []models.PIAServer) {
 	for _, server := range servers {
 		switch {
-		case filterByPossibilities(server.Region, regions):
+		case filterByPossibilities(server.Region, regions),
+			filterByPossibilities(server.OpenvpnUDP.CN, hostnames) &&
+				filterByPossibilities(server.OpenvpnTCP.CN, hostnames):
 		default:
 			filtered = append(filtered, server)
 		}
@@ -327,6 +373,41 @@ func filterPIAServers(servers []models.PIAServer, regions []string) (filtered []
 	return filtered
 }
 
+// filterPIAPortForwardServers returns the servers selected supporting port
+// forwarding. If none of them support it, it returns all the servers supporting
+// port forwarding if reselect is true, and an error listing their regions otherwise.
+func filterPIAPortForwardServers(allServers, selected []models.PIAServer, reselect bool) (
+	filtered []models.PIAServer, err error) {
+	for _, server := range selected {
+		if server.PortForward {
+			filtered = append(filtered, server)
+		}
+	}
+	if len(filtered) > 0 {
+		return filtered, nil
+	}
+	var supportedRegions []string
+	for _, server := range allServers {
+		if server.PortForward {
+			filtered = append(filtered, server)
+			supportedRegions = append(supportedRegions, server.Region)
+		}
+	}
+	selectedRegions := make([]string, len(selected))
+	for i, server := range selected {
+		selectedRegions[i] = server.Region
+	}
+	switch {
+	case len(filtered) == 0:
+		return nil, fmt.Errorf("no region supports port forwarding, try updating the servers information")
+	case !reselect:
+		return nil, fmt.Errorf("port forwarding is not supported by region %s: "+
+			"choose one of the regions %s or set PORT_FORWARDING_RESELECT=on",
+			commaJoin(uniqueSorted(selectedRegions)), commaJoin(uniqueSorted(supportedRegions)))
+	}
+	return filtered, nil
+}
+
 func newPIAHTTPClient(serverName string) (client *http.Client, err error) {
 	certificateBytes, err := base64.StdEncoding.DecodeString(constants.PIACertificateStrong)
 	if err != nil {
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_filterPIAPortForwardServers(t *testing.T) {
+	t.Parallel()
+	allServers := []models.PIAServer{
+		{Region: "CA Montreal", PortForward: true},
+		{Region: "US East"},
+		{Region: "Swiss", PortForward: true},
+		{Region: "US West"},
+	}
+	testCases := map[string]struct {
+		allServers []models.PIAServer
+		selected   []models.PIAServer
+		reselect   bool
+		filtered   []models.PIAServer
+		err        string
+	}{
+		"some selected support port forwarding": {
+			allServers: allServers,
+			selected:   allServers[:2],
+			filtered:   allServers[:1],
+		},
+		"none selected support port forwarding": {
+			allServers: allServers,
+			selected:   []models.PIAServer{allServers[3], allServers[1]},
+			err: "port forwarding is not supported by region US East,US West: " +
+				"choose one of the regions CA Montreal,Swiss or set PORT_FORWARDING_RESELECT=on",
+		},
+		"reselect": {
+			allServers: allServers,
+			selected:   allServers[1:2],
+			reselect:   true,
+			filtered:   []models.PIAServer{allServers[0], allServers[2]},
+		},
+		"no server supports port forwarding": {
+			allServers: allServers[1:2],
+			selected:   allServers[1:2],
+			reselect:   true,
+			err:        "no region supports port forwarding, try updating the servers information",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			filtered, err := filterPIAPortForwardServers(testCase.allServers, testCase.selected, testCase.reselect)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.filtered, filtered)
+		})
+	}
+}
+
+func Test_pia_GetOpenVPNConnection(t *testing.T) {
+	t.Parallel()
+	servers := []models.PIAServer{{
+		Region:     "Swiss",
+		OpenvpnUDP: models.PIAServerOpenvpn{CN: "zurich403", IPs: []net.IP{{1, 1, 1, 1}}},
+		OpenvpnTCP: models.PIAServerOpenvpn{CN: "zurich405", IPs: []net.IP{{2, 2, 2, 2}}},
+	}}
+	testCases := map[string]struct {
+		protocol   models.NetworkProtocol
+		connection models.OpenVPNConnection
+	}{
+		"UDP": {
+			protocol: constants.UDP,
+			connection: models.OpenVPNConnection{
+				IP: net.IP{1, 1, 1, 1}, Port: 1198, Protocol: constants.UDP, Hostname: "zurich403", Region: "Swiss",
+			},
+		},
+		"TCP": {
+			protocol: constants.TCP,
+			connection: models.OpenVPNConnection{
+				IP: net.IP{2, 2, 2, 2}, Port: 502, Protocol: constants.TCP, Hostname: "zurich405", Region: "Swiss",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			p := newPrivateInternetAccess(servers, nil, NewPicker(constants.ServerPickFirst, 0, nil))
+			selection := models.ServerSelection{
+				Protocol:         testCase.protocol,
+				EncryptionPreset: constants.PIAEncryptionPresetNormal,
+			}
+			connection, err := p.GetOpenVPNConnection(selection)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.connection, connection)
+		})
+	}
+}
+
+func Test_pia_GetWireguardConnection(t *testing.T) {
+	t.Parallel()
+	servers := []models.PIAServer{
+		{Region: "Swiss"},
+		{
+			Region: "Swiss",
+			Wireguard: &models.WireguardEndpoint{
+				CN: "zurich401", IPs: []net.IP{{1, 1, 1, 1}}, Port: 1337,
+			},
+		},
+		{
+			Region: "Germany",
+			Wireguard: &models.WireguardEndpoint{
+				CN: "berlin401", IPs: []net.IP{{2, 2, 2, 2}}, Port: 1337,
+			},
+		},
+	}
+	testCases := map[string]struct {
+		selection  models.ServerSelection
+		connection models.WireguardConnection
+		err        string
+	}{
+		"region": {
+			selection: models.ServerSelection{Regions: []string{"swiss"}},
+			connection: models.WireguardConnection{
+				IP: net.IP{1, 1, 1, 1}, Port: 1337, Hostname: "zurich401", Region: "Swiss",
+			},
+		},
+		"hostname": {
+			selection: models.ServerSelection{Hostnames: []string{"berlin401"}},
+			connection: models.WireguardConnection{
+				IP: net.IP{2, 2, 2, 2}, Port: 1337, Hostname: "berlin401", Region: "Germany",
+			},
+		},
+		"no region": {
+			selection: models.ServerSelection{Regions: []string{"Japan"}},
+			err:       "no server found for region Japan",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			p := newPrivateInternetAccess(servers, nil, NewPicker(constants.ServerPickFirst, 0, nil))
+			connection, err := p.GetWireguardConnection(testCase.selection)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.connection, connection)
+		})
+	}
+}
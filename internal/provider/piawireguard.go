@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+const piaTokenHost = "www.privateinternetaccess.com"
+
+// FetchPIAWireguardToken obtains a token with the PIA credentials given from
+// the PIA website, reached through the default gateway since the tunnel is not
+// up yet. The firewall VPN connection is set to the website connection.
+func FetchPIAWireguardToken(ctx context.Context, fw firewall.Configurator,
+	username, password string) (token string, err error) {
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, piaTokenHost)
+	if err != nil {
+		return "", fmt.Errorf("cannot obtain token: %w", err)
+	}
+	ip := addresses[0].IP
+	for _, address := range addresses { // prefer IPv4 for hosts without IPv6
+		if address.IP.To4() != nil {
+			ip = address.IP
+			break
+		}
+	}
+	const httpsPort = 443
+	connection := models.OpenVPNConnection{IP: ip, Port: httpsPort, Protocol: constants.TCP}
+	if err := fw.SetVPNConnection(ctx, connection); err != nil {
+		return "", fmt.Errorf("cannot obtain token: %w", err)
+	}
+	const httpTimeout = 30 * time.Second
+	dialer := &net.Dialer{Timeout: httpTimeout}
+	client := &http.Client{
+		Transport: &http.Transport{
+			// connect to the IP address allowed through the firewall
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), strconv.Itoa(httpsPort)))
+			},
+			TLSHandshakeTimeout: 10 * time.Second, //nolint:gomnd
+		},
+		Timeout: httpTimeout,
+	}
+	tokenURL := "https://" + piaTokenHost + "/gtoken/generateToken"
+	return fetchPIAWireguardToken(ctx, client, tokenURL, username, password)
+}
+
+func fetchPIAWireguardToken(ctx context.Context, client *http.Client,
+	tokenURL, username, password string) (token string, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("cannot obtain token: %w", err)
+	}
+	request.SetBasicAuth(username, password)
+	var data struct {
+		Status  string `json:"status"`
+		Token   string `json:"token"`
+		Message string `json:"message"`
+	}
+	if err := doPIARequest(client, request, &data); err != nil {
+		return "", fmt.Errorf("cannot obtain token: %w", err)
+	}
+	switch {
+	case data.Status != "OK":
+		return "", fmt.Errorf("cannot obtain token: response received from PIA has status %s: %s",
+			data.Status, data.Message)
+	case data.Token == "":
+		return "", fmt.Errorf("cannot obtain token: token is empty")
+	}
+	return data.Token, nil
+}
+
+// AddPIAWireguardKey adds the public key given to the server of the connection
+// given, using the token given. The server is reached through the default
+// gateway since the tunnel is not up yet, and the firewall VPN connection is set
+// to the key exchange connection. It returns the connection with the server
+// public key and port, and the address assigned to the Wireguard interface.
+func AddPIAWireguardKey(ctx context.Context, fw firewall.Configurator, token, publicKey string,
+	connection models.WireguardConnection) (updated models.WireguardConnection, address net.IPNet, err error) {
+	keyConnection := models.OpenVPNConnection{IP: connection.IP, Port: connection.Port, Protocol: constants.TCP}
+	if err := fw.SetVPNConnection(ctx, keyConnection); err != nil {
+		return updated, address, fmt.Errorf("cannot add key: %w", err)
+	}
+	client, err := newPIAHTTPClient(connection.Hostname)
+	if err != nil {
+		return updated, address, fmt.Errorf("cannot add key: %w", err)
+	}
+	keyURL := "https://" + net.JoinHostPort(connection.IP.String(), strconv.Itoa(int(connection.Port))) + "/addKey"
+	return addPIAWireguardKey(ctx, client, keyURL, token, publicKey, connection)
+}
+
+func addPIAWireguardKey(ctx context.Context, client *http.Client, keyURL, token, publicKey string,
+	connection models.WireguardConnection) (updated models.WireguardConnection, address net.IPNet, err error) {
+	queryParams := url.Values{}
+	queryParams.Add("pt", token)
+	queryParams.Add("pubkey", publicKey)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, keyURL+"?"+queryParams.Encode(), nil)
+	if err != nil {
+		return updated, address, fmt.Errorf("cannot add key: %w", err)
+	}
+	var data struct {
+		Status     string `json:"status"`
+		Message    string `json:"message"`
+		ServerKey  string `json:"server_key"`
+		ServerPort uint16 `json:"server_port"`
+		PeerIP     string `json:"peer_ip"`
+	}
+	if err := doPIARequest(client, request, &data); err != nil {
+		return updated, address, fmt.Errorf("cannot add key: %w", err)
+	}
+	if data.Status != "OK" {
+		return updated, address, fmt.Errorf("cannot add key: response received from PIA has status %s: %s",
+			data.Status, data.Message)
+	}
+	peerIP := net.ParseIP(data.PeerIP).To4()
+	switch {
+	case data.ServerKey == "":
+		return updated, address, fmt.Errorf("cannot add key: server key is empty")
+	case peerIP == nil:
+		return updated, address, fmt.Errorf("cannot add key: peer IP address %q is not a valid IPv4 address",
+			data.PeerIP)
+	}
+	updated = connection
+	updated.PublicKey = data.ServerKey
+	if data.ServerPort > 0 {
+		updated.Port = data.ServerPort
+	}
+	address = net.IPNet{IP: peerIP, Mask: net.CIDRMask(32, 32)} //nolint:gomnd
+	return updated, address, nil
+}
+
+// doPIARequest runs the request given and decodes its JSON response into
+// the data given.
+func doPIARequest(client *http.Client, request *http.Request, data interface{}) (err error) {
+	response, err := client.Do(request)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) { // the URL can contain the token
+			err = urlErr.Err
+		}
+		return err
+	}
+	defer response.Body.Close()
+	b, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status code %d: %s", response.StatusCode, string(b))
+	}
+	if err := json.Unmarshal(b, data); err != nil {
+		return fmt.Errorf("cannot decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fetchPIAWireguardToken(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		status   int
+		response string
+		token    string
+		err      string
+	}{
+		"token": {
+			status:   http.StatusOK,
+			response: `{"status":"OK","token":"abc"}`,
+			token:    "abc",
+		},
+		"wrong credentials": {
+			status:   http.StatusUnauthorized,
+			response: "HTTP Basic: Access denied.",
+			err:      "cannot obtain token: HTTP status code 401: HTTP Basic: Access denied.",
+		},
+		"error status": {
+			status:   http.StatusOK,
+			response: `{"status":"ERROR","message":"bad request"}`,
+			err:      "cannot obtain token: response received from PIA has status ERROR: bad request",
+		},
+		"empty token": {
+			status:   http.StatusOK,
+			response: `{"status":"OK"}`,
+			err:      "cannot obtain token: token is empty",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/gtoken/generateToken", r.URL.Path)
+				username, password, ok := r.BasicAuth()
+				assert.True(t, ok)
+				assert.Equal(t, "p1234567", username)
+				assert.Equal(t, "password", password)
+				w.WriteHeader(testCase.status)
+				_, _ = w.Write([]byte(testCase.response))
+			}))
+			defer server.Close()
+
+			token, err := fetchPIAWireguardToken(context.Background(), server.Client(),
+				server.URL+"/gtoken/generateToken", "p1234567", "password")
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.token, token)
+		})
+	}
+}
+
+func Test_addPIAWireguardKey(t *testing.T) {
+	t.Parallel()
+	connection := models.WireguardConnection{
+		IP: net.IP{1, 2, 3, 4}, Port: 1337, Hostname: "zurich401", Region: "Swiss",
+	}
+	testCases := map[string]struct {
+		response string
+		updated  models.WireguardConnection
+		address  net.IPNet
+		err      string
+	}{
+		"key added": {
+			response: `{"status":"OK","server_key":"serverkey","server_port":1338,` +
+				`"server_ip":"1.2.3.4","peer_ip":"10.1.2.3"}`,
+			updated: models.WireguardConnection{
+				IP: net.IP{1, 2, 3, 4}, Port: 1338, PublicKey: "serverkey", Hostname: "zurich401", Region: "Swiss",
+			},
+			address: net.IPNet{IP: net.IP{10, 1, 2, 3}, Mask: net.CIDRMask(32, 32)},
+		},
+		"error status": {
+			response: `{"status":"ERROR","message":"Login failed!"}`,
+			err:      "cannot add key: response received from PIA has status ERROR: Login failed!",
+		},
+		"invalid peer IP": {
+			response: `{"status":"OK","server_key":"serverkey","server_port":1337,"peer_ip":"x"}`,
+			err:      `cannot add key: peer IP address "x" is not a valid IPv4 address`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/addKey", r.URL.Path)
+				assert.Equal(t, "token", r.URL.Query().Get("pt"))
+				assert.Equal(t, "public+key=", r.URL.Query().Get("pubkey"))
+				_, _ = w.Write([]byte(testCase.response))
+			}))
+			defer server.Close()
+
+			updated, address, err := addPIAWireguardKey(context.Background(), server.Client(),
+				server.URL+"/addKey", "token", "public+key=", connection)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.updated, updated)
+			assert.Equal(t, testCase.address, address)
+		})
+	}
+}
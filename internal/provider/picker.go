@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"math/rand"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// Picker picks a connection among the connections of the servers matching
+// the server selection. It keeps its state, such as the round robin position,
+// across the providers created for each connection attempt.
+type Picker interface {
+	Pick(connections []models.OpenVPNConnection) (connection models.OpenVPNConnection)
+}
+
+// NewPicker returns a picker for the mode given. For the random mode, a seed
+// of 0 seeds the random generator with the current time.
+func NewPicker(mode string, seed int64, timeNow timeNowFunc) Picker {
+	switch mode {
+	case constants.ServerPickRoundRobin:
+		return &roundRobinPicker{}
+	case constants.ServerPickFirst:
+		return &firstPicker{}
+	default:
+		if seed == 0 {
+			seed = timeNow().UnixNano()
+		}
+		return &randomPicker{random: rand.New(rand.NewSource(seed))} //nolint:gosec
+	}
+}
+
+type randomPicker struct {
+	random *rand.Rand
+}
+
+func (p *randomPicker) Pick(connections []models.OpenVPNConnection) (connection models.OpenVPNConnection) {
+	return connections[p.random.Intn(len(connections))]
+}
+
+type roundRobinPicker struct {
+	next int
+}
+
+func (p *roundRobinPicker) Pick(connections []models.OpenVPNConnection) (connection models.OpenVPNConnection) {
+	// the connections can change between calls if the servers are updated
+	connection = connections[p.next%len(connections)]
+	p.next = (p.next + 1) % len(connections)
+	return connection
+}
+
+type firstPicker struct{}
+
+func (p *firstPicker) Pick(connections []models.OpenVPNConnection) (connection models.OpenVPNConnection) {
+	return connections[0]
+}
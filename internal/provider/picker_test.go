@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Picker(t *testing.T) {
+	t.Parallel()
+	connections := []models.OpenVPNConnection{
+		{IP: net.IP{1, 1, 1, 1}},
+		{IP: net.IP{2, 2, 2, 2}},
+		{IP: net.IP{3, 3, 3, 3}},
+	}
+	timeNow := func() time.Time { return time.Unix(0, 0) }
+	pickIPs := func(picker Picker, n int) (ips []string) {
+		for i := 0; i < n; i++ {
+			ips = append(ips, picker.Pick(connections).IP.String())
+		}
+		return ips
+	}
+
+	t.Run("first", func(t *testing.T) {
+		t.Parallel()
+		picker := NewPicker(constants.ServerPickFirst, 0, timeNow)
+		assert.Equal(t, []string{"1.1.1.1", "1.1.1.1", "1.1.1.1"}, pickIPs(picker, 3))
+	})
+	t.Run("round robin", func(t *testing.T) {
+		t.Parallel()
+		picker := NewPicker(constants.ServerPickRoundRobin, 0, timeNow)
+		assert.Equal(t, []string{"1.1.1.1", "2.2.2.2", "3.3.3.3", "1.1.1.1"}, pickIPs(picker, 4))
+	})
+	t.Run("random with seed", func(t *testing.T) {
+		t.Parallel()
+		a := NewPicker(constants.ServerPickRandom, 42, timeNow)
+		b := NewPicker(constants.ServerPickRandom, 42, time.Now)
+		assert.Equal(t, pickIPs(a, 10), pickIPs(b, 10))
+	})
+}
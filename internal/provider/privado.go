@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 
@@ -15,21 +14,24 @@ import (
 )
 
 type privado struct {
-	servers    []models.PrivadoServer
-	randSource rand.Source
+	servers []models.PrivadoServer
+	picker  Picker
 }
 
-func newPrivado(servers []models.PrivadoServer, timeNow timeNowFunc) *privado {
+func newPrivado(servers []models.PrivadoServer, picker Picker) *privado {
 	return &privado{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
-func (s *privado) filterServers(hostnames []string) (servers []models.PrivadoServer) {
+func (s *privado) filterServers(countries, cities, hostnames []string) (servers []models.PrivadoServer) {
 	for _, server := range s.servers {
 		switch {
-		case filterByPossibilities(server.Hostname, hostnames):
+		case
+			filterByCountry(server.Country, server.CountryCode, countries),
+			filterByPossibilities(server.City, cities),
+			filterByPossibilities(server.Hostname, hostnames):
 		default:
 			servers = append(servers, server)
 		}
@@ -39,35 +41,60 @@ func (s *privado) filterServers(hostnames []string) (servers []models.PrivadoSer
 
 func (s *privado) GetOpenVPNConnection(selection models.ServerSelection) (
 	connection models.OpenVPNConnection, err error) {
-	var port uint16 = 1194
+	// TCP is only supported by servers with TCP ports recorded
+	var defaultPort uint16
 	switch selection.Protocol {
+	case constants.TCP:
 	case constants.UDP:
+		defaultPort = 1194
 	default:
 		return connection, fmt.Errorf("protocol %q is not supported by Privado", selection.Protocol)
 	}
 
 	if selection.TargetIP != nil {
+		port := defaultPort
+		if selection.CustomPort > 0 {
+			port = selection.CustomPort
+		}
+		if port == 0 {
+			return connection, fmt.Errorf("a port must be set to use TCP with a target IP address")
+		}
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
 	}
 
-	servers := s.filterServers(selection.Hostnames)
+	servers := s.filterServers(selection.Countries, selection.Cities, selection.Hostnames)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for cities %s and server numbers %v",
-			commaJoin(selection.Cities), selection.Numbers)
+		return connection, fmt.Errorf("no server found for countries %s, cities %s and hostnames %s",
+			commaJoin(selection.Countries), commaJoin(selection.Cities), commaJoin(selection.Hostnames))
 	}
 
-	connections := make([]models.OpenVPNConnection, len(servers))
+	connections := make([]models.OpenVPNConnection, 0, len(servers))
 	for i := range servers {
+		port, ok := pickPort(servers[i].Ports, selection.Protocol, selection.CustomPort, defaultPort)
+		if !ok {
+			continue
+		}
 		connection := models.OpenVPNConnection{
 			IP:       servers[i].IP,
 			Port:     port,
 			Protocol: selection.Protocol,
 			Hostname: servers[i].Hostname,
+			Country:  servers[i].Country,
+			City:     servers[i].City,
 		}
 		connections = append(connections, connection)
 	}
 
-	return pickRandomConnection(connections, s.randSource), nil
+	if len(connections) == 0 {
+		return connection, noPortServerError(selection.Protocol, selection.CustomPort)
+	}
+
+	return s.picker.Pick(connections), nil
+}
+
+func (s *privado) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	return connection, wireguardNotSupportedError(constants.Privado)
 }
 
 func (s *privado) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int, root bool,
@@ -118,6 +145,6 @@ func (s *privado) BuildConf(connection models.OpenVPNConnection, verbosity, uid,
 
 func (s *privado) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for privado")
 }
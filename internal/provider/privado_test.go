@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_privado_GetOpenVPNConnection(t *testing.T) {
+	t.Parallel()
+	servers := []models.PrivadoServer{
+		{Country: "Germany", City: "Frankfurt", Hostname: "fra-001.vpn.privado.io", IP: net.IP{1, 1, 1, 1}},
+		{Country: "Germany", City: "Frankfurt", Hostname: "fra-002.vpn.privado.io", IP: net.IP{2, 2, 2, 2}},
+	}
+	p := newPrivado(servers, NewPicker(constants.ServerPickFirst, 0, nil))
+
+	connection, err := p.GetOpenVPNConnection(models.ServerSelection{Protocol: constants.UDP})
+
+	// the connections slice must not start with zero value connections
+	require.NoError(t, err)
+	assert.Equal(t, models.OpenVPNConnection{
+		IP: net.IP{1, 1, 1, 1}, Port: 1194, Protocol: constants.UDP,
+		Hostname: "fra-001.vpn.privado.io", Country: "Germany", City: "Frankfurt",
+	}, connection)
+}
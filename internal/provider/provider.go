@@ -12,36 +12,46 @@ import (
 	"github.com/qdm12/golibs/logging"
 )
 
-// Provider contains methods to read and modify the openvpn configuration to connect as a client.
+// Provider contains methods to read and modify the openvpn configuration to connect as a client,
+// and to pick a Wireguard connection for providers supporting Wireguard.
 type Provider interface {
 	GetOpenVPNConnection(selection models.ServerSelection) (connection models.OpenVPNConnection, err error)
+	GetWireguardConnection(selection models.ServerSelection) (connection models.WireguardConnection, err error)
 	BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int,
 		root bool, cipher, auth string, extras models.ExtraConfigOptions) (lines []string)
 	PortForward(ctx context.Context, client *http.Client,
 		fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-		syncState func(port uint16) (pfFilepath models.Filepath))
+		vpnInterface string, syncState func(port uint16))
 }
 
-func New(provider models.VPNProvider, allServers models.AllServers, timeNow timeNowFunc) Provider {
+// New returns the provider given, using the picker given to pick a connection
+// among the connections of the servers matching the server selection.
+func New(provider models.VPNProvider, allServers models.AllServers, timeNow timeNowFunc, picker Picker) Provider {
 	switch provider {
 	case constants.PrivateInternetAccess:
-		return newPrivateInternetAccess(allServers.Pia.Servers, timeNow)
+		return newPrivateInternetAccess(allServers.Pia.Servers, timeNow, picker)
+	case constants.HideMyAss:
+		return newHideMyAss(allServers.HideMyAss.Servers, picker)
 	case constants.Mullvad:
-		return newMullvad(allServers.Mullvad.Servers, timeNow)
+		return newMullvad(allServers.Mullvad.Servers, picker)
 	case constants.Windscribe:
-		return newWindscribe(allServers.Windscribe.Servers, timeNow)
+		return newWindscribe(allServers.Windscribe.Servers, picker)
 	case constants.Surfshark:
-		return newSurfshark(allServers.Surfshark.Servers, timeNow)
+		return newSurfshark(allServers.Surfshark.Servers, picker)
 	case constants.Cyberghost:
-		return newCyberghost(allServers.Cyberghost.Servers, timeNow)
+		return newCyberghost(allServers.Cyberghost.Servers, picker)
 	case constants.Vyprvpn:
-		return newVyprvpn(allServers.Vyprvpn.Servers, timeNow)
+		return newVyprvpn(allServers.Vyprvpn.Servers, picker)
 	case constants.Nordvpn:
-		return newNordvpn(allServers.Nordvpn.Servers, timeNow)
+		return newNordvpn(allServers.Nordvpn.Servers, picker)
 	case constants.Purevpn:
-		return newPurevpn(allServers.Purevpn.Servers, timeNow)
+		return newPurevpn(allServers.Purevpn.Servers, picker)
 	case constants.Privado:
-		return newPrivado(allServers.Privado.Servers, timeNow)
+		return newPrivado(allServers.Privado.Servers, picker)
+	case constants.VPNUnlimited:
+		return newVPNUnlimited(allServers.VPNUnlimited.Servers, picker)
+	case constants.WeVPN:
+		return newWeVPN(allServers.WeVPN.Servers, picker)
 	default:
 		return nil // should never occur
 	}
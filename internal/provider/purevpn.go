@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 
@@ -15,24 +14,25 @@ import (
 )
 
 type purevpn struct {
-	servers    []models.PurevpnServer
-	randSource rand.Source
+	servers []models.PurevpnServer
+	picker  Picker
 }
 
-func newPurevpn(servers []models.PurevpnServer, timeNow timeNowFunc) *purevpn {
+func newPurevpn(servers []models.PurevpnServer, picker Picker) *purevpn {
 	return &purevpn{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
-func (p *purevpn) filterServers(regions, countries, cities []string) (servers []models.PurevpnServer) {
+func (p *purevpn) filterServers(regions, countries, cities, hostnames []string) (servers []models.PurevpnServer) {
 	for _, server := range p.servers {
 		switch {
 		case
 			filterByPossibilities(server.Region, regions),
-			filterByPossibilities(server.Country, countries),
-			filterByPossibilities(server.City, cities):
+			filterByCountry(server.Country, server.CountryCode, countries),
+			filterByPossibilities(server.City, cities),
+			filterByPossibilities(server.Hostname, hostnames):
 		default:
 			servers = append(servers, server)
 		}
@@ -42,34 +42,60 @@ func (p *purevpn) filterServers(regions, countries, cities []string) (servers []
 
 func (p *purevpn) GetOpenVPNConnection(selection models.ServerSelection) (
 	connection models.OpenVPNConnection, err error) {
-	var port uint16
+	var defaultPort uint16
 	switch {
 	case selection.Protocol == constants.UDP:
-		port = 53
+		defaultPort = 53
 	case selection.Protocol == constants.TCP:
-		port = 80
+		defaultPort = 80
 	default:
 		return connection, fmt.Errorf("protocol %q is unknown", selection.Protocol)
 	}
 
 	if selection.TargetIP != nil {
+		port := defaultPort
+		if selection.CustomPort > 0 {
+			port = selection.CustomPort
+		}
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
 	}
 
-	servers := p.filterServers(selection.Regions, selection.Countries, selection.Cities)
+	servers := p.filterServers(selection.Regions, selection.Countries, selection.Cities, selection.Hostnames)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for regions %s, countries %s and cities %s",
-			commaJoin(selection.Regions), commaJoin(selection.Countries), commaJoin(selection.Cities))
+		return connection, fmt.Errorf("no server found for regions %s, countries %s, cities %s and hostnames %s",
+			commaJoin(selection.Regions), commaJoin(selection.Countries), commaJoin(selection.Cities),
+			commaJoin(selection.Hostnames))
 	}
 
 	var connections []models.OpenVPNConnection
 	for _, server := range servers {
+		port, ok := pickPort(server.Ports, selection.Protocol, selection.CustomPort, defaultPort)
+		if !ok {
+			continue
+		}
 		for _, IP := range server.IPs {
-			connections = append(connections, models.OpenVPNConnection{IP: IP, Port: port, Protocol: selection.Protocol})
+			connections = append(connections, models.OpenVPNConnection{
+				IP:       IP,
+				Port:     port,
+				Protocol: selection.Protocol,
+				Hostname: server.Hostname,
+				Country:  server.Country,
+				Region:   server.Region,
+				City:     server.City,
+			})
 		}
 	}
 
-	return pickRandomConnection(connections, p.randSource), nil
+	if len(connections) == 0 {
+		return connection, noPortServerError(selection.Protocol, selection.CustomPort)
+	}
+
+	return p.picker.Pick(connections), nil
+}
+
+func (p *purevpn) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	return connection, wireguardNotSupportedError(constants.Purevpn)
 }
 
 func (p *purevpn) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int, root bool,
@@ -86,8 +112,6 @@ func (p *purevpn) BuildConf(connection models.OpenVPNConnection, verbosity, uid,
 
 		// Purevpn specific
 		"key-direction 1",
-		"remote-cert-tls server",
-		"cipher AES-256-CBC",
 		"route-method exe",
 		"route-delay 0",
 		"route 0.0.0.0 0.0.0.0",
@@ -151,6 +175,6 @@ func (p *purevpn) BuildConf(connection models.OpenVPNConnection, verbosity, uid,
 
 func (p *purevpn) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for purevpn")
 }
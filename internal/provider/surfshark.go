@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 
@@ -15,22 +14,23 @@ import (
 )
 
 type surfshark struct {
-	servers    []models.SurfsharkServer
-	randSource rand.Source
+	servers []models.SurfsharkServer
+	picker  Picker
 }
 
-func newSurfshark(servers []models.SurfsharkServer, timeNow timeNowFunc) *surfshark {
+func newSurfshark(servers []models.SurfsharkServer, picker Picker) *surfshark {
 	return &surfshark{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
-func (s *surfshark) filterServers(regions []string) (servers []models.SurfsharkServer) {
+func (s *surfshark) filterServers(regions, hostnames []string) (servers []models.SurfsharkServer) {
 	for _, server := range s.servers {
 		switch {
 		case
-			filterByPossibilities(server.Region, regions):
+			filterByPossibilities(server.Region, regions),
+			filterByPossibilities(server.Hostname, hostnames):
 		default:
 			servers = append(servers, server)
 		}
@@ -40,37 +40,57 @@ func (s *surfshark) filterServers(regions []string) (servers []models.SurfsharkS
 
 func (s *surfshark) GetOpenVPNConnection(selection models.ServerSelection) (
 	connection models.OpenVPNConnection, err error) {
-	var port uint16
+	var defaultPort uint16
 	switch {
 	case selection.Protocol == constants.TCP:
-		port = 1443
+		defaultPort = 1443
 	case selection.Protocol == constants.UDP:
-		port = 1194
+		defaultPort = 1194
 	default:
 		return connection, fmt.Errorf("protocol %q is unknown", selection.Protocol)
 	}
 
 	if selection.TargetIP != nil {
+		port := defaultPort
+		if selection.CustomPort > 0 {
+			port = selection.CustomPort
+		}
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
 	}
 
-	servers := s.filterServers(selection.Regions)
+	servers := s.filterServers(selection.Regions, selection.Hostnames)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for region %s", commaJoin(selection.Regions))
+		return connection, fmt.Errorf("no server found for region %s and hostnames %s",
+			commaJoin(selection.Regions), commaJoin(selection.Hostnames))
 	}
 
 	var connections []models.OpenVPNConnection
 	for _, server := range servers {
+		port, ok := pickPort(server.Ports, selection.Protocol, selection.CustomPort, defaultPort)
+		if !ok {
+			continue
+		}
 		for _, IP := range server.IPs {
-			connections = append(connections, models.OpenVPNConnection{IP: IP, Port: port, Protocol: selection.Protocol})
+			connections = append(connections, models.OpenVPNConnection{
+				IP:       IP,
+				Port:     port,
+				Protocol: selection.Protocol,
+				Hostname: server.Hostname,
+				Region:   server.Region,
+			})
 		}
 	}
 
-	if selection.TargetIP != nil {
-		return connection, fmt.Errorf("target IP %s not found in IP addresses", selection.TargetIP)
+	if len(connections) == 0 {
+		return connection, noPortServerError(selection.Protocol, selection.CustomPort)
 	}
 
-	return pickRandomConnection(connections, s.randSource), nil
+	return s.picker.Pick(connections), nil
+}
+
+func (s *surfshark) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	return connection, wireguardNotSupportedError(constants.Surfshark)
 }
 
 func (s *surfshark) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int, root bool,
@@ -138,6 +158,6 @@ func (s *surfshark) BuildConf(connection models.OpenVPNConnection, verbosity, ui
 
 func (s *surfshark) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for surfshark")
 }
@@ -2,10 +2,11 @@ package provider
 
 import (
 	"context"
-	"math/rand"
+	"fmt"
 	"strings"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/logging"
 )
@@ -33,10 +34,6 @@ func tryUntilSuccessful(ctx context.Context, logger logging.Logger, fn func() er
 	}
 }
 
-func pickRandomConnection(connections []models.OpenVPNConnection, source rand.Source) models.OpenVPNConnection {
-	return connections[rand.New(source).Intn(len(connections))] //nolint:gosec
-}
-
 func filterByPossibilities(value string, possibilities []string) (filtered bool) {
 	if len(possibilities) == 0 {
 		return false
@@ -49,6 +46,82 @@ func filterByPossibilities(value string, possibilities []string) (filtered bool)
 	return true
 }
 
+// filterByCategories returns true if none of the server categories
+// is one of the categories given.
+func filterByCategories(serverCategories, categories []string) (filtered bool) {
+	if len(categories) == 0 {
+		return false
+	}
+	for _, category := range serverCategories {
+		if !filterByPossibilities(category, categories) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterByCountry returns true if the server country, given by its name and
+// its ISO country code, matches none of the countries given, each of which
+// can be a country name, a known alias or an ISO country code.
+func filterByCountry(name, code string, countries []string) (filtered bool) {
+	if len(countries) == 0 {
+		return false
+	}
+	for _, country := range countries {
+		if strings.EqualFold(name, country) {
+			return false
+		}
+		if code != "" && strings.EqualFold(code, constants.CountryCode(country)) {
+			return false
+		}
+	}
+	return true
+}
+
 func commaJoin(slice []string) string {
 	return strings.Join(slice, ",")
 }
+
+// pickPort returns the port to connect to a server with, given the ports
+// it supports and the default port of the provider for the protocol, which
+// is 0 if the provider does not support the protocol by default. If custom
+// port is set, it must be supported by the server. It returns false if the
+// server does not support the protocol or the custom port.
+func pickPort(ports models.OpenVPNPorts, protocol models.NetworkProtocol,
+	customPort, defaultPort uint16) (port uint16, ok bool) {
+	var supported []uint16
+	switch {
+	case len(ports.TCP) == 0 && len(ports.UDP) == 0: // unknown
+		if defaultPort > 0 {
+			supported = []uint16{defaultPort}
+		}
+	case protocol == constants.TCP:
+		supported = ports.TCP
+	case protocol == constants.UDP:
+		supported = ports.UDP
+	}
+	if len(supported) == 0 {
+		return 0, false
+	}
+	if customPort == 0 {
+		customPort = defaultPort
+	}
+	for _, port := range supported {
+		if port == customPort {
+			return port, true
+		}
+	}
+	if customPort != defaultPort {
+		return 0, false
+	}
+	return supported[0], true
+}
+
+// noPortServerError is returned when servers are found but
+// none of them support the protocol and custom port given.
+func noPortServerError(protocol models.NetworkProtocol, customPort uint16) error {
+	if customPort > 0 {
+		return fmt.Errorf("no server found supporting %s port %d", protocol, customPort)
+	}
+	return fmt.Errorf("no server found supporting %s", protocol)
+}
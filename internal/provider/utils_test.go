@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_pickPort(t *testing.T) {
+	t.Parallel()
+	known := models.OpenVPNPorts{TCP: []uint16{80, 443}, UDP: []uint16{1194}}
+	testCases := map[string]struct {
+		ports       models.OpenVPNPorts
+		protocol    models.NetworkProtocol
+		customPort  uint16
+		defaultPort uint16
+		port        uint16
+		ok          bool
+	}{
+		"unknown ports with default": {
+			protocol:    constants.UDP,
+			defaultPort: 1194,
+			port:        1194,
+			ok:          true,
+		},
+		"unknown ports without default": {
+			protocol: constants.TCP,
+		},
+		"unknown ports with custom port": {
+			protocol:    constants.UDP,
+			customPort:  53,
+			defaultPort: 1194,
+		},
+		"default port supported": {
+			ports:       known,
+			protocol:    constants.TCP,
+			defaultPort: 443,
+			port:        443,
+			ok:          true,
+		},
+		"default port not supported": {
+			ports:       known,
+			protocol:    constants.TCP,
+			defaultPort: 1443,
+			port:        80,
+			ok:          true,
+		},
+		"custom port supported": {
+			ports:       known,
+			protocol:    constants.TCP,
+			customPort:  80,
+			defaultPort: 443,
+			port:        80,
+			ok:          true,
+		},
+		"custom port not supported": {
+			ports:       known,
+			protocol:    constants.TCP,
+			customPort:  8080,
+			defaultPort: 443,
+		},
+		"protocol not supported": {
+			ports:       models.OpenVPNPorts{TCP: []uint16{443}},
+			protocol:    constants.UDP,
+			defaultPort: 1194,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			port, ok := pickPort(testCase.ports, testCase.protocol,
+				testCase.customPort, testCase.defaultPort)
+			assert.Equal(t, testCase.port, port)
+			assert.Equal(t, testCase.ok, ok)
+		})
+	}
+}
+
+func Test_filterByCountry(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		name      string
+		code      string
+		countries []string
+		filtered  bool
+	}{
+		"no country": {
+			name: "USA",
+			code: "us",
+		},
+		"same name": {
+			name:      "United States",
+			code:      "us",
+			countries: []string{"united states"},
+		},
+		"alias": {
+			name:      "United States",
+			code:      "us",
+			countries: []string{"USA"},
+		},
+		"code": {
+			name:      "USA",
+			code:      "us",
+			countries: []string{"US"},
+		},
+		"other name": {
+			name:      "UK",
+			code:      "gb",
+			countries: []string{"United Kingdom"},
+		},
+		"no match": {
+			name:      "USA",
+			code:      "us",
+			countries: []string{"Canada", "gb"},
+			filtered:  true,
+		},
+		"no server code": {
+			name:      "USA",
+			countries: []string{"us"},
+			filtered:  true,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			filtered := filterByCountry(testCase.name, testCase.code, testCase.countries)
+			assert.Equal(t, testCase.filtered, filtered)
+		})
+	}
+}
+
+func Test_filterByCategories(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		serverCategories []string
+		categories       []string
+		filtered         bool
+	}{
+		"no category to filter": {
+			serverCategories: []string{"standard"},
+		},
+		"server without category": {
+			categories: []string{"p2p"},
+			filtered:   true,
+		},
+		"matching category": {
+			serverCategories: []string{"standard", "p2p"},
+			categories:       []string{"obfuscated", "P2P"},
+		},
+		"no matching category": {
+			serverCategories: []string{"standard"},
+			categories:       []string{"p2p"},
+			filtered:         true,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			filtered := filterByCategories(testCase.serverCategories, testCase.categories)
+			assert.Equal(t, testCase.filtered, filtered)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_vpnUnlimited_GetOpenVPNConnection(t *testing.T) {
+	t.Parallel()
+	servers := []models.VPNUnlimitedServer{
+		{Country: "Netherlands", CountryCode: "nl", City: "Amsterdam", Hostname: "nl.vpnunlimitedapp.com"},
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "de.vpnunlimitedapp.com",
+			IPs: []net.IP{{2, 2, 2, 2}}},
+	}
+	testCases := map[string]struct {
+		selection  models.ServerSelection
+		connection models.OpenVPNConnection
+		err        string
+	}{
+		"country code with UDP": {
+			selection: models.ServerSelection{Protocol: constants.UDP, Countries: []string{"de"}},
+			connection: models.OpenVPNConnection{
+				IP: net.IP{2, 2, 2, 2}, Port: 1194, Protocol: constants.UDP, Hostname: "de.vpnunlimitedapp.com",
+				Country: "Germany", City: "Frankfurt",
+			},
+		},
+		"custom port with TCP": {
+			selection: models.ServerSelection{Protocol: constants.TCP, CustomPort: 80},
+			connection: models.OpenVPNConnection{
+				IP: net.IP{2, 2, 2, 2}, Port: 80, Protocol: constants.TCP, Hostname: "de.vpnunlimitedapp.com",
+				Country: "Germany", City: "Frankfurt",
+			},
+		},
+		"no IP address known": {
+			selection: models.ServerSelection{Protocol: constants.UDP, Cities: []string{"amsterdam"}},
+			err: "no IP address known for the servers matching the selection, " +
+				"VPN Unlimited servers must be updated first",
+		},
+		"no server matching": {
+			selection: models.ServerSelection{Protocol: constants.UDP, Countries: []string{"fr"}},
+			err:       "no server found for countries fr, cities  and hostnames ",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			v := newVPNUnlimited(servers, NewPicker(constants.ServerPickFirst, 0, nil))
+			connection, err := v.GetOpenVPNConnection(testCase.selection)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.connection, connection)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 
@@ -15,22 +14,23 @@ import (
 )
 
 type vyprvpn struct {
-	servers    []models.VyprvpnServer
-	randSource rand.Source
+	servers []models.VyprvpnServer
+	picker  Picker
 }
 
-func newVyprvpn(servers []models.VyprvpnServer, timeNow timeNowFunc) *vyprvpn {
+func newVyprvpn(servers []models.VyprvpnServer, picker Picker) *vyprvpn {
 	return &vyprvpn{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
-func (v *vyprvpn) filterServers(regions []string) (servers []models.VyprvpnServer) {
+func (v *vyprvpn) filterServers(regions, hostnames []string) (servers []models.VyprvpnServer) {
 	for _, server := range v.servers {
 		switch {
 		case
-			filterByPossibilities(server.Region, regions):
+			filterByPossibilities(server.Region, regions),
+			filterByPossibilities(server.Hostname, hostnames):
 		default:
 			servers = append(servers, server)
 		}
@@ -40,33 +40,60 @@ func (v *vyprvpn) filterServers(regions []string) (servers []models.VyprvpnServe
 
 func (v *vyprvpn) GetOpenVPNConnection(selection models.ServerSelection) (
 	connection models.OpenVPNConnection, err error) {
-	var port uint16
+	// TCP is only supported by servers with TCP ports recorded
+	var defaultPort uint16
 	switch {
 	case selection.Protocol == constants.TCP:
-		return connection, fmt.Errorf("TCP protocol not supported by this VPN provider")
 	case selection.Protocol == constants.UDP:
-		port = 443
+		defaultPort = 443
 	default:
 		return connection, fmt.Errorf("protocol %q is unknown", selection.Protocol)
 	}
 
 	if selection.TargetIP != nil {
+		port := defaultPort
+		if selection.CustomPort > 0 {
+			port = selection.CustomPort
+		}
+		if port == 0 {
+			return connection, fmt.Errorf("a port must be set to use TCP with a target IP address")
+		}
 		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
 	}
 
-	servers := v.filterServers(selection.Regions)
+	servers := v.filterServers(selection.Regions, selection.Hostnames)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for region %s", commaJoin(selection.Regions))
+		return connection, fmt.Errorf("no server found for region %s and hostnames %s",
+			commaJoin(selection.Regions), commaJoin(selection.Hostnames))
 	}
 
 	var connections []models.OpenVPNConnection
 	for _, server := range servers {
+		port, ok := pickPort(server.Ports, selection.Protocol, selection.CustomPort, defaultPort)
+		if !ok {
+			continue
+		}
 		for _, IP := range server.IPs {
-			connections = append(connections, models.OpenVPNConnection{IP: IP, Port: port, Protocol: selection.Protocol})
+			connections = append(connections, models.OpenVPNConnection{
+				IP:       IP,
+				Port:     port,
+				Protocol: selection.Protocol,
+				Hostname: server.Hostname,
+				Region:   server.Region,
+			})
 		}
 	}
 
-	return pickRandomConnection(connections, v.randSource), nil
+	if len(connections) == 0 {
+		return connection, noPortServerError(selection.Protocol, selection.CustomPort)
+	}
+
+	return v.picker.Pick(connections), nil
+}
+
+func (v *vyprvpn) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	return connection, wireguardNotSupportedError(constants.Vyprvpn)
 }
 
 func (v *vyprvpn) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int,
@@ -120,6 +147,6 @@ func (v *vyprvpn) BuildConf(connection models.OpenVPNConnection, verbosity, uid,
 
 func (v *vyprvpn) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for vyprvpn")
 }
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/files"
+	"github.com/qdm12/golibs/logging"
+)
+
+type weVPN struct {
+	servers           []models.WeVPNServer
+	picker            Picker
+	activeServer      models.WeVPNServer
+	activeServerMutex sync.RWMutex
+}
+
+func newWeVPN(servers []models.WeVPNServer, picker Picker) *weVPN {
+	return &weVPN{
+		servers: servers,
+		picker:  picker,
+	}
+}
+
+func (w *weVPN) filterServers(countries, cities, hostnames []string) (servers []models.WeVPNServer) {
+	for _, server := range w.servers {
+		switch {
+		case
+			filterByCountry(server.Country, server.CountryCode, countries),
+			filterByPossibilities(server.City, cities),
+			filterByPossibilities(server.Hostname, hostnames):
+		default:
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+func (w *weVPN) GetOpenVPNConnection(selection models.ServerSelection) (
+	connection models.OpenVPNConnection, err error) {
+	var port uint16
+	switch selection.Protocol {
+	case constants.TCP:
+		port = 1195
+	case constants.UDP:
+		port = 1194
+	default:
+		return connection, fmt.Errorf("protocol %q is unknown", selection.Protocol)
+	}
+	if selection.CustomPort > 0 {
+		port = selection.CustomPort
+	}
+
+	if selection.TargetIP != nil {
+		return models.OpenVPNConnection{IP: selection.TargetIP, Port: port, Protocol: selection.Protocol}, nil
+	}
+
+	servers := w.filterServers(selection.Countries, selection.Cities, selection.Hostnames)
+	if len(servers) == 0 {
+		return connection, fmt.Errorf("no server found for countries %s, cities %s and hostnames %s",
+			commaJoin(selection.Countries), commaJoin(selection.Cities), commaJoin(selection.Hostnames))
+	}
+
+	var connections []models.OpenVPNConnection
+	for _, server := range servers {
+		for _, IP := range server.IPs {
+			connections = append(connections, models.OpenVPNConnection{
+				IP:       IP,
+				Port:     port,
+				Protocol: selection.Protocol,
+				Hostname: server.Hostname,
+				Country:  server.Country,
+				City:     server.City,
+			})
+		}
+	}
+
+	if len(connections) == 0 {
+		return connection, fmt.Errorf("no IP address known for the servers matching the selection, " +
+			"WeVPN servers must be updated first")
+	}
+
+	return w.picker.Pick(connections), nil
+}
+
+// setActiveServer sets the active server to the server of the connection
+// given, found by reverse lookup of its IP address.
+func (w *weVPN) setActiveServer(connection models.OpenVPNConnection) {
+	w.activeServerMutex.Lock()
+	defer w.activeServerMutex.Unlock()
+	w.activeServer = models.WeVPNServer{}
+	for _, server := range w.servers {
+		for _, IP := range server.IPs {
+			if connection.IP.Equal(IP) {
+				w.activeServer = server
+				return
+			}
+		}
+	}
+}
+
+func (w *weVPN) getActiveServer() (server models.WeVPNServer) {
+	w.activeServerMutex.RLock()
+	defer w.activeServerMutex.RUnlock()
+	return w.activeServer
+}
+
+func (w *weVPN) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	return connection, wireguardNotSupportedError(constants.WeVPN)
+}
+
+func (w *weVPN) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int, root bool,
+	cipher, auth string, extras models.ExtraConfigOptions) (lines []string) {
+	// the connection may have been picked by another instance, so the active
+	// server is set for the connection the configuration is built for.
+	w.setActiveServer(connection)
+	if len(cipher) == 0 {
+		cipher = "aes-256-gcm"
+	}
+	lines = []string{
+		"client",
+		"dev tun",
+		"nobind",
+		"persist-key",
+		"remote-cert-tls server",
+
+		// WeVPN specific
+		"ping 10",
+		"ping-restart 60",
+
+		// Added constant values
+		"auth-nocache",
+		"mute-replay-warnings",
+		"pull-filter ignore \"auth-token\"", // prevent auth failed loops
+		"auth-retry nointeract",
+		"suppress-timestamps",
+
+		// Modified variables
+		fmt.Sprintf("verb %d", verbosity),
+		fmt.Sprintf("auth-user-pass %s", constants.OpenVPNAuthConf),
+		fmt.Sprintf("proto %s", connection.Protocol),
+		fmt.Sprintf("remote %s %d", connection.IP, connection.Port),
+		fmt.Sprintf("cipher %s", cipher),
+	}
+	if len(auth) > 0 {
+		lines = append(lines, "auth "+auth)
+	}
+	if !root {
+		lines = append(lines, "user nonrootuser")
+	}
+	lines = append(lines, []string{
+		"<ca>",
+		"-----BEGIN CERTIFICATE-----",
+		extras.CertificateAuthority,
+		"-----END CERTIFICATE-----",
+		"</ca>",
+	}...)
+	lines = append(lines, []string{
+		"<tls-crypt>",
+		"-----BEGIN OpenVPN Static key V1-----",
+		extras.TLSCryptKey,
+		"-----END OpenVPN Static key V1-----",
+		"</tls-crypt>",
+		"",
+	}...)
+	if connection.Protocol == constants.UDP {
+		lines = append(lines, "explicit-exit-notify")
+	}
+	return lines
+}
+
+// PortForward only checks the active server has the port forwarding
+// capability since port forwarding is not implemented yet for WeVPN.
+func (w *weVPN) PortForward(ctx context.Context, client *http.Client,
+	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
+	vpnInterface string, syncState func(port uint16)) {
+	activeServer := w.getActiveServer()
+	if !activeServer.PortForward {
+		pfLogger.Error("The server %s does not support port forwarding", activeServer.Hostname)
+		return
+	}
+	pfLogger.Error("port forwarding is not implemented yet for WeVPN")
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/logging/mock_logging"
+)
+
+func Test_weVPN_PortForward(t *testing.T) {
+	t.Parallel()
+	servers := []models.WeVPNServer{
+		{Hostname: "Amsterdam", PortForward: true, IPs: []net.IP{{1, 1, 1, 1}}},
+		{Hostname: "Paris", IPs: []net.IP{{2, 2, 2, 2}}},
+	}
+	testCases := map[string]struct {
+		connection models.OpenVPNConnection
+		errorArgs  []interface{}
+	}{
+		"server with port forwarding": {
+			connection: models.OpenVPNConnection{IP: net.IP{1, 1, 1, 1}, Protocol: constants.UDP},
+			errorArgs:  []interface{}{"port forwarding is not implemented yet for WeVPN"},
+		},
+		"server without port forwarding": {
+			connection: models.OpenVPNConnection{IP: net.IP{2, 2, 2, 2}, Protocol: constants.UDP},
+			errorArgs:  []interface{}{"The server %s does not support port forwarding", "Paris"},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			logger := mock_logging.NewMockLogger(mockCtrl)
+			logger.EXPECT().Error(testCase.errorArgs...)
+			// the connection is picked by another instance, as the
+			// OpenVPN loop does when retrying with the same server.
+			w := newWeVPN(servers, NewPicker(constants.ServerPickFirst, 0, nil))
+			w.BuildConf(testCase.connection, 0, 1000, 1000, true, "", "", models.ExtraConfigOptions{})
+
+			w.PortForward(context.Background(), nil, nil, logger, nil, nil, "tun0", nil)
+		})
+	}
+}
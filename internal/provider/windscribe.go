@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"net"
 	"net/http"
 	"strings"
@@ -16,14 +15,14 @@ import (
 )
 
 type windscribe struct {
-	servers    []models.WindscribeServer
-	randSource rand.Source
+	servers []models.WindscribeServer
+	picker  Picker
 }
 
-func newWindscribe(servers []models.WindscribeServer, timeNow timeNowFunc) *windscribe {
+func newWindscribe(servers []models.WindscribeServer, picker Picker) *windscribe {
 	return &windscribe{
-		servers:    servers,
-		randSource: rand.NewSource(timeNow().UnixNano()),
+		servers: servers,
+		picker:  picker,
 	}
 }
 
@@ -61,15 +60,28 @@ func (w *windscribe) GetOpenVPNConnection(selection models.ServerSelection) (con
 
 	servers := w.filterServers(selection.Regions, selection.Cities, selection.Hostnames)
 	if len(servers) == 0 {
-		return connection, fmt.Errorf("no server found for region %s", commaJoin(selection.Regions))
+		return connection, fmt.Errorf("no server found for region %s, cities %s and hostnames %s",
+			commaJoin(selection.Regions), commaJoin(selection.Cities), commaJoin(selection.Hostnames))
 	}
 
-	connections := make([]models.OpenVPNConnection, len(servers))
+	connections := make([]models.OpenVPNConnection, 0, len(servers))
 	for _, server := range servers {
-		connections = append(connections, models.OpenVPNConnection{IP: server.IP, Port: port, Protocol: selection.Protocol})
+		connections = append(connections, models.OpenVPNConnection{
+			IP:       server.IP,
+			Port:     port,
+			Protocol: selection.Protocol,
+			Hostname: server.Hostname,
+			Region:   server.Region,
+			City:     server.City,
+		})
 	}
 
-	return pickRandomConnection(connections, w.randSource), nil
+	return w.picker.Pick(connections), nil
+}
+
+func (w *windscribe) GetWireguardConnection(selection models.ServerSelection) (
+	connection models.WireguardConnection, err error) {
+	return connection, wireguardNotSupportedError(constants.Windscribe)
 }
 
 func (w *windscribe) BuildConf(connection models.OpenVPNConnection, verbosity, uid, gid int,
@@ -133,6 +145,6 @@ func (w *windscribe) BuildConf(connection models.OpenVPNConnection, verbosity, u
 
 func (w *windscribe) PortForward(ctx context.Context, client *http.Client,
 	fileManager files.FileManager, pfLogger logging.Logger, gateway net.IP, fw firewall.Configurator,
-	syncState func(port uint16) (pfFilepath models.Filepath)) {
+	vpnInterface string, syncState func(port uint16)) {
 	panic("port forwarding is not supported for windscribe")
 }
@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_windscribe_GetOpenVPNConnection(t *testing.T) {
+	t.Parallel()
+	servers := []models.WindscribeServer{
+		{Region: "Germany", City: "Frankfurt", Hostname: "de-001.whiskergalaxy.com", IP: net.IP{1, 1, 1, 1}},
+		{Region: "Germany", City: "Frankfurt", Hostname: "de-002.whiskergalaxy.com", IP: net.IP{2, 2, 2, 2}},
+	}
+	w := newWindscribe(servers, NewPicker(constants.ServerPickFirst, 0, nil))
+
+	connection, err := w.GetOpenVPNConnection(models.ServerSelection{Protocol: constants.UDP})
+
+	// the connections slice must not start with zero value connections
+	require.NoError(t, err)
+	assert.Equal(t, models.OpenVPNConnection{
+		IP: net.IP{1, 1, 1, 1}, Port: 443, Protocol: constants.UDP,
+		Hostname: "de-001.whiskergalaxy.com", Region: "Germany", City: "Frankfurt",
+	}, connection)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+func wireguardNotSupportedError(provider models.VPNProvider) error {
+	return fmt.Errorf("wireguard is not supported for %s", provider)
+}
+
+// pickWireguardConnection picks a connection among the connections given
+// using the picker given, or returns the connection with the target IP
+// address if it is set, since the public key of the server is needed.
+func pickWireguardConnection(picker Picker, connections []models.WireguardConnection,
+	selection models.ServerSelection) (connection models.WireguardConnection, err error) {
+	if len(connections) == 0 {
+		return connection, fmt.Errorf("no Wireguard endpoint found for the servers selected, " +
+			"try updating the servers data")
+	}
+
+	if selection.TargetIP != nil {
+		for _, connection := range connections {
+			if connection.IP.Equal(selection.TargetIP) {
+				return connection, nil
+			}
+		}
+		return connection, fmt.Errorf("no Wireguard endpoint found with IP address %s", selection.TargetIP)
+	}
+
+	endpoints := make([]models.OpenVPNConnection, len(connections))
+	for i := range connections {
+		endpoints[i] = connections[i].Endpoint()
+	}
+	endpoint := picker.Pick(endpoints)
+	for _, connection := range connections {
+		if connection.IP.Equal(endpoint.IP) {
+			return connection, nil
+		}
+	}
+	return connection, fmt.Errorf("no Wireguard endpoint found with IP address %s", endpoint.IP)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pickWireguardConnection(t *testing.T) {
+	t.Parallel()
+	connections := []models.WireguardConnection{
+		{IP: net.IP{1, 1, 1, 1}, PublicKey: "a"},
+		{IP: net.IP{2, 2, 2, 2}, PublicKey: "b"},
+	}
+
+	testCases := map[string]struct {
+		connections []models.WireguardConnection
+		selection   models.ServerSelection
+		connection  models.WireguardConnection
+		err         string
+	}{
+		"no connection": {
+			err: "no Wireguard endpoint found for the servers selected, try updating the servers data",
+		},
+		"picked": {
+			connections: connections,
+			connection:  connections[0],
+		},
+		"target IP": {
+			connections: connections,
+			selection:   models.ServerSelection{TargetIP: net.IP{2, 2, 2, 2}},
+			connection:  connections[1],
+		},
+		"target IP not found": {
+			connections: connections,
+			selection:   models.ServerSelection{TargetIP: net.IP{3, 3, 3, 3}},
+			err:         "no Wireguard endpoint found with IP address 3.3.3.3",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			connection, err := pickWireguardConnection(&firstPicker{}, testCase.connections, testCase.selection)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, testCase.connection, connection)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package publicip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// dnsLookup is a DNS server answering with the IP address of the client.
+type dnsLookup struct {
+	server string // IP address of the DNS server
+	lookup func(ctx context.Context, resolver *net.Resolver) (ip net.IP, err error)
+}
+
+func (i *ipGetter) getDNS(ctx context.Context) (ip net.IP, err error) {
+	lookups := []dnsLookup{
+		{server: "208.67.222.222", lookup: lookupOpenDNS}, // resolver1.opendns.com
+		{server: "208.67.220.220", lookup: lookupOpenDNS}, // resolver2.opendns.com
+		{server: "216.239.32.10", lookup: lookupGoogle},   // ns1.google.com
+		{server: "216.239.34.10", lookup: lookupGoogle},   // ns2.google.com
+	}
+	lookup := lookups[i.randIntn(len(lookups))]
+	ip, err = lookup.lookup(ctx, i.newResolver(lookup.server))
+	if err != nil {
+		return nil, fmt.Errorf("DNS server %s: %w", lookup.server, err)
+	}
+	return ip, nil
+}
+
+// newResolver returns a resolver sending all its queries to the DNS server given.
+func newResolver(server string) *net.Resolver {
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialer := net.Dialer{}
+			return dialer.DialContext(ctx, network, net.JoinHostPort(server, "53"))
+		},
+	}
+}
+
+func lookupOpenDNS(ctx context.Context, resolver *net.Resolver) (ip net.IP, err error) {
+	const host = "myip.opendns.com"
+	ips, err := resolver.LookupIP(ctx, "ip4", host)
+	if err != nil {
+		return nil, err
+	} else if len(ips) == 0 {
+		return nil, fmt.Errorf("no IP address found for %s", host)
+	}
+	return ips[0], nil
+}
+
+func lookupGoogle(ctx context.Context, resolver *net.Resolver) (ip net.IP, err error) {
+	const host = "o-o.myaddr.l.google.com"
+	records, err := resolver.LookupTXT(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	return parseTXTRecords(records)
+}
+
+// parseTXTRecords returns the first IP address found in the TXT records,
+// ignoring other records such as the EDNS client subnet one.
+func parseTXTRecords(records []string) (ip net.IP, err error) {
+	for _, record := range records {
+		ip = net.ParseIP(strings.TrimSpace(record))
+		if ip != nil {
+			return ip, nil
+		}
+	}
+	return nil, fmt.Errorf("no IP address found in TXT records %s", strings.Join(records, ", "))
+}
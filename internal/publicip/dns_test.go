@@ -0,0 +1,44 @@
+package publicip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTXTRecords(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		records []string
+		ip      net.IP
+		err     string
+	}{
+		"no record": {
+			err: "no IP address found in TXT records ",
+		},
+		"client subnet record first": {
+			records: []string{"edns0-client-subnet 1.2.3.0/24", "1.2.3.4"},
+			ip:      net.IP{1, 2, 3, 4},
+		},
+		"IPv6": {
+			records: []string{"2001:db8::1"},
+			ip:      net.ParseIP("2001:db8::1"),
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ip, err := parseTXTRecords(tc.records)
+			if tc.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, tc.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.ip.Equal(ip))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package publicip
+
+import "net"
+
+// checkEgress checks the public IP address is one of the VPN provider
+// server IP addresses, or in the same subnet as one of them. Since the
+// subnets are guessed, it only warns if it is not.
+func (l *looper) checkEgress(ip net.IP) {
+	if l.getEgressIPs == nil {
+		return
+	}
+	expectedIPs := l.getEgressIPs()
+	if len(expectedIPs) == 0 {
+		return
+	}
+	unexpected := !isExpectedEgress(ip, expectedIPs)
+	l.statusMutex.Lock()
+	wasUnexpected := l.status.UnexpectedEgress
+	l.status.UnexpectedEgress = unexpected
+	l.statusMutex.Unlock()
+	switch {
+	case unexpected && !wasUnexpected:
+		l.logger.Warn("public IP address %s is not next to a VPN provider server IP address: traffic may be misrouted", ip)
+	case !unexpected && wasUnexpected:
+		l.logger.Info("public IP address %s is next to a VPN provider server IP address again", ip)
+	}
+}
+
+// isExpectedEgress returns true if the IP address is one of the
+// expected IP addresses or in the same /24 IPv4 subnet or /64 IPv6
+// subnet as one of them, since providers often use exit addresses
+// next to the addresses of their servers.
+func isExpectedEgress(ip net.IP, expectedIPs []net.IP) bool {
+	const ipv4Bits, ipv6Bits = 24, 64
+	mask := net.CIDRMask(ipv6Bits, net.IPv6len*8) //nolint:gomnd
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
+		mask = net.CIDRMask(ipv4Bits, net.IPv4len*8) //nolint:gomnd
+	}
+	subnet := net.IPNet{IP: ip.Mask(mask), Mask: mask}
+	for _, expectedIP := range expectedIPs {
+		if subnet.Contains(expectedIP) {
+			return true
+		}
+	}
+	return false
+}
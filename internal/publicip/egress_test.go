@@ -0,0 +1,52 @@
+package publicip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isExpectedEgress(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		ip          net.IP
+		expectedIPs []net.IP
+		expected    bool
+	}{
+		"no expected IP": {
+			ip: net.IP{1, 2, 3, 4},
+		},
+		"server IP": {
+			ip:          net.IP{1, 2, 3, 4},
+			expectedIPs: []net.IP{{5, 6, 7, 8}, {1, 2, 3, 4}},
+			expected:    true,
+		},
+		"same IPv4 subnet": {
+			ip:          net.IPv4(1, 2, 3, 4),
+			expectedIPs: []net.IP{{1, 2, 3, 200}},
+			expected:    true,
+		},
+		"other IPv4 subnet": {
+			ip:          net.IP{1, 2, 4, 4},
+			expectedIPs: []net.IP{{1, 2, 3, 4}},
+		},
+		"same IPv6 subnet": {
+			ip:          net.ParseIP("2001:db8::1"),
+			expectedIPs: []net.IP{net.ParseIP("2001:db8::2")},
+			expected:    true,
+		},
+		"other IPv6 subnet": {
+			ip:          net.ParseIP("2001:db8::1"),
+			expectedIPs: []net.IP{net.ParseIP("2001:db8:0:1::1")},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			expected := isExpectedEgress(testCase.ip, testCase.expectedIPs)
+			assert.Equal(t, testCase.expected, expected)
+		})
+	}
+}
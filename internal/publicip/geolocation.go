@@ -0,0 +1,240 @@
+package publicip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/golibs/network"
+)
+
+// Geolocation contains the geolocation information of a public IP address.
+type Geolocation struct {
+	Country      string `json:"country,omitempty"`
+	Region       string `json:"region,omitempty"`
+	City         string `json:"city,omitempty"`
+	Organization string `json:"organization,omitempty"`
+	// Source is the geolocation API which gave the information.
+	Source string `json:"source"`
+}
+
+func (g Geolocation) String() string {
+	var parts []string
+	for _, part := range []string{g.City, g.Region, g.Country, g.Organization} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Geolocator obtains the geolocation of an IP address.
+type Geolocator interface {
+	Locate(ctx context.Context, ip net.IP) (geolocation Geolocation, err error)
+}
+
+type geolocator struct {
+	client  network.Client
+	apis    []*geolocationAPI
+	timeNow func() time.Time
+}
+
+// NewGeolocator creates a geolocator trying the APIs given in order, skipping
+// the ones rate limited. The tokens map an API name to its token or key.
+// It is not safe for concurrent use.
+func NewGeolocator(client network.Client, names []string, tokens map[string]string) Geolocator {
+	apis := make([]*geolocationAPI, len(names))
+	for i, name := range names {
+		apis[i] = newGeolocationAPI(name, tokens[name])
+	}
+	return &geolocator{
+		client:  client,
+		apis:    apis,
+		timeNow: time.Now,
+	}
+}
+
+func (g *geolocator) Locate(ctx context.Context, ip net.IP) (geolocation Geolocation, err error) {
+	var errs []string
+	for _, api := range g.apis {
+		now := g.timeNow()
+		if !api.available(now) {
+			errs = append(errs, fmt.Sprintf("%s: rate limited until %s",
+				api.name, api.nextRequest().Format(time.RFC3339)))
+			continue
+		}
+		api.lastRequest = now
+		content, status, err := g.get(ctx, api, ip)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Sprintf("%s: %s", api.name, err))
+			continue
+		case status == http.StatusTooManyRequests ||
+			(api.name == constants.IPData && status == http.StatusForbidden):
+			api.blockedUntil = now.Add(api.rateLimitedWait)
+			errs = append(errs, fmt.Sprintf("%s: rate limited with status %d", api.name, status))
+			continue
+		case status != http.StatusOK:
+			errs = append(errs, fmt.Sprintf("%s: received unexpected status code %d", api.name, status))
+			continue
+		}
+		geolocation, err = api.parse(content)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", api.name, err))
+			continue
+		}
+		geolocation.Source = api.name
+		return geolocation, nil
+	}
+	return geolocation, fmt.Errorf("cannot geolocate IP address %s: %s", ip, strings.Join(errs, "; "))
+}
+
+// get requests the geolocation of the IP address from the API given.
+// The token is only sent in the request headers, so it does not end
+// up in the URL logged in errors and by proxies.
+func (g *geolocator) get(ctx context.Context, api *geolocationAPI, ip net.IP) (
+	content []byte, status int, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, api.url(ip), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	for key, value := range api.headers {
+		request.Header.Set(key, value)
+	}
+	return g.client.Do(request)
+}
+
+// geolocationAPI is a geolocation API with its own rate limiting.
+type geolocationAPI struct {
+	name string
+	url  func(ip net.IP) string
+	// headers are the request headers, holding the token if any.
+	headers map[string]string
+	parse   func(content []byte) (geolocation Geolocation, err error)
+	// minInterval is the minimum duration between two requests.
+	minInterval time.Duration
+	// rateLimitedWait is the duration to wait once rate limited by the API.
+	rateLimitedWait time.Duration
+	lastRequest     time.Time
+	blockedUntil    time.Time
+}
+
+// newGeolocationAPI creates the geolocation API of the name given.
+// The ip-api token is ignored, since ip-api only accepts it in the URL query.
+func newGeolocationAPI(name, token string) *geolocationAPI {
+	switch name {
+	case constants.IPInfo:
+		var headers map[string]string
+		if token != "" {
+			headers = map[string]string{"Authorization": "Bearer " + token}
+		}
+		return &geolocationAPI{
+			name: name,
+			url: func(ip net.IP) string {
+				return "https://ipinfo.io/" + ip.String() + "/json"
+			},
+			headers:         headers,
+			parse:           parseIPInfo,
+			minInterval:     time.Minute, // 50000 requests per month without token
+			rateLimitedWait: time.Hour,
+		}
+	case constants.IPAPI:
+		return &geolocationAPI{
+			name: name,
+			url: func(ip net.IP) string {
+				return "http://ip-api.com/json/" + ip.String()
+			},
+			parse:           parseIPAPI,
+			minInterval:     2 * time.Second, // 45 requests per minute without key
+			rateLimitedWait: time.Minute,
+		}
+	default: // constants.IPData
+		return &geolocationAPI{
+			name: name,
+			url: func(ip net.IP) string {
+				return "https://api.ipdata.co/" + ip.String()
+			},
+			headers:         map[string]string{"api-key": token},
+			parse:           parseIPData,
+			minInterval:     time.Minute, // 1500 requests per day with the free key
+			rateLimitedWait: time.Hour,
+		}
+	}
+}
+
+func (g *geolocationAPI) nextRequest() time.Time {
+	next := g.lastRequest.Add(g.minInterval)
+	if g.blockedUntil.After(next) {
+		return g.blockedUntil
+	}
+	return next
+}
+
+func (g *geolocationAPI) available(now time.Time) bool {
+	return g.lastRequest.IsZero() || !now.Before(g.nextRequest())
+}
+
+func parseIPInfo(content []byte) (geolocation Geolocation, err error) {
+	var data struct {
+		Country string `json:"country"`
+		Region  string `json:"region"`
+		City    string `json:"city"`
+		Org     string `json:"org"`
+	}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return geolocation, err
+	}
+	return Geolocation{
+		Country:      data.Country,
+		Region:       data.Region,
+		City:         data.City,
+		Organization: data.Org,
+	}, nil
+}
+
+func parseIPAPI(content []byte) (geolocation Geolocation, err error) {
+	var data struct {
+		Status     string `json:"status"`
+		Message    string `json:"message"`
+		Country    string `json:"country"`
+		RegionName string `json:"regionName"`
+		City       string `json:"city"`
+		Org        string `json:"org"`
+	}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return geolocation, err
+	} else if data.Status != "success" {
+		return geolocation, fmt.Errorf("status %q: %s", data.Status, data.Message)
+	}
+	return Geolocation{
+		Country:      data.Country,
+		Region:       data.RegionName,
+		City:         data.City,
+		Organization: data.Org,
+	}, nil
+}
+
+func parseIPData(content []byte) (geolocation Geolocation, err error) {
+	var data struct {
+		CountryName string `json:"country_name"`
+		Region      string `json:"region"`
+		City        string `json:"city"`
+		ASN         struct {
+			Name string `json:"name"`
+		} `json:"asn"`
+	}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return geolocation, err
+	}
+	return Geolocation{
+		Country:      data.CountryName,
+		Region:       data.Region,
+		City:         data.City,
+		Organization: data.ASN.Name,
+	}, nil
+}
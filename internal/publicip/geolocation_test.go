@@ -0,0 +1,96 @@
+package publicip
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/golibs/network/mock_network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_geolocator_Locate(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+	ip := net.IP{1, 2, 3, 4}
+	client := mock_network.NewMockClient(mockCtrl)
+
+	now := time.Unix(1000, 0)
+	g := NewGeolocator(client, []string{constants.IPInfo, constants.IPAPI},
+		map[string]string{constants.IPInfo: "token"}).(*geolocator)
+	g.timeNow = func() time.Time { return now }
+
+	// ipinfo is rate limited so ip-api is used
+	client.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) ([]byte, int, error) {
+		assert.Equal(t, "https://ipinfo.io/1.2.3.4/json", request.URL.String())
+		assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		return nil, http.StatusTooManyRequests, nil
+	})
+	client.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) ([]byte, int, error) {
+		assert.Equal(t, "http://ip-api.com/json/1.2.3.4", request.URL.String())
+		assert.Empty(t, request.Header)
+		return []byte(`{"status":"success","country":"Canada","regionName":"Quebec","city":"Montreal","org":"Org"}`),
+			http.StatusOK, nil
+	})
+	geolocation, err := g.Locate(ctx, ip)
+	require.NoError(t, err)
+	assert.Equal(t, Geolocation{
+		Country:      "Canada",
+		Region:       "Quebec",
+		City:         "Montreal",
+		Organization: "Org",
+		Source:       constants.IPAPI,
+	}, geolocation)
+
+	// both APIs are skipped without any request
+	now = now.Add(time.Second)
+	_, err = g.Locate(ctx, ip)
+	require.Error(t, err)
+	assert.Equal(t, "cannot geolocate IP address 1.2.3.4: "+
+		"ipinfo: rate limited until "+time.Unix(1000, 0).Add(time.Hour).Format(time.RFC3339)+"; "+
+		"ip-api: rate limited until "+time.Unix(1002, 0).Format(time.RFC3339), err.Error())
+
+	// ip-api can be used again after its minimum interval
+	now = now.Add(time.Second)
+	client.EXPECT().Do(gomock.Any()).Return([]byte(`{"status":"fail","message":"reserved range"}`), http.StatusOK, nil)
+	_, err = g.Locate(ctx, ip)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `ip-api: status "fail": reserved range`)
+}
+
+func Test_geolocator_Locate_ipdata(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	client := mock_network.NewMockClient(mockCtrl)
+	g := NewGeolocator(client, []string{constants.IPData}, map[string]string{constants.IPData: "key"})
+
+	client.EXPECT().Do(gomock.Any()).DoAndReturn(func(request *http.Request) ([]byte, int, error) {
+		assert.Equal(t, "https://api.ipdata.co/1.2.3.4", request.URL.String())
+		assert.Equal(t, "key", request.Header.Get("api-key"))
+		return []byte(`{"country_name":"France"}`), http.StatusOK, nil
+	})
+	geolocation, err := g.Locate(context.Background(), net.IP{1, 2, 3, 4})
+	require.NoError(t, err)
+	assert.Equal(t, Geolocation{Country: "France", Source: constants.IPData}, geolocation)
+}
+
+func Test_parseIPData(t *testing.T) {
+	t.Parallel()
+	geolocation, err := parseIPData([]byte(`{"city":"Paris","region":"Ile-de-France",
+		"country_name":"France","asn":{"name":"Provider"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, Geolocation{
+		Country:      "France",
+		Region:       "Ile-de-France",
+		City:         "Paris",
+		Organization: "Provider",
+	}, geolocation)
+}
@@ -2,10 +2,13 @@ package publicip
 
 import (
 	"context"
+	"net"
 	"sync"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/loopstate"
 	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/golibs/files"
 	"github.com/qdm12/golibs/logging"
 	"github.com/qdm12/golibs/network"
@@ -18,13 +21,22 @@ type Looper interface {
 	Stop()
 	GetPeriod() (period time.Duration)
 	SetPeriod(period time.Duration)
+	GetStatus() (status Status)
+	// Refresh obtains the public IP addresses and geolocation right away,
+	// and returns the status updated.
+	Refresh(ctx context.Context) (status Status, err error)
 }
 
 type looper struct {
 	period           time.Duration
 	periodMutex      sync.RWMutex
+	jsonFilepath     string
+	jsonHistory      bool
+	getServer        func() (provider models.VPNProvider, server *models.OpenVPNConnection)
 	getter           IPGetter
+	geolocator       Geolocator // nil to disable geolocation
 	logger           logging.Logger
+	state            loopstate.Reporter
 	fileManager      files.FileManager
 	ipStatusFilepath models.Filepath
 	uid              int
@@ -34,14 +46,41 @@ type looper struct {
 	updateTicker     chan struct{}
 	timeNow          func() time.Time
 	timeSince        func(time.Time) time.Duration
+	ispIP            net.IP // nil to disable the leak check
+	onLeak           func()
+	getEgressIPs     func() (ips []net.IP) // nil to disable the egress check
+	status           Status
+	statusMutex      sync.RWMutex
+	hasIPv6          func() (ok bool, err error)
+	tunnelHasIPv6    func() (ok bool, err error)
+	// updateMutex prevents the loop and a refresh from updating at the same time
+	updateMutex sync.Mutex
 }
 
+// NewLooper creates a public IP looper. If ispIP is not nil, each public IP
+// address obtained is compared with it and onLeak is called, if not nil,
+// when they start matching. If getEgressIPs is not nil, each public IP
+// address obtained is checked to be in the same subnet as one of the IP
+// addresses it returns. getServer is used to record the VPN server
+// in the JSON file.
 func NewLooper(client network.Client, logger logging.Logger, fileManager files.FileManager,
-	ipStatusFilepath models.Filepath, period time.Duration, uid, gid int) Looper {
+	state loopstate.Reporter, settings settings.PublicIP, vpnInterface string,
+	ipStatusFilepath models.Filepath, uid, gid int,
+	ispIP net.IP, onLeak func(), getEgressIPs func() (ips []net.IP),
+	getServer func() (provider models.VPNProvider, server *models.OpenVPNConnection)) Looper {
+	var geolocator Geolocator
+	if len(settings.GeolocationAPIs) > 0 {
+		geolocator = NewGeolocator(client, settings.GeolocationAPIs, settings.GeolocationTokens)
+	}
 	return &looper{
-		period:           period,
-		getter:           NewIPGetter(client),
+		period:           settings.Period,
+		geolocator:       geolocator,
+		jsonFilepath:     settings.JSONFilepath,
+		jsonHistory:      settings.JSONHistory,
+		getServer:        getServer,
+		getter:           NewIPGetter(client, settings.Methods),
 		logger:           logger.WithPrefix("ip getter: "),
+		state:            state,
 		fileManager:      fileManager,
 		ipStatusFilepath: ipStatusFilepath,
 		uid:              uid,
@@ -51,6 +90,11 @@ func NewLooper(client network.Client, logger logging.Logger, fileManager files.F
 		updateTicker:     make(chan struct{}),
 		timeNow:          time.Now,
 		timeSince:        time.Since,
+		ispIP:            ispIP,
+		onLeak:           onLeak,
+		getEgressIPs:     getEgressIPs,
+		hasIPv6:          hostHasIPv6,
+		tunnelHasIPv6:    func() (ok bool, err error) { return tunnelHasIPv6(vpnInterface) },
 	}
 }
 
@@ -70,8 +114,85 @@ func (l *looper) SetPeriod(period time.Duration) {
 	l.updateTicker <- struct{}{}
 }
 
+func (l *looper) GetStatus() (status Status) {
+	l.statusMutex.RLock()
+	defer l.statusMutex.RUnlock()
+	return l.status
+}
+
+// checkLeak compares the public IP address with the ISP public IP address.
+func (l *looper) checkLeak(ip net.IP) {
+	if l.ispIP == nil {
+		return
+	}
+	leaking := ip.Equal(l.ispIP)
+	l.statusMutex.Lock()
+	wasLeaking := l.status.Leaking
+	l.status.Leaking = leaking
+	l.statusMutex.Unlock()
+	switch {
+	case leaking && !wasLeaking:
+		l.logger.Error("public IP address %s is the ISP public IP address: traffic is leaking outside the VPN!", ip)
+		if l.onLeak != nil {
+			l.onLeak()
+		}
+	case !leaking && wasLeaking:
+		l.logger.Info("public IP address %s is no longer the ISP public IP address", ip)
+	}
+}
+
+// geolocate returns the geolocation of the IP address, re-using the previous
+// geolocation if the IP address did not change, unless force is true.
+// It returns nil if the geolocation is disabled or failed.
+func (l *looper) geolocate(ctx context.Context, ip net.IP, force bool) (geolocation *Geolocation) {
+	if l.geolocator == nil {
+		return nil
+	}
+	previous := l.GetStatus()
+	if !force && previous.Geolocation != nil && previous.IP.Equal(ip) {
+		return previous.Geolocation
+	}
+	result, err := l.geolocator.Locate(ctx, ip)
+	if err != nil {
+		l.logger.Warn(err)
+		return nil
+	}
+	return &result
+}
+
+// updateIPv6 obtains the public IPv6 address if the host has IPv6, and
+// flags IPv6 traffic bypassing the tunnel if the tunnel has no IPv6.
+func (l *looper) updateIPv6(ctx context.Context) {
+	var ipv6 net.IP
+	bypass := false
+	hasIPv6, err := l.hasIPv6()
+	switch {
+	case err != nil:
+		l.logger.Warn(err)
+	case hasIPv6:
+		ipv6, err = l.getter.GetIPv6(ctx)
+		if err != nil {
+			l.logger.Info("no public IPv6 address: %s", err)
+			break
+		}
+		l.logger.Info("Public IPv6 address is %s", ipv6)
+		tunnelHasIPv6, err := l.tunnelHasIPv6()
+		if err != nil {
+			l.logger.Warn(err)
+		} else if !tunnelHasIPv6 {
+			bypass = true
+			l.logger.Error("public IPv6 address %s is reachable but the VPN tunnel has no IPv6: IPv6 traffic is bypassing the VPN!", ipv6) //nolint:lll
+		}
+	}
+	l.statusMutex.Lock()
+	l.status.IPv6 = ipv6
+	l.status.IPv6Bypass = bypass
+	l.statusMutex.Unlock()
+}
+
 func (l *looper) logAndWait(ctx context.Context, err error) {
 	l.logger.Error(err)
+	l.state.SetError(err)
 	const waitTime = 5 * time.Second
 	l.logger.Info("retrying in %s", waitTime)
 	timer := time.NewTimer(waitTime)
@@ -92,6 +213,7 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 		return
 	}
 	defer l.logger.Warn("loop exited")
+	defer l.state.SetState(loopstate.Stopped)
 
 	enabled := true
 
@@ -110,32 +232,65 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 
 		// Enabled and has a period set
 
-		ip, err := l.getter.Get(ctx)
-		if err != nil {
-			l.logAndWait(ctx, err)
-			continue
-		}
-		l.logger.Info("Public IP address is %s", ip)
-		const userReadWritePermissions = 0600
-		err = l.fileManager.WriteLinesToFile(
-			string(l.ipStatusFilepath),
-			[]string{ip.String()},
-			files.Ownership(l.uid, l.gid),
-			files.Permissions(userReadWritePermissions))
-		if err != nil {
+		l.state.SetState(loopstate.Starting)
+		const forceGeolocation = false
+		if err := l.update(ctx, forceGeolocation); err != nil {
 			l.logAndWait(ctx, err)
 			continue
 		}
+		l.state.SetState(loopstate.Running)
 		select {
 		case <-l.restart: // triggered restart
 		case <-l.stop:
 			enabled = false
+			l.state.SetState(loopstate.Stopped)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+func (l *looper) Refresh(ctx context.Context) (status Status, err error) {
+	const forceGeolocation = true
+	if err := l.update(ctx, forceGeolocation); err != nil {
+		return status, err
+	}
+	return l.GetStatus(), nil
+}
+
+// update obtains the public IP addresses and geolocation, updates the status
+// and writes the files.
+func (l *looper) update(ctx context.Context, forceGeolocation bool) (err error) {
+	l.updateMutex.Lock()
+	defer l.updateMutex.Unlock()
+	ip, err := l.getter.Get(ctx)
+	if err != nil {
+		return err
+	}
+	geolocation := l.geolocate(ctx, ip, forceGeolocation)
+	if geolocation != nil {
+		l.logger.Info("Public IP address is %s (%s)", ip, geolocation)
+	} else {
+		l.logger.Info("Public IP address is %s", ip)
+	}
+	l.statusMutex.Lock()
+	l.status.IP = ip
+	l.status.Geolocation = geolocation
+	l.statusMutex.Unlock()
+	l.checkLeak(ip)
+	l.checkEgress(ip)
+	l.updateIPv6(ctx)
+	if err := l.writeRecord(); err != nil {
+		l.logger.Error(err)
+	}
+	const userReadWritePermissions = 0600
+	return l.fileManager.WriteLinesToFile(
+		string(l.ipStatusFilepath),
+		[]string{ip.String()},
+		files.Ownership(l.uid, l.gid),
+		files.Permissions(userReadWritePermissions))
+}
+
 func (l *looper) RunRestartTicker(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 	timer := time.NewTimer(time.Hour)
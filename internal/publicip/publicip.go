@@ -8,26 +8,51 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/golibs/network"
 )
 
 type IPGetter interface {
 	Get(ctx context.Context) (ip net.IP, err error)
+	GetIPv6(ctx context.Context) (ip net.IP, err error)
 }
 
 type ipGetter struct {
-	client   network.Client
-	randIntn func(n int) int
+	client      network.Client
+	methods     []string
+	randIntn    func(n int) int
+	newResolver func(server string) *net.Resolver
 }
 
-func NewIPGetter(client network.Client) IPGetter {
+// NewIPGetter creates a public IP getter trying the methods given in order,
+// each method being either constants.PublicIPMethodHTTP or constants.PublicIPMethodDNS.
+func NewIPGetter(client network.Client, methods []string) IPGetter {
 	return &ipGetter{
-		client:   client,
-		randIntn: rand.Intn,
+		client:      client,
+		methods:     methods,
+		randIntn:    rand.Intn,
+		newResolver: newResolver,
 	}
 }
 
 func (i *ipGetter) Get(ctx context.Context) (ip net.IP, err error) {
+	errs := make([]string, 0, len(i.methods))
+	for _, method := range i.methods {
+		switch method {
+		case constants.PublicIPMethodDNS:
+			ip, err = i.getDNS(ctx)
+		default:
+			ip, err = i.getHTTP(ctx)
+		}
+		if err == nil {
+			return ip, nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", method, err))
+	}
+	return nil, fmt.Errorf("cannot obtain public IP address: %s", strings.Join(errs, "; "))
+}
+
+func (i *ipGetter) getHTTP(ctx context.Context) (ip net.IP, err error) {
 	urls := []string{
 		"https://ifconfig.me/ip",
 		"http://ip1.dynupdate.no-ip.com:8245",
@@ -38,6 +63,26 @@ func (i *ipGetter) Get(ctx context.Context) (ip net.IP, err error) {
 		"https://ifconfig.io/ip",
 		"https://ipinfo.io/ip",
 	}
+	return i.get(ctx, urls)
+}
+
+// GetIPv6 obtains the public IPv6 address using services only reachable over IPv6.
+func (i *ipGetter) GetIPv6(ctx context.Context) (ip net.IP, err error) {
+	urls := []string{
+		"https://api6.ipify.org",
+		"https://ipv6.icanhazip.com",
+		"https://v6.ident.me",
+	}
+	ip, err = i.get(ctx, urls)
+	if err != nil {
+		return nil, err
+	} else if ip.To4() != nil {
+		return nil, fmt.Errorf("IP address %s is not an IPv6 address", ip)
+	}
+	return ip, nil
+}
+
+func (i *ipGetter) get(ctx context.Context, urls []string) (ip net.IP, err error) {
 	url := urls[i.randIntn(len(urls))]
 	content, status, err := i.client.Get(ctx, url, network.UseRandomUserAgent())
 	if err != nil {
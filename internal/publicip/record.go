@@ -0,0 +1,84 @@
+package publicip
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/files"
+)
+
+// Record is the public IP information written to the JSON file.
+type Record struct {
+	Time     time.Time                 `json:"time"`
+	IP       net.IP                    `json:"ip"`
+	IPv6     net.IP                    `json:"ipv6,omitempty"`
+	Geo      *Geolocation              `json:"geolocation,omitempty"`
+	Provider models.VPNProvider        `json:"provider,omitempty"`
+	Server   *models.OpenVPNConnection `json:"server,omitempty"`
+}
+
+// maxHistoryRecords is the maximum number of records kept in
+// the JSON file, the oldest records being removed first.
+const maxHistoryRecords = 1000
+
+// writeRecord writes the current public IP information to the JSON file,
+// appending it to the previous records if the history is enabled.
+func (l *looper) writeRecord() error {
+	if l.jsonFilepath == "" {
+		return nil
+	}
+	status := l.GetStatus()
+	record := Record{
+		Time: l.timeNow(),
+		IP:   status.IP,
+		IPv6: status.IPv6,
+		Geo:  status.Geolocation,
+	}
+	if l.getServer != nil {
+		record.Provider, record.Server = l.getServer()
+	}
+
+	var toWrite interface{} = record
+	if l.jsonHistory {
+		records, err := l.readRecords()
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		if len(records) > maxHistoryRecords {
+			records = records[len(records)-maxHistoryRecords:]
+		}
+		toWrite = records
+	}
+	data, err := json.MarshalIndent(toWrite, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	const userReadWritePermissions = 0600
+	return l.fileManager.WriteToFile(l.jsonFilepath, data,
+		files.Ownership(l.uid, l.gid),
+		files.Permissions(userReadWritePermissions))
+}
+
+func (l *looper) readRecords() (records []Record, err error) {
+	exists, err := l.fileManager.FileExists(l.jsonFilepath)
+	if err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, nil
+	}
+	data, err := l.fileManager.ReadFile(l.jsonFilepath)
+	if err != nil {
+		return nil, err
+	} else if len(data) == 0 {
+		return nil, nil
+	}
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("cannot decode public IP history from %s: %w", l.jsonFilepath, err)
+	}
+	return records, nil
+}
@@ -0,0 +1,61 @@
+package publicip
+
+import (
+	"fmt"
+	"net"
+)
+
+// Status contains the public IP addresses last obtained.
+type Status struct {
+	IP   net.IP `json:"ip"`
+	IPv6 net.IP `json:"ipv6"`
+	// Geolocation is the geolocation of IP, nil if unknown.
+	Geolocation *Geolocation `json:"geolocation"`
+	// Leaking is true if IP is the ISP public IP address.
+	Leaking bool `json:"leaking"`
+	// UnexpectedEgress is true if IP is not in the same subnet as one of
+	// the VPN provider server IP addresses. It is only a guess, since the
+	// provider may use exit IP addresses in other subnets.
+	UnexpectedEgress bool `json:"unexpectedEgress"`
+	// IPv6Bypass is true if IPv6 is reachable but the VPN tunnel has no IPv6.
+	IPv6Bypass bool `json:"ipv6Bypass"`
+}
+
+func hostHasIPv6() (ok bool, err error) {
+	addresses, err := net.InterfaceAddrs()
+	if err != nil {
+		return false, fmt.Errorf("cannot list network interface addresses: %w", err)
+	}
+	return hasGlobalIPv6(addresses), nil
+}
+
+func tunnelHasIPv6(vpnInterface string) (ok bool, err error) {
+	tun, err := net.InterfaceByName(vpnInterface)
+	if err != nil {
+		return false, fmt.Errorf("cannot find tunnel interface: %w", err)
+	}
+	addresses, err := tun.Addrs()
+	if err != nil {
+		return false, fmt.Errorf("cannot list tunnel interface addresses: %w", err)
+	}
+	return hasGlobalIPv6(addresses), nil
+}
+
+func hasGlobalIPv6(addresses []net.Addr) bool {
+	for _, address := range addresses {
+		ipNet, ok := address.(*net.IPNet)
+		if !ok || ipNet.IP.To4() != nil {
+			continue
+		}
+		if ipNet.IP.IsGlobalUnicast() && !isUniqueLocal(ipNet.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUniqueLocal returns true for IPv6 unique local addresses fc00::/7,
+// which are not routed on the Internet.
+func isUniqueLocal(ip net.IP) bool {
+	return ip[0]&0xfe == 0xfc
+}
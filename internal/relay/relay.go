@@ -0,0 +1,72 @@
+// Package relay forwards the TCP connections and UDP datagrams received
+// on a port to another address, so that services outside the network
+// namespace can receive the traffic of the forwarded port.
+package relay
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/qdm12/golibs/logging"
+)
+
+type Relay interface {
+	// Run relays the TCP and UDP traffic received on the port given
+	// to the target address until the context is canceled.
+	Run(ctx context.Context, port uint16) (err error)
+}
+
+type relay struct {
+	target string
+	logger logging.Logger
+	dialer net.Dialer
+	// udpIdleTimeout is the duration after which a UDP session
+	// without any reply from the target is closed.
+	udpIdleTimeout time.Duration
+}
+
+// New returns a relay to the target host:port address given.
+func New(target string, logger logging.Logger) Relay {
+	const udpIdleTimeout = 2 * time.Minute
+	return &relay{
+		target:         target,
+		logger:         logger,
+		udpIdleTimeout: udpIdleTimeout,
+	}
+}
+
+func (r *relay) Run(ctx context.Context, port uint16) (err error) {
+	address := ":" + strconv.Itoa(int(port))
+	listenConfig := net.ListenConfig{}
+	listener, err := listenConfig.Listen(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Errorf("cannot relay port %d: %w", port, err)
+	}
+	packetConn, err := listenConfig.ListenPacket(ctx, "udp", address)
+	if err != nil {
+		_ = listener.Close()
+		return fmt.Errorf("cannot relay port %d: %w", port, err)
+	}
+	r.logger.Info("relaying TCP and UDP port %d to %s", port, r.target)
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2) //nolint:gomnd
+	go func() {
+		defer wg.Done()
+		r.serveTCP(ctx, listener)
+	}()
+	go func() {
+		defer wg.Done()
+		r.serveUDP(ctx, packetConn)
+	}()
+	<-ctx.Done()
+	_ = listener.Close()
+	_ = packetConn.Close()
+	wg.Wait()
+	r.logger.Info("stopped relaying port %d", port)
+	return nil
+}
@@ -0,0 +1,107 @@
+package relay
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_relay_serveTCP(t *testing.T) {
+	t.Parallel()
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+	go func() {
+		conn, err := target.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buffer := make([]byte, 4)
+		n, _ := conn.Read(buffer)
+		_, _ = conn.Write(append([]byte("re:"), buffer[:n]...))
+	}()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	r := &relay{target: target.Addr().String(), logger: logger}
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.serveTCP(ctx, listener)
+	}()
+
+	client, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	_, err = client.Write([]byte("ping"))
+	require.NoError(t, err)
+	reply := make([]byte, 16)
+	require.NoError(t, client.SetReadDeadline(time.Now().Add(time.Second)))
+	n, err := client.Read(reply)
+	require.NoError(t, err)
+	assert.Equal(t, "re:ping", string(reply[:n]))
+
+	cancel()
+	_ = listener.Close()
+	wg.Wait()
+}
+
+func Test_relay_serveUDP(t *testing.T) {
+	t.Parallel()
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+
+	target, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer target.Close()
+	go func() {
+		buffer := make([]byte, 16)
+		for {
+			n, address, err := target.ReadFrom(buffer)
+			if err != nil {
+				return
+			}
+			_, _ = target.WriteTo(append([]byte("re:"), buffer[:n]...), address)
+		}
+	}()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	r := &relay{target: target.LocalAddr().String(), logger: logger, udpIdleTimeout: time.Second}
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		r.serveUDP(ctx, conn)
+	}()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	reply := make([]byte, 16)
+	for _, message := range []string{"a", "b"} {
+		_, err = client.Write([]byte(message))
+		require.NoError(t, err)
+		require.NoError(t, client.SetReadDeadline(time.Now().Add(time.Second)))
+		n, err := client.Read(reply)
+		require.NoError(t, err)
+		assert.Equal(t, "re:"+message, string(reply[:n]))
+	}
+
+	cancel()
+	_ = conn.Close()
+	wg.Wait()
+}
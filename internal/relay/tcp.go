@@ -0,0 +1,59 @@
+package relay
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+)
+
+func (r *relay) serveTCP(ctx context.Context, listener net.Listener) {
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.Warn("cannot accept TCP connection: %s", err)
+				continue
+			}
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.relayTCP(ctx, conn)
+		}()
+	}
+}
+
+// relayTCP copies the data between the client connection given and a
+// new connection to the target, until either side closes its connection
+// or the context is canceled.
+func (r *relay) relayTCP(ctx context.Context, client net.Conn) {
+	defer client.Close()
+	target, err := r.dialer.DialContext(ctx, "tcp", r.target)
+	if err != nil {
+		r.logger.Warn("cannot relay TCP connection from %s: %s", client.RemoteAddr(), err)
+		return
+	}
+	defer target.Close()
+
+	done := make(chan struct{}, 2) //nolint:gomnd
+	go func() {
+		_, _ = io.Copy(target, client)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(client, target)
+		done <- struct{}{}
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+	// closing both connections unblocks the other copy
+	_ = client.Close()
+	_ = target.Close()
+	<-done
+}
@@ -0,0 +1,80 @@
+package relay
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+)
+
+// maxDatagramSize is the maximum size of a UDP datagram payload.
+const maxDatagramSize = 65507
+
+func (r *relay) serveUDP(ctx context.Context, conn net.PacketConn) {
+	sessions := make(map[string]net.Conn)
+	mutex := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	defer func() {
+		mutex.Lock()
+		for _, target := range sessions {
+			_ = target.Close()
+		}
+		mutex.Unlock()
+		wg.Wait()
+	}()
+
+	buffer := make([]byte, maxDatagramSize)
+	for {
+		n, client, err := conn.ReadFrom(buffer)
+		if err != nil {
+			if ctx.Err() == nil {
+				r.logger.Warn("cannot read UDP datagram: %s", err)
+				continue
+			}
+			return
+		}
+		mutex.Lock()
+		target, ok := sessions[client.String()]
+		if !ok {
+			target, err = r.dialer.DialContext(ctx, "udp", r.target)
+			if err != nil {
+				mutex.Unlock()
+				r.logger.Warn("cannot relay UDP datagram from %s: %s", client, err)
+				continue
+			}
+			sessions[client.String()] = target
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				r.relayUDPReplies(conn, client, target)
+				mutex.Lock()
+				if sessions[client.String()] == target {
+					delete(sessions, client.String())
+				}
+				mutex.Unlock()
+			}()
+		}
+		mutex.Unlock()
+		if _, err := target.Write(buffer[:n]); err != nil {
+			r.logger.Warn("cannot relay UDP datagram from %s: %s", client, err)
+		}
+	}
+}
+
+// relayUDPReplies sends the datagrams received from the target back to
+// the client, until no datagram is received during the idle timeout or
+// the target connection is closed.
+func (r *relay) relayUDPReplies(conn net.PacketConn, client net.Addr, target net.Conn) {
+	defer target.Close()
+	buffer := make([]byte, maxDatagramSize)
+	for {
+		_ = target.SetReadDeadline(time.Now().Add(r.udpIdleTimeout))
+		n, err := target.Read(buffer)
+		if err != nil {
+			return
+		}
+		if _, err := conn.WriteTo(buffer[:n], client); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package routing
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/vishvananda/netlink"
+)
+
+// FlushConntrack deletes the connection tracking entries of the flows
+// originating from the IP address given. It is meant to be called with the
+// IP address of the previous VPN connection once the tunnel is up again,
+// so flows pinned to the previous connection fail fast instead of hanging.
+func (r *routing) FlushConntrack(sourceIP net.IP) (flushed uint, err error) {
+	family := netlink.FAMILY_V4
+	if sourceIP.To4() == nil {
+		family = netlink.FAMILY_V6
+	}
+	filter := &netlink.ConntrackFilter{}
+	if err := filter.AddIP(netlink.ConntrackOrigSrcIP, sourceIP); err != nil {
+		return 0, fmt.Errorf("cannot flush conntrack entries: %w", err)
+	}
+	flushed, err = netlink.ConntrackDeleteFilter(netlink.ConntrackTable, netlink.InetFamily(family), filter)
+	if err != nil {
+		return 0, fmt.Errorf("cannot flush conntrack entries from %s: %w", sourceIP, err)
+	}
+	return flushed, nil
+}
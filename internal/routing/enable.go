@@ -2,7 +2,9 @@ package routing
 
 import (
 	"fmt"
-	"net"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/vishvananda/netlink"
 )
 
 var (
@@ -13,10 +15,13 @@ var (
 const (
 	table    = 200
 	priority = 100
+	// packets marked to bypass the VPN are looked up in the table
+	// before the other rules.
+	bypassPriority = 99
 )
 
 func (r *routing) Setup() (err error) {
-	defaultIP, err := r.DefaultIP()
+	defaultRoutes, err := r.defaultRoutes()
 	if err != nil {
 		return fmt.Errorf("%s: %w", ErrSetup, err)
 	}
@@ -24,6 +29,14 @@ func (r *routing) Setup() (err error) {
 	if err != nil {
 		return fmt.Errorf("%s: %w", ErrSetup, err)
 	}
+	defaultLink, err := netlink.LinkByName(defaultInterfaceName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", ErrSetup, err)
+	}
+	r.stateMutex.Lock()
+	r.defaultLinkIndex = defaultLink.Attrs().Index
+	r.defaultGateway = defaultGateway
+	r.stateMutex.Unlock()
 
 	defer func() {
 		if err == nil {
@@ -33,11 +46,17 @@ func (r *routing) Setup() (err error) {
 			r.logger.Error(err)
 		}
 	}()
-	if err := r.addIPRule(defaultIP, table, priority); err != nil {
-		return fmt.Errorf("%s: %w", ErrSetup, err)
+	// replies from the default IP addresses, for IPv4 and IPv6, are
+	// routed through the default route instead of the VPN tunnel.
+	for _, route := range defaultRoutes {
+		if err := r.addIPRule(route.assignedIP, table, priority); err != nil {
+			return fmt.Errorf("%s: %w", ErrSetup, err)
+		}
+		if err := r.addRouteVia(route.destination, route.gateway, route.interfaceName, table); err != nil {
+			return fmt.Errorf("%s: %w", ErrSetup, err)
+		}
 	}
-	defaultDestination := net.IPNet{IP: net.IPv4(0, 0, 0, 0), Mask: net.IPv4Mask(0, 0, 0, 0)}
-	if err := r.addRouteVia(defaultDestination, defaultGateway, defaultInterfaceName, table); err != nil {
+	if err := r.addMarkIPRule(constants.VPNBypassMark, table, bypassPriority); err != nil {
 		return fmt.Errorf("%s: %w", ErrSetup, err)
 	}
 
@@ -52,7 +71,7 @@ func (r *routing) Setup() (err error) {
 }
 
 func (r *routing) TearDown() error {
-	defaultIP, err := r.DefaultIP()
+	defaultRoutes, err := r.defaultRoutes()
 	if err != nil {
 		return fmt.Errorf("%s: %w", ErrTeardown, err)
 	}
@@ -61,17 +80,22 @@ func (r *routing) TearDown() error {
 		return fmt.Errorf("%s: %w", ErrTeardown, err)
 	}
 
-	defaultNet := net.IPNet{IP: net.IPv4(0, 0, 0, 0), Mask: net.IPv4Mask(0, 0, 0, 0)}
-	if err := r.deleteRouteVia(defaultNet, defaultGateway, defaultInterfaceName, table); err != nil {
-		return fmt.Errorf("%s: %w", ErrTeardown, err)
+	for _, route := range defaultRoutes {
+		if err := r.deleteRouteVia(route.destination, route.gateway, route.interfaceName, table); err != nil {
+			return fmt.Errorf("%s: %w", ErrTeardown, err)
+		}
+		if err := r.deleteIPRule(route.assignedIP, table, priority); err != nil {
+			return fmt.Errorf("%s: %w", ErrTeardown, err)
+		}
 	}
-	if err := r.deleteIPRule(defaultIP, table, priority); err != nil {
+	if err := r.deleteMarkIPRule(constants.VPNBypassMark, table, bypassPriority); err != nil {
 		return fmt.Errorf("%s: %w", ErrTeardown, err)
 	}
 
 	if err := r.setOutboundRoutes(nil, defaultInterfaceName, defaultGateway); err != nil {
 		return fmt.Errorf("%s: %w", ErrSetup, err)
 	}
+	r.removeStaticRoutes(defaultInterfaceName)
 
 	return nil
 }
@@ -26,6 +26,7 @@ func (r *routing) addRouteVia(destination net.IPNet, gateway net.IP, iface strin
 		Gw:        gateway,
 		LinkIndex: link.Attrs().Index,
 		Table:     table,
+		Priority:  r.metric,
 	}
 	if err := netlink.RouteReplace(&route); err != nil {
 		return fmt.Errorf("cannot add route for %s: %w", destinationStr, err)
@@ -51,6 +52,7 @@ func (r *routing) deleteRouteVia(destination net.IPNet, gateway net.IP, iface st
 		Gw:        gateway,
 		LinkIndex: link.Attrs().Index,
 		Table:     table,
+		Priority:  r.metric,
 	}
 	if err := netlink.RouteDel(&route); err != nil {
 		return fmt.Errorf("cannot delete route for %s: %w", destinationStr, err)
@@ -112,3 +114,54 @@ func (r *routing) deleteIPRule(src net.IP, table, priority int) error {
 	}
 	return nil
 }
+
+func (r *routing) addMarkIPRule(mark, table, priority int) error {
+	if r.debug {
+		fmt.Printf("ip rule add fwmark %d lookup %d pref %d\n",
+			mark, table, priority)
+	}
+
+	rule := netlink.NewRule()
+	rule.Mark = mark
+	rule.Priority = priority
+	rule.Table = table
+
+	rules, err := netlink.RuleList(netlink.FAMILY_ALL)
+	if err != nil {
+		return fmt.Errorf("cannot add ip rule: %w", err)
+	}
+	for _, existingRule := range rules {
+		if existingRule.Mark == rule.Mark &&
+			existingRule.Priority == rule.Priority &&
+			existingRule.Table == rule.Table {
+			return nil // already exists
+		}
+	}
+
+	return netlink.RuleAdd(rule)
+}
+
+func (r *routing) deleteMarkIPRule(mark, table, priority int) error {
+	if r.debug {
+		fmt.Printf("ip rule del fwmark %d lookup %d pref %d\n",
+			mark, table, priority)
+	}
+
+	rule := netlink.NewRule()
+	rule.Mark = mark
+	rule.Priority = priority
+	rule.Table = table
+
+	rules, err := netlink.RuleList(netlink.FAMILY_ALL)
+	if err != nil {
+		return fmt.Errorf("cannot delete ip rule: %w", err)
+	}
+	for _, existingRule := range rules {
+		if existingRule.Mark == rule.Mark &&
+			existingRule.Priority == rule.Priority &&
+			existingRule.Table == rule.Table {
+			return netlink.RuleDel(rule)
+		}
+	}
+	return nil
+}
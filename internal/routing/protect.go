@@ -0,0 +1,166 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+// ProtectVPNRoutes records the routes set by OpenVPN through the tunnel
+// interface and to the VPN server, so that they are restored if another
+// agent removes them. It is meant to be called each time the tunnel is up.
+func (r *routing) ProtectVPNRoutes() error {
+	link, err := netlink.LinkByName(r.vpnInterface)
+	if err != nil {
+		return fmt.Errorf("cannot protect VPN routes: %w", err)
+	}
+	routes, err := netlink.RouteList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		return fmt.Errorf("cannot protect VPN routes: %w", err)
+	}
+	vpnDestination, err := r.VPNDestinationIP()
+	if err != nil {
+		return fmt.Errorf("cannot protect VPN routes: %w", err)
+	}
+	var vpnRoutes []netlink.Route
+	for _, route := range routes {
+		toVPNServer := route.Dst != nil && route.Dst.IP.Equal(vpnDestination)
+		if route.LinkIndex == link.Attrs().Index || toVPNServer {
+			vpnRoutes = append(vpnRoutes, route)
+		}
+	}
+	r.stateMutex.Lock()
+	defer r.stateMutex.Unlock()
+	r.vpnRoutes = vpnRoutes
+	r.vpnLinkIndex = link.Attrs().Index
+	return nil
+}
+
+// CheckRoutes restores the routes of gluetun which were removed or replaced,
+// for example by a DHCP lease renewal, and returns how many were restored.
+// The routes via the default gateway are checked against the default gateway
+// recorded at Setup, and the VPN routes are only restored if the tunnel
+// interface is still the one they were recorded with.
+func (r *routing) CheckRoutes() (restored int, err error) {
+	routes, err := netlink.RouteListFiltered(netlink.FAMILY_V4,
+		&netlink.Route{Table: unix.RT_TABLE_UNSPEC}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		return 0, fmt.Errorf("cannot check routes: %w", err)
+	}
+	vpnLinkIndex := -1
+	if tun, err := netlink.LinkByName(r.vpnInterface); err == nil {
+		vpnLinkIndex = tun.Attrs().Index
+	}
+
+	expected, err := r.expectedRoutes(vpnLinkIndex)
+	if err != nil {
+		return 0, fmt.Errorf("cannot check routes: %w", err)
+	}
+
+	for _, route := range missingRoutes(expected, routes) {
+		route := route
+		r.logger.Warn("restoring route %s", route)
+		if err := netlink.RouteReplace(&route); err != nil {
+			return restored, fmt.Errorf("cannot restore route %s: %w", route, err)
+		}
+		restored++
+	}
+	return restored, nil
+}
+
+// expectedRoutes returns the routes set up by gluetun, including the VPN
+// routes if the tunnel interface index given is the one they were recorded with.
+func (r *routing) expectedRoutes(vpnLinkIndex int) (expected []netlink.Route, err error) {
+	r.stateMutex.RLock()
+	defer r.stateMutex.RUnlock()
+	if r.defaultGateway == nil {
+		return nil, fmt.Errorf("routing is not set up")
+	}
+	expected = make([]netlink.Route, 0, 1+len(r.outboundSubnets)+len(r.vpnRoutes))
+	defaultDestination := net.IPNet{IP: net.IPv4(0, 0, 0, 0), Mask: net.IPv4Mask(0, 0, 0, 0)}
+	expected = append(expected, r.viaRoute(defaultDestination, r.defaultGateway, r.defaultLinkIndex, table))
+	for _, subnet := range r.outboundSubnets {
+		expected = append(expected, r.viaRoute(subnet, r.defaultGateway, r.defaultLinkIndex, 0))
+	}
+	if vpnLinkIndex == r.vpnLinkIndex {
+		expected = append(expected, r.vpnRoutes...)
+	}
+	return expected, nil
+}
+
+// Protect checks the routes every period given and restores the routes
+// removed or replaced, until the context is canceled.
+func (r *routing) Protect(ctx context.Context, wg *sync.WaitGroup, period time.Duration) {
+	defer wg.Done()
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			restored, err := r.CheckRoutes()
+			if err != nil {
+				r.logger.Error(err)
+			}
+			if restored > 0 {
+				r.logger.Warn("restored %d route(s) removed or replaced by another program", restored)
+			}
+		}
+	}
+}
+
+func (r *routing) viaRoute(destination net.IPNet, gateway net.IP, linkIndex, table int) netlink.Route {
+	return netlink.Route{
+		Dst:       &destination,
+		Gw:        gateway,
+		LinkIndex: linkIndex,
+		Table:     table,
+		Priority:  r.metric,
+	}
+}
+
+// missingRoutes returns the expected routes which are not in the existing
+// routes, where a table of 0 is the main routing table.
+func missingRoutes(expected, existing []netlink.Route) (missing []netlink.Route) {
+	for _, route := range expected {
+		found := false
+		for _, existingRoute := range existing {
+			if sameRoute(route, existingRoute) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, route)
+		}
+	}
+	return missing
+}
+
+func sameRoute(a, b netlink.Route) bool {
+	return routeTable(a) == routeTable(b) &&
+		a.LinkIndex == b.LinkIndex &&
+		a.Gw.Equal(b.Gw) &&
+		a.Priority == b.Priority &&
+		destination(a) == destination(b)
+}
+
+func routeTable(route netlink.Route) int {
+	if route.Table == 0 {
+		return unix.RT_TABLE_MAIN
+	}
+	return route.Table
+}
+
+func destination(route netlink.Route) string {
+	if route.Dst == nil {
+		return "0.0.0.0/0"
+	}
+	return route.Dst.String()
+}
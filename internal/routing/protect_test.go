@@ -0,0 +1,64 @@
+package routing
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_routing_expectedRoutes(t *testing.T) {
+	t.Parallel()
+	gateway := net.IP{192, 168, 1, 1}
+	defaultDestination := net.IPNet{IP: net.IPv4(0, 0, 0, 0), Mask: net.IPv4Mask(0, 0, 0, 0)}
+	outboundSubnet := net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.IPv4Mask(255, 0, 0, 0)}
+	vpnRoute := netlink.Route{LinkIndex: 5, Gw: net.IP{10, 8, 0, 1}}
+	viaGatewayRoutes := []netlink.Route{
+		{Dst: &defaultDestination, Gw: gateway, LinkIndex: 2, Table: table, Priority: 10},
+		{Dst: &outboundSubnet, Gw: gateway, LinkIndex: 2, Priority: 10},
+	}
+	testCases := map[string]struct {
+		defaultGateway net.IP
+		vpnLinkIndex   int
+		expected       []netlink.Route
+		err            string
+	}{
+		"not set up": {
+			err: "routing is not set up",
+		},
+		"same tunnel interface": {
+			defaultGateway: gateway,
+			vpnLinkIndex:   5,
+			expected:       append(append([]netlink.Route{}, viaGatewayRoutes...), vpnRoute),
+		},
+		"tunnel interface changed": {
+			defaultGateway: gateway,
+			vpnLinkIndex:   6,
+			expected:       viaGatewayRoutes,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			r := &routing{
+				metric:           10,
+				outboundSubnets:  []net.IPNet{outboundSubnet},
+				vpnRoutes:        []netlink.Route{vpnRoute},
+				vpnLinkIndex:     5,
+				defaultLinkIndex: 2,
+				defaultGateway:   testCase.defaultGateway,
+			}
+			expected, err := r.expectedRoutes(testCase.vpnLinkIndex)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, expected)
+		})
+	}
+}
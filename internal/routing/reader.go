@@ -4,33 +4,109 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 
-	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/vishvananda/netlink"
 )
 
 func (r *routing) DefaultRoute() (defaultInterface string, defaultGateway net.IP, err error) {
+	defaultInterface, defaultGateway, err = r.defaultRoute()
+	if err != nil {
+		return "", nil, err
+	}
+	if r.verbose {
+		r.logger.Info("default route found: interface %s, gateway %s", defaultInterface, defaultGateway.String())
+	}
+	return defaultInterface, defaultGateway, nil
+}
+
+func (r *routing) defaultRoute() (defaultInterface string, defaultGateway net.IP, err error) {
 	routes, err := netlink.RouteList(nil, netlink.FAMILY_ALL)
 	if err != nil {
 		return "", nil, fmt.Errorf("cannot list routes: %w", err)
 	}
+	route, ok := findDefaultRoute(routes)
+	if !ok {
+		return "", nil, fmt.Errorf("cannot find default route in %d routes", len(routes))
+	}
+	link, err := netlink.LinkByIndex(route.LinkIndex)
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot obtain link with index %d for default route: %w", route.LinkIndex, err)
+	}
+	return link.Attrs().Name, route.Gw, nil
+}
+
+// findDefaultRoute returns the default route with the lowest metric,
+// for hosts with a default route on several interfaces.
+func findDefaultRoute(routes []netlink.Route) (defaultRoute netlink.Route, ok bool) {
 	for _, route := range routes {
-		if route.Dst == nil {
-			defaultGateway = route.Gw
-			linkIndex := route.LinkIndex
-			link, err := netlink.LinkByIndex(linkIndex)
-			if err != nil {
-				return "", nil, fmt.Errorf("cannot obtain link with index %d for default route: %w", linkIndex, err)
-			}
-			attributes := link.Attrs()
-			defaultInterface = attributes.Name
-			if r.verbose {
-				r.logger.Info("default route found: interface %s, gateway %s", defaultInterface, defaultGateway.String())
-			}
-			return defaultInterface, defaultGateway, nil
+		if route.Dst != nil {
+			continue
+		}
+		if !ok || route.Priority < defaultRoute.Priority {
+			defaultRoute = route
+			ok = true
+		}
+	}
+	return defaultRoute, ok
+}
+
+// familyRoute is the default route of an IP family, with the
+// IP address assigned to its interface in this family.
+type familyRoute struct {
+	interfaceName string
+	gateway       net.IP
+	assignedIP    net.IP
+	destination   net.IPNet
+}
+
+// defaultRoutes returns the IPv4 default route followed by the IPv6 default
+// route, each being only present if the host has a default route and an
+// assigned address for its IP family, for IPv6 only hosts for example.
+// The IPv6 default route is ignored if the default interface has no global
+// IPv6 address, such as with only link-local IPv6 addresses.
+func (r *routing) defaultRoutes() (routes []familyRoute, err error) {
+	families := []struct {
+		family      int
+		destination net.IPNet
+	}{
+		{netlink.FAMILY_V4, net.IPNet{IP: net.IPv4(0, 0, 0, 0), Mask: net.IPv4Mask(0, 0, 0, 0)}},
+		{netlink.FAMILY_V6, net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(0, 8*net.IPv6len)}},
+	}
+	for _, family := range families {
+		familyRoutes, err := netlink.RouteList(nil, family.family)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list routes: %w", err)
+		}
+		route, ok := findDefaultRoute(familyRoutes)
+		if !ok {
+			continue
 		}
+		link, err := netlink.LinkByIndex(route.LinkIndex)
+		if err != nil {
+			return nil, fmt.Errorf("cannot obtain link with index %d for default route: %w", route.LinkIndex, err)
+		}
+		interfaceName := link.Attrs().Name
+		ip, err := r.assignedIPOfFamily(interfaceName, family.family == netlink.FAMILY_V6)
+		if err != nil && family.family == netlink.FAMILY_V6 {
+			// hosts with only link-local IPv6 addresses still work with IPv4
+			r.logger.Warn("ignoring the IPv6 default route: %s", err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		routes = append(routes, familyRoute{
+			interfaceName: interfaceName,
+			gateway:       route.Gw,
+			assignedIP:    ip,
+			destination:   family.destination,
+		})
+	}
+	if len(routes) == 0 {
+		return nil, fmt.Errorf("cannot find an IPv4 or IPv6 default route")
 	}
-	return "", nil, fmt.Errorf("cannot find default route in %d routes", len(routes))
+	return routes, nil
 }
 
 func (r *routing) DefaultIP() (ip net.IP, err error) {
@@ -39,22 +115,16 @@ func (r *routing) DefaultIP() (ip net.IP, err error) {
 		return nil, fmt.Errorf("cannot get default IP address: %w", err)
 	}
 
-	defaultLinkName := ""
-	for _, route := range routes {
-		if route.Dst == nil {
-			linkIndex := route.LinkIndex
-			link, err := netlink.LinkByIndex(linkIndex)
-			if err != nil {
-				return nil, fmt.Errorf("cannot get default IP address: %w", err)
-			}
-			defaultLinkName = link.Attrs().Name
-		}
-	}
-	if len(defaultLinkName) == 0 {
+	route, ok := findDefaultRoute(routes)
+	if !ok {
 		return nil, fmt.Errorf("cannot find default link name in %d routes", len(routes))
 	}
+	link, err := netlink.LinkByIndex(route.LinkIndex)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get default IP address: %w", err)
+	}
 
-	return r.assignedIP(defaultLinkName)
+	return r.assignedIP(link.Attrs().Name)
 }
 
 func (r *routing) LocalSubnet() (defaultSubnet net.IPNet, err error) {
@@ -63,19 +133,13 @@ func (r *routing) LocalSubnet() (defaultSubnet net.IPNet, err error) {
 		return defaultSubnet, fmt.Errorf("cannot find local subnet: %w", err)
 	}
 
-	defaultLinkIndex := -1
-	for _, route := range routes {
-		if route.Dst == nil {
-			defaultLinkIndex = route.LinkIndex
-			break
-		}
-	}
-	if defaultLinkIndex == -1 {
+	defaultRoute, ok := findDefaultRoute(routes)
+	if !ok {
 		return defaultSubnet, fmt.Errorf("cannot find local subnet: cannot find default link")
 	}
 
 	for _, route := range routes {
-		if route.Gw != nil || route.LinkIndex != defaultLinkIndex {
+		if route.Gw != nil || route.LinkIndex != defaultRoute.LinkIndex {
 			continue
 		}
 		defaultSubnet = *route.Dst
@@ -88,6 +152,96 @@ func (r *routing) LocalSubnet() (defaultSubnet net.IPNet, err error) {
 	return defaultSubnet, fmt.Errorf("cannot find default subnet in %d routes", len(routes))
 }
 
+// LocalNetwork is a subnet directly reachable through a host interface.
+type LocalNetwork struct {
+	InterfaceName string
+	// IP is the IP address of the interface in the subnet.
+	IP     net.IP
+	Subnet net.IPNet
+}
+
+// LocalNetworks returns the local network of the default interface first,
+// followed by the local networks of the interfaces given, for hosts with
+// several networks attached such as macvlan networks.
+func (r *routing) LocalNetworks(interfaces []string) (localNetworks []LocalNetwork, err error) {
+	routes, err := netlink.RouteList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find local networks: %w", err)
+	}
+
+	linkNames := make(map[int]string)
+	for _, route := range routes {
+		if _, ok := linkNames[route.LinkIndex]; ok {
+			continue
+		}
+		link, err := netlink.LinkByIndex(route.LinkIndex)
+		if err != nil {
+			return nil, fmt.Errorf("cannot find local networks: %w", err)
+		}
+		linkNames[route.LinkIndex] = link.Attrs().Name
+	}
+
+	localNetworks, err = findLocalNetworks(routes, linkNames, interfaces)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find local networks: %w", err)
+	}
+
+	for i, network := range localNetworks {
+		localNetworks[i].IP, err = r.assignedIPInSubnet(network.InterfaceName, network.Subnet)
+		if err != nil {
+			return nil, fmt.Errorf("cannot find local networks: %w", err)
+		}
+		if r.verbose {
+			r.logger.Info("local network found: interface %s, subnet %s, IP address %s",
+				network.InterfaceName, network.Subnet.String(), localNetworks[i].IP.String())
+		}
+	}
+	return localNetworks, nil
+}
+
+// findLocalNetworks returns the local networks, without their IP address,
+// of the default route interface first followed by the interfaces given,
+// using the first link scope subnet of each interface in the routes given.
+// linkNames maps the link indexes of the routes to their interface name.
+func findLocalNetworks(routes []netlink.Route, linkNames map[int]string,
+	interfaces []string) (localNetworks []LocalNetwork, err error) {
+	defaultRoute, ok := findDefaultRoute(routes)
+	if !ok {
+		return nil, fmt.Errorf("cannot find default link")
+	}
+
+	// first local subnet of each link, in the order of the routes
+	nameToSubnet := make(map[string]net.IPNet)
+	for _, route := range routes {
+		if route.Gw != nil || route.Dst == nil || route.Scope != netlink.SCOPE_LINK {
+			continue
+		}
+		name := linkNames[route.LinkIndex]
+		if _, ok := nameToSubnet[name]; ok {
+			continue
+		}
+		nameToSubnet[name] = *route.Dst
+	}
+
+	interfaces = append([]string{linkNames[defaultRoute.LinkIndex]}, interfaces...)
+	seen := make(map[string]struct{}, len(interfaces))
+	for _, name := range interfaces {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		subnet, ok := nameToSubnet[name]
+		if !ok {
+			return nil, fmt.Errorf("cannot find local subnet of interface %s", name)
+		}
+		localNetworks = append(localNetworks, LocalNetwork{
+			InterfaceName: name,
+			Subnet:        subnet,
+		})
+	}
+	return localNetworks, nil
+}
+
 func (r *routing) assignedIP(interfaceName string) (ip net.IP, err error) {
 	iface, err := net.InterfaceByName(interfaceName)
 	if err != nil {
@@ -108,25 +262,45 @@ func (r *routing) assignedIP(interfaceName string) (ip net.IP, err error) {
 	return nil, fmt.Errorf("IP address not found in addresses of interface %s", interfaceName)
 }
 
+// assignedIPOfFamily returns the first global unicast IP address assigned
+// to the interface which is an IPv6 address if ipv6 is true, and an IPv4
+// address otherwise.
+func (r *routing) assignedIPOfFamily(interfaceName string, ipv6 bool) (ip net.IP, err error) {
+	iface, err := net.InterfaceByName(interfaceName)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := iface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range addresses {
+		ipNet, ok := address.(*net.IPNet)
+		if !ok || !ipNet.IP.IsGlobalUnicast() || (ipNet.IP.To4() == nil) != ipv6 {
+			continue
+		}
+		return ipNet.IP, nil
+	}
+	family := "IPv4"
+	if ipv6 {
+		family = "IPv6"
+	}
+	return nil, fmt.Errorf("%s address not found in addresses of interface %s", family, interfaceName)
+}
+
 func (r *routing) VPNDestinationIP() (ip net.IP, err error) {
 	routes, err := netlink.RouteList(nil, netlink.FAMILY_ALL)
 	if err != nil {
 		return nil, fmt.Errorf("cannot find VPN destination IP: %w", err)
 	}
 
-	defaultLinkIndex := -1
-	for _, route := range routes {
-		if route.Dst == nil {
-			defaultLinkIndex = route.LinkIndex
-			break
-		}
-	}
-	if defaultLinkIndex == -1 {
+	defaultRoute, ok := findDefaultRoute(routes)
+	if !ok {
 		return nil, fmt.Errorf("cannot find VPN destination IP: cannot find default link")
 	}
 
 	for _, route := range routes {
-		if route.LinkIndex == defaultLinkIndex &&
+		if route.LinkIndex == defaultRoute.LinkIndex &&
 			route.Dst != nil &&
 			!IPIsPrivate(route.Dst.IP) &&
 			bytes.Equal(route.Dst.Mask, net.IPMask{255, 255, 255, 255}) {
@@ -136,6 +310,26 @@ func (r *routing) VPNDestinationIP() (ip net.IP, err error) {
 	return nil, fmt.Errorf("cannot find VPN destination IP address from ip routes")
 }
 
+// assignedIPInSubnet returns the IP address assigned to the interface
+// in the subnet given.
+func (r *routing) assignedIPInSubnet(interfaceName string, subnet net.IPNet) (ip net.IP, err error) {
+	iface, err := net.InterfaceByName(interfaceName)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := iface.Addrs()
+	if err != nil {
+		return nil, err
+	}
+	for _, address := range addresses {
+		ipNet, ok := address.(*net.IPNet)
+		if ok && subnet.Contains(ipNet.IP) {
+			return ipNet.IP, nil
+		}
+	}
+	return nil, fmt.Errorf("IP address in subnet %s not found in addresses of interface %s", subnet.String(), interfaceName)
+}
+
 func (r *routing) VPNLocalGatewayIP() (ip net.IP, err error) {
 	routes, err := netlink.RouteList(nil, netlink.FAMILY_ALL)
 	if err != nil {
@@ -147,7 +341,7 @@ func (r *routing) VPNLocalGatewayIP() (ip net.IP, err error) {
 			return nil, fmt.Errorf("cannot find VPN local gateway IP: %w", err)
 		}
 		interfaceName := link.Attrs().Name
-		if interfaceName == string(constants.TUN) &&
+		if interfaceName == r.vpnInterface &&
 			route.Dst != nil &&
 			route.Dst.IP.Equal(net.IP{0, 0, 0, 0}) {
 			return route.Gw, nil
@@ -156,6 +350,37 @@ func (r *routing) VPNLocalGatewayIP() (ip net.IP, err error) {
 	return nil, fmt.Errorf("cannot find VPN local gateway IP address from ip routes")
 }
 
+// VPNLocalIP returns the IP address assigned to the tunnel interface.
+func (r *routing) VPNLocalIP() (ip net.IP, err error) {
+	ip, err = r.assignedIP(r.vpnInterface)
+	if err != nil {
+		return nil, fmt.Errorf("cannot find VPN local IP address: %w", err)
+	}
+	return ip, nil
+}
+
+// FreeInterfaceName returns the name given if no interface has this name,
+// or the first name not used by an interface with the same prefix followed
+// by a higher number otherwise, such as tun1 for tun0.
+func (r *routing) FreeInterfaceName(name string) (free string, err error) {
+	prefix := strings.TrimRight(name, "0123456789")
+	number := -1
+	if prefix != name {
+		number, _ = strconv.Atoi(name[len(prefix):])
+	}
+	const maxTries = 100
+	for i := 0; i < maxTries; i++ {
+		free = name
+		if i > 0 {
+			free = prefix + strconv.Itoa(number+i)
+		}
+		if _, err := net.InterfaceByName(free); err != nil {
+			return free, nil
+		}
+	}
+	return "", fmt.Errorf("cannot find a free interface name from %s: %d names already used", name, maxTries)
+}
+
 func IPIsPrivate(ip net.IP) bool {
 	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
 		return true
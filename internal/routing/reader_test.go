@@ -0,0 +1,118 @@
+package routing
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+)
+
+func Test_findDefaultRoute(t *testing.T) {
+	t.Parallel()
+	subnet := &net.IPNet{IP: net.IP{192, 168, 1, 0}, Mask: net.IPv4Mask(255, 255, 255, 0)}
+	testCases := map[string]struct {
+		routes       []netlink.Route
+		defaultRoute netlink.Route
+		ok           bool
+	}{
+		"no route": {},
+		"no default route": {
+			routes: []netlink.Route{{LinkIndex: 1, Dst: subnet}},
+		},
+		"single default route": {
+			routes: []netlink.Route{
+				{LinkIndex: 1, Dst: subnet},
+				{LinkIndex: 1, Gw: net.IP{192, 168, 1, 1}},
+			},
+			defaultRoute: netlink.Route{LinkIndex: 1, Gw: net.IP{192, 168, 1, 1}},
+			ok:           true,
+		},
+		"lowest metric default route": {
+			routes: []netlink.Route{
+				{LinkIndex: 1, Gw: net.IP{192, 168, 1, 1}, Priority: 100},
+				{LinkIndex: 2, Gw: net.IP{10, 0, 0, 1}, Priority: 10},
+				{LinkIndex: 3, Gw: net.IP{172, 17, 0, 1}, Priority: 50},
+			},
+			defaultRoute: netlink.Route{LinkIndex: 2, Gw: net.IP{10, 0, 0, 1}, Priority: 10},
+			ok:           true,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			defaultRoute, ok := findDefaultRoute(testCase.routes)
+			assert.Equal(t, testCase.ok, ok)
+			assert.Equal(t, testCase.defaultRoute, defaultRoute)
+		})
+	}
+}
+
+func Test_findLocalNetworks(t *testing.T) {
+	t.Parallel()
+	eth0Subnet := net.IPNet{IP: net.IP{172, 17, 0, 0}, Mask: net.IPv4Mask(255, 255, 0, 0)}
+	eth1Subnet := net.IPNet{IP: net.IP{192, 168, 1, 0}, Mask: net.IPv4Mask(255, 255, 255, 0)}
+	tunSubnet := net.IPNet{IP: net.IP{10, 8, 0, 0}, Mask: net.IPv4Mask(255, 255, 255, 0)}
+	routes := []netlink.Route{
+		{LinkIndex: 2, Gw: net.IP{172, 17, 0, 1}},
+		{LinkIndex: 2, Dst: &eth0Subnet, Scope: netlink.SCOPE_LINK},
+		{LinkIndex: 3, Dst: &eth1Subnet, Scope: netlink.SCOPE_LINK},
+		{LinkIndex: 4, Dst: &tunSubnet, Scope: netlink.SCOPE_LINK},
+		{LinkIndex: 3, Dst: &tunSubnet, Gw: net.IP{192, 168, 1, 1}},
+	}
+	linkNames := map[int]string{2: "eth0", 3: "eth1", 4: "tun0"}
+	testCases := map[string]struct {
+		routes        []netlink.Route
+		interfaces    []string
+		localNetworks []LocalNetwork
+		err           string
+	}{
+		"no default route": {
+			routes: routes[1:],
+			err:    "cannot find default link",
+		},
+		"default interface only": {
+			routes: routes,
+			localNetworks: []LocalNetwork{
+				{InterfaceName: "eth0", Subnet: eth0Subnet},
+			},
+		},
+		"additional interface": {
+			routes:     routes,
+			interfaces: []string{"eth1"},
+			localNetworks: []LocalNetwork{
+				{InterfaceName: "eth0", Subnet: eth0Subnet},
+				{InterfaceName: "eth1", Subnet: eth1Subnet},
+			},
+		},
+		"default interface given": {
+			routes:     routes,
+			interfaces: []string{"eth0", "eth1", "eth1"},
+			localNetworks: []LocalNetwork{
+				{InterfaceName: "eth0", Subnet: eth0Subnet},
+				{InterfaceName: "eth1", Subnet: eth1Subnet},
+			},
+		},
+		"interface without local subnet": {
+			routes:     routes,
+			interfaces: []string{"eth2"},
+			err:        "cannot find local subnet of interface eth2",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			localNetworks, err := findLocalNetworks(testCase.routes, linkNames, testCase.interfaces)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.localNetworks, localNetworks)
+		})
+	}
+}
@@ -1,10 +1,15 @@
 package routing
 
 import (
+	"context"
 	"net"
 	"sync"
+	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/logging"
+	"github.com/vishvananda/netlink"
 )
 
 type Routing interface {
@@ -12,17 +17,38 @@ type Routing interface {
 	Setup() (err error)
 	TearDown() error
 	SetOutboundRoutes(outboundSubnets []net.IPNet) error
+	SetStaticRoutes(routes []models.StaticRoute) error
+	FlushConntrack(sourceIP net.IP) (flushed uint, err error)
+	ProtectVPNRoutes() error
+	SetWireguardRoutes(endpoint net.IP, ipv6 bool) error
+	RemoveWireguardRoutes(endpoint net.IP) error
+	SetTransparentProxyRoutes() error
+	SetTunnelRoutes(intf string, mark int) error
+	RemoveTunnelRoutes(mark int) error
+	SetTunnelServerRoute(server net.IP) error
+	RemoveTunnelServerRoute(server net.IP) error
+	CheckRoutes() (restored int, err error)
+	Protect(ctx context.Context, wg *sync.WaitGroup, period time.Duration)
 
 	// Read only
 	DefaultRoute() (defaultInterface string, defaultGateway net.IP, err error)
 	LocalSubnet() (defaultSubnet net.IPNet, err error)
+	LocalNetworks(interfaces []string) (localNetworks []LocalNetwork, err error)
+	FreeInterfaceName(name string) (free string, err error)
 	DefaultIP() (defaultIP net.IP, err error)
 	VPNDestinationIP() (ip net.IP, err error)
 	VPNLocalGatewayIP() (ip net.IP, err error)
+	VPNLocalIP() (ip net.IP, err error)
 
 	// Internal state
 	SetVerbose(verbose bool)
 	SetDebug()
+	// SetMetric sets the metric of the routes added, and
+	// should be called before Setup.
+	SetMetric(metric int)
+	// SetVPNInterface sets the name of the VPN tunnel interface,
+	// which is tun0 by default.
+	SetVPNInterface(name string)
 }
 
 type routing struct {
@@ -30,14 +56,26 @@ type routing struct {
 	verbose         bool
 	debug           bool
 	outboundSubnets []net.IPNet
-	stateMutex      sync.RWMutex
+	staticRoutes    []models.StaticRoute
+	metric          int
+	vpnInterface    string
+	// vpnRoutes are restored if removed while the tunnel
+	// interface index is vpnLinkIndex.
+	vpnRoutes    []netlink.Route
+	vpnLinkIndex int
+	// defaultLinkIndex and defaultGateway are recorded at Setup,
+	// so the routes through them are restored if replaced.
+	defaultLinkIndex int
+	defaultGateway   net.IP
+	stateMutex       sync.RWMutex
 }
 
 // NewConfigurator creates a new Configurator instance.
 func NewRouting(logger logging.Logger) Routing {
 	return &routing{
-		logger:  logger.WithPrefix("routing: "),
-		verbose: true,
+		logger:       logger.WithPrefix("routing: "),
+		verbose:      true,
+		vpnInterface: string(constants.TUN),
 	}
 }
 
@@ -48,3 +86,11 @@ func (c *routing) SetVerbose(verbose bool) {
 func (c *routing) SetDebug() {
 	c.debug = true
 }
+
+func (c *routing) SetMetric(metric int) {
+	c.metric = metric
+}
+
+func (c *routing) SetVPNInterface(name string) {
+	c.vpnInterface = name
+}
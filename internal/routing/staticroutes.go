@@ -0,0 +1,115 @@
+package routing
+
+import (
+	"fmt"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/vishvananda/netlink"
+)
+
+// SetStaticRoutes adds or replaces the static routes given in the main
+// routing table and removes the static routes previously set which are
+// no longer in the routes given. It is meant to be called each time
+// the tunnel is up, since reconnecting may modify the routing table.
+func (r *routing) SetStaticRoutes(routes []models.StaticRoute) error {
+	defaultInterface, _, err := r.DefaultRoute()
+	if err != nil {
+		return fmt.Errorf("cannot set static routes: %w", err)
+	}
+
+	r.stateMutex.Lock()
+	defer r.stateMutex.Unlock()
+
+	for _, oldRoute := range r.staticRoutes {
+		if staticRoutesContain(routes, oldRoute) {
+			continue
+		}
+		if err := r.deleteStaticRoute(oldRoute, defaultInterface); err != nil {
+			r.logger.Error("cannot remove outdated static route: %s", err)
+		}
+	}
+	r.staticRoutes = nil
+
+	for _, route := range routes {
+		if err := r.replaceStaticRoute(route, defaultInterface); err != nil {
+			return fmt.Errorf("cannot set static routes: %w", err)
+		}
+		r.staticRoutes = append(r.staticRoutes, route)
+	}
+	return nil
+}
+
+func (r *routing) removeStaticRoutes(defaultInterface string) {
+	r.stateMutex.Lock()
+	defer r.stateMutex.Unlock()
+	for _, route := range r.staticRoutes {
+		if err := r.deleteStaticRoute(route, defaultInterface); err != nil {
+			r.logger.Error("cannot remove static route: %s", err)
+		}
+	}
+	r.staticRoutes = nil
+}
+
+func staticRoutesContain(routes []models.StaticRoute, route models.StaticRoute) bool {
+	for _, r := range routes {
+		if r.String() == route.String() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *routing) replaceStaticRoute(route models.StaticRoute, defaultInterface string) error {
+	if r.verbose {
+		r.logger.Info("adding static route %s", route)
+	}
+	if r.debug {
+		fmt.Printf("ip route replace %s\n", route)
+	}
+	netlinkRoute, err := toNetlinkRoute(route, defaultInterface)
+	if err != nil {
+		return fmt.Errorf("cannot add static route %s: %w", route, err)
+	}
+	if err := netlink.RouteReplace(&netlinkRoute); err != nil {
+		return fmt.Errorf("cannot add static route %s: %w", route, err)
+	}
+	return nil
+}
+
+func (r *routing) deleteStaticRoute(route models.StaticRoute, defaultInterface string) error {
+	if r.verbose {
+		r.logger.Info("deleting static route %s", route)
+	}
+	if r.debug {
+		fmt.Printf("ip route delete %s\n", route)
+	}
+	netlinkRoute, err := toNetlinkRoute(route, defaultInterface)
+	if err != nil {
+		return fmt.Errorf("cannot delete static route %s: %w", route, err)
+	}
+	if err := netlink.RouteDel(&netlinkRoute); err != nil {
+		return fmt.Errorf("cannot delete static route %s: %w", route, err)
+	}
+	return nil
+}
+
+func toNetlinkRoute(route models.StaticRoute, defaultInterface string) (netlinkRoute netlink.Route, err error) {
+	iface := route.Interface
+	if iface == "" {
+		iface = defaultInterface
+	}
+	link, err := netlink.LinkByName(iface)
+	if err != nil {
+		return netlinkRoute, err
+	}
+	destination := route.Destination
+	netlinkRoute = netlink.Route{
+		Dst:       &destination,
+		Gw:        route.Gateway,
+		LinkIndex: link.Attrs().Index,
+	}
+	if route.Gateway == nil {
+		netlinkRoute.Scope = netlink.SCOPE_LINK
+	}
+	return netlinkRoute, nil
+}
@@ -0,0 +1,45 @@
+package routing
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	transparentProxyTable    = 201
+	transparentProxyPriority = 98
+)
+
+// SetTransparentProxyRoutes delivers locally the packets marked by the
+// TPROXY firewall rules, so the transparent proxy UDP socket receives them
+// even though their destination address is not a local address.
+func (r *routing) SetTransparentProxyRoutes() error {
+	link, err := netlink.LinkByName("lo")
+	if err != nil {
+		return fmt.Errorf("cannot set transparent proxy routes: %w", err)
+	}
+	destination := net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)} //nolint:gomnd
+	if r.debug {
+		fmt.Printf("ip route replace local %s dev lo table %d\n", destination.String(), transparentProxyTable)
+	}
+	route := netlink.Route{
+		Dst:       &destination,
+		LinkIndex: link.Attrs().Index,
+		Scope:     netlink.SCOPE_HOST,
+		Type:      unix.RTN_LOCAL,
+		Table:     transparentProxyTable,
+	}
+	if err := netlink.RouteReplace(&route); err != nil {
+		return fmt.Errorf("cannot set transparent proxy routes: cannot add route for %s: %w",
+			destination.String(), err)
+	}
+	if err := r.addMarkIPRule(constants.TransparentProxyMark,
+		transparentProxyTable, transparentProxyPriority); err != nil {
+		return fmt.Errorf("cannot set transparent proxy routes: %w", err)
+	}
+	return nil
+}
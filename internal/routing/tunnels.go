@@ -0,0 +1,83 @@
+package routing
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	// each extra tunnel mark has its table, from tunnelTable
+	// for the constants.TunnelMark mark onwards.
+	tunnelTable    = 210
+	tunnelPriority = 97
+)
+
+// SetTunnelRoutes routes the packets marked with the tunnel mark given through
+// the extra tunnel interface given, using a routing table of their own. The
+// route through the interface is removed with the interface, so it should be
+// set again each time the tunnel is up.
+func (r *routing) SetTunnelRoutes(intf string, mark int) error {
+	table := tunnelTable + mark - constants.TunnelMark
+	link, err := netlink.LinkByName(intf)
+	if err != nil {
+		return fmt.Errorf("cannot set routes of tunnel %s: %w", intf, err)
+	}
+	destination := net.IPNet{IP: net.IPv4zero, Mask: net.CIDRMask(0, 32)} //nolint:gomnd
+	if r.debug {
+		fmt.Printf("ip route replace %s dev %s table %d\n", destination.String(), intf, table)
+	}
+	route := netlink.Route{
+		Dst:       &destination,
+		LinkIndex: link.Attrs().Index,
+		Scope:     netlink.SCOPE_LINK,
+		Table:     table,
+	}
+	if err := netlink.RouteReplace(&route); err != nil {
+		return fmt.Errorf("cannot set routes of tunnel %s: cannot add route for %s: %w",
+			intf, destination.String(), err)
+	}
+	if err := r.addMarkIPRule(mark, table, tunnelPriority); err != nil {
+		return fmt.Errorf("cannot set routes of tunnel %s: %w", intf, err)
+	}
+	return nil
+}
+
+// SetTunnelServerRoute routes the traffic to the VPN server of an extra tunnel
+// through the default gateway, so the extra tunnel does not connect through
+// the main tunnel routes.
+func (r *routing) SetTunnelServerRoute(server net.IP) error {
+	defaultInterface, defaultGateway, err := r.DefaultRoute()
+	if err != nil {
+		return fmt.Errorf("cannot set tunnel server route: %w", err)
+	}
+	if err := r.addRouteVia(endpointSubnet(server), defaultGateway, defaultInterface, 0); err != nil {
+		return fmt.Errorf("cannot set tunnel server route: %w", err)
+	}
+	return nil
+}
+
+// RemoveTunnelServerRoute removes the route to the VPN server
+// of an extra tunnel through the default gateway.
+func (r *routing) RemoveTunnelServerRoute(server net.IP) error {
+	defaultInterface, defaultGateway, err := r.DefaultRoute()
+	if err != nil {
+		return fmt.Errorf("cannot remove tunnel server route: %w", err)
+	}
+	if err := r.deleteRouteVia(endpointSubnet(server), defaultGateway, defaultInterface, 0); err != nil {
+		return fmt.Errorf("cannot remove tunnel server route: %w", err)
+	}
+	return nil
+}
+
+// RemoveTunnelRoutes removes the rule routing the packets marked with the
+// tunnel mark given through their routing table.
+func (r *routing) RemoveTunnelRoutes(mark int) error {
+	table := tunnelTable + mark - constants.TunnelMark
+	if err := r.deleteMarkIPRule(mark, table, tunnelPriority); err != nil {
+		return fmt.Errorf("cannot remove routes of tunnel mark %d: %w", mark, err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package routing
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/vishvananda/netlink"
+)
+
+// SetWireguardRoutes routes all the IPv4 traffic, and the IPv6 traffic if
+// ipv6 is true, through the Wireguard interface, except the traffic to the
+// Wireguard endpoint IP address which is routed through the default gateway.
+// The routes through the interface are removed with the interface.
+func (r *routing) SetWireguardRoutes(endpoint net.IP, ipv6 bool) error {
+	defaultInterface, defaultGateway, err := r.DefaultRoute()
+	if err != nil {
+		return fmt.Errorf("cannot set Wireguard routes: %w", err)
+	}
+	if err := r.addRouteVia(endpointSubnet(endpoint), defaultGateway, defaultInterface, 0); err != nil {
+		return fmt.Errorf("cannot set Wireguard routes: %w", err)
+	}
+
+	link, err := netlink.LinkByName(r.vpnInterface)
+	if err != nil {
+		return fmt.Errorf("cannot set Wireguard routes: %w", err)
+	}
+	// Two halves are more specific than the default route, which is kept.
+	halves := []net.IPNet{
+		{IP: net.IPv4(0, 0, 0, 0), Mask: net.CIDRMask(1, 32)},   //nolint:gomnd
+		{IP: net.IPv4(128, 0, 0, 0), Mask: net.CIDRMask(1, 32)}, //nolint:gomnd
+	}
+	if ipv6 {
+		halves = append(halves,
+			net.IPNet{IP: net.IPv6zero, Mask: net.CIDRMask(1, 128)},          //nolint:gomnd
+			net.IPNet{IP: net.ParseIP("8000::"), Mask: net.CIDRMask(1, 128)}, //nolint:gomnd
+		)
+	}
+	for _, destination := range halves {
+		destination := destination
+		if r.debug {
+			fmt.Printf("ip route replace %s dev %s\n", destination.String(), r.vpnInterface)
+		}
+		route := netlink.Route{
+			Dst:       &destination,
+			LinkIndex: link.Attrs().Index,
+			Scope:     netlink.SCOPE_LINK,
+			Priority:  r.metric,
+		}
+		if err := netlink.RouteReplace(&route); err != nil {
+			return fmt.Errorf("cannot set Wireguard routes: cannot add route for %s: %w", destination.String(), err)
+		}
+	}
+	return nil
+}
+
+// RemoveWireguardRoutes removes the route to the Wireguard endpoint
+// IP address through the default gateway.
+func (r *routing) RemoveWireguardRoutes(endpoint net.IP) error {
+	defaultInterface, defaultGateway, err := r.DefaultRoute()
+	if err != nil {
+		return fmt.Errorf("cannot remove Wireguard routes: %w", err)
+	}
+	if err := r.deleteRouteVia(endpointSubnet(endpoint), defaultGateway, defaultInterface, 0); err != nil {
+		return fmt.Errorf("cannot remove Wireguard routes: %w", err)
+	}
+	return nil
+}
+
+func endpointSubnet(endpoint net.IP) net.IPNet {
+	if ipv4 := endpoint.To4(); ipv4 != nil {
+		return net.IPNet{IP: ipv4, Mask: net.CIDRMask(32, 32)} //nolint:gomnd
+	}
+	return net.IPNet{IP: endpoint, Mask: net.CIDRMask(128, 128)} //nolint:gomnd
+}
@@ -0,0 +1,40 @@
+// Command generate generates the settings schema Go source file
+// from the environment variables tables of the README.
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+
+	"github.com/qdm12/gluetun/internal/schema"
+)
+
+func main() {
+	if len(os.Args) != 3 { //nolint:gomnd
+		fmt.Println("usage: generate README.md output.go")
+		os.Exit(1)
+	}
+	if err := generate(os.Args[1], os.Args[2]); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func generate(readmePath, outputPath string) error {
+	readme, err := os.Open(readmePath)
+	if err != nil {
+		return err
+	}
+	defer readme.Close()
+	settings, err := schema.Parse(readme)
+	if err != nil {
+		return err
+	}
+	source, err := format.Source(schema.GoSource(settings))
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(outputPath, source, 0644) //nolint:gosec
+}
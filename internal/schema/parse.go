@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	regexChoices = regexp.MustCompile("^`[^`]+`((, | or )`[^`]+`)*$")
+	regexRange   = regexp.MustCompile("^`(-?[0-9]+)` to `(-?[0-9]+)`$")
+	regexValue   = regexp.MustCompile("`([^`]+)`")
+)
+
+// Parse parses the settings from the environment variables
+// tables of the README given.
+func Parse(readme io.Reader) (settings []Setting, err error) {
+	scanner := bufio.NewScanner(readme)
+	inEnvironment := false
+	var section, provider string
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "## "):
+			inEnvironment = line == "## Environment variables"
+			continue
+		case !inEnvironment:
+			continue
+		case strings.HasPrefix(line, "### "):
+			section = strings.TrimPrefix(line, "### ")
+			provider = ""
+			continue
+		case strings.HasPrefix(line, "- "):
+			provider = strings.TrimPrefix(line, "- ")
+			if i := strings.Index(provider, " ("); i >= 0 {
+				provider = provider[:i]
+			}
+			continue
+		}
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "| `") && !strings.HasPrefix(line, "| 🏁 `") {
+			continue
+		}
+		setting, err := parseRow(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		setting.Section = section
+		setting.Provider = provider
+		settings = append(settings, setting)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// parseRow parses a table row with the variable, default,
+// choices and description columns.
+func parseRow(row string) (setting Setting, err error) {
+	const escapedPipe = "\x00"
+	row = strings.ReplaceAll(row, `\|`, escapedPipe)
+	row = strings.TrimSuffix(strings.TrimPrefix(row, "|"), "|")
+	columns := strings.Split(row, "|")
+	const expectedColumns = 4
+	if len(columns) != expectedColumns {
+		return setting, fmt.Errorf("table row has %d columns instead of %d", len(columns), expectedColumns)
+	}
+	for i := range columns {
+		columns[i] = strings.TrimSpace(strings.ReplaceAll(columns[i], escapedPipe, "|"))
+	}
+	setting.Name = strings.Trim(strings.TrimPrefix(columns[0], "🏁 "), "`")
+	setting.Default = strings.Trim(columns[1], "`")
+	setting.Description = columns[3]
+	setType(&setting, columns[2])
+	return setting, nil
+}
+
+func setType(setting *Setting, choices string) {
+	switch {
+	case choices == "`on`, `off`", choices == "`on` or `off`", choices == "`yes` or `no`":
+		setting.Type = TypeBoolean
+		setting.Choices = backtickedValues(choices)
+	case choices == "Duration", isDuration(setting.Default):
+		setting.Type = TypeDuration
+	case choices == "Integer":
+		setting.Type = TypeInteger
+	case regexRange.MatchString(choices):
+		setting.Type = TypeInteger
+		matches := regexRange.FindStringSubmatch(choices)
+		minimum, _ := strconv.Atoi(matches[1])
+		maximum, _ := strconv.Atoi(matches[2])
+		setting.Minimum, setting.Maximum = &minimum, &maximum
+	case regexChoices.MatchString(choices):
+		setting.Type = TypeEnum
+		setting.Choices = backtickedValues(choices)
+	default:
+		setting.Type = TypeString
+		setting.Hint = choices
+	}
+}
+
+func backtickedValues(s string) (values []string) {
+	for _, match := range regexValue.FindAllStringSubmatch(s, -1) {
+		values = append(values, match[1])
+	}
+	return values
+}
+
+// isDuration returns true if the default value is a duration with
+// a unit, since integers such as 0 can also be parsed as durations.
+func isDuration(defaultValue string) bool {
+	if _, err := strconv.Atoi(defaultValue); err == nil {
+		return false
+	}
+	_, err := time.ParseDuration(defaultValue)
+	return err == nil
+}
@@ -0,0 +1,42 @@
+// Package schema describes the settings which can be set with
+// environment variables, for user interfaces to render them.
+package schema
+
+//go:generate go run ./generate ../../README.md settings_generated.go
+
+// Setting describes a setting set with an environment variable.
+type Setting struct {
+	Name string `json:"name"`
+	// Section is the README section the setting is documented in,
+	// such as DNS over TLS.
+	Section string `json:"section"`
+	// Provider is the VPN service provider the setting applies to,
+	// and is empty if it applies to all providers.
+	Provider string `json:"provider,omitempty"`
+	// Type is one of boolean, integer, duration, enum or string.
+	Type    string `json:"type"`
+	Default string `json:"default"`
+	// Choices are the values allowed for the boolean and enum types.
+	Choices []string `json:"choices,omitempty"`
+	// Minimum and Maximum are set for integers allowed in a range.
+	Minimum *int `json:"minimum,omitempty"`
+	Maximum *int `json:"maximum,omitempty"`
+	// Hint describes the value expected for the string type,
+	// such as an example value.
+	Hint        string `json:"hint,omitempty"`
+	Description string `json:"description"`
+}
+
+// Types of settings values.
+const (
+	TypeBoolean  = "boolean"
+	TypeInteger  = "integer"
+	TypeDuration = "duration"
+	TypeEnum     = "enum"
+	TypeString   = "string"
+)
+
+// Settings returns the description of all the settings.
+func Settings() []Setting {
+	return settings
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Parse(t *testing.T) {
+	t.Parallel()
+	const readme = "# Title\n\n| `NOT_A_SETTING` | | | |\n\n" +
+		"## Environment variables\n\n### VPN\n\n" +
+		"| Variable | Default | Choices | Description |\n| --- | --- | --- | --- |\n" +
+		"| 🏁 `VPNSP` | `mullvad` | `mullvad`, `nordvpn` or `surfshark` | VPN Service Provider |\n" +
+		"| `OPENVPN_VERBOSITY` | `1` | `0` to `6` | Verbosity |\n\n" +
+		"- Mullvad (see [this](https://mullvad.net))\n\n" +
+		"    | Variable | Default | Choices | Description |\n    | --- | --- | --- | --- |\n" +
+		"    | `OWNED` | `no` | `yes` or `no` | Owned servers only |\n\n" +
+		"### Other\n\n" +
+		"| `PUBLICIP_PERIOD` | `12h` | Duration | Period |\n" +
+		"| `LOG_FILTER` | | i.e. `a\\|b` | Filter |\n\n" +
+		"## Connect to it\n\n| `AFTER` | | | |\n"
+	minimum, maximum := 0, 6
+
+	settings, err := Parse(strings.NewReader(readme))
+
+	require.NoError(t, err)
+	expected := []Setting{
+		{Name: "VPNSP", Section: "VPN", Type: TypeEnum, Default: "mullvad",
+			Choices: []string{"mullvad", "nordvpn", "surfshark"}, Description: "VPN Service Provider"},
+		{Name: "OPENVPN_VERBOSITY", Section: "VPN", Type: TypeInteger, Default: "1",
+			Minimum: &minimum, Maximum: &maximum, Description: "Verbosity"},
+		{Name: "OWNED", Section: "VPN", Provider: "Mullvad", Type: TypeBoolean, Default: "no",
+			Choices: []string{"yes", "no"}, Description: "Owned servers only"},
+		{Name: "PUBLICIP_PERIOD", Section: "Other", Type: TypeDuration, Default: "12h", Description: "Period"},
+		{Name: "LOG_FILTER", Section: "Other", Type: TypeString, Hint: "i.e. `a|b`", Description: "Filter"},
+	}
+	assert.Equal(t, expected, settings)
+}
+
+func Test_Parse_error(t *testing.T) {
+	t.Parallel()
+	const readme = "## Environment variables\n\n| `VPNSP` | `mullvad` | |\n"
+
+	_, err := Parse(strings.NewReader(readme))
+
+	require.Error(t, err)
+	assert.Equal(t, "line 3: table row has 3 columns instead of 4", err.Error())
+}
+
+// Test_Settings_upToDate checks the generated settings match the README,
+// which otherwise requires running go generate ./internal/schema.
+func Test_Settings_upToDate(t *testing.T) {
+	t.Parallel()
+	readme, err := os.Open("../../README.md")
+	require.NoError(t, err)
+	defer readme.Close()
+
+	settings, err := Parse(readme)
+
+	require.NoError(t, err)
+	assert.Equal(t, settings, Settings(), "run go generate ./internal/schema")
+}
+
+// Test_Settings_choices checks the choices documented in the README match
+// the choices accepted when reading the settings.
+func Test_Settings_choices(t *testing.T) {
+	t.Parallel()
+	expected := map[string][]string{
+		"VPNSP":    append([]string{"pia"}, constants.VPNProviderChoices()...),
+		"VPN_TYPE": constants.VPNTypeChoices(),
+	}
+	for _, setting := range Settings() {
+		choices, ok := expected[setting.Name]
+		if !ok {
+			continue
+		}
+		assert.ElementsMatch(t, choices, setting.Choices, setting.Name)
+		delete(expected, setting.Name)
+	}
+	assert.Empty(t, expected, "settings not found")
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// GoSource returns the Go source code, not formatted, of the
+// settings variable returned by Settings.
+func GoSource(settings []Setting) []byte {
+	buffer := bytes.NewBuffer(nil)
+	buffer.WriteString("// Code generated by go generate; DO NOT EDIT.\n\n")
+	buffer.WriteString("package schema\n\n")
+	buffer.WriteString("func intPtr(n int) *int { return &n }\n\n")
+	buffer.WriteString("var settings = []Setting{\n")
+	for _, setting := range settings {
+		fmt.Fprintf(buffer, "{\nName: %q,\nSection: %q,\n", setting.Name, setting.Section)
+		if setting.Provider != "" {
+			fmt.Fprintf(buffer, "Provider: %q,\n", setting.Provider)
+		}
+		fmt.Fprintf(buffer, "Type: %s,\nDefault: %q,\n", typeConstant(setting.Type), setting.Default)
+		if len(setting.Choices) > 0 {
+			fmt.Fprintf(buffer, "Choices: %#v,\n", setting.Choices)
+		}
+		if setting.Minimum != nil {
+			fmt.Fprintf(buffer, "Minimum: intPtr(%d),\nMaximum: intPtr(%d),\n", *setting.Minimum, *setting.Maximum)
+		}
+		if setting.Hint != "" {
+			fmt.Fprintf(buffer, "Hint: %q,\n", setting.Hint)
+		}
+		fmt.Fprintf(buffer, "Description: %q,\n},\n", setting.Description)
+	}
+	buffer.WriteString("}\n")
+	return buffer.Bytes()
+}
+
+func typeConstant(settingType string) string {
+	switch settingType {
+	case TypeBoolean:
+		return "TypeBoolean"
+	case TypeInteger:
+		return "TypeInteger"
+	case TypeDuration:
+		return "TypeDuration"
+	case TypeEnum:
+		return "TypeEnum"
+	default:
+		return "TypeString"
+	}
+}
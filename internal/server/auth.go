@@ -0,0 +1,162 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/qdm12/golibs/files"
+)
+
+// Role is a group of control server routes an API key can be granted.
+type Role string
+
+const (
+	// RoleRead grants access to the routes returning information only.
+	RoleRead Role = "read"
+	// RoleControl grants access to the routes starting, stopping
+	// or restarting components.
+	RoleControl Role = "control"
+	// RoleSettings grants access to the routes changing settings.
+	RoleSettings Role = "settings"
+	// rolePublic is for routes accessible without API key.
+	rolePublic Role = ""
+)
+
+// APIKey is a named API key restricted to the roles given.
+type APIKey struct {
+	Name  string `json:"name"`
+	Key   string `json:"key"`
+	Roles []Role `json:"roles"`
+}
+
+func (k APIKey) hasRole(role Role) bool {
+	for _, r := range k.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadAPIKeys reads the API keys from the JSON file given, which contains
+// a list of keys each with a name, a key and its roles.
+func ReadAPIKeys(fileManager files.FileManager, filepath string) (keys []APIKey, err error) {
+	data, err := fileManager.ReadFile(filepath)
+	if err != nil {
+		return nil, err
+	}
+	var authFile struct {
+		Keys []APIKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &authFile); err != nil {
+		return nil, fmt.Errorf("cannot decode API keys file %s: %w", filepath, err)
+	}
+	if len(authFile.Keys) == 0 {
+		return nil, fmt.Errorf("no API key defined in %s", filepath)
+	}
+	names := make(map[string]struct{}, len(authFile.Keys))
+	for _, key := range authFile.Keys {
+		switch {
+		case key.Name == "":
+			return nil, fmt.Errorf("API key name cannot be empty in %s", filepath)
+		case key.Key == "":
+			return nil, fmt.Errorf("API key %q: key cannot be empty", key.Name)
+		}
+		if _, ok := names[key.Name]; ok {
+			return nil, fmt.Errorf("API key %q is defined more than once", key.Name)
+		}
+		names[key.Name] = struct{}{}
+		for _, role := range key.Roles {
+			switch role {
+			case RoleRead, RoleControl, RoleSettings:
+			default:
+				return nil, fmt.Errorf("API key %q: role %q is not valid", key.Name, role)
+			}
+		}
+	}
+	return authFile.Keys, nil
+}
+
+// routeRole returns the role required for the method and URL path given,
+// where the URL path has its /v1 prefix already removed.
+func routeRole(method, uri string) Role {
+	switch uri {
+	case "/healthz", "/readyz", "/proxy.pac":
+		return rolePublic
+	}
+	if method != http.MethodGet {
+		if uri == "/servers/rollback" { // overwrites the servers data file
+			return RoleSettings
+		}
+		return RoleControl
+	}
+	switch {
+	case uri == "/openvpn/actions/restart", uri == "/wireguard/actions/restart", uri == "/unbound/actions/restart",
+		uri == "/updater/restart", uri == "/publicip/actions/refresh":
+		return RoleControl
+	case strings.HasPrefix(uri, "/shadowsocks/") && strings.Contains(uri, "/actions/"):
+		return RoleControl
+	case strings.HasPrefix(uri, "/openvpn/profiles/"):
+		return RoleSettings
+	default:
+		return RoleRead
+	}
+}
+
+// requestAPIKey returns the API key from the X-API-Key header or
+// from the bearer token of the Authorization header.
+func requestAPIKey(request *http.Request) (key string) {
+	if key = request.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+	const bearerPrefix = "Bearer "
+	authorization := request.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, bearerPrefix) {
+		return strings.TrimPrefix(authorization, bearerPrefix)
+	}
+	return ""
+}
+
+// findAPIKey returns the API key matching the key given, comparing
+// keys in constant time.
+func findAPIKey(apiKeys []APIKey, key string) (apiKey APIKey, ok bool) {
+	for _, k := range apiKeys {
+		if subtle.ConstantTimeCompare([]byte(k.Key), []byte(key)) == 1 {
+			return k, true
+		}
+	}
+	return apiKey, false
+}
+
+// authorized writes an error response and returns false if the request
+// is not allowed by the API keys configured. All requests are allowed
+// if no API key is configured.
+func (h *handler) authorized(w http.ResponseWriter, request *http.Request) bool {
+	if len(h.apiKeys) == 0 {
+		return true
+	}
+	role := routeRole(request.Method, request.URL.Path)
+	if role == rolePublic {
+		return true
+	}
+	key := requestAPIKey(request)
+	if key == "" {
+		http.Error(w, "API key required", http.StatusUnauthorized)
+		return false
+	}
+	apiKey, ok := findAPIKey(h.apiKeys, key)
+	if !ok {
+		h.logger.Warn("invalid API key for %s %s", request.Method, request.RequestURI)
+		http.Error(w, "invalid API key", http.StatusUnauthorized)
+		return false
+	}
+	if !apiKey.hasRole(role) {
+		h.logger.Warn("API key %q is not allowed to %s %s", apiKey.Name, request.Method, request.RequestURI)
+		http.Error(w, fmt.Sprintf("API key %q does not have the %s role", apiKey.Name, role), http.StatusForbidden)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_routeRole(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		method string
+		uri    string
+		role   Role
+	}{
+		"health":                {http.MethodGet, "/healthz", rolePublic},
+		"pac":                   {http.MethodGet, "/proxy.pac", rolePublic},
+		"openvpn status":        {http.MethodGet, "/openvpn/status", RoleRead},
+		"shadowsocks status":    {http.MethodGet, "/shadowsocks/main/status", RoleRead},
+		"metrics":               {http.MethodGet, "/metrics", RoleRead},
+		"openvpn restart":       {http.MethodGet, "/openvpn/actions/restart", RoleControl},
+		"wireguard restart":     {http.MethodGet, "/wireguard/actions/restart", RoleControl},
+		"shadowsocks stop":      {http.MethodGet, "/shadowsocks/main/actions/stop", RoleControl},
+		"public ip refresh":     {http.MethodGet, "/publicip/actions/refresh", RoleControl},
+		"set status":            {http.MethodPut, "/openvpn/status", RoleControl},
+		"profiles list":         {http.MethodGet, "/openvpn/profiles", RoleRead},
+		"profile activation":    {http.MethodGet, "/openvpn/profiles/eu/activate", RoleSettings},
+		"servers backups":       {http.MethodGet, "/servers/backups", RoleRead},
+		"servers rollback":      {http.MethodPut, "/servers/rollback", RoleSettings},
+		"unknown route":         {http.MethodGet, "/unknown", RoleRead},
+		"unknown method":        {http.MethodPost, "/openvpn/status", RoleControl},
+		"unknown method health": {http.MethodPost, "/healthz", rolePublic},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			role := routeRole(testCase.method, testCase.uri)
+			assert.Equal(t, testCase.role, role)
+		})
+	}
+}
+
+func Test_handler_authorized(t *testing.T) {
+	t.Parallel()
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	h := &handler{
+		logger: logger,
+		apiKeys: []APIKey{
+			{Name: "dashboard", Key: "readkey", Roles: []Role{RoleRead}},
+			{Name: "admin", Key: "adminkey", Roles: []Role{RoleRead, RoleControl, RoleSettings}},
+		},
+	}
+	testCases := map[string]struct {
+		uri     string
+		headers map[string]string
+		status  int
+	}{
+		"public route without key": {
+			uri:    "/healthz",
+			status: http.StatusOK,
+		},
+		"missing key": {
+			uri:    "/openvpn/status",
+			status: http.StatusUnauthorized,
+		},
+		"unknown key": {
+			uri:     "/openvpn/status",
+			headers: map[string]string{"X-API-Key": "wrong"},
+			status:  http.StatusUnauthorized,
+		},
+		"read key on read route": {
+			uri:     "/openvpn/status",
+			headers: map[string]string{"X-API-Key": "readkey"},
+			status:  http.StatusOK,
+		},
+		"read key on control route": {
+			uri:     "/openvpn/actions/restart",
+			headers: map[string]string{"X-API-Key": "readkey"},
+			status:  http.StatusForbidden,
+		},
+		"bearer admin key on control route": {
+			uri:     "/openvpn/actions/restart",
+			headers: map[string]string{"Authorization": "Bearer adminkey"},
+			status:  http.StatusOK,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := httptest.NewRequest(http.MethodGet, testCase.uri, nil)
+			for key, value := range testCase.headers {
+				request.Header.Set(key, value)
+			}
+			recorder := httptest.NewRecorder()
+			ok := h.authorized(recorder, request)
+			assert.Equal(t, testCase.status == http.StatusOK, ok)
+			assert.Equal(t, testCase.status, recorder.Code)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/qdm12/gluetun/internal/storage"
+)
+
+type rollbackRequest struct {
+	Backup int `json:"backup"`
+}
+
+func (h *handler) getServersBackups(w http.ResponseWriter) {
+	backups, err := h.updaterLooper.GetBackups()
+	if err != nil {
+		h.logger.Warn(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	data, err := json.Marshal(backups)
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// rollbackServers restores the servers data from the backup number
+// given in the body of the request.
+func (h *handler) rollbackServers(w http.ResponseWriter, request *http.Request) {
+	var rollback rollbackRequest
+	if err := json.NewDecoder(request.Body).Decode(&rollback); err != nil {
+		http.Error(w, fmt.Sprintf("cannot decode rollback: %s", err), http.StatusBadRequest)
+		return
+	}
+	if err := h.updaterLooper.Rollback(rollback.Backup); errors.Is(err, storage.ErrBackupNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		h.logger.Warn(err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
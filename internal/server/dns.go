@@ -0,0 +1,21 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *handler) getDNSStats(w http.ResponseWriter) {
+	stats := h.unboundLooper.GetBlockStats()
+	data, err := json.Marshal(stats)
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -3,46 +3,112 @@ package server
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/dns"
+	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/httpproxy"
+	"github.com/qdm12/gluetun/internal/loopstate"
+	"github.com/qdm12/gluetun/internal/metrics"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/publicip"
+	"github.com/qdm12/gluetun/internal/shadowsocks"
 	"github.com/qdm12/gluetun/internal/updater"
+	"github.com/qdm12/gluetun/internal/wireguard"
 	"github.com/qdm12/golibs/logging"
 )
 
 func newHandler(logger logging.Logger, logging bool,
 	buildInfo models.BuildInformation,
+	vpnType string,
 	openvpnLooper openvpn.Looper,
+	wireguardLooper wireguard.Looper,
 	unboundLooper dns.Looper,
 	updaterLooper updater.Looper,
-) http.Handler {
+	httpProxyLooper httpproxy.Looper,
+	shadowsocksLoopers map[string]shadowsocks.Looper,
+	publicIPLooper publicip.Looper,
+	loopStates loopstate.Registry,
+	firewallConf firewall.Configurator,
+	metricsCollector metrics.Collector,
+	healthHandler http.Handler,
+	apiKeys []APIKey,
+	saveComponentStatus func(component, status string) error,
+) *handler {
 	return &handler{
-		logger:        logger,
-		logging:       logging,
-		buildInfo:     buildInfo,
-		openvpnLooper: openvpnLooper,
-		unboundLooper: unboundLooper,
-		updaterLooper: updaterLooper,
+		logger:              logger,
+		logging:             logging,
+		buildInfo:           buildInfo,
+		vpnType:             vpnType,
+		openvpnLooper:       openvpnLooper,
+		wireguardLooper:     wireguardLooper,
+		unboundLooper:       unboundLooper,
+		updaterLooper:       updaterLooper,
+		httpProxyLooper:     httpProxyLooper,
+		shadowsocksLoopers:  shadowsocksLoopers,
+		publicIPLooper:      publicIPLooper,
+		loopStates:          loopStates,
+		firewallConf:        firewallConf,
+		metricsCollector:    metricsCollector,
+		healthHandler:       healthHandler,
+		apiKeys:             apiKeys,
+		saveComponentStatus: saveComponentStatus,
 	}
 }
 
 type handler struct {
-	logger        logging.Logger
-	logging       bool
-	buildInfo     models.BuildInformation
-	openvpnLooper openvpn.Looper
-	unboundLooper dns.Looper
-	updaterLooper updater.Looper
+	logger             logging.Logger
+	logging            bool
+	buildInfo          models.BuildInformation
+	vpnType            string
+	openvpnLooper      openvpn.Looper
+	wireguardLooper    wireguard.Looper
+	unboundLooper      dns.Looper
+	updaterLooper      updater.Looper
+	httpProxyLooper    httpproxy.Looper
+	shadowsocksLoopers map[string]shadowsocks.Looper
+	publicIPLooper     publicip.Looper
+	loopStates         loopstate.Registry
+	firewallConf       firewall.Configurator
+	metricsCollector   metrics.Collector
+	healthHandler      http.Handler
+	apiKeys            []APIKey
+	// saveComponentStatus persists the status of a component,
+	// and is nil if the statuses are not persisted.
+	saveComponentStatus func(component, status string) error
 }
 
 func (h *handler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
 	if h.logging {
 		h.logger.Info("HTTP %s %s", request.Method, request.RequestURI)
 	}
+	// all routes are also served with the /v1 prefix
+	if strings.HasPrefix(request.URL.Path, "/v1/") {
+		request = stripV1Prefix(request)
+	}
+	// routes are matched on the URL path, so the query string and
+	// escaped characters do not change the route served
+	path := request.URL.Path
+	if !h.authorized(responseWriter, request) {
+		return
+	}
+	// the looper of the VPN type not used is never started
+	unusedVPNPrefix := "/wireguard/"
+	if h.vpnType == constants.Wireguard {
+		unusedVPNPrefix = "/openvpn/"
+	}
+	if strings.HasPrefix(path, unusedVPNPrefix) {
+		errString := fmt.Sprintf("VPN type is %s, nothing here for %s %s",
+			h.vpnType, request.Method, request.RequestURI)
+		http.Error(responseWriter, errString, http.StatusNotFound)
+		return
+	}
 	switch request.Method {
 	case http.MethodGet:
-		switch request.RequestURI {
+		switch path {
 		case "/version":
 			h.getVersion(responseWriter)
 			responseWriter.WriteHeader(http.StatusOK)
@@ -56,15 +122,94 @@ func (h *handler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Re
 			h.getPortForwarded(responseWriter)
 		case "/openvpn/settings":
 			h.getOpenvpnSettings(responseWriter)
+		case "/openvpn/status":
+			h.getOpenvpnStatus(responseWriter)
+		case "/openvpn/profiles":
+			h.getProfiles(responseWriter)
+		case "/wireguard/actions/restart":
+			h.wireguardLooper.Restart()
+			responseWriter.WriteHeader(http.StatusOK)
+		case "/wireguard/settings":
+			h.getWireguardSettings(responseWriter)
+		case "/wireguard/status":
+			h.getWireguardStatus(responseWriter)
+		case "/status":
+			h.getLoopStatuses(responseWriter)
+		case "/dns/status", "/httpproxy/status", "/portforwarding/status", "/shadowsocks/status":
+			h.getComponentStatus(responseWriter, request)
 		case "/updater/restart":
 			h.updaterLooper.Restart()
 			responseWriter.WriteHeader(http.StatusOK)
+		case "/httpproxy/stats":
+			h.getHTTPProxyStats(responseWriter)
+		case "/shadowsocks/stats":
+			h.getShadowsocksStats(responseWriter)
+		case "/dns/stats":
+			h.getDNSStats(responseWriter)
+		case "/publicip/ip":
+			h.getPublicIP(responseWriter)
+		case "/publicip/actions/refresh":
+			h.refreshPublicIP(responseWriter, request)
+		case "/firewall/rules":
+			h.getFirewallRules(responseWriter, request)
+		case "/metrics":
+			h.getMetrics(responseWriter)
+		case "/healthz", "/readyz":
+			h.healthHandler.ServeHTTP(responseWriter, request)
+		case "/proxy.pac":
+			h.getPAC(responseWriter, request)
+		case "/servers/backups":
+			h.getServersBackups(responseWriter)
+		case "/settings/schema":
+			h.getSettingsSchema(responseWriter)
 		default:
+			if strings.HasPrefix(path, "/shadowsocks/") &&
+				strings.HasSuffix(path, "/status") {
+				h.getComponentStatus(responseWriter, request)
+				return
+			}
+			if strings.HasPrefix(path, "/shadowsocks/") {
+				h.shadowsocksAction(responseWriter, request)
+				return
+			}
+			if strings.HasPrefix(path, "/openvpn/profiles/") {
+				h.activateProfile(responseWriter, request)
+				return
+			}
+			if strings.HasPrefix(path, "/servers/") {
+				h.getServerLocations(responseWriter, request)
+				return
+			}
 			errString := fmt.Sprintf("Nothing here for %s %s", request.Method, request.RequestURI)
 			http.Error(responseWriter, errString, http.StatusBadRequest)
 		}
+	case http.MethodPut:
+		if path == "/servers/rollback" {
+			h.rollbackServers(responseWriter, request)
+			return
+		}
+		if strings.HasSuffix(path, "/status") {
+			h.setComponentStatus(responseWriter, request)
+			return
+		}
+		errString := fmt.Sprintf("Nothing here for %s %s", request.Method, request.RequestURI)
+		http.Error(responseWriter, errString, http.StatusBadRequest)
 	default:
 		errString := fmt.Sprintf("Nothing here for %s %s", request.Method, request.RequestURI)
 		http.Error(responseWriter, errString, http.StatusBadRequest)
 	}
 }
+
+// stripV1Prefix returns a shallow copy of the request without the /v1 prefix
+// in its URI and URL path, as http.StripPrefix does, so the request given is
+// not modified.
+func stripV1Prefix(request *http.Request) *http.Request {
+	stripped := new(http.Request)
+	*stripped = *request
+	stripped.URL = new(url.URL)
+	*stripped.URL = *request.URL
+	stripped.URL.Path = strings.TrimPrefix(request.URL.Path, "/v1")
+	stripped.URL.RawPath = strings.TrimPrefix(request.URL.RawPath, "/v1")
+	stripped.RequestURI = strings.TrimPrefix(request.RequestURI, "/v1")
+	return stripped
+}
@@ -0,0 +1,88 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_handler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	h := &handler{
+		logger:    logger,
+		buildInfo: models.BuildInformation{Version: "v3"},
+		apiKeys:   []APIKey{{Name: "dashboard", Key: "readkey", Roles: []Role{RoleRead}}},
+	}
+	testCases := map[string]struct {
+		target      string
+		status      int
+		contentType string
+		body        string
+	}{
+		"route": {
+			target:      "/v1/version",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"version":"v3","commit":"","buildDate":""}`,
+		},
+		"route with query": {
+			target:      "/version?format=json",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"version":"v3","commit":"","buildDate":""}`,
+		},
+		"escaped route": {
+			target:      "/v1/%76ersion",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"version":"v3","commit":"","buildDate":""}`,
+		},
+		"control route with query": {
+			target:      "/v1/openvpn/actions/restart?force=true",
+			status:      http.StatusForbidden,
+			contentType: "text/plain; charset=utf-8",
+			body:        "API key \"dashboard\" does not have the control role\n",
+		},
+		"unknown route": {
+			target:      "/v1/unknown?version",
+			status:      http.StatusBadRequest,
+			contentType: "text/plain; charset=utf-8",
+			body:        "Nothing here for GET /unknown?version\n",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			request := httptest.NewRequest(http.MethodGet, testCase.target, nil)
+			request.Header.Set("X-API-Key", "readkey")
+			recorder := httptest.NewRecorder()
+
+			h.ServeHTTP(recorder, request)
+
+			assert.Equal(t, testCase.status, recorder.Code)
+			assert.Equal(t, testCase.contentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, testCase.body, recorder.Body.String())
+		})
+	}
+}
+
+func Test_stripV1Prefix(t *testing.T) {
+	t.Parallel()
+	request := httptest.NewRequest(http.MethodGet, "/v1/openvpn/status?format=json", nil)
+
+	stripped := stripV1Prefix(request)
+
+	assert.Equal(t, "/openvpn/status?format=json", stripped.RequestURI)
+	assert.Equal(t, "/openvpn/status", stripped.URL.Path)
+	assert.Equal(t, "format=json", stripped.URL.RawQuery)
+	assert.Equal(t, "/v1/openvpn/status?format=json", request.RequestURI)
+	assert.Equal(t, "/v1/openvpn/status", request.URL.Path)
+}
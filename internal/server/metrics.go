@@ -0,0 +1,20 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+)
+
+func (h *handler) getMetrics(w http.ResponseWriter) {
+	buffer := bytes.NewBuffer(nil)
+	if err := h.metricsCollector.WritePrometheus(buffer); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	if _, err := w.Write(buffer.Bytes()); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/settings"
+)
+
+func (h *handler) getPAC(w http.ResponseWriter, request *http.Request) {
+	// The proxy is reachable on the same host as the control server for the client
+	host, _, err := net.SplitHostPort(request.Host)
+	if err != nil {
+		host = request.Host
+	}
+	w.Header().Set("Content-Type", "application/x-ns-proxy-autoconfig")
+	pac := buildPAC(h.httpProxyLooper.GetSettings(), host)
+	if _, err := w.Write([]byte(pac)); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// buildPAC generates a proxy auto-config file pointing to the
+// HTTP proxy at the given host, and respecting the bypass rules.
+func buildPAC(proxySettings settings.HTTPProxy, host string) (pac string) {
+	lines := []string{"function FindProxyForURL(url, host) {"}
+	if !proxySettings.Enabled {
+		lines = append(lines,
+			`  return "DIRECT";`,
+			"}",
+		)
+		return strings.Join(lines, "\n") + "\n"
+	}
+
+	for _, rule := range proxySettings.PACBypass {
+		_, subnet, err := net.ParseCIDR(rule)
+		if err == nil && subnet.IP.To4() != nil {
+			lines = append(lines, fmt.Sprintf(`  if (isInNet(dnsResolve(host), "%s", "%s")) return "DIRECT";`,
+				subnet.IP, net.IP(subnet.Mask)))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf(`  if (shExpMatch(host, "%s")) return "DIRECT";`, rule))
+	}
+
+	proxyType := "PROXY"
+	if proxySettings.TLSCertificate != "" {
+		proxyType = "HTTPS"
+	}
+	lines = append(lines,
+		fmt.Sprintf(`  return "%s %s";`, proxyType, net.JoinHostPort(host, fmt.Sprint(proxySettings.Port))),
+		"}",
+	)
+	return strings.Join(lines, "\n") + "\n"
+}
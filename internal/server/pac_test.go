@@ -0,0 +1,56 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildPAC(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		settings settings.HTTPProxy
+		host     string
+		pac      string
+	}{
+		"proxy disabled": {
+			pac: `function FindProxyForURL(url, host) {
+  return "DIRECT";
+}
+`,
+		},
+		"proxy enabled": {
+			settings: settings.HTTPProxy{Enabled: true, Port: 8888},
+			host:     "192.168.1.10",
+			pac: `function FindProxyForURL(url, host) {
+  return "PROXY 192.168.1.10:8888";
+}
+`,
+		},
+		"bypass rules and TLS": {
+			settings: settings.HTTPProxy{
+				Enabled:        true,
+				Port:           8888,
+				TLSCertificate: "/cert.pem",
+				PACBypass:      []string{"*.lan", "192.168.1.0/24", "localhost"},
+			},
+			host: "gluetun.lan",
+			pac: `function FindProxyForURL(url, host) {
+  if (shExpMatch(host, "*.lan")) return "DIRECT";
+  if (isInNet(dnsResolve(host), "192.168.1.0", "255.255.255.0")) return "DIRECT";
+  if (shExpMatch(host, "localhost")) return "DIRECT";
+  return "HTTPS gluetun.lan:8888";
+}
+`,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			pac := buildPAC(testCase.settings, testCase.host)
+			assert.Equal(t, testCase.pac, pac)
+		})
+	}
+}
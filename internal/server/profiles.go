@@ -0,0 +1,56 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (h *handler) getProfiles(w http.ResponseWriter) {
+	names, active := h.openvpnLooper.GetProfiles()
+	data, err := json.Marshal(struct {
+		Profiles []string `json:"profiles"`
+		Active   string   `json:"active"`
+	}{names, active})
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// activateProfile activates the profile for a URL path
+// of the form /openvpn/profiles/{name}/activate.
+func (h *handler) activateProfile(w http.ResponseWriter, request *http.Request) {
+	const expectedParts = 2
+	parts := strings.Split(strings.TrimPrefix(request.URL.Path, "/openvpn/profiles/"), "/")
+	if len(parts) != expectedParts || parts[1] != "activate" {
+		errString := fmt.Sprintf("Nothing here for %s %s", request.Method, request.RequestURI)
+		http.Error(w, errString, http.StatusBadRequest)
+		return
+	}
+	name := parts[0]
+	names, _ := h.openvpnLooper.GetProfiles()
+	found := false
+	for _, existing := range names {
+		if existing == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		http.Error(w, fmt.Sprintf("profile %q not found", name), http.StatusNotFound)
+		return
+	}
+	if err := h.openvpnLooper.ActivateProfile(name); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
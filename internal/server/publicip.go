@@ -0,0 +1,40 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (h *handler) getPublicIP(w http.ResponseWriter) {
+	data, err := json.Marshal(h.publicIPLooper.GetStatus())
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (h *handler) refreshPublicIP(w http.ResponseWriter, r *http.Request) {
+	status, err := h.publicIPLooper.Refresh(r.Context())
+	if err != nil {
+		h.logger.Warn(err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	data, err := json.Marshal(status)
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -7,9 +7,16 @@ import (
 	"time"
 
 	"github.com/qdm12/gluetun/internal/dns"
+	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/httpproxy"
+	"github.com/qdm12/gluetun/internal/loopstate"
+	"github.com/qdm12/gluetun/internal/metrics"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/publicip"
+	"github.com/qdm12/gluetun/internal/shadowsocks"
 	"github.com/qdm12/gluetun/internal/updater"
+	"github.com/qdm12/gluetun/internal/wireguard"
 	"github.com/qdm12/golibs/logging"
 )
 
@@ -18,39 +25,71 @@ type Server interface {
 }
 
 type server struct {
-	address string
-	logger  logging.Logger
-	handler http.Handler
+	listeners []models.ControlServerListener
+	logger    logging.Logger
+	handler   *handler
 }
 
-func New(address string, logging bool, logger logging.Logger, buildInfo models.BuildInformation,
-	openvpnLooper openvpn.Looper, unboundLooper dns.Looper, updaterLooper updater.Looper) Server {
+// New creates a control server serving on each of the listeners given,
+// without checking API keys for the listeners with Auth set to false.
+// The saveComponentStatus function, if not nil, is called to persist each
+// component status changed.
+func New(listeners []models.ControlServerListener, logging bool, logger logging.Logger,
+	buildInfo models.BuildInformation,
+	vpnType string, openvpnLooper openvpn.Looper, wireguardLooper wireguard.Looper,
+	unboundLooper dns.Looper, updaterLooper updater.Looper,
+	httpProxyLooper httpproxy.Looper, shadowsocksLoopers map[string]shadowsocks.Looper,
+	publicIPLooper publicip.Looper, loopStates loopstate.Registry, firewallConf firewall.Configurator,
+	metricsCollector metrics.Collector, healthHandler http.Handler, apiKeys []APIKey,
+	saveComponentStatus func(component, status string) error) Server {
 	serverLogger := logger.WithPrefix("http server: ")
-	handler := newHandler(serverLogger, logging, buildInfo, openvpnLooper, unboundLooper, updaterLooper)
+	handler := newHandler(serverLogger, logging, buildInfo, vpnType, openvpnLooper, wireguardLooper,
+		unboundLooper, updaterLooper,
+		httpProxyLooper, shadowsocksLoopers, publicIPLooper, loopStates, firewallConf, metricsCollector,
+		healthHandler, apiKeys, saveComponentStatus)
 	return &server{
-		address: address,
-		logger:  serverLogger,
-		handler: handler,
+		listeners: listeners,
+		logger:    serverLogger,
+		handler:   handler,
 	}
 }
 
 func (s *server) Run(ctx context.Context, wg *sync.WaitGroup) {
-	server := http.Server{Addr: s.address, Handler: s.handler}
+	defer wg.Done()
+	listenersWg := &sync.WaitGroup{}
+	for _, listener := range s.listeners {
+		var handler http.Handler = s.handler
+		if !listener.Auth {
+			noAuthHandler := *s.handler
+			noAuthHandler.apiKeys = nil
+			handler = &noAuthHandler
+		}
+		listenersWg.Add(1)
+		go s.listen(ctx, listenersWg, listener.Address, handler)
+	}
+	listenersWg.Wait()
+	s.logger.Warn("loop exited")
+}
+
+func (s *server) listen(ctx context.Context, wg *sync.WaitGroup, address string, handler http.Handler) {
+	defer wg.Done()
+	server := http.Server{Addr: address, Handler: handler}
+	shutdownDone := make(chan struct{})
 	go func() {
-		defer wg.Done()
+		defer close(shutdownDone)
 		<-ctx.Done()
-		s.logger.Warn("context canceled: exiting loop")
-		defer s.logger.Warn("loop exited")
+		s.logger.Warn("context canceled: stopping listener on %s", address)
 		const shutdownGraceDuration = 2 * time.Second
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownGraceDuration)
 		defer cancel()
 		if err := server.Shutdown(shutdownCtx); err != nil {
-			s.logger.Error("failed shutting down: %s", err)
+			s.logger.Error("failed shutting down listener on %s: %s", address, err)
 		}
 	}()
-	s.logger.Info("listening on %s", s.address)
+	s.logger.Info("listening on %s", address)
 	err := server.ListenAndServe()
 	if err != nil && ctx.Err() != context.Canceled {
 		s.logger.Error(err)
 	}
+	<-shutdownDone
 }
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/provider"
+)
+
+// getServerLocations writes the values which can be used to filter the
+// servers of a provider, for a URL path of the form /servers/{provider}/regions.
+func (h *handler) getServerLocations(w http.ResponseWriter, request *http.Request) {
+	const expectedParts = 2
+	parts := strings.Split(strings.TrimPrefix(request.URL.Path, "/servers/"), "/")
+	if len(parts) != expectedParts || parts[1] != "regions" {
+		errString := fmt.Sprintf("Nothing here for %s %s", request.Method, request.RequestURI)
+		http.Error(w, errString, http.StatusBadRequest)
+		return
+	}
+	name := parts[0]
+	locations, ok := provider.GetLocations(models.VPNProvider(strings.ToLower(name)),
+		h.openvpnLooper.GetAllServers())
+	if !ok {
+		http.Error(w, fmt.Sprintf("VPN service provider %q not found", name), http.StatusNotFound)
+		return
+	}
+	data, err := json.Marshal(locations)
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/shadowsocks"
+)
+
+// shadowsocksAction runs the action on the Shadowsocks listener for
+// a URL path of the form /shadowsocks/{listener}/actions/{action}.
+func (h *handler) shadowsocksAction(w http.ResponseWriter, request *http.Request) {
+	const expectedParts = 3
+	parts := strings.Split(strings.TrimPrefix(request.URL.Path, "/shadowsocks/"), "/")
+	if len(parts) != expectedParts || parts[1] != "actions" {
+		errString := fmt.Sprintf("Nothing here for %s %s", request.Method, request.RequestURI)
+		http.Error(w, errString, http.StatusBadRequest)
+		return
+	}
+	name, action := parts[0], parts[2]
+	looper, ok := h.shadowsocksLoopers[name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Shadowsocks listener %q not found", name), http.StatusNotFound)
+		return
+	}
+	switch action {
+	case "start":
+		looper.Start()
+	case "stop":
+		looper.Stop()
+	case "restart":
+		looper.Restart()
+	default:
+		http.Error(w, fmt.Sprintf("Shadowsocks action %q not supported", action), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// getShadowsocksStats writes the traffic statistics of each client
+// IP address, for each Shadowsocks listener name.
+func (h *handler) getShadowsocksStats(w http.ResponseWriter) {
+	listeners := make(map[string]map[string]shadowsocks.ClientStats, len(h.shadowsocksLoopers))
+	for name, looper := range h.shadowsocksLoopers {
+		listeners[name] = looper.GetStats()
+	}
+	data, err := json.Marshal(listeners)
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/openvpn"
+)
+
+const (
+	statusRunning = "running"
+	statusStopped = "stopped"
+)
+
+type componentStatus struct {
+	Status string `json:"status"`
+}
+
+// component is a part of the program which can be started and stopped
+// through its looper.
+type component struct {
+	running func() bool
+	start   func()
+	stop    func()
+	// startable returns an error if the component cannot be started,
+	// and is nil if it can always be started.
+	startable func() error
+}
+
+// componentName returns the component name for a URL path of the form
+// /{component}/status or /shadowsocks/{listener}/status.
+func componentName(path string) (name string) {
+	return strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/status")
+}
+
+// component returns the component with the name given.
+func (h *handler) component(name string) (c component, err error) {
+	switch name {
+	case "openvpn":
+		return component{
+			running: func() bool {
+				return h.openvpnLooper.GetConnectionStatus().State != openvpn.StateStopped
+			},
+			start: h.openvpnLooper.Start,
+			stop:  h.openvpnLooper.Stop,
+		}, nil
+	case "wireguard":
+		return component{
+			running: func() bool {
+				return h.wireguardLooper.GetConnectionStatus().State != openvpn.StateStopped
+			},
+			start: h.wireguardLooper.Start,
+			stop:  h.wireguardLooper.Stop,
+		}, nil
+	case "dns":
+		return component{
+			running: func() bool { return h.unboundLooper.GetSettings().Enabled },
+			start:   h.unboundLooper.Start,
+			stop:    h.unboundLooper.Stop,
+		}, nil
+	case "httpproxy":
+		return component{
+			running: func() bool { return h.httpProxyLooper.GetSettings().Enabled },
+			start:   h.httpProxyLooper.Start,
+			stop:    h.httpProxyLooper.Stop,
+		}, nil
+	case "portforwarding":
+		return component{
+			running:   func() bool { return h.openvpnLooper.GetSettings().Provider.PortForwarding.Enabled },
+			start:     func() { h.openvpnLooper.SetPortForwarding(true) },
+			stop:      func() { h.openvpnLooper.SetPortForwarding(false) },
+			startable: h.portForwardingStartable,
+		}, nil
+	case "shadowsocks":
+		return component{
+			running: func() bool {
+				for _, looper := range h.shadowsocksLoopers {
+					if looper.GetSettings().Enabled {
+						return true
+					}
+				}
+				return false
+			},
+			start: func() {
+				for _, looper := range h.shadowsocksLoopers {
+					if !looper.GetSettings().Enabled {
+						looper.Start()
+					}
+				}
+			},
+			stop: func() {
+				for _, looper := range h.shadowsocksLoopers {
+					if looper.GetSettings().Enabled {
+						looper.Stop()
+					}
+				}
+			},
+		}, nil
+	}
+	if listener := strings.TrimPrefix(name, "shadowsocks/"); listener != name {
+		looper, ok := h.shadowsocksLoopers[listener]
+		if !ok {
+			return c, fmt.Errorf("shadowsocks listener %q not found", listener)
+		}
+		return component{
+			running: func() bool { return looper.GetSettings().Enabled },
+			start:   looper.Start,
+			stop:    looper.Stop,
+		}, nil
+	}
+	return c, fmt.Errorf("component %q not found", name)
+}
+
+func (h *handler) getComponentStatus(w http.ResponseWriter, request *http.Request) {
+	c, err := h.component(componentName(request.URL.Path))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	status := componentStatus{Status: statusStopped}
+	if c.running() {
+		status.Status = statusRunning
+	}
+	h.writeComponentStatus(w, status)
+}
+
+func (h *handler) setComponentStatus(w http.ResponseWriter, request *http.Request) {
+	name := componentName(request.URL.Path)
+	c, err := h.component(name)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	var status componentStatus
+	if err := json.NewDecoder(request.Body).Decode(&status); err != nil {
+		http.Error(w, fmt.Sprintf("cannot decode status: %s", err), http.StatusBadRequest)
+		return
+	}
+	// the loopers channels are unbuffered, so the start and stop calls
+	// are dispatched without blocking until the looper receives them.
+	switch status.Status {
+	case statusRunning:
+		if c.startable != nil {
+			if err := c.startable(); err != nil {
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
+		}
+		if !c.running() {
+			go c.start()
+		}
+	case statusStopped:
+		if c.running() {
+			go c.stop()
+		}
+	default:
+		http.Error(w, fmt.Sprintf("status %q must be %q or %q", status.Status, statusRunning, statusStopped),
+			http.StatusBadRequest)
+		return
+	}
+	if h.saveComponentStatus != nil {
+		if err := h.saveComponentStatus(name, status.Status); err != nil {
+			h.logger.Warn("cannot save status of %s: %s", name, err)
+		}
+	}
+	h.writeComponentStatus(w, status)
+}
+
+// portForwardingStartable returns an error if port forwarding cannot be
+// started, since it is only implemented for Private Internet Access.
+func (h *handler) portForwardingStartable() error {
+	if h.vpnType == constants.Wireguard {
+		return fmt.Errorf("port forwarding is not supported with Wireguard")
+	}
+	provider := h.openvpnLooper.GetSettings().Provider.Name
+	if provider != constants.PrivateInternetAccess {
+		return fmt.Errorf("port forwarding is not supported for %s", provider)
+	}
+	return nil
+}
+
+// getLoopStatuses writes the state, last error and last
+// state transition time of each looper.
+func (h *handler) getLoopStatuses(w http.ResponseWriter) {
+	data, err := json.Marshal(h.loopStates.Statuses())
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (h *handler) writeComponentStatus(w http.ResponseWriter, status componentStatus) {
+	data, err := json.Marshal(status)
+	if err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(data); err != nil {
+		h.logger.Warn(err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
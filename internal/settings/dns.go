@@ -3,6 +3,7 @@ package settings
 import (
 	"fmt"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,21 +14,45 @@ import (
 
 // DNS contains settings to configure Unbound for DNS over TLS operation.
 type DNS struct {
-	Enabled               bool
-	KeepNameserver        bool
-	Providers             []models.DNSProvider
+	Enabled        bool
+	KeepNameserver bool
+	Providers      []models.DNSProvider
+	DNSCrypt       bool
+	// DNSCryptStamps are the stamps of the DNSCrypt servers to forward
+	// queries to if DNSCrypt is enabled.
+	DNSCryptStamps        []string
+	DomainProviders       []models.DNSDomainProviders
 	PlaintextAddress      net.IP
 	AllowedHostnames      []string
 	PrivateAddresses      []string
+	DockerHostnames       []string
+	LocalTLDs             []string
+	LocalRecords          []models.DNSLocalRecord
+	LocalResolver         net.IP
 	Caching               bool
+	Prefetch              bool
+	ServeExpired          bool
+	ServeExpiredTTL       time.Duration
 	BlockMalicious        bool
 	BlockSurveillance     bool
 	BlockAds              bool
+	BlockPatterns         []models.DNSBlockPattern
+	RPZ                   []string // zone file paths and URLs
 	VerbosityLevel        uint8
 	VerbosityDetailsLevel uint8
 	ValidationLogLevel    uint8
 	IPv6                  bool
 	UpdatePeriod          time.Duration
+	// ConnectionReuse is true to send several queries over each
+	// TLS connection to the upstream servers.
+	ConnectionReuse       bool
+	ConnectionIdleTimeout time.Duration
+	// MaxConnections is the maximum number of simultaneous TLS connections
+	// to the upstream servers per Unbound thread, idle ones included.
+	MaxConnections int
+	// QueryTimeout is the timeout of a query over TLS to an upstream
+	// server, including establishing the connection.
+	QueryTimeout time.Duration
 }
 
 func (d *DNS) String() string {
@@ -58,19 +83,71 @@ func (d *DNS) String() string {
 	if d.UpdatePeriod > 0 {
 		update = fmt.Sprintf("every %s", d.UpdatePeriod)
 	}
+	prefetch := disabled
+	if d.Prefetch {
+		prefetch = enabled
+	}
+	serveExpired := disabled
+	if d.ServeExpired {
+		serveExpired = "up to " + d.ServeExpiredTTL.String() + " after expiry"
+		if d.ServeExpiredTTL == 0 {
+			serveExpired = "without limit"
+		}
+	}
+	connectionReuse := disabled
+	if d.ConnectionReuse {
+		connectionReuse = "idle connections kept for " + d.ConnectionIdleTimeout.String()
+	}
 	keepNameserver := "no"
 	if d.KeepNameserver {
 		keepNameserver = "yes"
 	}
+	localRecords := make([]string, len(d.LocalRecords))
+	for i, record := range d.LocalRecords {
+		localRecords[i] = record.Hostname + " -> " + record.IP.String()
+	}
+	localResolver := "none, local TLDs are answered from local records only"
+	if d.LocalResolver != nil {
+		localResolver = d.LocalResolver.String()
+	}
+	blockPatterns := make([]string, len(d.BlockPatterns))
+	for i, pattern := range d.BlockPatterns {
+		blockPatterns[i] = pattern.Pattern
+	}
+	domainProvidersStr := make([]string, len(d.DomainProviders))
+	for i, domainProviders := range d.DomainProviders {
+		names := make([]string, len(domainProviders.Providers))
+		for j, provider := range domainProviders.Providers {
+			names[j] = string(provider)
+		}
+		domainProvidersStr[i] = domainProviders.Domain + ": " + strings.Join(names, ", ")
+	}
+	transport := "DNS over TLS"
+	if d.DNSCrypt {
+		transport = fmt.Sprintf("DNSCrypt with %d server(s)", len(d.DNSCryptStamps))
+	}
 	settingsList := []string{
 		"DNS over TLS settings:",
+		"Transport: " + transport,
 		"DNS over TLS provider:\n  |--" + strings.Join(providersStr, "\n  |--"),
+		"DNS over TLS provider by domain:\n  |--" + strings.Join(domainProvidersStr, "\n  |--"),
 		"Caching: " + caching,
+		"Prefetch: " + prefetch,
+		"Serve expired: " + serveExpired,
+		"Connection reuse: " + connectionReuse,
+		"Max connections: " + strconv.Itoa(d.MaxConnections),
+		"Query timeout: " + d.QueryTimeout.String(),
 		"Block malicious: " + blockMalicious,
 		"Block surveillance: " + blockSurveillance,
 		"Block ads: " + blockAds,
+		"Block patterns:\n  |--" + strings.Join(blockPatterns, "\n  |--"),
+		"Response policy zones:\n  |--" + strings.Join(d.RPZ, "\n  |--"),
 		"Allowed hostnames:\n  |--" + strings.Join(d.AllowedHostnames, "\n  |--"),
 		"Private addresses:\n  |--" + strings.Join(d.PrivateAddresses, "\n  |--"),
+		"Docker hostnames:\n  |--" + strings.Join(d.DockerHostnames, "\n  |--"),
+		"Local TLDs:\n  |--" + strings.Join(d.LocalTLDs, "\n  |--"),
+		"Local records:\n  |--" + strings.Join(localRecords, "\n  |--"),
+		"Local resolver: " + localResolver,
 		"Verbosity level: " + fmt.Sprintf("%d/5", d.VerbosityLevel),
 		"Verbosity details level: " + fmt.Sprintf("%d/4", d.VerbosityDetailsLevel),
 		"Validation log level: " + fmt.Sprintf("%d/2", d.ValidationLogLevel),
@@ -95,6 +172,20 @@ func GetDNSSettings(paramsReader params.Reader) (settings DNS, err error) {
 	if err != nil {
 		return settings, err
 	}
+	settings.DNSCrypt, err = paramsReader.GetDNSCrypt()
+	if err != nil {
+		return settings, err
+	}
+	if settings.DNSCrypt {
+		settings.DNSCryptStamps, err = paramsReader.GetDNSCryptStamps()
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.DomainProviders, err = paramsReader.GetDNSOverTLSDomainProviders()
+	if err != nil {
+		return settings, err
+	}
 	settings.AllowedHostnames, err = paramsReader.GetDNSUnblockedHostnames()
 	if err != nil {
 		return settings, err
@@ -103,6 +194,38 @@ func GetDNSSettings(paramsReader params.Reader) (settings DNS, err error) {
 	if err != nil {
 		return settings, err
 	}
+	settings.Prefetch, err = paramsReader.GetDNSOverTLSPrefetch()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServeExpired, err = paramsReader.GetDNSOverTLSServeExpired()
+	if err != nil {
+		return settings, err
+	}
+	if settings.ServeExpired {
+		settings.ServeExpiredTTL, err = paramsReader.GetDNSOverTLSServeExpiredTTL()
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.ConnectionReuse, err = paramsReader.GetDNSOverTLSConnectionReuse()
+	if err != nil {
+		return settings, err
+	}
+	if settings.ConnectionReuse {
+		settings.ConnectionIdleTimeout, err = paramsReader.GetDNSOverTLSConnectionIdleTimeout()
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.MaxConnections, err = paramsReader.GetDNSOverTLSMaxConnections()
+	if err != nil {
+		return settings, err
+	}
+	settings.QueryTimeout, err = paramsReader.GetDNSOverTLSQueryTimeout()
+	if err != nil {
+		return settings, err
+	}
 	settings.BlockMalicious, err = paramsReader.GetDNSMaliciousBlocking()
 	if err != nil {
 		return settings, err
@@ -115,6 +238,14 @@ func GetDNSSettings(paramsReader params.Reader) (settings DNS, err error) {
 	if err != nil {
 		return settings, err
 	}
+	settings.BlockPatterns, err = paramsReader.GetDNSBlockPatterns()
+	if err != nil {
+		return settings, err
+	}
+	settings.RPZ, err = paramsReader.GetDNSRPZ()
+	if err != nil {
+		return settings, err
+	}
 	settings.VerbosityLevel, err = paramsReader.GetDNSOverTLSVerbosity()
 	if err != nil {
 		return settings, err
@@ -131,6 +262,24 @@ func GetDNSSettings(paramsReader params.Reader) (settings DNS, err error) {
 	if err != nil {
 		return settings, err
 	}
+	settings.DockerHostnames, err = paramsReader.GetDNSDockerHostnames()
+	if err != nil {
+		return settings, err
+	}
+	settings.LocalTLDs, err = paramsReader.GetDNSLocalTLDs()
+	if err != nil {
+		return settings, err
+	}
+	settings.LocalRecords, err = paramsReader.GetDNSLocalRecords()
+	if err != nil {
+		return settings, err
+	}
+	if len(settings.LocalTLDs) > 0 {
+		settings.LocalResolver, err = paramsReader.GetDNSLocalResolver()
+		if err != nil {
+			return settings, err
+		}
+	}
 	settings.IPv6, err = paramsReader.GetDNSOverTLSIPv6()
 	if err != nil {
 		return settings, err
@@ -148,17 +297,73 @@ func GetDNSSettings(paramsReader params.Reader) (settings DNS, err error) {
 	IPv6Support := false
 	for _, provider := range settings.Providers {
 		providerData, ok := constants.DNSProviderMapping()[provider]
+		var providerErr error
 		switch {
 		case !ok:
-			return settings, fmt.Errorf("DNS provider %q does not have associated data", provider)
+			providerErr = fmt.Errorf("DNS provider %q does not have associated data", provider)
 		case !providerData.SupportsTLS:
-			return settings, fmt.Errorf("DNS provider %q does not support DNS over TLS", provider)
+			providerErr = fmt.Errorf("DNS provider %q does not support DNS over TLS", provider)
 		case providerData.SupportsIPv6:
 			IPv6Support = true
 		}
+		if err := paramsReader.CollectError(providerErr); err != nil {
+			return settings, err
+		}
 	}
 	if settings.IPv6 && !IPv6Support {
-		return settings, fmt.Errorf("None of the DNS over TLS provider(s) set support IPv6")
+		err = paramsReader.CollectError(fmt.Errorf("None of the DNS over TLS provider(s) set support IPv6"))
+		if err != nil {
+			return settings, err
+		}
+	}
+	if settings.DNSCrypt && len(settings.DNSCryptStamps) == 0 {
+		settings.DNSCryptStamps, err = dnscryptStamps(settings.Providers)
+		if err := paramsReader.CollectError(err); err != nil {
+			return settings, err
+		}
+	}
+	for _, domainProviders := range settings.DomainProviders {
+		for _, provider := range domainProviders.Providers {
+			providerData, ok := constants.DNSProviderMapping()[provider]
+			var providerErr error
+			switch {
+			case !ok:
+				providerErr = fmt.Errorf("DNS provider %q does not have associated data", provider)
+			case !providerData.SupportsTLS:
+				providerErr = fmt.Errorf("DNS provider %q for domain %s does not support DNS over TLS",
+					provider, domainProviders.Domain)
+			}
+			if err := paramsReader.CollectError(providerErr); err != nil {
+				return settings, err
+			}
+		}
 	}
 	return settings, nil
 }
+
+// dnscryptStamps returns the DNSCrypt stamps of the providers given,
+// which must all support DNSCrypt.
+func dnscryptStamps(providers []models.DNSProvider) (stamps []string, err error) {
+	for _, provider := range providers {
+		providerStamps := constants.DNSProviderMapping()[provider].DNSCryptStamps
+		if len(providerStamps) == 0 {
+			return nil, fmt.Errorf("DNS provider %q does not support DNSCrypt, set DNSCRYPT_STAMPS instead", provider)
+		}
+		stamps = append(stamps, providerStamps...)
+	}
+	return stamps, nil
+}
+
+// localResolverPorts returns the outbound ports to allow outside the VPN
+// tunnel to reach the LAN resolver, if any.
+func (d *DNS) localResolverPorts() (ports []models.OutboundPort) {
+	if !d.Enabled || d.LocalResolver == nil {
+		return nil
+	}
+	const dnsPort = 53
+	destination := &net.IPNet{IP: d.LocalResolver, Mask: net.IPv4Mask(255, 255, 255, 255)}
+	return []models.OutboundPort{
+		{Protocol: constants.UDP, Port: dnsPort, Destination: destination},
+		{Protocol: constants.TCP, Port: dnsPort, Destination: destination},
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
+	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/params"
 )
 
@@ -13,8 +15,45 @@ type Firewall struct {
 	VPNInputPorts   []uint16
 	InputPorts      []uint16
 	OutboundSubnets []net.IPNet
-	Enabled         bool
-	Debug           bool
+	// OutboundPorts are destination ports allowed through the default
+	// interface outside the VPN tunnel.
+	OutboundPorts []models.OutboundPort
+	// VPNBypassSubnets are source subnets routed through the default gateway
+	// instead of the VPN tunnel.
+	VPNBypassSubnets []net.IPNet
+	// VPNBypassUIDs and VPNBypassGIDs are the user and group IDs of the local
+	// processes routed through the default gateway instead of the VPN tunnel.
+	VPNBypassUIDs []uint32
+	VPNBypassGIDs []uint32
+	// VPNForcedUIDs and VPNForcedGIDs are the user and group IDs of the local
+	// processes whose traffic can only go through the VPN tunnel.
+	VPNForcedUIDs []uint32
+	VPNForcedGIDs []uint32
+	StaticRoutes  []models.StaticRoute
+	Enabled       bool
+	Debug         bool
+	// DryRun makes the validate command print the iptables commands
+	// the firewall would run, and is ignored when running the VPN.
+	DryRun bool
+	// ConntrackFlush deletes the conntrack entries of the previous VPN
+	// connection once the tunnel is up again.
+	ConntrackFlush bool
+	// MSSClamping clamps the TCP MSS to the path MTU on the tunnel interface.
+	MSSClamping bool
+	// InputICMPEcho is how ICMP echo requests are answered through the
+	// default interface, one of the constants.ICMPEcho* values.
+	InputICMPEcho string
+	// VPNInputICMPEcho is true to answer ICMP echo requests through the tunnel.
+	VPNInputICMPEcho bool
+	// RoutesProtection restores the routes removed or replaced by another
+	// program, checking them every RoutesProtectionPeriod.
+	RoutesProtection       bool
+	RoutesProtectionPeriod time.Duration
+	// RoutesMetric is the metric of the routes added.
+	RoutesMetric int
+	// LocalInterfaces are the host interfaces whose local networks are
+	// allowed in addition to the default interface.
+	LocalInterfaces []string
 }
 
 func (f *Firewall) String() string {
@@ -33,16 +72,75 @@ func (f *Firewall) String() string {
 	for i := range f.OutboundSubnets {
 		outboundSubnets[i] = f.OutboundSubnets[i].String()
 	}
+	outboundPorts := make([]string, len(f.OutboundPorts))
+	for i := range f.OutboundPorts {
+		outboundPorts[i] = f.OutboundPorts[i].String()
+	}
+	vpnBypassSubnets := make([]string, len(f.VPNBypassSubnets))
+	for i := range f.VPNBypassSubnets {
+		vpnBypassSubnets[i] = f.VPNBypassSubnets[i].String()
+	}
+
+	vpnBypassOwners := make([]string, 0, len(f.VPNBypassUIDs)+len(f.VPNBypassGIDs))
+	for _, uid := range f.VPNBypassUIDs {
+		vpnBypassOwners = append(vpnBypassOwners, fmt.Sprintf("uid %d", uid))
+	}
+	for _, gid := range f.VPNBypassGIDs {
+		vpnBypassOwners = append(vpnBypassOwners, fmt.Sprintf("gid %d", gid))
+	}
+
+	vpnForcedOwners := make([]string, 0, len(f.VPNForcedUIDs)+len(f.VPNForcedGIDs))
+	for _, uid := range f.VPNForcedUIDs {
+		vpnForcedOwners = append(vpnForcedOwners, fmt.Sprintf("uid %d", uid))
+	}
+	for _, gid := range f.VPNForcedGIDs {
+		vpnForcedOwners = append(vpnForcedOwners, fmt.Sprintf("gid %d", gid))
+	}
+
+	staticRoutes := make([]string, len(f.StaticRoutes))
+	for i := range f.StaticRoutes {
+		staticRoutes[i] = f.StaticRoutes[i].String()
+	}
 
 	settingsList := []string{
 		"Firewall settings:",
 		"VPN input ports: " + strings.Join(vpnInputPorts, ", "),
 		"Input ports: " + strings.Join(inputPorts, ", "),
 		"Outbound subnets: " + strings.Join(outboundSubnets, ", "),
+		"Outbound ports outside the tunnel: " + strings.Join(outboundPorts, ", "),
+		"VPN bypass subnets: " + strings.Join(vpnBypassSubnets, ", "),
+		"VPN bypass processes: " + strings.Join(vpnBypassOwners, ", "),
+		"VPN forced processes: " + strings.Join(vpnForcedOwners, ", "),
+		"Static routes: " + strings.Join(staticRoutes, ", "),
+	}
+	if len(f.LocalInterfaces) > 0 {
+		settingsList = append(settingsList, "Local interfaces: "+strings.Join(f.LocalInterfaces, ", "))
+	}
+	if f.MSSClamping {
+		settingsList = append(settingsList, "TCP MSS clamping: on")
+	}
+	icmpEcho := "ICMP echo: " + f.InputICMPEcho
+	if f.VPNInputICMPEcho {
+		icmpEcho += ", on through the VPN"
+	}
+	settingsList = append(settingsList, icmpEcho)
+	if f.RoutesProtection {
+		settingsList = append(settingsList, "Routes protection: every "+f.RoutesProtectionPeriod.String())
+	} else {
+		settingsList = append(settingsList, "Routes protection: off")
+	}
+	if f.RoutesMetric > 0 {
+		settingsList = append(settingsList, fmt.Sprintf("Routes metric: %d", f.RoutesMetric))
+	}
+	if !f.ConntrackFlush {
+		settingsList = append(settingsList, "Conntrack flush on reconnect: off")
 	}
 	if f.Debug {
 		settingsList = append(settingsList, "Debug: on")
 	}
+	if f.DryRun {
+		settingsList = append(settingsList, "Dry run: on")
+	}
 	return strings.Join(settingsList, "\n |--")
 }
 
@@ -60,6 +158,42 @@ func GetFirewallSettings(paramsReader params.Reader) (settings Firewall, err err
 	if err != nil {
 		return settings, err
 	}
+	settings.OutboundPorts, err = paramsReader.GetOutboundPorts()
+	if err != nil {
+		return settings, err
+	}
+	settings.VPNBypassSubnets, err = paramsReader.GetVPNBypassSubnets()
+	if err != nil {
+		return settings, err
+	}
+	settings.VPNBypassUIDs, err = paramsReader.GetVPNBypassUIDs()
+	if err != nil {
+		return settings, err
+	}
+	settings.VPNBypassGIDs, err = paramsReader.GetVPNBypassGIDs()
+	if err != nil {
+		return settings, err
+	}
+	settings.VPNForcedUIDs, err = paramsReader.GetVPNForcedUIDs()
+	if err != nil {
+		return settings, err
+	}
+	settings.VPNForcedGIDs, err = paramsReader.GetVPNForcedGIDs()
+	if err != nil {
+		return settings, err
+	}
+	if err := paramsReader.CollectError(checkBypassedAndForced(settings.VPNBypassUIDs, settings.VPNForcedUIDs,
+		"user ID")); err != nil {
+		return settings, err
+	}
+	if err := paramsReader.CollectError(checkBypassedAndForced(settings.VPNBypassGIDs, settings.VPNForcedGIDs,
+		"group ID")); err != nil {
+		return settings, err
+	}
+	settings.StaticRoutes, err = paramsReader.GetStaticRoutes()
+	if err != nil {
+		return settings, err
+	}
 	settings.Enabled, err = paramsReader.GetFirewall()
 	if err != nil {
 		return settings, err
@@ -68,5 +202,67 @@ func GetFirewallSettings(paramsReader params.Reader) (settings Firewall, err err
 	if err != nil {
 		return settings, err
 	}
+	settings.DryRun, err = paramsReader.GetFirewallDryRun()
+	if err != nil {
+		return settings, err
+	}
+	settings.ConntrackFlush, err = paramsReader.GetFirewallConntrackFlush()
+	if err != nil {
+		return settings, err
+	}
+	settings.MSSClamping, err = paramsReader.GetFirewallMSSClamping()
+	if err != nil {
+		return settings, err
+	}
+	settings.InputICMPEcho, err = paramsReader.GetFirewallInputICMPEcho()
+	if err != nil {
+		return settings, err
+	}
+	settings.VPNInputICMPEcho, err = paramsReader.GetFirewallVPNInputICMPEcho()
+	if err != nil {
+		return settings, err
+	}
+	settings.RoutesProtection, err = paramsReader.GetRoutesProtection()
+	if err != nil {
+		return settings, err
+	}
+	if settings.RoutesProtection {
+		settings.RoutesProtectionPeriod, err = paramsReader.GetRoutesProtectionPeriod()
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.RoutesMetric, err = paramsReader.GetRoutesMetric()
+	if err != nil {
+		return settings, err
+	}
+	settings.LocalInterfaces, err = paramsReader.GetFirewallLocalInterfaces()
+	if err != nil {
+		return settings, err
+	}
 	return settings, nil
 }
+
+// checkBypassedAndForced verifies no ID is both bypassing the VPN tunnel
+// and forced into it.
+func checkBypassedAndForced(bypassed, forced []uint32, kind string) error {
+	for _, bypassedID := range bypassed {
+		for _, forcedID := range forced {
+			if bypassedID == forcedID {
+				return fmt.Errorf("%s %d cannot both bypass the VPN tunnel and be forced into it", kind, forcedID)
+			}
+		}
+	}
+	return nil
+}
+
+// checkForcedUID verifies OpenVPN, running as the user ID given,
+// is not forced into the VPN tunnel it creates.
+func (f *Firewall) checkForcedUID(openvpnUID int) error {
+	for _, uid := range f.VPNForcedUIDs {
+		if int(uid) == openvpnUID {
+			return fmt.Errorf("user ID %d cannot be forced into the VPN tunnel since OpenVPN runs as this user", uid)
+		}
+	}
+	return nil
+}
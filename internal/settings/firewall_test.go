@@ -0,0 +1,26 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkBypassedAndForced(t *testing.T) {
+	t.Parallel()
+	err := checkBypassedAndForced([]uint32{1000, 1001}, []uint32{1002}, "user ID")
+	assert.NoError(t, err)
+	err = checkBypassedAndForced([]uint32{1000, 1001}, []uint32{1001}, "user ID")
+	require.Error(t, err)
+	assert.Equal(t, "user ID 1001 cannot both bypass the VPN tunnel and be forced into it", err.Error())
+}
+
+func Test_Firewall_checkForcedUID(t *testing.T) {
+	t.Parallel()
+	f := Firewall{VPNForcedUIDs: []uint32{1001}}
+	assert.NoError(t, f.checkForcedUID(1000))
+	err := f.checkForcedUID(1001)
+	require.Error(t, err)
+	assert.Equal(t, "user ID 1001 cannot be forced into the VPN tunnel since OpenVPN runs as this user", err.Error())
+}
@@ -0,0 +1,92 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// Health contains settings to configure the periodic health probes.
+type Health struct {
+	// DNSDomain is resolved by the DNS probes, which are
+	// disabled if it is empty.
+	DNSDomain string
+	// DNSRestart restarts the DNS over TLS server when the
+	// internal DNS probe becomes unhealthy.
+	DNSRestart bool
+	// TCPTargets are host:port addresses which must
+	// accept TCP connections through the tunnel.
+	TCPTargets []string
+	Interval   time.Duration
+	Timeout    time.Duration
+	// FailureThreshold is the number of consecutive failures
+	// for a healthy probe to become unhealthy.
+	FailureThreshold int
+	// SuccessThreshold is the number of consecutive successes
+	// for an unhealthy probe to become healthy again.
+	SuccessThreshold int
+}
+
+func (h *Health) String() string {
+	dns := "disabled"
+	if h.DNSDomain != "" {
+		dns = "resolving " + h.DNSDomain
+		if h.DNSRestart {
+			dns += ", restarting DNS over TLS on failure"
+		}
+	}
+	settingsList := []string{
+		"Health:",
+		fmt.Sprintf("Probes every %s with a %s timeout", h.Interval, h.Timeout),
+		fmt.Sprintf("Unhealthy after %d failures and healthy again after %d successes",
+			h.FailureThreshold, h.SuccessThreshold),
+		"DNS probes: " + dns,
+	}
+	if len(h.TCPTargets) > 0 {
+		settingsList = append(settingsList, "TCP probes: "+strings.Join(h.TCPTargets, ", "))
+	}
+	return strings.Join(settingsList, "\n |--")
+}
+
+// GetHealthSettings obtains the health settings from
+// environment variables using the params package.
+func GetHealthSettings(paramsReader params.Reader) (settings Health, err error) {
+	settings.DNSDomain, err = paramsReader.GetHealthDNSDomain()
+	if err != nil {
+		return settings, err
+	}
+	settings.DNSRestart, err = paramsReader.GetHealthDNSRestart()
+	if err != nil {
+		return settings, err
+	}
+	settings.TCPTargets, err = paramsReader.GetHealthTCPTargets()
+	if err != nil {
+		return settings, err
+	}
+	settings.Interval, err = paramsReader.GetHealthInterval()
+	if err != nil {
+		return settings, err
+	}
+	settings.Timeout, err = paramsReader.GetHealthTimeout()
+	if err != nil {
+		return settings, err
+	}
+	if settings.Timeout > settings.Interval {
+		err = paramsReader.CollectError(fmt.Errorf("health probe timeout %s cannot be longer than the interval %s",
+			settings.Timeout, settings.Interval))
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.FailureThreshold, err = paramsReader.GetHealthFailureThreshold()
+	if err != nil {
+		return settings, err
+	}
+	settings.SuccessThreshold, err = paramsReader.GetHealthSuccessThreshold()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
@@ -2,6 +2,8 @@ package settings
 
 import (
 	"fmt"
+	"net"
+	"net/url"
 	"strings"
 
 	"github.com/qdm12/gluetun/internal/params"
@@ -15,13 +17,30 @@ type HTTPProxy struct { //nolint:maligned
 	Password string
 	Stealth  bool
 	Log      bool
+	// AllowedSubnets are the client subnets allowed to use the proxy,
+	// all clients are allowed if it is empty.
+	AllowedSubnets []net.IPNet
+	// TLSCertificate and TLSKey are file paths to serve the proxy over TLS
+	TLSCertificate string
+	TLSKey         string
+	// PACBypass are host patterns and CIDR subnets to reach without
+	// the proxy in the proxy auto-config file served.
+	PACBypass []string
+	// Upstream is an optional proxy to forward all requests to.
+	Upstream *url.URL
+	// ConnectPorts are the destination ports allowed for CONNECT
+	// requests, all ports are allowed if it is empty.
+	ConnectPorts []uint16
+	// ListeningAddress is the IP address to listen on,
+	// all interfaces are listened on if it is nil.
+	ListeningAddress net.IP
 }
 
 func (h *HTTPProxy) String() string {
 	if !h.Enabled {
 		return "HTTP Proxy settings: disabled"
 	}
-	auth, log, stealth := disabled, disabled, disabled
+	auth, log, stealth, tls := disabled, disabled, disabled, disabled
 	if h.User != "" {
 		auth = enabled
 	}
@@ -31,12 +50,41 @@ func (h *HTTPProxy) String() string {
 	if h.Stealth {
 		stealth = enabled
 	}
+	if h.TLSCertificate != "" {
+		tls = enabled
+	}
 	settingsList := []string{
 		"HTTP proxy settings:",
 		fmt.Sprintf("Port: %d", h.Port),
 		"Authentication: " + auth,
 		"Stealth: " + stealth,
 		"Log: " + log,
+		"TLS: " + tls,
+	}
+	connectPorts := "all"
+	if len(h.ConnectPorts) > 0 {
+		ports := make([]string, len(h.ConnectPorts))
+		for i, port := range h.ConnectPorts {
+			ports[i] = fmt.Sprint(port)
+		}
+		connectPorts = strings.Join(ports, ", ")
+	}
+	settingsList = append(settingsList, "CONNECT ports: "+connectPorts)
+	if h.ListeningAddress != nil {
+		settingsList = append(settingsList, "Listening address: "+h.ListeningAddress.String())
+	}
+	if h.Upstream != nil {
+		settingsList = append(settingsList, "Upstream proxy: "+h.Upstream.Redacted())
+	}
+	if len(h.PACBypass) > 0 {
+		settingsList = append(settingsList, "Auto-config bypass: "+strings.Join(h.PACBypass, ", "))
+	}
+	if len(h.AllowedSubnets) > 0 {
+		subnets := make([]string, len(h.AllowedSubnets))
+		for i := range h.AllowedSubnets {
+			subnets[i] = h.AllowedSubnets[i].String()
+		}
+		settingsList = append(settingsList, "Allowed client subnets: "+strings.Join(subnets, ", "))
 	}
 	return strings.Join(settingsList, "\n |--")
 }
@@ -51,6 +99,10 @@ func GetHTTPProxySettings(paramsReader params.Reader) (settings HTTPProxy, err e
 	if err != nil {
 		return settings, err
 	}
+	settings.ListeningAddress, err = paramsReader.GetHTTPProxyListeningAddress()
+	if err != nil {
+		return settings, err
+	}
 	settings.User, err = paramsReader.GetHTTPProxyUser()
 	if err != nil {
 		return settings, err
@@ -67,5 +119,36 @@ func GetHTTPProxySettings(paramsReader params.Reader) (settings HTTPProxy, err e
 	if err != nil {
 		return settings, err
 	}
+	settings.AllowedSubnets, err = paramsReader.GetHTTPProxyAllowedSubnets()
+	if err != nil {
+		return settings, err
+	}
+	settings.TLSCertificate, err = paramsReader.GetHTTPProxyTLSCertificate()
+	if err != nil {
+		return settings, err
+	}
+	settings.TLSKey, err = paramsReader.GetHTTPProxyTLSKey()
+	if err != nil {
+		return settings, err
+	}
+	settings.Upstream, err = paramsReader.GetHTTPProxyUpstream()
+	if err != nil {
+		return settings, err
+	}
+	settings.PACBypass, err = paramsReader.GetHTTPProxyPACBypass()
+	if err != nil {
+		return settings, err
+	}
+	settings.ConnectPorts, err = paramsReader.GetHTTPProxyConnectPorts()
+	if err != nil {
+		return settings, err
+	}
+	if (settings.TLSCertificate == "") != (settings.TLSKey == "") {
+		err = paramsReader.CollectError(fmt.Errorf(
+			"both the TLS certificate and key must be set to serve the HTTP proxy over TLS"))
+		if err != nil {
+			return settings, err
+		}
+	}
 	return settings, nil
 }
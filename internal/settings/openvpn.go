@@ -2,7 +2,9 @@ package settings
 
 import (
 	"fmt"
+	"net"
 	"strings"
+	"time"
 
 	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
@@ -11,30 +13,123 @@ import (
 
 // OpenVPN contains settings to configure the OpenVPN client.
 type OpenVPN struct {
-	User      string                  `json:"user"`
-	Password  string                  `json:"-"`
-	Verbosity int                     `json:"verbosity"`
-	Root      bool                    `json:"runAsRoot"`
-	Cipher    string                  `json:"cipher"`
-	Auth      string                  `json:"auth"`
-	Provider  models.ProviderSettings `json:"provider"`
+	User          string                  `json:"user"`
+	Password      string                  `json:"-"`
+	Verbosity     int                     `json:"verbosity"`
+	Root          bool                    `json:"runAsRoot"`
+	Cipher        string                  `json:"cipher"`
+	Auth          string                  `json:"auth"`
+	TLSVersionMin string                  `json:"tlsVersionMin"`
+	DataCiphers   []string                `json:"dataCiphers"`
+	TLSCipher     string                  `json:"tlsCipher"`
+	Scramble      string                  `json:"scramble"`
+	ScrambleKey   string                  `json:"-"`
+	Compression   string                  `json:"compression"`
+	Provider      models.ProviderSettings `json:"provider"`
+	Retry         OpenVPNRetry            `json:"retry"`
+	// ProfilesFilepath is the JSON file defining named profiles
+	// which can be activated through the control server.
+	ProfilesFilepath string `json:"profilesFilepath"`
+	// ExtraTunnels are the names of the profiles used to connect
+	// extra tunnels, the connections being balanced across the tunnels.
+	ExtraTunnels []string `json:"extraTunnels"`
+	// CredentialsFilepath is the file containing the user and password,
+	// checked every CredentialsPeriod to renew them without full restart.
+	CredentialsFilepath string        `json:"credentialsFilepath"`
+	CredentialsPeriod   time.Duration `json:"credentialsPeriod"`
+	// PersistTun keeps the TUN device and its routes across restarts
+	// which do not change the settings, by signaling OpenVPN to reconnect.
+	PersistTun bool `json:"persistTun"`
+	// Interface is the name of the tunnel interface.
+	Interface string `json:"interface"`
+	// RouteNoPull ignores the routes pushed by the VPN server,
+	// so that only the Routes subnets go through the tunnel.
+	RouteNoPull bool        `json:"routeNoPull"`
+	Routes      []net.IPNet `json:"routes"`
+}
+
+// OpenVPNRetry contains settings to restart OpenVPN after failures.
+type OpenVPNRetry struct {
+	InitialWait time.Duration `json:"initialWait"`
+	MaxWait     time.Duration `json:"maxWait"`
+	// SwitchServerAfter is the number of failed attempts with the same server
+	// before picking another one. 0 picks a server for every attempt.
+	SwitchServerAfter int `json:"switchServerAfter"`
+	// FailedServerCooldown is the duration during which a server IP address
+	// which failed to connect is avoided when picking a server. 0 disables it.
+	FailedServerCooldown time.Duration `json:"failedServerCooldown"`
+	// AuthFailedAttempts is the number of authentication failures after
+	// which AuthFailedAction is taken. 0 lets OpenVPN retry forever.
+	AuthFailedAttempts int    `json:"authFailedAttempts"`
+	AuthFailedAction   string `json:"authFailedAction"`
+}
+
+func (o *OpenVPNRetry) String() string {
+	switchServer := "every attempt"
+	if o.SwitchServerAfter > 0 {
+		switchServer = fmt.Sprintf("after %d failed attempts", o.SwitchServerAfter)
+	}
+	if o.FailedServerCooldown > 0 {
+		switchServer += fmt.Sprintf(" avoiding failed servers for %s", o.FailedServerCooldown)
+	}
+	authFailed := "retry forever"
+	if o.AuthFailedAttempts > 0 {
+		authFailed = fmt.Sprintf("%s after %d failures", o.AuthFailedAction, o.AuthFailedAttempts)
+	}
+	return fmt.Sprintf("Retry: wait from %s to %s with jitter, switch server %s, on authentication failure %s",
+		o.InitialWait, o.MaxWait, switchServer, authFailed)
 }
 
 // GetOpenVPNSettings obtains the OpenVPN settings using the params functions.
 func GetOpenVPNSettings(paramsReader params.Reader, vpnProvider models.VPNProvider) (settings OpenVPN, err error) {
-	settings.User, err = paramsReader.GetUser()
+	settings.CredentialsFilepath, err = paramsReader.GetOpenVPNCredentialsFilepath()
+	if err != nil {
+		return settings, err
+	}
+	if settings.CredentialsFilepath != "" {
+		settings.CredentialsPeriod, err = paramsReader.GetOpenVPNCredentialsPeriod()
+		if err != nil {
+			return settings, err
+		}
+	}
+	credentialsFromFile := settings.CredentialsFilepath != ""
+	settings.User, err = paramsReader.GetUser(!credentialsFromFile)
 	if err != nil {
 		return settings, err
 	}
 	// Remove spaces in user ID to simplify user's life, thanks @JeordyR
 	settings.User = strings.ReplaceAll(settings.User, " ", "")
 	isMullvad := vpnProvider == constants.Mullvad
-	settings.Password, err = paramsReader.GetPassword(!isMullvad)
+	settings.Password, err = paramsReader.GetPassword(!isMullvad && !credentialsFromFile)
 	if err != nil {
 		return settings, err
 	} else if isMullvad {
 		settings.Password = "m"
 	}
+	settings.PersistTun, err = paramsReader.GetOpenVPNPersistTun()
+	if err != nil {
+		return settings, err
+	}
+	settings.Interface, err = paramsReader.GetVPNInterface(string(constants.TUN))
+	if err != nil {
+		return settings, err
+	}
+	settings.RouteNoPull, err = paramsReader.GetOpenVPNRouteNoPull()
+	if err != nil {
+		return settings, err
+	}
+	if settings.RouteNoPull {
+		settings.Routes, err = paramsReader.GetOpenVPNRoutes()
+		if err != nil {
+			return settings, err
+		} else if len(settings.Routes) == 0 {
+			err = paramsReader.CollectError(fmt.Errorf(
+				"OpenVPN route-nopull mode requires at least one route through the tunnel"))
+			if err != nil {
+				return settings, err
+			}
+		}
+	}
 	settings.Verbosity, err = paramsReader.GetOpenVPNVerbosity()
 	if err != nil {
 		return settings, err
@@ -51,29 +146,220 @@ func GetOpenVPNSettings(paramsReader params.Reader, vpnProvider models.VPNProvid
 	if err != nil {
 		return settings, err
 	}
+	settings.TLSVersionMin, err = paramsReader.GetOpenVPNTLSVersionMin()
+	if err != nil {
+		return settings, err
+	}
+	settings.DataCiphers, err = paramsReader.GetOpenVPNDataCiphers()
+	if err != nil {
+		return settings, err
+	}
+	settings.TLSCipher, err = paramsReader.GetOpenVPNTLSCipher()
+	if err != nil {
+		return settings, err
+	}
+	if err := paramsReader.CollectError(checkDataCiphers(vpnProvider, settings.Cipher, settings.DataCiphers)); err != nil {
+		return settings, err
+	}
+	settings.Scramble, err = paramsReader.GetOpenVPNScramble()
+	if err != nil {
+		return settings, err
+	}
+	settings.ScrambleKey, err = paramsReader.GetOpenVPNScrambleKey()
+	if err != nil {
+		return settings, err
+	}
+	if err := paramsReader.CollectError(checkScramble(settings.Scramble, settings.ScrambleKey)); err != nil {
+		return settings, err
+	}
+	settings.Compression, err = paramsReader.GetOpenVPNCompression()
+	if err != nil {
+		return settings, err
+	}
+	if err := paramsReader.CollectError(checkCompression(vpnProvider, settings.Compression)); err != nil {
+		return settings, err
+	}
+	settings.Retry.InitialWait, err = paramsReader.GetOpenVPNRetryInitialWait()
+	if err != nil {
+		return settings, err
+	}
+	settings.Retry.MaxWait, err = paramsReader.GetOpenVPNRetryMaxWait()
+	if err != nil {
+		return settings, err
+	}
+	if settings.Retry.MaxWait < settings.Retry.InitialWait {
+		err = paramsReader.CollectError(fmt.Errorf("OpenVPN retry maximum wait %s cannot be lower than the initial wait %s",
+			settings.Retry.MaxWait, settings.Retry.InitialWait))
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.Retry.SwitchServerAfter, err = paramsReader.GetOpenVPNRetrySwitchServer()
+	if err != nil {
+		return settings, err
+	}
+	settings.Retry.FailedServerCooldown, err = paramsReader.GetOpenVPNRetryCooldown()
+	if err != nil {
+		return settings, err
+	}
+	settings.Retry.AuthFailedAttempts, err = paramsReader.GetOpenVPNAuthFailedAttempts()
+	if err != nil {
+		return settings, err
+	}
+	settings.Retry.AuthFailedAction, err = paramsReader.GetOpenVPNAuthFailedAction()
+	if err != nil {
+		return settings, err
+	}
+	settings.ProfilesFilepath, err = paramsReader.GetProfilesFilepath()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraTunnels, err = paramsReader.GetOpenVPNExtraTunnels()
+	if err != nil {
+		return settings, err
+	} else if len(settings.ExtraTunnels) > 0 && settings.ProfilesFilepath == "" {
+		err = paramsReader.CollectError(fmt.Errorf("OpenVPN extra tunnels require a profiles file"))
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.Provider, err = getProviderSettings(paramsReader, vpnProvider)
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// checkNoExtraTunnels returns an error if extra OpenVPN tunnels are set,
+// since they are balanced against an OpenVPN main tunnel only.
+func checkNoExtraTunnels(paramsReader params.Reader) error {
+	extraTunnels, err := paramsReader.GetOpenVPNExtraTunnels()
+	if err != nil {
+		return err
+	} else if len(extraTunnels) > 0 {
+		return paramsReader.CollectError(fmt.Errorf("OpenVPN extra tunnels are not supported with Wireguard"))
+	}
+	return nil
+}
+
+// getProviderSettings obtains the settings of the VPN provider given,
+// used to select a server for both OpenVPN and Wireguard.
+func getProviderSettings(paramsReader params.Reader, vpnProvider models.VPNProvider) (
+	settings models.ProviderSettings, err error) {
 	switch vpnProvider {
 	case constants.PrivateInternetAccess:
-		settings.Provider, err = GetPIASettings(paramsReader)
+		settings, err = GetPIASettings(paramsReader)
 	case constants.Mullvad:
-		settings.Provider, err = GetMullvadSettings(paramsReader)
+		settings, err = GetMullvadSettings(paramsReader)
 	case constants.Windscribe:
-		settings.Provider, err = GetWindscribeSettings(paramsReader)
+		settings, err = GetWindscribeSettings(paramsReader)
 	case constants.Surfshark:
-		settings.Provider, err = GetSurfsharkSettings(paramsReader)
+		settings, err = GetSurfsharkSettings(paramsReader)
 	case constants.Cyberghost:
-		settings.Provider, err = GetCyberghostSettings(paramsReader)
+		settings, err = GetCyberghostSettings(paramsReader)
 	case constants.Vyprvpn:
-		settings.Provider, err = GetVyprvpnSettings(paramsReader)
+		settings, err = GetVyprvpnSettings(paramsReader)
 	case constants.Nordvpn:
-		settings.Provider, err = GetNordvpnSettings(paramsReader)
+		settings, err = GetNordvpnSettings(paramsReader)
 	case constants.Purevpn:
-		settings.Provider, err = GetPurevpnSettings(paramsReader)
+		settings, err = GetPurevpnSettings(paramsReader)
 	case constants.Privado:
-		settings.Provider, err = GetPrivadoSettings(paramsReader)
+		settings, err = GetPrivadoSettings(paramsReader)
+	case constants.HideMyAss:
+		settings, err = GetHideMyAssSettings(paramsReader)
+	case constants.WeVPN:
+		settings, err = GetWeVPNSettings(paramsReader)
+	case constants.VPNUnlimited:
+		settings, err = GetVPNUnlimitedSettings(paramsReader)
 	default:
 		err = fmt.Errorf("VPN service provider %q is not valid", vpnProvider)
 	}
-	return settings, err
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.PickMode, err = paramsReader.GetServerPickMode()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.PickSeed, err = paramsReader.GetServerPickSeed()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// CheckProvider verifies the OpenVPN settings can be used with the VPN
+// provider given, for example to switch provider with a profile.
+func (o *OpenVPN) CheckProvider(vpnProvider models.VPNProvider) error {
+	if err := checkDataCiphers(vpnProvider, o.Cipher, o.DataCiphers); err != nil {
+		return err
+	}
+	return checkCompression(vpnProvider, o.Compression)
+}
+
+// checkScramble verifies a key is given only for the scramble methods using one.
+func checkScramble(method, key string) error {
+	switch method {
+	case constants.OpenVPNScrambleXormask, constants.OpenVPNScrambleObfuscate:
+		if key == "" {
+			return fmt.Errorf("OpenVPN scramble method %s requires a scramble key", method)
+		}
+	default:
+		if key != "" {
+			return fmt.Errorf("OpenVPN scramble key cannot be used with scramble method %q", method)
+		}
+	}
+	return nil
+}
+
+// checkCompression verifies the compression set is compatible with the
+// provider servers, so the compression directives the provider requires
+// are never removed. Servers configured with comp-lzo expect the compression
+// framing, so they only work with lzo or stub. PIA servers choose the
+// compression with its compress directive, so it cannot be replaced.
+// Other providers are not checked since their servers push the compression
+// to use, if any.
+func checkCompression(vpnProvider models.VPNProvider, compression string) error {
+	switch vpnProvider {
+	case constants.PrivateInternetAccess:
+		if compression != "" {
+			return fmt.Errorf("compression %s cannot be used with %s whose servers choose the compression to use",
+				compression, vpnProvider)
+		}
+	case constants.Nordvpn, constants.Vyprvpn, constants.Windscribe:
+		switch compression {
+		case "", constants.OpenVPNCompressionLZO, constants.OpenVPNCompressionStub:
+		default:
+			return fmt.Errorf("compression %s cannot be used with %s whose servers use LZO compression, use %s or %s",
+				compression, vpnProvider, constants.OpenVPNCompressionLZO, constants.OpenVPNCompressionStub)
+		}
+	}
+	return nil
+}
+
+// checkDataCiphers verifies the data ciphers to negotiate are compatible
+// with the provider configuration and with the cipher set.
+func checkDataCiphers(vpnProvider models.VPNProvider, cipher string, dataCiphers []string) error {
+	if len(dataCiphers) == 0 {
+		return nil
+	}
+	switch {
+	case vpnProvider == constants.Cyberghost:
+		return fmt.Errorf("data ciphers cannot be set for %s since its configuration disables cipher negotiation",
+			vpnProvider)
+	case vpnProvider == constants.PrivateInternetAccess && strings.HasSuffix(cipher, "-gcm"):
+		return fmt.Errorf("data ciphers cannot be set for %s with cipher %s since cipher negotiation is disabled",
+			vpnProvider, cipher)
+	case cipher == "":
+		return nil
+	}
+	for _, dataCipher := range dataCiphers {
+		if strings.EqualFold(cipher, dataCipher) {
+			return nil
+		}
+	}
+	return fmt.Errorf("cipher %s must be one of the data ciphers %s",
+		cipher, strings.Join(dataCiphers, ", "))
 }
 
 func (o *OpenVPN) String() string {
@@ -87,6 +373,7 @@ func (o *OpenVPN) String() string {
 		"Password: [redacted]",
 		"Verbosity level: " + fmt.Sprintf("%d", o.Verbosity),
 		"Run as root: " + runAsRoot,
+		o.Retry.String(),
 		o.Provider.String(),
 	}
 	if len(o.Cipher) > 0 {
@@ -95,5 +382,43 @@ func (o *OpenVPN) String() string {
 	if len(o.Auth) > 0 {
 		settingsList = append(settingsList, "Custom auth algorithm: "+o.Auth)
 	}
+	if len(o.TLSVersionMin) > 0 {
+		settingsList = append(settingsList, "Minimum TLS version: "+o.TLSVersionMin)
+	}
+	if len(o.DataCiphers) > 0 {
+		settingsList = append(settingsList, "Data ciphers: "+strings.Join(o.DataCiphers, ", "))
+	}
+	if len(o.TLSCipher) > 0 {
+		settingsList = append(settingsList, "TLS cipher: "+o.TLSCipher)
+	}
+	if len(o.Scramble) > 0 {
+		settingsList = append(settingsList, "XOR scramble: "+o.Scramble)
+	}
+	if len(o.Compression) > 0 {
+		settingsList = append(settingsList, "Compression: "+o.Compression)
+	}
+	if len(o.ProfilesFilepath) > 0 {
+		settingsList = append(settingsList, "Profiles file: "+o.ProfilesFilepath)
+	}
+	if len(o.ExtraTunnels) > 0 {
+		settingsList = append(settingsList, "Extra tunnels: "+strings.Join(o.ExtraTunnels, ", "))
+	}
+	if len(o.CredentialsFilepath) > 0 {
+		settingsList = append(settingsList, fmt.Sprintf("Credentials file: %s checked every %s",
+			o.CredentialsFilepath, o.CredentialsPeriod))
+	}
+	if o.PersistTun {
+		settingsList = append(settingsList, "Persist TUN device across restarts: on")
+	}
+	if o.Interface != string(constants.TUN) {
+		settingsList = append(settingsList, "Interface: "+o.Interface)
+	}
+	if o.RouteNoPull {
+		routes := make([]string, len(o.Routes))
+		for i := range o.Routes {
+			routes[i] = o.Routes[i].String()
+		}
+		settingsList = append(settingsList, "Routes through the tunnel only: "+strings.Join(routes, ", "))
+	}
 	return strings.Join(settingsList, "\n|--")
 }
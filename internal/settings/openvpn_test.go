@@ -2,8 +2,10 @@ package settings
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,9 +22,110 @@ func Test_OpenVPN_JSON(t *testing.T) {
 	data, err := json.Marshal(in)
 	require.NoError(t, err)
 	//nolint:lll
-	assert.Equal(t, `{"user":"","verbosity":0,"runAsRoot":true,"cipher":"","auth":"","provider":{"name":"name","serverSelection":{"networkProtocol":"","regions":null,"group":"","countries":null,"cities":null,"hostnames":null,"isps":null,"owned":false,"customPort":0,"numbers":null,"encryptionPreset":""},"extraConfig":{"encryptionPreset":"","openvpnIPv6":false},"portForwarding":{"enabled":false,"filepath":""}}}`, string(data))
+	assert.Equal(t, `{"user":"","verbosity":0,"runAsRoot":true,"cipher":"","auth":"","tlsVersionMin":"","dataCiphers":null,"tlsCipher":"","scramble":"","compression":"","provider":{"name":"name","serverSelection":{"networkProtocol":"","regions":null,"group":"","countries":null,"cities":null,"hostnames":null,"isps":null,"owned":false,"ipv6Endpoint":false,"customPort":0,"numbers":null,"categories":null,"encryptionPreset":"","portForwardOnly":false,"portForwardReselect":false,"pickMode":"","pickSeed":0},"extraConfig":{"encryptionPreset":"","openvpnIPv6":false},"portForwarding":{"enabled":false,"filepath":"","format":"","template":"","transmission":{"url":"","user":""},"deluge":{"url":"","user":""},"relay":""}},"retry":{"initialWait":0,"maxWait":0,"switchServerAfter":0,"failedServerCooldown":0,"authFailedAttempts":0,"authFailedAction":""},"profilesFilepath":"","extraTunnels":null,"credentialsFilepath":"","credentialsPeriod":0,"persistTun":false,"interface":"","routeNoPull":false,"routes":null}`, string(data))
 	var out OpenVPN
 	err = json.Unmarshal(data, &out)
 	require.NoError(t, err)
 	assert.Equal(t, in, out)
 }
+
+func Test_checkDataCiphers(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		vpnProvider models.VPNProvider
+		cipher      string
+		dataCiphers []string
+		err         error
+	}{
+		"no data ciphers": {
+			vpnProvider: constants.Cyberghost,
+		},
+		"cyberghost": {
+			vpnProvider: constants.Cyberghost,
+			dataCiphers: []string{"aes-256-gcm"},
+			err:         fmt.Errorf("data ciphers cannot be set for cyberghost since its configuration disables cipher negotiation"), //nolint:lll
+		},
+		"pia with gcm cipher": {
+			vpnProvider: constants.PrivateInternetAccess,
+			cipher:      "aes-256-gcm",
+			dataCiphers: []string{"aes-256-gcm"},
+			err:         fmt.Errorf("data ciphers cannot be set for private internet access with cipher aes-256-gcm since cipher negotiation is disabled"), //nolint:lll
+		},
+		"cipher not in data ciphers": {
+			vpnProvider: constants.Mullvad,
+			cipher:      "aes-256-cbc",
+			dataCiphers: []string{"aes-256-gcm", "aes-128-gcm"},
+			err:         fmt.Errorf("cipher aes-256-cbc must be one of the data ciphers aes-256-gcm, aes-128-gcm"),
+		},
+		"cipher in data ciphers": {
+			vpnProvider: constants.Mullvad,
+			cipher:      "AES-256-GCM",
+			dataCiphers: []string{"aes-256-gcm", "aes-128-gcm"},
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := checkDataCiphers(tc.vpnProvider, tc.cipher, tc.dataCiphers)
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.err.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_checkCompression(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		vpnProvider models.VPNProvider
+		compression string
+		err         error
+	}{
+		"no compression": {
+			vpnProvider: constants.Nordvpn,
+		},
+		"lzo server with stub": {
+			vpnProvider: constants.Windscribe,
+			compression: constants.OpenVPNCompressionStub,
+		},
+		"lzo server with lz4-v2": {
+			vpnProvider: constants.Vyprvpn,
+			compression: constants.OpenVPNCompressionLZ4V2,
+			err:         fmt.Errorf("compression lz4-v2 cannot be used with vyprvpn whose servers use LZO compression, use lzo or stub"), //nolint:lll
+		},
+		"lzo server with off": {
+			vpnProvider: constants.Nordvpn,
+			compression: constants.OpenVPNCompressionOff,
+			err:         fmt.Errorf("compression off cannot be used with nordvpn whose servers use LZO compression, use lzo or stub"), //nolint:lll
+		},
+		"pia with off": {
+			vpnProvider: constants.PrivateInternetAccess,
+			compression: constants.OpenVPNCompressionOff,
+			err:         fmt.Errorf("compression off cannot be used with private internet access whose servers choose the compression to use"), //nolint:lll
+		},
+		"pia without compression": {
+			vpnProvider: constants.PrivateInternetAccess,
+		},
+		"other provider": {
+			vpnProvider: constants.Mullvad,
+			compression: constants.OpenVPNCompressionOff,
+		},
+	}
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := checkCompression(tc.vpnProvider, tc.compression)
+			if tc.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, tc.err.Error(), err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package settings
 
 import (
 	"fmt"
+	"text/template"
 
 	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
@@ -19,6 +20,10 @@ func GetPIASettings(paramsReader params.Reader) (settings models.ProviderSetting
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
 	encryptionPreset, err := paramsReader.GetPIAEncryptionPreset()
 	if err != nil {
 		return settings, err
@@ -33,11 +38,40 @@ func GetPIASettings(paramsReader params.Reader) (settings models.ProviderSetting
 	if err != nil {
 		return settings, err
 	}
-	if settings.PortForwarding.Enabled {
-		settings.PortForwarding.Filepath, err = paramsReader.GetPortForwardingStatusFilepath()
+	settings.ServerSelection.PortForwardOnly = settings.PortForwarding.Enabled
+	// the port forwarding settings are read even if it is disabled,
+	// since it can be enabled at runtime through the control server.
+	settings.ServerSelection.PortForwardReselect, err = paramsReader.GetPortForwardingReselect()
+	if err != nil {
+		return settings, err
+	}
+	settings.PortForwarding.Filepath, err = paramsReader.GetPortForwardingStatusFilepath()
+	if err != nil {
+		return settings, err
+	}
+	settings.PortForwarding.Format, err = paramsReader.GetPortForwardingStatusFileFormat()
+	if err != nil {
+		return settings, err
+	}
+	if settings.PortForwarding.Format == constants.PortForwardingFormatTemplate {
+		settings.PortForwarding.Template, err = paramsReader.GetPortForwardingStatusFileTemplate()
 		if err != nil {
 			return settings, err
 		}
+		if _, err := template.New("").Parse(settings.PortForwarding.Template); err != nil {
+			err = paramsReader.CollectError(fmt.Errorf("port forwarding status file template is invalid: %w", err))
+			if err != nil {
+				return settings, err
+			}
+		}
+	}
+	settings.PortForwarding.Transmission, settings.PortForwarding.Deluge, err = getTorrentClientsSettings(paramsReader)
+	if err != nil {
+		return settings, err
+	}
+	settings.PortForwarding.Relay, err = paramsReader.GetPortForwardingRelay()
+	if err != nil {
+		return settings, err
 	}
 	return settings, nil
 }
@@ -53,6 +87,10 @@ func GetMullvadSettings(paramsReader params.Reader) (settings models.ProviderSet
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
 	settings.ServerSelection.Countries, err = paramsReader.GetMullvadCountries()
 	if err != nil {
 		return settings, err
@@ -73,15 +111,27 @@ func GetMullvadSettings(paramsReader params.Reader) (settings models.ProviderSet
 		switch settings.ServerSelection.CustomPort {
 		case 0, 80, 443, 1401: //nolint:gomnd
 		default:
-			return settings, fmt.Errorf("port %d is not valid for TCP protocol", settings.ServerSelection.CustomPort)
+			err = paramsReader.CollectError(fmt.Errorf(
+				"port %d is not valid for TCP protocol", settings.ServerSelection.CustomPort))
+			if err != nil {
+				return settings, err
+			}
 		}
 	} else {
 		switch settings.ServerSelection.CustomPort {
 		case 0, 53, 1194, 1195, 1196, 1197, 1300, 1301, 1302, 1303, 1400: //nolint:gomnd
 		default:
-			return settings, fmt.Errorf("port %d is not valid for UDP protocol", settings.ServerSelection.CustomPort)
+			err = paramsReader.CollectError(fmt.Errorf(
+				"port %d is not valid for UDP protocol", settings.ServerSelection.CustomPort))
+			if err != nil {
+				return settings, err
+			}
 		}
 	}
+	settings.ServerSelection.IPv6Endpoint, err = paramsReader.GetOpenVPNIPv6Endpoint()
+	if err != nil {
+		return settings, err
+	}
 	settings.ServerSelection.Owned, err = paramsReader.GetMullvadOwned()
 	if err != nil {
 		return settings, err
@@ -134,10 +184,18 @@ func GetSurfsharkSettings(paramsReader params.Reader) (settings models.ProviderS
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
 	settings.ServerSelection.Regions, err = paramsReader.GetSurfsharkRegions()
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.CustomPort, err = paramsReader.GetCustomPort()
+	if err != nil {
+		return settings, err
+	}
 	return settings, nil
 }
 
@@ -152,6 +210,10 @@ func GetCyberghostSettings(paramsReader params.Reader) (settings models.Provider
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
 	settings.ExtraConfigOptions.ClientKey, err = paramsReader.GetCyberghostClientKey()
 	if err != nil {
 		return settings, err
@@ -182,10 +244,18 @@ func GetVyprvpnSettings(paramsReader params.Reader) (settings models.ProviderSet
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
 	settings.ServerSelection.Regions, err = paramsReader.GetVyprvpnRegions()
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.CustomPort, err = paramsReader.GetCustomPort()
+	if err != nil {
+		return settings, err
+	}
 	return settings, nil
 }
 
@@ -200,6 +270,10 @@ func GetNordvpnSettings(paramsReader params.Reader) (settings models.ProviderSet
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
 	settings.ServerSelection.Regions, err = paramsReader.GetNordvpnRegions()
 	if err != nil {
 		return settings, err
@@ -208,12 +282,16 @@ func GetNordvpnSettings(paramsReader params.Reader) (settings models.ProviderSet
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Categories, err = paramsReader.GetNordvpnCategories()
+	if err != nil {
+		return settings, err
+	}
 	return settings, nil
 }
 
 // GetPurevpnSettings obtains Purevpn settings from environment variables using the params package.
 func GetPurevpnSettings(paramsReader params.Reader) (settings models.ProviderSettings, err error) {
-	settings.Name = constants.Mullvad
+	settings.Name = constants.Purevpn
 	settings.ServerSelection.Protocol, err = paramsReader.GetNetworkProtocol()
 	if err != nil {
 		return settings, err
@@ -222,6 +300,10 @@ func GetPurevpnSettings(paramsReader params.Reader) (settings models.ProviderSet
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
 	settings.ServerSelection.Regions, err = paramsReader.GetPurevpnRegions()
 	if err != nil {
 		return settings, err
@@ -234,6 +316,10 @@ func GetPurevpnSettings(paramsReader params.Reader) (settings models.ProviderSet
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.CustomPort, err = paramsReader.GetCustomPort()
+	if err != nil {
+		return settings, err
+	}
 	return settings, nil
 }
 
@@ -248,9 +334,191 @@ func GetPrivadoSettings(paramsReader params.Reader) (settings models.ProviderSet
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.Countries, err = paramsReader.GetPrivadoCountries()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Cities, err = paramsReader.GetPrivadoCities()
+	if err != nil {
+		return settings, err
+	}
 	settings.ServerSelection.Hostnames, err = paramsReader.GetPrivadoHostnames()
 	if err != nil {
 		return settings, err
 	}
+	settings.ServerSelection.CustomPort, err = paramsReader.GetCustomPort()
+	if err != nil {
+		return settings, err
+	}
 	return settings, nil
 }
+
+// GetVPNUnlimitedSettings obtains VPN Unlimited settings from environment variables using the params package.
+func GetVPNUnlimitedSettings(paramsReader params.Reader) (settings models.ProviderSettings, err error) {
+	settings.Name = constants.VPNUnlimited
+	settings.ServerSelection.Protocol, err = paramsReader.GetNetworkProtocol()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.TargetIP, err = paramsReader.GetTargetIP()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.ClientKey, err = paramsReader.GetVPNUnlimitedClientKey()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.ClientCertificate, err = paramsReader.GetVPNUnlimitedClientCertificate()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.CertificateAuthority, err = paramsReader.GetVPNUnlimitedCertificateAuthority()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Countries, err = paramsReader.GetVPNUnlimitedCountries()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Cities, err = paramsReader.GetVPNUnlimitedCities()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.CustomPort, err = paramsReader.GetCustomPort()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// GetHideMyAssSettings obtains HideMyAss settings from environment variables using the params package.
+func GetHideMyAssSettings(paramsReader params.Reader) (settings models.ProviderSettings, err error) {
+	settings.Name = constants.HideMyAss
+	settings.ServerSelection.Protocol, err = paramsReader.GetNetworkProtocol()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.TargetIP, err = paramsReader.GetTargetIP()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.ClientKey, err = paramsReader.GetHideMyAssClientKey()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.ClientCertificate, err = paramsReader.GetHideMyAssClientCertificate()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.CertificateAuthority, err = paramsReader.GetHideMyAssCertificateAuthority()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Countries, err = paramsReader.GetHideMyAssCountries()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Regions, err = paramsReader.GetHideMyAssRegions()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Cities, err = paramsReader.GetHideMyAssCities()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.CustomPort, err = paramsReader.GetCustomPort()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// GetWeVPNSettings obtains WeVPN settings from environment variables using the params package.
+func GetWeVPNSettings(paramsReader params.Reader) (settings models.ProviderSettings, err error) {
+	settings.Name = constants.WeVPN
+	settings.ServerSelection.Protocol, err = paramsReader.GetNetworkProtocol()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.TargetIP, err = paramsReader.GetTargetIP()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.CertificateAuthority, err = paramsReader.GetWeVPNCertificateAuthority()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraConfigOptions.TLSCryptKey, err = paramsReader.GetWeVPNTLSCryptKey()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Countries, err = paramsReader.GetWeVPNCountries()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Cities, err = paramsReader.GetWeVPNCities()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.Hostnames, err = paramsReader.GetServerHostnames()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServerSelection.CustomPort, err = paramsReader.GetCustomPort()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
+
+// GetProviderExtraConfig obtains the certificates, keys and options the VPN
+// provider given requires from environment variables using the params package,
+// to switch provider with a profile.
+func GetProviderExtraConfig(paramsReader params.Reader, vpnProvider models.VPNProvider) (
+	extra models.ExtraConfigOptions, err error) {
+	switch vpnProvider {
+	case constants.PrivateInternetAccess:
+		extra.EncryptionPreset, err = paramsReader.GetPIAEncryptionPreset()
+	case constants.Cyberghost:
+		extra.ClientKey, err = paramsReader.GetCyberghostClientKey()
+		if err != nil {
+			return extra, err
+		}
+		extra.ClientCertificate, err = paramsReader.GetCyberghostClientCertificate()
+	case constants.VPNUnlimited:
+		extra.ClientKey, err = paramsReader.GetVPNUnlimitedClientKey()
+		if err != nil {
+			return extra, err
+		}
+		extra.ClientCertificate, err = paramsReader.GetVPNUnlimitedClientCertificate()
+		if err != nil {
+			return extra, err
+		}
+		extra.CertificateAuthority, err = paramsReader.GetVPNUnlimitedCertificateAuthority()
+	case constants.HideMyAss:
+		extra.ClientKey, err = paramsReader.GetHideMyAssClientKey()
+		if err != nil {
+			return extra, err
+		}
+		extra.ClientCertificate, err = paramsReader.GetHideMyAssClientCertificate()
+		if err != nil {
+			return extra, err
+		}
+		extra.CertificateAuthority, err = paramsReader.GetHideMyAssCertificateAuthority()
+	case constants.WeVPN:
+		extra.CertificateAuthority, err = paramsReader.GetWeVPNCertificateAuthority()
+		if err != nil {
+			return extra, err
+		}
+		extra.TLSCryptKey, err = paramsReader.GetWeVPNTLSCryptKey()
+	}
+	return extra, err
+}
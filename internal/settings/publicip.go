@@ -0,0 +1,126 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// PublicIP contains settings to check the public IP address.
+type PublicIP struct {
+	Period time.Duration
+	// Methods are the methods to try in order to obtain the public IP address.
+	Methods []string
+	// LeakCheck is true to record the ISP public IP address before the tunnel
+	// is up and to check the public IP address is never equal to it.
+	LeakCheck bool
+	// LeakStopProxies is true to stop the HTTP proxy and Shadowsocks
+	// if a leak is detected.
+	LeakStopProxies bool
+	// EgressCheck is true to check the public IP address belongs
+	// to the VPN provider.
+	EgressCheck bool
+	// JSONFilepath is the path of the JSON file to write the public IP
+	// information to, and is empty to disable it.
+	JSONFilepath string
+	// JSONHistory is true to keep previous records in the JSON file.
+	JSONHistory bool
+	// GeolocationAPIs are the geolocation APIs to try in order,
+	// and is empty to disable the geolocation.
+	GeolocationAPIs []string
+	// GeolocationTokens maps geolocation API names to their token.
+	GeolocationTokens map[string]string
+}
+
+func (p *PublicIP) String() string {
+	period := disabled
+	if p.Period > 0 {
+		period = p.Period.String()
+	}
+	settingsList := []string{
+		"Public IP:",
+		"Check period: " + period,
+		"Methods: " + strings.Join(p.Methods, ", "),
+	}
+	if p.LeakCheck {
+		leakCheck := "Leak check: enabled"
+		if p.LeakStopProxies {
+			leakCheck += ", stopping proxies on leak"
+		}
+		settingsList = append(settingsList, leakCheck)
+	}
+	if p.EgressCheck {
+		settingsList = append(settingsList, "Egress check: enabled")
+	}
+	if p.JSONFilepath != "" {
+		jsonFile := "JSON file: " + p.JSONFilepath
+		if p.JSONHistory {
+			jsonFile += " with history"
+		}
+		settingsList = append(settingsList, jsonFile)
+	}
+	if len(p.GeolocationAPIs) > 0 {
+		settingsList = append(settingsList, "Geolocation APIs: "+strings.Join(p.GeolocationAPIs, ", "))
+	}
+	return strings.Join(settingsList, "\n |--")
+}
+
+// GetPublicIPSettings obtains the public IP settings from
+// environment variables using the params package.
+func GetPublicIPSettings(paramsReader params.Reader) (settings PublicIP, err error) {
+	settings.Period, err = paramsReader.GetPublicIPPeriod()
+	if err != nil {
+		return settings, err
+	}
+	settings.Methods, err = paramsReader.GetPublicIPMethods()
+	if err != nil {
+		return settings, err
+	}
+	settings.LeakCheck, err = paramsReader.GetPublicIPLeakCheck()
+	if err != nil {
+		return settings, err
+	}
+	settings.LeakStopProxies, err = paramsReader.GetPublicIPLeakStopProxies()
+	if err != nil {
+		return settings, err
+	}
+	settings.EgressCheck, err = paramsReader.GetPublicIPEgressCheck()
+	if err != nil {
+		return settings, err
+	}
+	settings.JSONFilepath, err = paramsReader.GetPublicIPJSONFilepath()
+	if err != nil {
+		return settings, err
+	}
+	settings.JSONHistory, err = paramsReader.GetPublicIPJSONHistory()
+	if err != nil {
+		return settings, err
+	}
+	settings.GeolocationAPIs, err = paramsReader.GetPublicIPGeolocation()
+	if err != nil {
+		return settings, err
+	}
+	settings.GeolocationTokens = make(map[string]string, len(settings.GeolocationAPIs))
+	for _, api := range settings.GeolocationAPIs {
+		token, err := paramsReader.GetPublicIPGeolocationToken(api)
+		if err != nil {
+			return settings, err
+		} else if token == "" && api == constants.IPData {
+			err = paramsReader.CollectError(fmt.Errorf("geolocation API %s requires an API key", api))
+			if err != nil {
+				return settings, err
+			}
+		}
+		settings.GeolocationTokens[api] = token
+	}
+	if settings.LeakCheck && settings.Period == 0 {
+		err = paramsReader.CollectError(fmt.Errorf("public IP leak check requires a public IP check period"))
+		if err != nil {
+			return settings, err
+		}
+	}
+	return settings, nil
+}
@@ -2,15 +2,24 @@ package settings
 
 import (
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/params"
 )
 
 // ControlServer contains settings to customize the control server operation.
 type ControlServer struct {
-	Port uint16
-	Log  bool
+	Port         uint16
+	Log          bool
+	AuthFilepath string
+	// ListeningAddress is the IP address to listen on, all interfaces if nil.
+	ListeningAddress net.IP
+	// ExtraListeners are additional listeners, for example to serve
+	// clients on localhost without API key.
+	ExtraListeners []models.ControlServerListener
 }
 
 func (c *ControlServer) String() string {
@@ -19,6 +28,19 @@ func (c *ControlServer) String() string {
 		fmt.Sprintf("Listening port: %d", c.Port),
 		fmt.Sprintf("Logging: %t", c.Log),
 	}
+	if c.ListeningAddress != nil {
+		settingsList = append(settingsList, "Listening address: "+c.ListeningAddress.String())
+	}
+	if c.AuthFilepath != "" {
+		settingsList = append(settingsList, "API keys file: "+c.AuthFilepath)
+	}
+	for _, listener := range c.ExtraListeners {
+		auth := "with API key"
+		if !listener.Auth {
+			auth = "without API key"
+		}
+		settingsList = append(settingsList, fmt.Sprintf("Extra listener: %s %s", listener.Address, auth))
+	}
 	return strings.Join(settingsList, "\n |--")
 }
 
@@ -33,5 +55,43 @@ func GetControlServerSettings(paramsReader params.Reader) (settings ControlServe
 	if err != nil {
 		return settings, err
 	}
+	settings.AuthFilepath, err = paramsReader.GetControlServerAuthFilepath()
+	if err != nil {
+		return settings, err
+	}
+	settings.ListeningAddress, err = paramsReader.GetControlServerListeningAddress()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraListeners, err = paramsReader.GetControlServerExtraListeners()
+	if err != nil {
+		return settings, err
+	}
+	addresses := map[string]struct{}{}
+	for _, listener := range settings.Listeners() {
+		if _, ok := addresses[listener.Address]; ok {
+			err = paramsReader.CollectError(fmt.Errorf(
+				"control server listening address %s is used more than once", listener.Address))
+			if err != nil {
+				return settings, err
+			}
+		}
+		addresses[listener.Address] = struct{}{}
+	}
 	return settings, nil
 }
+
+// Listeners returns the main listener, requiring an API key if authentication
+// is enabled, followed by the extra listeners.
+func (c *ControlServer) Listeners() (listeners []models.ControlServerListener) {
+	host := "0.0.0.0"
+	if c.ListeningAddress != nil {
+		host = c.ListeningAddress.String()
+	}
+	listeners = make([]models.ControlServerListener, 0, 1+len(c.ExtraListeners))
+	listeners = append(listeners, models.ControlServerListener{
+		Address: net.JoinHostPort(host, strconv.Itoa(int(c.Port))),
+		Auth:    true,
+	})
+	return append(listeners, c.ExtraListeners...)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/params"
 )
@@ -17,16 +18,23 @@ const (
 // Settings contains all settings for the program to run.
 type Settings struct {
 	VPNSP              models.VPNProvider
+	VPNType            string
 	OpenVPN            OpenVPN
+	Wireguard          Wireguard
 	System             System
 	DNS                DNS
 	Firewall           Firewall
 	HTTPProxy          HTTPProxy
 	ShadowSocks        ShadowSocks
-	PublicIPPeriod     time.Duration
+	TransparentProxy   TransparentProxy
+	PublicIP           PublicIP
 	UpdaterPeriod      time.Duration
 	VersionInformation bool
 	ControlServer      ControlServer
+	Tracing            Tracing
+	Health             Health
+	Sidecar            Sidecar
+	Telegram           Telegram
 }
 
 func (s *Settings) String() string {
@@ -38,68 +46,149 @@ func (s *Settings) String() string {
 	if s.UpdaterPeriod > 0 {
 		updaterLine = fmt.Sprintf("Updater period: %s", s.UpdaterPeriod)
 	}
+	vpnSettings := s.OpenVPN.String()
+	if s.VPNType == constants.Wireguard {
+		vpnSettings = s.Wireguard.String()
+	}
 	return strings.Join([]string{
 		"Settings summary below:",
-		s.OpenVPN.String(),
+		vpnSettings,
 		s.System.String(),
 		s.DNS.String(),
 		s.Firewall.String(),
 		s.HTTPProxy.String(),
 		s.ShadowSocks.String(),
+		s.TransparentProxy.String(),
 		s.ControlServer.String(),
-		"Public IP check period: " + s.PublicIPPeriod.String(), // TODO print disabled if 0
+		s.PublicIP.String(),
+		s.Tracing.String(),
+		s.Health.String(),
+		s.Sidecar.String(),
+		s.Telegram.String(),
 		"Version information: " + versionInformation,
 		updaterLine,
 		"", // new line at the end
 	}, "\n")
 }
 
-// GetAllSettings obtains all settings for the program and returns an error as soon
-// as an error is encountered reading them.
+// Secrets returns the secret values of the settings, such as credentials,
+// keys and tokens, which must not appear in the logs.
+func (s *Settings) Secrets() (secrets []string) {
+	secrets = []string{
+		s.OpenVPN.User,
+		s.OpenVPN.Password,
+		s.OpenVPN.ScrambleKey,
+		s.Wireguard.PrivateKey,
+		s.Wireguard.User,
+		s.Wireguard.Password,
+		s.OpenVPN.Provider.PortForwarding.Transmission.Password,
+		s.OpenVPN.Provider.PortForwarding.Deluge.Password,
+		s.HTTPProxy.User,
+		s.HTTPProxy.Password,
+		s.ShadowSocks.Password,
+		s.Telegram.Token,
+	}
+	for _, listener := range s.ShadowSocks.ExtraListeners {
+		secrets = append(secrets, listener.Password)
+	}
+	for _, token := range s.PublicIP.GeolocationTokens {
+		secrets = append(secrets, token)
+	}
+	if s.HTTPProxy.Upstream != nil && s.HTTPProxy.Upstream.User != nil {
+		password, _ := s.HTTPProxy.Upstream.User.Password()
+		secrets = append(secrets, password)
+	}
+	return secrets
+}
+
+// VPNInterface returns the name of the tunnel interface of the VPN type used.
+func (s *Settings) VPNInterface() string {
+	if s.VPNType == constants.Wireguard {
+		return s.Wireguard.Interface
+	}
+	return s.OpenVPN.Interface
+}
+
+// GetAllSettings obtains all settings for the program. It reads all of them
+// before returning an error, listing all the invalid settings found, so they
+// can all be fixed at once.
 func GetAllSettings(paramsReader params.Reader) (settings Settings, err error) {
-	settings.VPNSP, err = paramsReader.GetVPNSP()
-	if err != nil {
-		return settings, err
+	var errs []error
+	check := func(err error) {
+		if err != nil {
+			errs = append(errs, err)
+		}
 	}
-	settings.OpenVPN, err = GetOpenVPNSettings(paramsReader, settings.VPNSP)
-	if err != nil {
-		return settings, err
+
+	settings.VPNSP, err = paramsReader.GetVPNSP()
+	vpnspErr := err
+	stopCollecting := paramsReader.CollectErrors()
+	settings.VPNType, err = paramsReader.GetVPNType()
+	check(err)
+	switch {
+	case vpnspErr != nil: // the VPN settings depend on the provider
+	case settings.VPNType == constants.Wireguard:
+		settings.Wireguard, err = GetWireguardSettings(paramsReader, settings.VPNSP)
+		check(err)
+		check(checkNoExtraTunnels(paramsReader))
+	default:
+		settings.OpenVPN, err = GetOpenVPNSettings(paramsReader, settings.VPNSP)
+		check(err)
 	}
 	settings.DNS, err = GetDNSSettings(paramsReader)
-	if err != nil {
-		return settings, err
-	}
+	check(err)
 	settings.Firewall, err = GetFirewallSettings(paramsReader)
-	if err != nil {
-		return settings, err
-	}
+	check(err)
+	settings.Firewall.OutboundPorts = append(settings.Firewall.OutboundPorts,
+		settings.DNS.localResolverPorts()...)
 	settings.HTTPProxy, err = GetHTTPProxySettings(paramsReader)
-	if err != nil {
-		return settings, err
-	}
+	check(err)
 	settings.ShadowSocks, err = GetShadowSocksSettings(paramsReader)
-	if err != nil {
-		return settings, err
-	}
+	check(err)
+	settings.TransparentProxy, err = GetTransparentProxySettings(paramsReader)
+	check(err)
 	settings.System, err = GetSystemSettings(paramsReader)
-	if err != nil {
-		return settings, err
-	}
-	settings.PublicIPPeriod, err = paramsReader.GetPublicIPPeriod()
-	if err != nil {
-		return settings, err
+	check(err)
+	if settings.VPNType != constants.Wireguard && !settings.OpenVPN.Root {
+		check(settings.Firewall.checkForcedUID(settings.System.UID))
 	}
+	settings.PublicIP, err = GetPublicIPSettings(paramsReader)
+	check(err)
 	settings.VersionInformation, err = paramsReader.GetVersionInformation()
-	if err != nil {
-		return settings, err
-	}
+	check(err)
 	settings.UpdaterPeriod, err = paramsReader.GetUpdaterPeriod()
-	if err != nil {
-		return settings, err
-	}
+	check(err)
 	settings.ControlServer, err = GetControlServerSettings(paramsReader)
-	if err != nil {
-		return settings, err
+	check(err)
+	settings.Tracing, err = GetTracingSettings(paramsReader)
+	check(err)
+	settings.Health, err = GetHealthSettings(paramsReader)
+	check(err)
+	settings.Sidecar, err = GetSidecarSettings(paramsReader)
+	check(err)
+	settings.Telegram, err = GetTelegramSettings(paramsReader)
+	check(err)
+
+	// errors of invalid variables first, in the order they are read
+	errs = append(stopCollecting(), errs...)
+	if vpnspErr != nil {
+		errs = append([]error{vpnspErr}, errs...)
+	}
+	return settings, joinErrors(errs)
+}
+
+// joinErrors returns nil if there is no error, the error itself if there is
+// a single error, or an error listing all the errors given otherwise.
+func joinErrors(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = "- " + err.Error()
 	}
-	return settings, nil
+	return fmt.Errorf("%d invalid settings:\n%s", len(errs), strings.Join(messages, "\n"))
 }
@@ -0,0 +1,40 @@
+package settings
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_joinErrors(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		errs []error
+		err  error
+	}{
+		"no error": {},
+		"single error": {
+			errs: []error{errors.New("first")},
+			err:  errors.New("first"),
+		},
+		"multiple errors": {
+			errs: []error{errors.New("first"), errors.New("second")},
+			err:  errors.New("2 invalid settings:\n- first\n- second"),
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := joinErrors(testCase.errs)
+			if testCase.err != nil {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package settings
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/params"
 )
 
@@ -14,22 +17,41 @@ type ShadowSocks struct {
 	Port     uint16
 	Enabled  bool
 	Log      bool
+	UDP      bool
+	// ExtraListeners are additional listeners each with their own
+	// port, method and password.
+	ExtraListeners []models.ShadowSocksListener
+	// ListeningAddress is the IP address the listeners listen on,
+	// all interfaces are listened on if it is nil.
+	ListeningAddress net.IP
 }
 
 func (s *ShadowSocks) String() string {
 	if !s.Enabled {
 		return "ShadowSocks settings: disabled"
 	}
-	log := disabled
+	log, udp := disabled, disabled
 	if s.Log {
 		log = enabled
 	}
+	if s.UDP {
+		udp = enabled
+	}
 	settingsList := []string{
 		"ShadowSocks settings:",
 		"Password: [redacted]",
 		"Log: " + log,
 		fmt.Sprintf("Port: %d", s.Port),
 		"Method: " + s.Method,
+		"UDP relay: " + udp,
+	}
+	if s.ListeningAddress != nil {
+		settingsList = append(settingsList, "Listening address: "+s.ListeningAddress.String())
+	}
+	for _, listener := range s.ExtraListeners {
+		settingsList = append(settingsList,
+			fmt.Sprintf("Listener %s: port %d, method %s, password [redacted]",
+				listener.Name, listener.Port, listener.Method))
 	}
 	return strings.Join(settingsList, "\n |--")
 }
@@ -44,6 +66,10 @@ func GetShadowSocksSettings(paramsReader params.Reader) (settings ShadowSocks, e
 	if err != nil {
 		return settings, err
 	}
+	settings.ListeningAddress, err = paramsReader.GetShadowSocksListeningAddress()
+	if err != nil {
+		return settings, err
+	}
 	settings.Password, err = paramsReader.GetShadowSocksPassword()
 	if err != nil {
 		return settings, err
@@ -56,5 +82,31 @@ func GetShadowSocksSettings(paramsReader params.Reader) (settings ShadowSocks, e
 	if err != nil {
 		return settings, err
 	}
+	settings.UDP, err = paramsReader.GetShadowSocksUDP()
+	if err != nil {
+		return settings, err
+	}
+	settings.ExtraListeners, err = paramsReader.GetShadowSocksExtraListeners()
+	if err != nil {
+		return settings, err
+	}
+	names := map[string]struct{}{constants.ShadowsocksDefaultListener: {}}
+	ports := map[uint16]struct{}{settings.Port: {}}
+	for _, listener := range settings.ExtraListeners {
+		if _, ok := names[listener.Name]; ok {
+			err = paramsReader.CollectError(fmt.Errorf("Shadowsocks listener name %q is used more than once", listener.Name))
+			if err != nil {
+				return settings, err
+			}
+		}
+		names[listener.Name] = struct{}{}
+		if _, ok := ports[listener.Port]; ok {
+			err = paramsReader.CollectError(fmt.Errorf("Shadowsocks listener port %d is used more than once", listener.Port))
+			if err != nil {
+				return settings, err
+			}
+		}
+		ports[listener.Port] = struct{}{}
+	}
 	return settings, nil
 }
@@ -0,0 +1,44 @@
+package settings
+
+import (
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// Sidecar contains settings to shut down once the main
+// container of a Kubernetes pod exits.
+type Sidecar struct {
+	// Kind is the kind of target watched, and the
+	// sidecar mode is disabled if it is empty.
+	Kind   string
+	Target string
+	Period time.Duration
+}
+
+func (s *Sidecar) String() string {
+	if s.Kind == "" {
+		return "Sidecar: disabled"
+	}
+	settingsList := []string{
+		"Sidecar:",
+		"Watching " + s.Kind + " " + s.Target,
+		"Period: " + s.Period.String(),
+	}
+	return strings.Join(settingsList, "\n |--")
+}
+
+// GetSidecarSettings obtains the sidecar settings from
+// environment variables using the params package.
+func GetSidecarSettings(paramsReader params.Reader) (settings Sidecar, err error) {
+	settings.Kind, settings.Target, err = paramsReader.GetSidecarTarget()
+	if err != nil || settings.Kind == "" {
+		return settings, err
+	}
+	settings.Period, err = paramsReader.GetSidecarPeriod()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
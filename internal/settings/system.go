@@ -2,6 +2,7 @@ package settings
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/qdm12/gluetun/internal/models"
@@ -14,6 +15,15 @@ type System struct {
 	GID              int
 	Timezone         string
 	IPStatusFilepath models.Filepath
+	LogFilter        *regexp.Regexp
+	// LogTimeLayout is the Go time layout of log timestamps,
+	// and timestamps are not logged if it is empty.
+	LogTimeLayout string
+	LogTimeUTC    bool
+	LogColor      string
+	// OverridesFilepath is the file where settings changed at
+	// runtime are persisted, if not empty.
+	OverridesFilepath string
 }
 
 // GetSystemSettings obtains the System settings using the params functions.
@@ -34,6 +44,26 @@ func GetSystemSettings(paramsReader params.Reader) (settings System, err error)
 	if err != nil {
 		return settings, err
 	}
+	settings.LogFilter, err = paramsReader.GetLogFilter()
+	if err != nil {
+		return settings, err
+	}
+	settings.LogTimeLayout, err = paramsReader.GetLogTimeLayout()
+	if err != nil {
+		return settings, err
+	}
+	settings.LogTimeUTC, err = paramsReader.GetLogTimeUTC()
+	if err != nil {
+		return settings, err
+	}
+	settings.LogColor, err = paramsReader.GetLogColor()
+	if err != nil {
+		return settings, err
+	}
+	settings.OverridesFilepath, err = paramsReader.GetOverridesFilepath()
+	if err != nil {
+		return settings, err
+	}
 	return settings, nil
 }
 
@@ -45,5 +75,21 @@ func (s *System) String() string {
 		fmt.Sprintf("Timezone: %s", s.Timezone),
 		fmt.Sprintf("IP Status filepath: %s", s.IPStatusFilepath),
 	}
+	timestamps := "none"
+	if s.LogTimeLayout != "" {
+		timestamps = s.LogTimeLayout
+		if s.LogTimeUTC {
+			timestamps += " in UTC"
+		}
+	}
+	settingsList = append(settingsList,
+		"Log timestamps: "+timestamps,
+		"Log colors: "+s.LogColor)
+	if s.LogFilter != nil {
+		settingsList = append(settingsList, "Log filter: "+s.LogFilter.String())
+	}
+	if s.OverridesFilepath != "" {
+		settingsList = append(settingsList, "Settings overrides file: "+s.OverridesFilepath)
+	}
 	return strings.Join(settingsList, "\n|--")
 }
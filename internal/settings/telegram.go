@@ -0,0 +1,46 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// Telegram contains settings for the Telegram bot reporting
+// the VPN events and answering commands.
+type Telegram struct {
+	// Token is the bot token, and the bot is disabled if it is empty.
+	Token string
+	// ChatIDs are the chats allowed to use the bot and notified of the events.
+	ChatIDs []int64
+}
+
+func (t *Telegram) String() string {
+	if t.Token == "" {
+		return "Telegram bot: disabled"
+	}
+	chatIDs := make([]string, len(t.ChatIDs))
+	for i, chatID := range t.ChatIDs {
+		chatIDs[i] = fmt.Sprintf("%d", chatID)
+	}
+	settingsList := []string{
+		"Telegram bot:",
+		"Allowed chats: " + strings.Join(chatIDs, ", "),
+	}
+	return strings.Join(settingsList, "\n |--")
+}
+
+// GetTelegramSettings obtains the Telegram bot settings from
+// environment variables using the params package.
+func GetTelegramSettings(paramsReader params.Reader) (settings Telegram, err error) {
+	settings.Token, err = paramsReader.GetTelegramToken()
+	if err != nil || settings.Token == "" {
+		return settings, err
+	}
+	settings.ChatIDs, err = paramsReader.GetTelegramChatIDs()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,33 @@
+package settings
+
+import (
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// getTorrentClientsSettings obtains the settings of the torrent clients to update
+// with the forwarded port from environment variables using the params package.
+func getTorrentClientsSettings(paramsReader params.Reader) (
+	transmission, deluge models.TorrentClient, err error) {
+	transmission.URL, err = paramsReader.GetTransmissionURL()
+	if err != nil {
+		return transmission, deluge, err
+	}
+	transmission.User, err = paramsReader.GetTransmissionUser()
+	if err != nil {
+		return transmission, deluge, err
+	}
+	transmission.Password, err = paramsReader.GetTransmissionPassword()
+	if err != nil {
+		return transmission, deluge, err
+	}
+	deluge.URL, err = paramsReader.GetDelugeURL()
+	if err != nil {
+		return transmission, deluge, err
+	}
+	deluge.Password, err = paramsReader.GetDelugePassword()
+	if err != nil {
+		return transmission, deluge, err
+	}
+	return transmission, deluge, nil
+}
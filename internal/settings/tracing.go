@@ -0,0 +1,42 @@
+package settings
+
+import (
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// Tracing contains settings to export spans of the major
+// operations to an OpenTelemetry collector.
+type Tracing struct {
+	// Endpoint is the OTLP HTTP endpoint, and tracing
+	// is disabled if it is empty.
+	Endpoint    string
+	ServiceName string
+}
+
+func (t *Tracing) String() string {
+	if t.Endpoint == "" {
+		return "Tracing: disabled"
+	}
+	settingsList := []string{
+		"Tracing:",
+		"OTLP endpoint: " + t.Endpoint,
+		"Service name: " + t.ServiceName,
+	}
+	return strings.Join(settingsList, "\n |--")
+}
+
+// GetTracingSettings obtains the tracing settings from
+// environment variables using the params package.
+func GetTracingSettings(paramsReader params.Reader) (settings Tracing, err error) {
+	settings.Endpoint, err = paramsReader.GetTracingEndpoint()
+	if err != nil {
+		return settings, err
+	}
+	settings.ServiceName, err = paramsReader.GetTracingServiceName()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,50 @@
+package settings
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// TransparentProxy contains settings to configure the transparent proxy.
+type TransparentProxy struct {
+	Enabled bool
+	Port    uint16
+	// Subnets are the source subnets of the TCP and UDP traffic redirected to the proxy.
+	Subnets []net.IPNet
+}
+
+func (t *TransparentProxy) String() string {
+	if !t.Enabled {
+		return "Transparent proxy settings: disabled"
+	}
+	subnets := make([]string, len(t.Subnets))
+	for i := range t.Subnets {
+		subnets[i] = t.Subnets[i].String()
+	}
+	settingsList := []string{
+		"Transparent proxy settings:",
+		fmt.Sprintf("Port: %d", t.Port),
+		"Source subnets: " + strings.Join(subnets, ", "),
+	}
+	return strings.Join(settingsList, "\n |--")
+}
+
+// GetTransparentProxySettings obtains TransparentProxy settings from environment variables using the params package.
+func GetTransparentProxySettings(paramsReader params.Reader) (settings TransparentProxy, err error) {
+	settings.Enabled, err = paramsReader.GetTransparentProxy()
+	if err != nil || !settings.Enabled {
+		return settings, err
+	}
+	settings.Port, err = paramsReader.GetTransparentProxyPort()
+	if err != nil {
+		return settings, err
+	}
+	settings.Subnets, err = paramsReader.GetTransparentProxySubnets()
+	if err != nil {
+		return settings, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,102 @@
+package settings
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/params"
+)
+
+// Wireguard contains settings to configure the Wireguard tunnel.
+type Wireguard struct {
+	PrivateKey string `json:"-"`
+	// Addresses are the addresses assigned to the Wireguard interface,
+	// which are empty for providers assigning them when connecting.
+	Addresses []net.IPNet `json:"addresses"`
+	Interface string      `json:"interface"`
+	// User and Password are the credentials used to add the key
+	// to the server when connecting, only for PIA.
+	User     string                  `json:"user"`
+	Password string                  `json:"-"`
+	Provider models.ProviderSettings `json:"provider"`
+}
+
+func (w *Wireguard) String() string {
+	addresses := make([]string, len(w.Addresses))
+	for i := range w.Addresses {
+		addresses[i] = w.Addresses[i].String()
+	}
+	settingsList := []string{
+		"Wireguard settings:",
+		"Private key: [redacted]",
+	}
+	if len(addresses) > 0 {
+		settingsList = append(settingsList, "Addresses: "+strings.Join(addresses, ", "))
+	}
+	if w.User != "" {
+		settingsList = append(settingsList, "User: [redacted]", "Password: [redacted]")
+	}
+	settingsList = append(settingsList,
+		"Interface: "+w.Interface,
+		w.Provider.String(),
+	)
+	return strings.Join(settingsList, "\n|--")
+}
+
+// GetWireguardSettings obtains the Wireguard settings using the params functions.
+func GetWireguardSettings(paramsReader params.Reader, vpnProvider models.VPNProvider) (
+	settings Wireguard, err error) {
+	switch vpnProvider {
+	case constants.Mullvad, constants.Nordvpn, constants.PrivateInternetAccess:
+	default:
+		return settings, fmt.Errorf("wireguard is not supported for %s", vpnProvider)
+	}
+	settings.PrivateKey, err = paramsReader.GetWireguardPrivateKey()
+	if err != nil {
+		return settings, err
+	}
+	const keyLength = 32
+	key, decodeErr := base64.StdEncoding.DecodeString(settings.PrivateKey)
+	// the key is empty if its error is collected
+	if settings.PrivateKey != "" && (decodeErr != nil || len(key) != keyLength) {
+		err = paramsReader.CollectError(fmt.Errorf("private key is not a base64 encoded %d bytes Wireguard key", keyLength))
+		if err != nil {
+			return settings, err
+		}
+	}
+	if vpnProvider == constants.PrivateInternetAccess {
+		// the key is added with the credentials when connecting,
+		// which assigns the address of the interface.
+		settings.User, err = paramsReader.GetUser(true)
+		if err != nil {
+			return settings, err
+		}
+		settings.Password, err = paramsReader.GetPassword(true)
+		if err != nil {
+			return settings, err
+		}
+	} else {
+		settings.Addresses, err = paramsReader.GetWireguardAddresses()
+		if err != nil {
+			return settings, err
+		}
+	}
+	settings.Interface, err = paramsReader.GetVPNInterface("wg0")
+	if err != nil {
+		return settings, err
+	}
+	settings.Provider, err = getProviderSettings(paramsReader, vpnProvider)
+	if err != nil {
+		return settings, err
+	} else if settings.Provider.PortForwarding.Enabled {
+		err = paramsReader.CollectError(fmt.Errorf("port forwarding is not supported with Wireguard"))
+		if err != nil {
+			return settings, err
+		}
+	}
+	return settings, nil
+}
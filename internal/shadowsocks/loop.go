@@ -3,12 +3,16 @@ package shadowsocks
 import (
 	"context"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/loopstate"
 	"github.com/qdm12/gluetun/internal/settings"
 	"github.com/qdm12/golibs/logging"
 	shadowsockslib "github.com/qdm12/ss-server/pkg"
+	"github.com/qdm12/ss-server/pkg/tcp"
 )
 
 type Looper interface {
@@ -18,12 +22,15 @@ type Looper interface {
 	Stop()
 	GetSettings() (settings settings.ShadowSocks)
 	SetSettings(settings settings.ShadowSocks)
+	GetStats() (clients map[string]ClientStats)
 }
 
 type looper struct {
 	settings         settings.ShadowSocks
 	settingsMutex    sync.RWMutex
 	logger           logging.Logger
+	state            loopstate.Reporter
+	stats            *stats
 	defaultInterface string
 	restart          chan struct{}
 	start            chan struct{}
@@ -32,6 +39,7 @@ type looper struct {
 
 func (l *looper) logAndWait(ctx context.Context, err error) {
 	l.logger.Error(err)
+	l.state.SetError(err)
 	const waitTime = time.Minute
 	l.logger.Info("retrying in %s", waitTime)
 	timer := time.NewTimer(waitTime)
@@ -44,10 +52,40 @@ func (l *looper) logAndWait(ctx context.Context, err error) {
 	}
 }
 
-func NewLooper(settings settings.ShadowSocks, logger logging.Logger, defaultInterface string) Looper {
+func NewLooper(settings settings.ShadowSocks, logger logging.Logger, state loopstate.Reporter,
+	defaultInterface string) Looper {
+	return newLooper(settings, logger.WithPrefix("shadowsocks: "), state, defaultInterface)
+}
+
+// NewLoopers returns a looper for the default listener and for each extra listener
+// of the settings given, mapped by listener name, so each can be controlled independently.
+// Each looper reports its state to the registry as shadowsocks or shadowsocks/{listener}.
+func NewLoopers(settings settings.ShadowSocks, logger logging.Logger, registry loopstate.Registry,
+	defaultInterface string) (loopers map[string]Looper) {
+	loopers = make(map[string]Looper, len(settings.ExtraListeners)+1)
+	listeners := settings.ExtraListeners
+	settings.ExtraListeners = nil
+	loopers[constants.ShadowsocksDefaultListener] = NewLooper(settings, logger,
+		registry.Reporter("shadowsocks"), defaultInterface)
+	for _, listener := range listeners {
+		listenerSettings := settings
+		listenerSettings.Port = listener.Port
+		listenerSettings.Method = listener.Method
+		listenerSettings.Password = listener.Password
+		listenerLogger := logger.WithPrefix("shadowsocks " + listener.Name + ": ")
+		listenerState := registry.Reporter("shadowsocks/" + listener.Name)
+		loopers[listener.Name] = newLooper(listenerSettings, listenerLogger, listenerState, defaultInterface)
+	}
+	return loopers
+}
+
+func newLooper(settings settings.ShadowSocks, logger logging.Logger, state loopstate.Reporter,
+	defaultInterface string) *looper {
 	return &looper{
 		settings:         settings,
-		logger:           logger.WithPrefix("shadowsocks: "),
+		logger:           logger,
+		state:            state,
+		stats:            newStats(),
 		defaultInterface: defaultInterface,
 		restart:          make(chan struct{}),
 		start:            make(chan struct{}),
@@ -71,6 +109,11 @@ func (l *looper) SetSettings(settings settings.ShadowSocks) {
 	l.settings = settings
 }
 
+// GetStats returns the traffic statistics for each client IP address.
+func (l *looper) GetStats() (clients map[string]ClientStats) {
+	return l.stats.get()
+}
+
 func (l *looper) isEnabled() bool {
 	l.settingsMutex.RLock()
 	defer l.settingsMutex.RUnlock()
@@ -83,6 +126,19 @@ func (l *looper) setEnabled(enabled bool) {
 	l.settings.Enabled = enabled
 }
 
+type server interface {
+	Listen(ctx context.Context, address string) (err error)
+}
+
+// newServer creates a Shadowsocks server relaying TCP traffic,
+// and UDP traffic as well if it is enabled in the settings.
+func newServer(settings settings.ShadowSocks, logger *logAdapter) (s server, err error) {
+	if settings.UDP {
+		return shadowsockslib.NewServer(settings.Method, settings.Password, logger)
+	}
+	return tcp.NewServer(settings.Method, settings.Password, logger)
+}
+
 func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 	waitForStart := true
@@ -99,6 +155,7 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 		}
 	}
 	defer l.logger.Warn("loop exited")
+	defer l.state.SetState(loopstate.Stopped)
 
 	l.setEnabled(true)
 
@@ -118,23 +175,30 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 		}
 
 		settings := l.GetSettings()
-		server, err := shadowsockslib.NewServer(settings.Method, settings.Password, adaptLogger(l.logger, settings.Log))
+		server, err := newServer(settings, adaptLogger(l.logger, settings.Log))
 		if err != nil {
 			l.logAndWait(ctx, err)
 			continue
 		}
 
+		host := "0.0.0.0"
+		if settings.ListeningAddress != nil {
+			host = settings.ListeningAddress.String()
+		}
+		address := net.JoinHostPort(host, fmt.Sprint(settings.Port))
+
 		shadowsocksCtx, shadowsocksCancel := context.WithCancel(context.Background())
 
 		waitError := make(chan error)
 		go func() {
-			waitError <- server.Listen(shadowsocksCtx, fmt.Sprintf("0.0.0.0:%d", settings.Port))
+			waitError <- listenWithStats(shadowsocksCtx, server, address, settings.UDP, l.stats, l.logger)
 		}()
 		if err != nil {
 			shadowsocksCancel()
 			l.logAndWait(ctx, err)
 			continue
 		}
+		l.state.SetState(loopstate.Running)
 
 		stayHere := true
 		for stayHere {
@@ -159,11 +223,15 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 				<-waitError
 				close(waitError)
 				l.setEnabled(false)
+				l.state.SetState(loopstate.Stopped)
 				stayHere = false
 			case err := <-waitError: // unexpected error
 				shadowsocksCancel()
 				close(waitError)
 				l.logAndWait(ctx, err)
+				// restart the server, since staying here would receive from
+				// the closed waitError channel and close it again, panicking.
+				stayHere = false
 			}
 		}
 		shadowsocksCancel() // repetition for linter only
@@ -0,0 +1,242 @@
+package shadowsocks
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/qdm12/golibs/logging"
+)
+
+const (
+	// udpAssociationTimeout is the time without packet after which
+	// a UDP association is closed, as in the Shadowsocks server.
+	udpAssociationTimeout = time.Minute
+	maxUDPPacketSize      = 64 * 1024
+)
+
+// listenWithStats runs the Shadowsocks server on a free loopback address and
+// relays to it the traffic of the clients on the address given, in order to
+// record the statistics of each client IP address, since the Shadowsocks
+// server library does not expose its connections.
+func listenWithStats(ctx context.Context, server server, address string, udp bool,
+	stats *stats, logger logging.Logger) (err error) {
+	internalAddress, err := freeLoopbackAddress(udp)
+	if err != nil {
+		return err
+	}
+
+	listenConfig := net.ListenConfig{}
+	listener, err := listenConfig.Listen(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	var packetConn net.PacketConn
+	if udp {
+		packetConn, err = listenConfig.ListenPacket(ctx, "udp", address)
+		if err != nil {
+			_ = listener.Close()
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+		if packetConn != nil {
+			_ = packetConn.Close()
+		}
+	}()
+
+	goroutines := 2
+	errs := make(chan error, goroutines+1)
+	go func() { errs <- server.Listen(ctx, internalAddress) }()
+	go func() { errs <- relayTCP(ctx, listener, internalAddress, stats, logger) }()
+	if udp {
+		goroutines++
+		go func() { errs <- relayUDP(ctx, packetConn, internalAddress, stats, logger) }()
+	}
+
+	for i := 0; i < goroutines; i++ {
+		if goroutineErr := <-errs; goroutineErr != nil && err == nil && ctx.Err() == nil {
+			err = goroutineErr
+		}
+		cancel()
+	}
+	return err
+}
+
+// freeLoopbackAddress returns a loopback address with a TCP port free,
+// which is free for UDP as well if udp is true.
+func freeLoopbackAddress(udp bool) (address string, err error) {
+	const tries = 10
+	for i := 0; i < tries; i++ {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			return "", fmt.Errorf("cannot find a free loopback address: %w", err)
+		}
+		address = listener.Addr().String()
+		_ = listener.Close()
+		if !udp {
+			return address, nil
+		}
+		packetConn, err := net.ListenPacket("udp", address)
+		if err != nil {
+			continue
+		}
+		_ = packetConn.Close()
+		return address, nil
+	}
+	return "", fmt.Errorf("cannot find a free loopback address: no port free for TCP and UDP after %d tries", tries)
+}
+
+func relayTCP(ctx context.Context, listener net.Listener, internalAddress string,
+	stats *stats, logger logging.Logger) error {
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+	for {
+		clientConn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			relayTCPConnection(ctx, clientConn, internalAddress, stats, logger)
+		}()
+	}
+}
+
+func relayTCPConnection(ctx context.Context, clientConn net.Conn, internalAddress string,
+	stats *stats, logger logging.Logger) {
+	defer clientConn.Close()
+	clientIP := ipOf(clientConn.RemoteAddr())
+
+	dialer := net.Dialer{}
+	serverConn, err := dialer.DialContext(ctx, "tcp", internalAddress)
+	if err != nil {
+		logger.Warn("cannot relay connection from %s: %s", clientConn.RemoteAddr(), err)
+		return
+	}
+	defer serverConn.Close()
+
+	stats.connectionStarted(clientIP)
+	defer stats.connectionEnded(clientIP)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = clientConn.Close()
+		_ = serverConn.Close()
+	}()
+
+	const transferGoroutines = 2
+	done := make(chan struct{}, transferGoroutines)
+	go func() {
+		_, _ = io.Copy(&statsWriter{Writer: serverConn, stats: stats, clientIP: clientIP, inbound: true}, clientConn)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(&statsWriter{Writer: clientConn, stats: stats, clientIP: clientIP}, serverConn)
+		done <- struct{}{}
+	}()
+	<-done
+	cancel() // unblock the other copy
+	<-done
+}
+
+func relayUDP(ctx context.Context, packetConn net.PacketConn, internalAddress string,
+	stats *stats, logger logging.Logger) error {
+	associations := make(map[string]net.Conn)
+	mutex := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	defer wg.Wait()
+
+	buffer := make([]byte, maxUDPPacketSize)
+	for {
+		n, clientAddress, err := packetConn.ReadFrom(buffer)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		clientIP := ipOf(clientAddress)
+
+		key := clientAddress.String()
+		mutex.Lock()
+		serverConn, ok := associations[key]
+		if !ok {
+			dialer := net.Dialer{}
+			serverConn, err = dialer.DialContext(ctx, "udp", internalAddress)
+			if err != nil {
+				mutex.Unlock()
+				logger.Warn("cannot relay packet from %s: %s", clientAddress, err)
+				continue
+			}
+			associations[key] = serverConn
+			stats.connectionStarted(clientIP)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				relayUDPReplies(ctx, packetConn, serverConn, clientAddress, stats)
+				mutex.Lock()
+				delete(associations, key)
+				mutex.Unlock()
+				stats.connectionEnded(clientIP)
+			}()
+		}
+		mutex.Unlock()
+
+		n, err = serverConn.Write(buffer[:n])
+		stats.addBytes(clientIP, int64(n), 0)
+		if err != nil && ctx.Err() == nil {
+			logger.Debug("cannot relay packet from %s: %s", clientAddress, err)
+		}
+	}
+}
+
+// relayUDPReplies sends the packets from the Shadowsocks server back to the
+// client, until no packet is received for the association timeout or the
+// context is canceled. It closes the server connection on exit.
+func relayUDPReplies(ctx context.Context, packetConn net.PacketConn, serverConn net.Conn,
+	clientAddress net.Addr, stats *stats) {
+	defer serverConn.Close()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = serverConn.Close() // unblock the read
+	}()
+
+	clientIP := ipOf(clientAddress)
+	buffer := make([]byte, maxUDPPacketSize)
+	for {
+		if err := serverConn.SetReadDeadline(time.Now().Add(udpAssociationTimeout)); err != nil {
+			return
+		}
+		n, err := serverConn.Read(buffer)
+		if err != nil {
+			return
+		}
+		n, _ = packetConn.WriteTo(buffer[:n], clientAddress)
+		stats.addBytes(clientIP, 0, int64(n))
+	}
+}
+
+func ipOf(address net.Addr) (ip string) {
+	host, _, err := net.SplitHostPort(address.String())
+	if err != nil {
+		return address.String()
+	}
+	return host
+}
@@ -0,0 +1,125 @@
+package shadowsocks
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// echoServer replies to each TCP read and UDP packet with the data
+// received followed by " reply".
+type echoServer struct {
+	udp bool
+}
+
+func (e *echoServer) Listen(ctx context.Context, address string) (err error) {
+	listenConfig := net.ListenConfig{}
+	listener, err := listenConfig.Listen(ctx, "tcp", address)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	if e.udp {
+		packetConn, err := listenConfig.ListenPacket(ctx, "udp", address)
+		if err != nil {
+			return err
+		}
+		defer packetConn.Close()
+		go func() {
+			buffer := make([]byte, maxUDPPacketSize)
+			for {
+				n, address, err := packetConn.ReadFrom(buffer)
+				if err != nil {
+					return
+				}
+				_, _ = packetConn.WriteTo(append(buffer[:n], " reply"...), address)
+			}
+		}()
+	}
+	go func() {
+		for {
+			connection, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer connection.Close()
+				buffer := make([]byte, maxUDPPacketSize)
+				for {
+					n, err := connection.Read(buffer)
+					if err != nil {
+						return
+					}
+					if _, err := connection.Write(append(buffer[:n], " reply"...)); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	<-ctx.Done()
+	return nil
+}
+
+func Test_listenWithStats(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	logger := mock_logging.NewMockLogger(mockCtrl)
+	// the first connections may be accepted before the server listens
+	logger.EXPECT().Warn("cannot relay connection from %s: %s", gomock.Any(), gomock.Any()).AnyTimes()
+
+	address, err := freeLoopbackAddress(true)
+	require.NoError(t, err)
+	stats := newStats()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- listenWithStats(ctx, &echoServer{udp: true}, address, true, stats, logger)
+	}()
+
+	buffer := make([]byte, maxUDPPacketSize)
+	assert.Eventually(t, func() bool {
+		// retry until the relay and the server are listening
+		tcpConn, err := net.Dial("tcp", address)
+		if err != nil {
+			return false
+		}
+		defer tcpConn.Close()
+		if _, err := tcpConn.Write([]byte("query")); err != nil {
+			return false
+		}
+		_ = tcpConn.SetReadDeadline(time.Now().Add(time.Second))
+		n, err := tcpConn.Read(buffer)
+		return err == nil && string(buffer[:n]) == "query reply"
+	}, time.Second, 10*time.Millisecond)
+
+	udpConn, err := net.Dial("udp", address)
+	require.NoError(t, err)
+	defer udpConn.Close()
+	_, err = udpConn.Write([]byte("packet"))
+	require.NoError(t, err)
+	require.NoError(t, udpConn.SetReadDeadline(time.Now().Add(time.Second)))
+	n, err := udpConn.Read(buffer)
+	require.NoError(t, err)
+	assert.Equal(t, "packet reply", string(buffer[:n]))
+
+	cancel()
+	require.NoError(t, <-done)
+
+	// connections failing to reach the server are not counted
+	expectedClients := map[string]ClientStats{
+		"127.0.0.1": {
+			BytesIn:          int64(len("query") + len("packet")),
+			BytesOut:         int64(len("query reply") + len("packet reply")),
+			TotalConnections: 2,
+		},
+	}
+	assert.Equal(t, expectedClients, stats.get())
+}
@@ -0,0 +1,159 @@
+// Package sidecar watches the main container of a Kubernetes pod
+// so gluetun can shut down once the main container exits.
+package sidecar
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/files"
+	"github.com/qdm12/golibs/logging"
+)
+
+type Watcher interface {
+	// Wait blocks until the target exits and returns the exit code
+	// gluetun should exit with, or until the context is canceled.
+	Wait(ctx context.Context) (exitCode int, err error)
+}
+
+type watcher struct {
+	settings    settings.Sidecar
+	fileManager files.FileManager
+	client      *http.Client
+	logger      logging.Logger
+	readDir     func(dirname string) ([]os.FileInfo, error)
+}
+
+func NewWatcher(settings settings.Sidecar, fileManager files.FileManager,
+	client *http.Client, logger logging.Logger) Watcher {
+	return &watcher{
+		settings:    settings,
+		fileManager: fileManager,
+		client:      client,
+		logger:      logger.WithPrefix("sidecar: "),
+		readDir:     ioutil.ReadDir,
+	}
+}
+
+func (w *watcher) Wait(ctx context.Context) (exitCode int, err error) {
+	var check func(ctx context.Context) (exited bool, exitCode int, err error)
+	switch w.settings.Kind {
+	case constants.SidecarProcess:
+		check = w.waitTarget(w.processRunning)
+	case constants.SidecarHTTP:
+		check = w.waitTarget(w.endpointResponds)
+	case constants.SidecarFile:
+		check = w.fileExited
+	default:
+		return 0, fmt.Errorf("sidecar target kind %q is not supported", w.settings.Kind)
+	}
+	w.logger.Info("watching %s %s", w.settings.Kind, w.settings.Target)
+	ticker := time.NewTicker(w.settings.Period)
+	defer ticker.Stop()
+	for {
+		exited, exitCode, err := check(ctx)
+		switch {
+		case err != nil:
+			w.logger.Warn(err)
+		case exited:
+			w.logger.Info("%s %s exited with code %d", w.settings.Kind, w.settings.Target, exitCode)
+			return exitCode, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// waitTarget returns a check function which reports the target as exited
+// once it is no longer alive, after it has been seen alive at least once,
+// so gluetun keeps running while the main container is starting.
+func (w *watcher) waitTarget(alive func(ctx context.Context) (bool, error)) (
+	check func(ctx context.Context) (exited bool, exitCode int, err error)) {
+	seen := false
+	return func(ctx context.Context) (exited bool, exitCode int, err error) {
+		ok, err := alive(ctx)
+		switch {
+		case err != nil:
+			return false, 0, err
+		case ok && !seen:
+			w.logger.Info("%s %s is up", w.settings.Kind, w.settings.Target)
+			seen = true
+		case !ok && seen:
+			return true, 0, nil
+		}
+		return false, 0, nil
+	}
+}
+
+func (w *watcher) processRunning(ctx context.Context) (running bool, err error) {
+	infos, err := w.readDir("/proc")
+	if err != nil {
+		return false, err
+	}
+	for _, info := range infos {
+		if _, err := strconv.Atoi(info.Name()); err != nil || !info.IsDir() {
+			continue
+		}
+		data, err := w.fileManager.ReadFile("/proc/" + info.Name() + "/comm")
+		if err != nil { // process exited meanwhile
+			continue
+		}
+		if strings.TrimSpace(string(data)) == w.settings.Target {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (w *watcher) endpointResponds(ctx context.Context) (responds bool, err error) {
+	requestCtx, cancel := context.WithTimeout(ctx, w.settings.Period)
+	defer cancel()
+	request, err := http.NewRequestWithContext(requestCtx, http.MethodGet, w.settings.Target, nil)
+	if err != nil {
+		return false, err
+	}
+	response, err := w.client.Do(request)
+	switch {
+	case ctx.Err() != nil:
+		return false, ctx.Err()
+	case err != nil: // any response, whatever its status, means it is up
+		return false, nil
+	}
+	response.Body.Close()
+	return true, nil
+}
+
+// fileExited reports the target as exited once the file exists. If the
+// file contains an integer, it is used as the exit code, so the main
+// container can write its own exit code to it.
+func (w *watcher) fileExited(ctx context.Context) (exited bool, exitCode int, err error) {
+	exists, err := w.fileManager.FileExists(w.settings.Target)
+	if err != nil || !exists {
+		return false, 0, err
+	}
+	data, err := w.fileManager.ReadFile(w.settings.Target)
+	if err != nil {
+		return false, 0, err
+	}
+	s := strings.TrimSpace(string(data))
+	if s == "" {
+		return true, 0, nil
+	}
+	exitCode, err = strconv.Atoi(s)
+	if err != nil {
+		w.logger.Warn("file %s does not contain an exit code: %q", w.settings.Target, s)
+		return true, 0, nil
+	}
+	return true, exitCode, nil
+}
@@ -0,0 +1,106 @@
+package sidecar
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/files/mock_files"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fileInfo struct {
+	os.FileInfo
+	name string
+}
+
+func (f fileInfo) Name() string { return f.name }
+func (f fileInfo) IsDir() bool  { return true }
+
+func Test_watcher_Wait_process(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// the process is not started, then runs, then exits
+	runs := []bool{false, true, true, false}
+	fileManager := mock_files.NewMockFileManager(mockCtrl)
+	for _, running := range runs {
+		comm := "other\n"
+		if running {
+			comm = "myapp\n"
+		}
+		fileManager.EXPECT().ReadFile("/proc/12/comm").Return([]byte(comm), nil)
+	}
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	w := NewWatcher(settings.Sidecar{
+		Kind:   constants.SidecarProcess,
+		Target: "myapp",
+		Period: time.Millisecond,
+	}, fileManager, nil, logger).(*watcher)
+	w.readDir = func(dirname string) ([]os.FileInfo, error) {
+		assert.Equal(t, "/proc", dirname)
+		return []os.FileInfo{fileInfo{name: "self"}, fileInfo{name: "12"}}, nil
+	}
+
+	exitCode, err := w.Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+}
+
+func Test_watcher_fileExited(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		exists   bool
+		data     string
+		exited   bool
+		exitCode int
+	}{
+		"not created": {},
+		"empty": {
+			exists: true,
+			exited: true,
+		},
+		"exit code": {
+			exists:   true,
+			data:     "3\n",
+			exited:   true,
+			exitCode: 3,
+		},
+		"not an exit code": {
+			exists: true,
+			data:   "done",
+			exited: true,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			const path = "/shared/exit-code"
+			fileManager := mock_files.NewMockFileManager(mockCtrl)
+			fileManager.EXPECT().FileExists(path).Return(testCase.exists, nil)
+			if testCase.exists {
+				fileManager.EXPECT().ReadFile(path).Return([]byte(testCase.data), nil)
+			}
+			logger, err := logging.NewEmptyLogger()
+			require.NoError(t, err)
+			w := NewWatcher(settings.Sidecar{Kind: constants.SidecarFile, Target: path},
+				fileManager, nil, logger).(*watcher)
+
+			exited, exitCode, err := w.fileExited(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, testCase.exited, exited)
+			assert.Equal(t, testCase.exitCode, exitCode)
+		})
+	}
+}
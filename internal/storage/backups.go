@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// maxBackups is the number of previous versions of the servers
+// data file kept, as servers.json.1 (most recent) to servers.json.3.
+const maxBackups = 3
+
+// ErrBackupNotFound is returned if the backup number given does not exist.
+var ErrBackupNotFound = errors.New("backup not found")
+
+// Backup is a previous version of the servers data file.
+type Backup struct {
+	Number   int       `json:"number"`
+	Modified time.Time `json:"modified"`
+	Servers  int       `json:"servers"`
+}
+
+func (s *storage) backupFilepath(number int) string {
+	return fmt.Sprintf("%s.%d", s.filepath, number)
+}
+
+// writeServersFile writes the servers data given to the servers data file,
+// after rotating the backups and copying the current file as backup 1.
+// Nothing is written if the data is unchanged.
+func (s *storage) writeServersFile(data []byte) error {
+	current, err := s.readFile(s.filepath)
+	switch {
+	case os.IsNotExist(err):
+		return s.writeFile(s.filepath, data, 0644)
+	case err != nil:
+		return err
+	case bytes.Equal(current, data):
+		return nil
+	}
+	if err := s.rotateBackups(current); err != nil {
+		return fmt.Errorf("cannot backup servers data: %w", err)
+	}
+	return s.writeFile(s.filepath, data, 0644)
+}
+
+func (s *storage) rotateBackups(current []byte) error {
+	for number := maxBackups - 1; number > 0; number-- {
+		data, err := s.readFile(s.backupFilepath(number))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if err := s.writeFile(s.backupFilepath(number+1), data, 0644); err != nil {
+			return err
+		}
+	}
+	return s.writeFile(s.backupFilepath(1), current, 0644)
+}
+
+// ListBackups returns the backups of the servers data file existing,
+// from the most recent to the oldest.
+func (s *storage) ListBackups() (backups []Backup, err error) {
+	for number := 1; number <= maxBackups; number++ {
+		filepath := s.backupFilepath(number)
+		info, err := s.osStat(filepath)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		servers, err := s.readBackup(number)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, Backup{
+			Number:   number,
+			Modified: info.ModTime(),
+			Servers:  countServers(servers),
+		})
+	}
+	return backups, nil
+}
+
+func (s *storage) readBackup(number int) (servers models.AllServers, err error) {
+	if number < 1 || number > maxBackups {
+		return servers, fmt.Errorf("%w: number %d must be between 1 and %d", ErrBackupNotFound, number, maxBackups)
+	}
+	data, err := s.readFile(s.backupFilepath(number))
+	if os.IsNotExist(err) {
+		return servers, fmt.Errorf("%w: %d", ErrBackupNotFound, number)
+	} else if err != nil {
+		return servers, err
+	}
+	if err := json.Unmarshal(data, &servers); err != nil {
+		return servers, fmt.Errorf("cannot decode backup %d: %w", number, err)
+	}
+	return servers, nil
+}
+
+// Rollback replaces the servers data file with the backup number given,
+// and returns its servers merged with the hardcoded servers given.
+// The servers data replaced becomes backup 1, so a rollback can be undone.
+func (s *storage) Rollback(hardcodedServers models.AllServers, backup int) (
+	allServers models.AllServers, err error) {
+	backupServers, err := s.readBackup(backup)
+	if err != nil {
+		return allServers, fmt.Errorf("cannot rollback servers data: %w", err)
+	}
+	if err := s.FlushToFile(backupServers); err != nil {
+		return allServers, fmt.Errorf("cannot rollback servers data: %w", err)
+	}
+	s.logger.Info("rolled back servers data to backup %d with %d servers", backup, countServers(backupServers))
+	return s.mergeServers(hardcodedServers, backupServers), nil
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_storage_backups(t *testing.T) {
+	t.Parallel()
+	logger, err := logging.NewLogger(logging.ConsoleEncoding, logging.ErrorLevel)
+	require.NoError(t, err)
+	s := &storage{
+		filepath:  filepath.Join(t.TempDir(), "servers.json"),
+		osStat:    os.Stat,
+		readFile:  ioutil.ReadFile,
+		writeFile: ioutil.WriteFile,
+		logger:    logger,
+	}
+	serversWithCount := func(count int) (servers models.AllServers) {
+		servers.Mullvad.Timestamp = int64(count)
+		servers.Mullvad.Servers = make([]models.MullvadServer, count)
+		return servers
+	}
+
+	backups, err := s.ListBackups()
+	require.NoError(t, err)
+	assert.Empty(t, backups)
+
+	for count := 1; count <= maxBackups+2; count++ {
+		err := s.FlushToFile(serversWithCount(count))
+		require.NoError(t, err)
+	}
+	// writing the same servers does not rotate the backups
+	err = s.FlushToFile(serversWithCount(maxBackups + 2))
+	require.NoError(t, err)
+
+	backups, err = s.ListBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, maxBackups)
+	for i, backup := range backups {
+		assert.Equal(t, i+1, backup.Number)
+		assert.Equal(t, maxBackups+1-i, backup.Servers)
+	}
+
+	_, err = s.Rollback(models.AllServers{}, maxBackups+1)
+	require.Error(t, err)
+	assert.Equal(t, "cannot rollback servers data: backup not found: number 4 must be between 1 and 3", err.Error())
+
+	allServers, err := s.Rollback(models.AllServers{}, 2)
+	require.NoError(t, err)
+	assert.Len(t, allServers.Mullvad.Servers, 3)
+	servers, err := s.readFromFile()
+	require.NoError(t, err)
+	assert.Equal(t, serversWithCount(3), servers)
+	// the servers data replaced is the most recent backup
+	backups, err = s.ListBackups()
+	require.NoError(t, err)
+	require.Len(t, backups, maxBackups)
+	assert.Equal(t, 5, backups[0].Servers)
+}
@@ -18,21 +18,51 @@ func (s *storage) mergeServers(hardcoded, persistent models.AllServers) (merged
 	merged.Version = hardcoded.Version
 	merged.Cyberghost = hardcoded.Cyberghost
 	if persistent.Cyberghost.Timestamp > hardcoded.Cyberghost.Timestamp {
-		s.logger.Info("Using Cyberghost servers from file (%s more recent)",
-			getUnixTimeDifference(persistent.Cyberghost.Timestamp, hardcoded.Cyberghost.Timestamp))
-		merged.Cyberghost = persistent.Cyberghost
+		versionDiff := int(hardcoded.Cyberghost.Version) - int(persistent.Cyberghost.Version)
+		if versionDiff > 0 {
+			s.logger.Info("Cyberghost servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using Cyberghost servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.Cyberghost.Timestamp, hardcoded.Cyberghost.Timestamp))
+			merged.Cyberghost = persistent.Cyberghost
+		}
+	}
+	merged.HideMyAss = hardcoded.HideMyAss
+	if persistent.HideMyAss.Timestamp > hardcoded.HideMyAss.Timestamp {
+		versionDiff := int(hardcoded.HideMyAss.Version) - int(persistent.HideMyAss.Version)
+		if versionDiff > 0 {
+			s.logger.Info("HideMyAss servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using HideMyAss servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.HideMyAss.Timestamp, hardcoded.HideMyAss.Timestamp))
+			merged.HideMyAss = persistent.HideMyAss
+		}
 	}
 	merged.Mullvad = hardcoded.Mullvad
 	if persistent.Mullvad.Timestamp > hardcoded.Mullvad.Timestamp {
-		s.logger.Info("Using Mullvad servers from file (%s more recent)",
-			getUnixTimeDifference(persistent.Mullvad.Timestamp, hardcoded.Mullvad.Timestamp))
-		merged.Mullvad = persistent.Mullvad
+		versionDiff := int(hardcoded.Mullvad.Version) - int(persistent.Mullvad.Version)
+		if versionDiff > 0 {
+			s.logger.Info("Mullvad servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using Mullvad servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.Mullvad.Timestamp, hardcoded.Mullvad.Timestamp))
+			merged.Mullvad = persistent.Mullvad
+		}
 	}
 	merged.Nordvpn = hardcoded.Nordvpn
 	if persistent.Nordvpn.Timestamp > hardcoded.Nordvpn.Timestamp {
-		s.logger.Info("Using Nordvpn servers from file (%s more recent)",
-			getUnixTimeDifference(persistent.Nordvpn.Timestamp, hardcoded.Nordvpn.Timestamp))
-		merged.Nordvpn = persistent.Nordvpn
+		versionDiff := int(hardcoded.Nordvpn.Version) - int(persistent.Nordvpn.Version)
+		if versionDiff > 0 {
+			s.logger.Info("Nordvpn servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using Nordvpn servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.Nordvpn.Timestamp, hardcoded.Nordvpn.Timestamp))
+			merged.Nordvpn = persistent.Nordvpn
+		}
 	}
 	merged.Pia = hardcoded.Pia
 	if persistent.Pia.Timestamp > hardcoded.Pia.Timestamp {
@@ -62,21 +92,63 @@ func (s *storage) mergeServers(hardcoded, persistent models.AllServers) (merged
 
 	merged.Purevpn = hardcoded.Purevpn
 	if persistent.Purevpn.Timestamp > hardcoded.Purevpn.Timestamp {
-		s.logger.Info("Using Purevpn servers from file (%s more recent)",
-			getUnixTimeDifference(persistent.Purevpn.Timestamp, hardcoded.Purevpn.Timestamp))
-		merged.Purevpn = persistent.Purevpn
+		versionDiff := int(hardcoded.Purevpn.Version) - int(persistent.Purevpn.Version)
+		if versionDiff > 0 {
+			s.logger.Info("Purevpn servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using Purevpn servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.Purevpn.Timestamp, hardcoded.Purevpn.Timestamp))
+			merged.Purevpn = persistent.Purevpn
+		}
 	}
 	merged.Surfshark = hardcoded.Surfshark
 	if persistent.Surfshark.Timestamp > hardcoded.Surfshark.Timestamp {
-		s.logger.Info("Using Surfshark servers from file (%s more recent)",
-			getUnixTimeDifference(persistent.Surfshark.Timestamp, hardcoded.Surfshark.Timestamp))
-		merged.Surfshark = persistent.Surfshark
+		versionDiff := int(hardcoded.Surfshark.Version) - int(persistent.Surfshark.Version)
+		if versionDiff > 0 {
+			s.logger.Info("Surfshark servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using Surfshark servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.Surfshark.Timestamp, hardcoded.Surfshark.Timestamp))
+			merged.Surfshark = persistent.Surfshark
+		}
+	}
+	merged.VPNUnlimited = hardcoded.VPNUnlimited
+	if persistent.VPNUnlimited.Timestamp > hardcoded.VPNUnlimited.Timestamp {
+		versionDiff := int(hardcoded.VPNUnlimited.Version) - int(persistent.VPNUnlimited.Version)
+		if versionDiff > 0 {
+			s.logger.Info("VPN Unlimited servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using VPN Unlimited servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.VPNUnlimited.Timestamp, hardcoded.VPNUnlimited.Timestamp))
+			merged.VPNUnlimited = persistent.VPNUnlimited
+		}
 	}
 	merged.Vyprvpn = hardcoded.Vyprvpn
 	if persistent.Vyprvpn.Timestamp > hardcoded.Vyprvpn.Timestamp {
-		s.logger.Info("Using Vyprvpn servers from file (%s more recent)",
-			getUnixTimeDifference(persistent.Vyprvpn.Timestamp, hardcoded.Vyprvpn.Timestamp))
-		merged.Vyprvpn = persistent.Vyprvpn
+		versionDiff := int(hardcoded.Vyprvpn.Version) - int(persistent.Vyprvpn.Version)
+		if versionDiff > 0 {
+			s.logger.Info("Vyprvpn servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using Vyprvpn servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.Vyprvpn.Timestamp, hardcoded.Vyprvpn.Timestamp))
+			merged.Vyprvpn = persistent.Vyprvpn
+		}
+	}
+	merged.WeVPN = hardcoded.WeVPN
+	if persistent.WeVPN.Timestamp > hardcoded.WeVPN.Timestamp {
+		versionDiff := int(hardcoded.WeVPN.Version) - int(persistent.WeVPN.Version)
+		if versionDiff > 0 {
+			s.logger.Info("WeVPN servers from file discarded because they are %d versions behind",
+				versionDiff)
+		} else {
+			s.logger.Info("Using WeVPN servers from file (%s more recent)",
+				getUnixTimeDifference(persistent.WeVPN.Timestamp, hardcoded.WeVPN.Timestamp))
+			merged.WeVPN = persistent.WeVPN
+		}
 	}
 	merged.Windscribe = hardcoded.Windscribe
 	if persistent.Windscribe.Timestamp > hardcoded.Windscribe.Timestamp {
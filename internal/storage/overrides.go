@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// ReadOverrides reads the settings overrides from the file given,
+// returning empty overrides if the file does not exist.
+func (s *storage) ReadOverrides(filepath string) (overrides models.Overrides, err error) {
+	s.overridesMutex.Lock()
+	defer s.overridesMutex.Unlock()
+	return s.readOverrides(filepath)
+}
+
+func (s *storage) readOverrides(filepath string) (overrides models.Overrides, err error) {
+	data, err := s.readFile(filepath)
+	if os.IsNotExist(err) {
+		return overrides, nil
+	} else if err != nil {
+		return overrides, err
+	}
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return overrides, fmt.Errorf("cannot decode overrides file %s: %w", filepath, err)
+	}
+	return overrides, nil
+}
+
+// UpdateOverrides reads the settings overrides from the file given, modifies
+// them with the update function and writes them back to the file, so each
+// setting changed at runtime is kept alongside the others.
+// The file is only readable and writable by its owner.
+func (s *storage) UpdateOverrides(filepath string, update func(overrides *models.Overrides)) error {
+	s.overridesMutex.Lock()
+	defer s.overridesMutex.Unlock()
+	overrides, err := s.readOverrides(filepath)
+	if err != nil {
+		return err
+	}
+	update(&overrides)
+	data, err := json.MarshalIndent(overrides, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot write overrides file: %w", err)
+	}
+	const perm os.FileMode = 0600
+	if err := s.writeFile(filepath, data, perm); err != nil {
+		return err
+	}
+	// the permissions are not changed if the file already existed
+	return s.chmod(filepath, perm)
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_storage_UpdateOverrides(t *testing.T) {
+	t.Parallel()
+	s := &storage{
+		readFile:  ioutil.ReadFile,
+		writeFile: ioutil.WriteFile,
+		chmod:     os.Chmod,
+	}
+	path := filepath.Join(t.TempDir(), "overrides.json")
+	// a file written before with too open permissions
+	err := ioutil.WriteFile(path, []byte(`{"openvpnProfile":"old"}`), 0644)
+	require.NoError(t, err)
+
+	err = s.UpdateOverrides(path, func(overrides *models.Overrides) {
+		overrides.SetComponentStatus("httpproxy", "stopped")
+	})
+	require.NoError(t, err)
+	err = s.UpdateOverrides(path, func(overrides *models.Overrides) {
+		overrides.OpenVPNProfile = "new"
+	})
+	require.NoError(t, err)
+
+	overrides, err := s.ReadOverrides(path)
+	require.NoError(t, err)
+	assert.Equal(t, models.Overrides{
+		OpenVPNProfile: "new",
+		Components:     map[string]string{"httpproxy": "stopped"},
+	}, overrides)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func Test_storage_ReadOverrides(t *testing.T) {
+	t.Parallel()
+	s := &storage{readFile: ioutil.ReadFile}
+	dir := t.TempDir()
+
+	overrides, err := s.ReadOverrides(filepath.Join(dir, "missing.json"))
+	require.NoError(t, err)
+	assert.Equal(t, models.Overrides{}, overrides)
+
+	path := filepath.Join(dir, "malformed.json")
+	err = ioutil.WriteFile(path, []byte("{"), 0600)
+	require.NoError(t, err)
+	_, err = s.ReadOverrides(path)
+	require.Error(t, err)
+	assert.Equal(t, "cannot decode overrides file "+path+": unexpected end of JSON input", err.Error())
+}
@@ -3,6 +3,7 @@ package storage
 import (
 	"io/ioutil"
 	"os"
+	"sync"
 
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/logging"
@@ -11,20 +12,29 @@ import (
 type Storage interface {
 	SyncServers(hardcodedServers models.AllServers, write bool) (allServers models.AllServers, err error)
 	FlushToFile(servers models.AllServers) error
+	ListBackups() (backups []Backup, err error)
+	Rollback(hardcodedServers models.AllServers, backup int) (allServers models.AllServers, err error)
+	ReadOverrides(filepath string) (overrides models.Overrides, err error)
+	UpdateOverrides(filepath string, update func(overrides *models.Overrides)) error
 }
 
 type storage struct {
-	osStat    func(name string) (os.FileInfo, error)
-	readFile  func(filename string) (data []byte, err error)
-	writeFile func(filename string, data []byte, perm os.FileMode) error
-	logger    logging.Logger
+	filepath       string
+	osStat         func(name string) (os.FileInfo, error)
+	readFile       func(filename string) (data []byte, err error)
+	writeFile      func(filename string, data []byte, perm os.FileMode) error
+	chmod          func(name string, mode os.FileMode) error
+	overridesMutex sync.Mutex
+	logger         logging.Logger
 }
 
 func New(logger logging.Logger) Storage {
 	return &storage{
+		filepath:  jsonFilepath,
 		osStat:    os.Stat,
 		readFile:  ioutil.ReadFile,
 		writeFile: ioutil.WriteFile,
+		chmod:     os.Chmod,
 		logger:    logger.WithPrefix("storage: "),
 	}
 }
@@ -15,13 +15,16 @@ const (
 
 func countServers(allServers models.AllServers) int {
 	return len(allServers.Cyberghost.Servers) +
+		len(allServers.HideMyAss.Servers) +
 		len(allServers.Mullvad.Servers) +
 		len(allServers.Nordvpn.Servers) +
 		len(allServers.Pia.Servers) +
 		len(allServers.Privado.Servers) +
 		len(allServers.Purevpn.Servers) +
 		len(allServers.Surfshark.Servers) +
+		len(allServers.VPNUnlimited.Servers) +
 		len(allServers.Vyprvpn.Servers) +
+		len(allServers.WeVPN.Servers) +
 		len(allServers.Windscribe.Servers)
 }
 
@@ -29,7 +32,7 @@ func (s *storage) SyncServers(hardcodedServers models.AllServers, write bool) (
 	allServers models.AllServers, err error) {
 	// Eventually read file
 	var serversOnFile models.AllServers
-	_, err = s.osStat(jsonFilepath)
+	_, err = s.osStat(s.filepath)
 	if err == nil {
 		serversOnFile, err = s.readFromFile()
 		if err != nil {
@@ -41,7 +44,7 @@ func (s *storage) SyncServers(hardcodedServers models.AllServers, write bool) (
 
 	// Merge data from file and hardcoded
 	s.logger.Info("Merging by most recent %d hardcoded servers and %d servers read from %s",
-		countServers(hardcodedServers), countServers(serversOnFile), jsonFilepath)
+		countServers(hardcodedServers), countServers(serversOnFile), s.filepath)
 	allServers = s.mergeServers(hardcodedServers, serversOnFile)
 
 	// Eventually write file
@@ -52,7 +55,7 @@ func (s *storage) SyncServers(hardcodedServers models.AllServers, write bool) (
 }
 
 func (s *storage) readFromFile() (servers models.AllServers, err error) {
-	bytes, err := s.readFile(jsonFilepath)
+	bytes, err := s.readFile(s.filepath)
 	if err != nil {
 		return servers, err
 	}
@@ -67,8 +70,5 @@ func (s *storage) FlushToFile(servers models.AllServers) error {
 	if err != nil {
 		return fmt.Errorf("cannot write to file: %w", err)
 	}
-	if err := s.writeFile(jsonFilepath, bytes, 0644); err != nil {
-		return err
-	}
-	return nil
+	return s.writeServersFile(bytes)
 }
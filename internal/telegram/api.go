@@ -0,0 +1,85 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const apiURL = "https://api.telegram.org"
+
+type update struct {
+	ID      int64    `json:"update_id"`
+	Message *message `json:"message"`
+}
+
+type message struct {
+	Chat struct {
+		ID int64 `json:"id"`
+	} `json:"chat"`
+	Text string `json:"text"`
+}
+
+type apiResponse struct {
+	OK          bool            `json:"ok"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+}
+
+// call calls the Bot API method given with the parameters given
+// and decodes its result into the result given, if not nil.
+func (b *bot) call(ctx context.Context, method string, parameters, result interface{}) error {
+	body, err := json.Marshal(parameters)
+	if err != nil {
+		return err
+	}
+	url := b.apiURL + "/bot" + b.token + "/" + method
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := b.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("cannot call Telegram method %s: %w", method, err)
+	}
+	defer response.Body.Close()
+	var decoded apiResponse
+	if err := json.NewDecoder(response.Body).Decode(&decoded); err != nil {
+		return fmt.Errorf("cannot decode Telegram %s response: %w", method, err)
+	}
+	if !decoded.OK {
+		return fmt.Errorf("Telegram method %s failed: %s: %s", method, response.Status, decoded.Description)
+	}
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(decoded.Result, result); err != nil {
+		return fmt.Errorf("cannot decode Telegram %s result: %w", method, err)
+	}
+	return nil
+}
+
+// getUpdates long polls the updates with an ID from the offset given.
+func (b *bot) getUpdates(ctx context.Context, offset int64, timeout time.Duration) (
+	updates []update, err error) {
+	parameters := map[string]interface{}{
+		"offset":          offset,
+		"timeout":         int(timeout.Seconds()),
+		"allowed_updates": []string{"message"},
+	}
+	err = b.call(ctx, "getUpdates", parameters, &updates)
+	return updates, err
+}
+
+func (b *bot) sendMessage(ctx context.Context, chatID int64, text string) error {
+	parameters := map[string]string{
+		"chat_id": strconv.FormatInt(chatID, 10), //nolint:gomnd
+		"text":    text,
+	}
+	return b.call(ctx, "sendMessage", parameters, nil)
+}
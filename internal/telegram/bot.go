@@ -0,0 +1,227 @@
+// Package telegram runs a Telegram bot reporting the VPN connection
+// events and answering status and control commands.
+package telegram
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/publicip"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/logging"
+)
+
+type Bot interface {
+	// Run answers the commands and reports the events
+	// until the context is canceled.
+	Run(ctx context.Context, wg *sync.WaitGroup)
+}
+
+type bot struct {
+	token       string
+	chatIDs     []int64
+	apiURL      string
+	client      *http.Client
+	getStatus   func() openvpn.ConnectionStatus
+	getPublicIP func() publicip.Status
+	restart     func()
+	logger      logging.Logger
+	timeNow     func() time.Time
+	// pollTimeout is the long polling timeout to get updates,
+	// and eventsPeriod the period to check for events.
+	pollTimeout  time.Duration
+	eventsPeriod time.Duration
+}
+
+// NewBot returns a Telegram bot for the settings given. The client
+// timeout must be longer than the long polling timeout of 30 seconds.
+func NewBot(settings settings.Telegram, client *http.Client,
+	getStatus func() openvpn.ConnectionStatus, getPublicIP func() publicip.Status,
+	restart func(), logger logging.Logger) Bot {
+	const pollTimeout = 30 * time.Second
+	const eventsPeriod = 5 * time.Second
+	return &bot{
+		token:        settings.Token,
+		chatIDs:      settings.ChatIDs,
+		apiURL:       apiURL,
+		client:       client,
+		getStatus:    getStatus,
+		getPublicIP:  getPublicIP,
+		restart:      restart,
+		logger:       logger.WithPrefix("telegram: "),
+		timeNow:      time.Now,
+		pollTimeout:  pollTimeout,
+		eventsPeriod: eventsPeriod,
+	}
+}
+
+func (b *bot) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	b.logger.Info("started")
+	innerWg := &sync.WaitGroup{}
+	innerWg.Add(2) //nolint:gomnd
+	go b.answerCommands(ctx, innerWg)
+	go b.reportEvents(ctx, innerWg)
+	innerWg.Wait()
+}
+
+func (b *bot) isAllowed(chatID int64) bool {
+	for _, allowed := range b.chatIDs {
+		if chatID == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *bot) answerCommands(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	var offset int64
+	for {
+		updates, err := b.getUpdates(ctx, offset, b.pollTimeout)
+		if ctx.Err() != nil {
+			return
+		} else if err != nil {
+			b.logger.Warn(err)
+			b.wait(ctx, b.pollTimeout)
+			continue
+		}
+		for _, update := range updates {
+			offset = update.ID + 1
+			if update.Message == nil {
+				continue
+			}
+			chatID := update.Message.Chat.ID
+			if !b.isAllowed(chatID) {
+				b.logger.Warn("ignoring message from chat %d which is not allowed", chatID)
+				continue
+			}
+			answer := b.answer(update.Message.Text)
+			if err := b.sendMessage(ctx, chatID, answer); err != nil {
+				b.logger.Warn(err)
+			}
+		}
+	}
+}
+
+// answer returns the answer to the command text given.
+func (b *bot) answer(text string) string {
+	fields := strings.Fields(text)
+	command := ""
+	if len(fields) > 0 {
+		// commands in groups can be suffixed with @botname
+		command = strings.SplitN(fields[0], "@", 2)[0] //nolint:gomnd
+	}
+	switch command {
+	case "/status":
+		return formatStatus(b.getStatus(), b.getPublicIP())
+	case "/restart":
+		go b.restart() // blocks until OpenVPN is restarting
+		return "Restarting OpenVPN"
+	default:
+		return "Commands:\n/status - VPN connection status and public IP\n/restart - restart OpenVPN"
+	}
+}
+
+// reportEvents notifies the allowed chats when the VPN connects,
+// disconnects or when the public IP address changes.
+func (b *bot) reportEvents(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(b.eventsPeriod)
+	defer ticker.Stop()
+	report := &eventsReport{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		b.reportChanges(ctx, report, newEvents(b.getStatus(), b.getPublicIP()))
+	}
+}
+
+// eventsReport is the state of the events reported.
+type eventsReport struct {
+	// previous are the last events whose changes were all sent.
+	previous events
+	// unsent are the messages which could not be sent, for example
+	// because the firewall blocks traffic while the VPN is down,
+	// and unsentEvents are the events they lead to.
+	unsent       []chatMessage
+	unsentEvents events
+}
+
+type chatMessage struct {
+	chatID int64
+	text   string
+}
+
+// reportChanges sends the messages for the changes from the previous events
+// to the current events given. Messages failing to be sent are retried on the
+// next call, before looking for new changes, and the previous events are only
+// advanced once all their messages are sent.
+func (b *bot) reportChanges(ctx context.Context, report *eventsReport, current events) {
+	if len(report.unsent) > 0 {
+		report.unsent = b.send(ctx, report.unsent)
+		if len(report.unsent) > 0 {
+			return
+		}
+		report.previous = report.unsentEvents
+	}
+
+	var messages []chatMessage
+	for _, text := range report.previous.changes(current) {
+		for _, chatID := range b.chatIDs {
+			messages = append(messages, chatMessage{chatID: chatID, text: text})
+		}
+	}
+	if len(messages) == 0 {
+		report.previous = current
+		return
+	}
+
+	report.unsent = b.send(ctx, messages)
+	if len(report.unsent) == 0 {
+		report.previous = current
+		return
+	}
+	report.unsentEvents = current
+	at := " (at " + b.timeNow().Format("15:04:05 MST") + ")"
+	for i := range report.unsent {
+		report.unsent[i].text += at
+	}
+}
+
+// send sends the messages in order and returns the messages not sent. Once
+// a message fails to be sent to a chat, the next messages for this chat are
+// not sent, so the messages of each chat stay in order.
+func (b *bot) send(ctx context.Context, messages []chatMessage) (unsent []chatMessage) {
+	failedChats := make(map[int64]struct{})
+	for _, message := range messages {
+		if _, failed := failedChats[message.chatID]; !failed {
+			err := b.sendMessage(ctx, message.chatID, message.text)
+			if err == nil {
+				continue
+			}
+			b.logger.Warn(err)
+			failedChats[message.chatID] = struct{}{}
+		}
+		unsent = append(unsent, message)
+	}
+	return unsent
+}
+
+func (b *bot) wait(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		if !timer.Stop() {
+			<-timer.C
+		}
+	}
+}
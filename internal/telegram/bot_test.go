@@ -0,0 +1,132 @@
+package telegram
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/publicip"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_bot_answerCommands(t *testing.T) {
+	t.Parallel()
+
+	sent := make(chan map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/bottoken/getUpdates":
+			var parameters struct {
+				Offset int64 `json:"offset"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&parameters)
+			if parameters.Offset > 0 {
+				_, _ = w.Write([]byte(`{"ok":true,"result":[]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"ok":true,"result":[
+				{"update_id":1,"message":{"chat":{"id":666},"text":"/restart"}},
+				{"update_id":2,"message":{"chat":{"id":42},"text":"/status@gluetun_bot"}}]}`))
+		case "/bottoken/sendMessage":
+			var parameters map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&parameters)
+			sent <- parameters
+			_, _ = w.Write([]byte(`{"ok":true,"result":{}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	restarted := false
+	b := NewBot(settings.Telegram{Token: "token", ChatIDs: []int64{42}}, server.Client(),
+		func() openvpn.ConnectionStatus {
+			return openvpn.ConnectionStatus{State: openvpn.StateConnected}
+		},
+		func() publicip.Status { return publicip.Status{} },
+		func() { restarted = true },
+		logger,
+	).(*bot)
+	b.apiURL = server.URL
+	b.pollTimeout = time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go b.answerCommands(ctx, wg)
+
+	parameters := <-sent
+	cancel()
+	wg.Wait()
+
+	require.Equal(t, "42", parameters["chat_id"])
+	assert.Equal(t, "OpenVPN is connected since 0001-01-01 00:00:00 UTC", parameters["text"])
+	assert.False(t, restarted)
+}
+
+func Test_bot_reportChanges(t *testing.T) {
+	t.Parallel()
+
+	var mutex sync.Mutex
+	down := true
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if down {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		var parameters map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&parameters)
+		sent = append(sent, parameters["chat_id"]+": "+parameters["text"])
+		_, _ = w.Write([]byte(`{"ok":true,"result":{}}`))
+	}))
+	defer server.Close()
+
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	b := &bot{
+		token:   "token",
+		chatIDs: []int64{1, 2},
+		apiURL:  server.URL,
+		client:  server.Client(),
+		logger:  logger,
+		timeNow: func() time.Time { return time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC) },
+	}
+	ctx := context.Background()
+	connected := events{connected: true, server: "mullvad server 1.2.3.4"}
+	disconnected := events{}
+	report := &eventsReport{previous: connected}
+
+	// the disconnection message cannot be sent while the VPN is down
+	b.reportChanges(ctx, report, disconnected)
+	b.reportChanges(ctx, report, disconnected)
+	assert.Equal(t, connected, report.previous)
+	require.Len(t, report.unsent, 2)
+
+	mutex.Lock()
+	down = false
+	mutex.Unlock()
+	b.reportChanges(ctx, report, connected)
+
+	assert.Empty(t, report.unsent)
+	assert.Equal(t, connected, report.previous)
+	expected := []string{
+		"1: VPN disconnected from mullvad server 1.2.3.4 (at 10:00:00 UTC)",
+		"2: VPN disconnected from mullvad server 1.2.3.4 (at 10:00:00 UTC)",
+		"1: VPN connected to mullvad server 1.2.3.4",
+		"2: VPN connected to mullvad server 1.2.3.4",
+	}
+	assert.Equal(t, expected, sent)
+}
@@ -0,0 +1,87 @@
+package telegram
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/publicip"
+)
+
+// events is the state compared between two checks to report events.
+type events struct {
+	connected bool
+	server    string
+	publicIP  net.IP
+	leaking   bool
+	location  string
+}
+
+func newEvents(status openvpn.ConnectionStatus, ipStatus publicip.Status) events {
+	e := events{
+		connected: status.State == openvpn.StateConnected,
+		publicIP:  ipStatus.IP,
+		leaking:   ipStatus.Leaking,
+		location:  formatLocation(ipStatus.Geolocation),
+	}
+	if status.Server != nil {
+		e.server = fmt.Sprintf("%s server %s", status.Provider, status.Server.IP)
+	}
+	return e
+}
+
+// changes returns the messages describing the changes from the
+// previous events to the current events given.
+func (e events) changes(current events) (messages []string) {
+	switch {
+	case current.connected && !e.connected:
+		messages = append(messages, "VPN connected to "+current.server)
+	case !current.connected && e.connected:
+		messages = append(messages, "VPN disconnected from "+e.server)
+	}
+	if current.publicIP != nil && !current.publicIP.Equal(e.publicIP) {
+		message := "Public IP address is now " + current.publicIP.String()
+		if current.location != "" {
+			message += " (" + current.location + ")"
+		}
+		if current.leaking {
+			message += ": it is the ISP public IP address, traffic is leaking outside the VPN!"
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func formatStatus(status openvpn.ConnectionStatus, ipStatus publicip.Status) string {
+	lines := []string{
+		fmt.Sprintf("OpenVPN is %s since %s", status.State, status.Since.Format("2006-01-02 15:04:05 MST")),
+	}
+	if status.Server != nil {
+		lines = append(lines, fmt.Sprintf("Server: %s %s", status.Provider, status.Server.IP))
+	}
+	if status.Restarts > 0 {
+		lines = append(lines, fmt.Sprintf("Restarts: %d", status.Restarts))
+	}
+	if ipStatus.IP != nil {
+		line := "Public IP address: " + ipStatus.IP.String()
+		if location := formatLocation(ipStatus.Geolocation); location != "" {
+			line += " (" + location + ")"
+		}
+		lines = append(lines, line)
+	}
+	if ipStatus.Leaking {
+		lines = append(lines, "Traffic is leaking outside the VPN!")
+	}
+	if ipStatus.UnexpectedEgress {
+		lines = append(lines, "Public IP address may not belong to the VPN provider")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatLocation(geolocation *publicip.Geolocation) string {
+	if geolocation == nil {
+		return ""
+	}
+	return geolocation.String()
+}
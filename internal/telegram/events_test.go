@@ -0,0 +1,54 @@
+package telegram
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_events_changes(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		previous events
+		current  events
+		messages []string
+	}{
+		"no change": {
+			previous: events{connected: true, publicIP: net.IP{1, 2, 3, 4}},
+			current:  events{connected: true, publicIP: net.IP{1, 2, 3, 4}},
+		},
+		"connected": {
+			current:  events{connected: true, server: "mullvad server 1.1.1.1"},
+			messages: []string{"VPN connected to mullvad server 1.1.1.1"},
+		},
+		"disconnected": {
+			previous: events{connected: true, server: "mullvad server 1.1.1.1"},
+			current:  events{server: "mullvad server 1.1.1.1"},
+			messages: []string{"VPN disconnected from mullvad server 1.1.1.1"},
+		},
+		"public IP changed": {
+			previous: events{connected: true, publicIP: net.IP{1, 2, 3, 4}},
+			current:  events{connected: true, publicIP: net.IP{5, 6, 7, 8}, location: "Stockholm, Sweden"},
+			messages: []string{"Public IP address is now 5.6.7.8 (Stockholm, Sweden)"},
+		},
+		"public IP unknown": {
+			previous: events{connected: true, publicIP: net.IP{1, 2, 3, 4}},
+			current:  events{connected: true},
+		},
+		"leaking": {
+			current: events{publicIP: net.IP{1, 2, 3, 4}, leaking: true},
+			messages: []string{
+				"Public IP address is now 1.2.3.4: it is the ISP public IP address, traffic is leaking outside the VPN!",
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			messages := testCase.previous.changes(testCase.current)
+			assert.Equal(t, testCase.messages, messages)
+		})
+	}
+}
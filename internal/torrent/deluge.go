@@ -0,0 +1,86 @@
+package torrent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type deluge struct {
+	client   *http.Client
+	url      string
+	password string
+}
+
+// NewDeluge returns a port setter using the Deluge web UI JSON RPC API
+// at the url given, for example http://127.0.0.1:8112/json.
+func NewDeluge(client *http.Client, url, password string) PortSetter {
+	return &deluge{
+		client:   client,
+		url:      url,
+		password: password,
+	}
+}
+
+func (d *deluge) SetPeerPort(ctx context.Context, port uint16) error {
+	cookies, err := d.call(ctx, nil, "auth.login", d.password)
+	if err != nil {
+		return err
+	}
+	config := map[string]interface{}{
+		"listen_ports": []uint16{port, port},
+		"random_port":  false,
+	}
+	_, err = d.call(ctx, cookies, "core.set_config", config)
+	return err
+}
+
+func (d *deluge) call(ctx context.Context, cookies []*http.Cookie,
+	method string, params ...interface{}) (responseCookies []*http.Cookie, err error) {
+	body, err := json.Marshal(struct {
+		Method string        `json:"method"`
+		Params []interface{} `json:"params"`
+		ID     int           `json:"id"`
+	}{
+		Method: method,
+		Params: params,
+		ID:     1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for _, cookie := range cookies {
+		request.AddCookie(cookie)
+	}
+	response, err := d.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("deluge: %s: %s", method, response.Status)
+	}
+	var result struct {
+		Result interface{} `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	decoder := json.NewDecoder(response.Body)
+	if err := decoder.Decode(&result); err != nil {
+		return nil, fmt.Errorf("deluge: %s: %w", method, err)
+	}
+	if result.Error != nil {
+		return nil, fmt.Errorf("deluge: %s: %s", method, result.Error.Message)
+	} else if result.Result == false {
+		return nil, fmt.Errorf("deluge: %s: failed", method)
+	}
+	return response.Cookies(), nil
+}
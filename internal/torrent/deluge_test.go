@@ -0,0 +1,78 @@
+package torrent
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_deluge_SetPeerPort(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		loginResponse  string
+		configResponse string
+		requests       int
+		err            string
+	}{
+		"success": {
+			loginResponse:  `{"result":true,"error":null,"id":1}`,
+			configResponse: `{"result":null,"error":null,"id":1}`,
+			requests:       2,
+		},
+		"wrong password": {
+			loginResponse: `{"result":false,"error":null,"id":1}`,
+			requests:      1,
+			err:           "deluge: auth.login: failed",
+		},
+		"set config error": {
+			loginResponse:  `{"result":true,"error":null,"id":1}`,
+			configResponse: `{"result":null,"error":{"message":"Not authenticated","code":1},"id":1}`,
+			requests:       2,
+			err:            "deluge: core.set_config: Not authenticated",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				body, err := ioutil.ReadAll(r.Body)
+				require.NoError(t, err)
+				switch requests {
+				case 1:
+					assert.Equal(t, `{"method":"auth.login","params":["password"],"id":1}`, string(body))
+					http.SetCookie(w, &http.Cookie{Name: "_session_id", Value: "session"})
+					_, _ = w.Write([]byte(testCase.loginResponse))
+				case 2:
+					cookie, err := r.Cookie("_session_id")
+					require.NoError(t, err)
+					assert.Equal(t, "session", cookie.Value)
+					assert.Equal(t, `{"method":"core.set_config","params":[`+
+						`{"listen_ports":[5914,5914],"random_port":false}],"id":1}`, string(body))
+					_, _ = w.Write([]byte(testCase.configResponse))
+				default:
+					t.Errorf("unexpected request %d", requests)
+				}
+			}))
+			defer server.Close()
+
+			portSetter := NewDeluge(server.Client(), server.URL, "password")
+			err := portSetter.SetPeerPort(context.Background(), 5914)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, testCase.requests, requests)
+		})
+	}
+}
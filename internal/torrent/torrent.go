@@ -0,0 +1,12 @@
+// Package torrent contains RPC clients for torrent clients, used to
+// update their peer listening port to the VPN forwarded port.
+package torrent
+
+import (
+	"context"
+)
+
+// PortSetter sets the peer listening port of a torrent client.
+type PortSetter interface {
+	SetPeerPort(ctx context.Context, port uint16) error
+}
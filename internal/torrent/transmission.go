@@ -0,0 +1,94 @@
+package torrent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+type transmission struct {
+	client    *http.Client
+	url       string
+	user      string
+	password  string
+	sessionID string
+}
+
+// NewTransmission returns a port setter using the Transmission RPC API
+// at the url given, for example http://127.0.0.1:9091/transmission/rpc.
+func NewTransmission(client *http.Client, url, user, password string) PortSetter {
+	return &transmission{
+		client:   client,
+		url:      url,
+		user:     user,
+		password: password,
+	}
+}
+
+const transmissionSessionIDHeader = "X-Transmission-Session-Id"
+
+func (t *transmission) SetPeerPort(ctx context.Context, port uint16) error {
+	type arguments struct {
+		PeerPort uint16 `json:"peer-port"`
+	}
+	body, err := json.Marshal(struct {
+		Method    string    `json:"method"`
+		Arguments arguments `json:"arguments"`
+	}{
+		Method:    "session-set",
+		Arguments: arguments{PeerPort: port},
+	})
+	if err != nil {
+		return err
+	}
+
+	response, err := t.do(ctx, body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode == http.StatusConflict {
+		// Transmission requires a session ID obtained from a first request
+		response.Body.Close()
+		t.sessionID = response.Header.Get(transmissionSessionIDHeader)
+		response, err = t.do(ctx, body)
+		if err != nil {
+			return err
+		}
+	}
+	defer response.Body.Close()
+	b, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("transmission: %s: %s", response.Status, string(b))
+	}
+
+	var result struct {
+		Result string `json:"result"`
+	}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return fmt.Errorf("transmission: %w", err)
+	} else if result.Result != "success" {
+		return fmt.Errorf("transmission: %s", result.Result)
+	}
+	return nil
+}
+
+func (t *transmission) do(ctx context.Context, body []byte) (response *http.Response, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if t.sessionID != "" {
+		request.Header.Set(transmissionSessionIDHeader, t.sessionID)
+	}
+	if t.user != "" || t.password != "" {
+		request.SetBasicAuth(t.user, t.password)
+	}
+	return t.client.Do(request)
+}
@@ -0,0 +1,39 @@
+package torrent
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_transmission_SetPeerPort(t *testing.T) {
+	t.Parallel()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get(transmissionSessionIDHeader) != "id" {
+			w.Header().Set(transmissionSessionIDHeader, "id")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		user, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", user)
+		assert.Equal(t, "password", password)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"method":"session-set","arguments":{"peer-port":5914}}`, string(body))
+		_, _ = w.Write([]byte(`{"arguments":{},"result":"success"}`))
+	}))
+	defer server.Close()
+
+	portSetter := NewTransmission(server.Client(), server.URL, "user", "password")
+	err := portSetter.SetPeerPort(context.Background(), 5914)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
@@ -0,0 +1,273 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/qdm12/golibs/logging"
+)
+
+const (
+	exportPeriod = 5 * time.Second
+	// maxQueuedSpans is the maximum number of ended spans kept
+	// in memory if the collector cannot be reached.
+	maxQueuedSpans = 2048
+)
+
+type otlpTracer struct {
+	client      *http.Client
+	url         string
+	serviceName string
+	logger      logging.Logger
+	timeNow     func() time.Time
+	ended       []*span
+	endedMutex  sync.Mutex
+}
+
+// NewOTLP returns a tracer exporting spans to the OTLP HTTP endpoint
+// given, such as http://collector:4318, with the service name given.
+func NewOTLP(client *http.Client, endpoint, serviceName string, logger logging.Logger) Tracer {
+	return &otlpTracer{
+		client:      client,
+		url:         strings.TrimSuffix(endpoint, "/") + "/v1/traces",
+		serviceName: serviceName,
+		logger:      logger.WithPrefix("tracing: "),
+		timeNow:     time.Now,
+	}
+}
+
+type spanContextKey struct{}
+
+func (t *otlpTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	s := &span{
+		tracer: t,
+		name:   name,
+		start:  t.timeNow(),
+		spanID: randomHex(8), //nolint:gomnd
+	}
+	if parent, ok := ctx.Value(spanContextKey{}).(*span); ok {
+		s.traceID = parent.traceID
+		s.parentID = parent.spanID
+	} else {
+		s.traceID = randomHex(16) //nolint:gomnd
+	}
+	return context.WithValue(ctx, spanContextKey{}, s), s
+}
+
+func (t *otlpTracer) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	ticker := time.NewTicker(exportPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			t.export(ctx)
+		case <-ctx.Done():
+			// export the last spans such as the ones ended by the shutdown
+			const timeout = 2 * time.Second
+			exportCtx, cancel := context.WithTimeout(context.Background(), timeout)
+			t.export(exportCtx)
+			cancel()
+			return
+		}
+	}
+}
+
+func (t *otlpTracer) push(s *span) {
+	t.endedMutex.Lock()
+	defer t.endedMutex.Unlock()
+	if len(t.ended) == maxQueuedSpans {
+		t.ended = t.ended[1:]
+	}
+	t.ended = append(t.ended, s)
+}
+
+func (t *otlpTracer) export(ctx context.Context) {
+	t.endedMutex.Lock()
+	spans := t.ended
+	t.ended = nil
+	t.endedMutex.Unlock()
+	if len(spans) == 0 {
+		return
+	}
+	if err := t.post(ctx, spans); err != nil {
+		t.logger.Warn("cannot export %d spans: %s", len(spans), err)
+		t.endedMutex.Lock()
+		if len(t.ended)+len(spans) <= maxQueuedSpans {
+			t.ended = append(spans, t.ended...)
+		}
+		t.endedMutex.Unlock()
+	}
+}
+
+func (t *otlpTracer) post(ctx context.Context, spans []*span) error {
+	data, err := json.Marshal(t.request(spans))
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	response, err := t.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status code %d from %s", response.StatusCode, t.url)
+	}
+	return nil
+}
+
+type span struct {
+	tracer     *otlpTracer
+	name       string
+	traceID    string
+	spanID     string
+	parentID   string
+	start      time.Time
+	end        time.Time
+	attributes map[string]string
+	err        error
+	mutex      sync.Mutex
+}
+
+func (s *span) SetAttribute(key, value string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]string)
+	}
+	s.attributes[key] = value
+}
+
+func (s *span) End(err error) {
+	s.mutex.Lock()
+	if !s.end.IsZero() {
+		s.mutex.Unlock()
+		return
+	}
+	s.end = s.tracer.timeNow()
+	s.err = err
+	s.mutex.Unlock()
+	s.tracer.push(s)
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// The types below are the subset of the OTLP JSON encoding used.
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpStatusOK         = 1
+	otlpStatusError      = 2
+)
+
+func (t *otlpTracer) request(spans []*span) (request otlpRequest) {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, s := range spans {
+		s.mutex.Lock()
+		otlpSpans[i] = otlpSpan{
+			TraceID:           s.traceID,
+			SpanID:            s.spanID,
+			ParentSpanID:      s.parentID,
+			Name:              s.name,
+			Kind:              otlpSpanKindInternal,
+			StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+			Attributes:        toAttributes(s.attributes),
+			Status:            otlpStatus{Code: otlpStatusOK},
+		}
+		if s.err != nil {
+			otlpSpans[i].Status = otlpStatus{Code: otlpStatusError, Message: s.err.Error()}
+		}
+		s.mutex.Unlock()
+	}
+	return otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: toAttributes(map[string]string{"service.name": t.serviceName}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/qdm12/gluetun"},
+				Spans: otlpSpans,
+			}},
+		}},
+	}
+}
+
+func toAttributes(m map[string]string) (attributes []otlpAttribute) {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attributes = append(attributes, otlpAttribute{Key: key, Value: otlpValue{StringValue: m[key]}})
+	}
+	return attributes
+}
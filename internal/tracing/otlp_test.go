@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/qdm12/golibs/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_otlpTracer(t *testing.T) {
+	t.Parallel()
+	requests := make(chan otlpRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		data, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var request otlpRequest
+		require.NoError(t, json.Unmarshal(data, &request))
+		requests <- request
+	}))
+	defer server.Close()
+
+	logger, err := logging.NewEmptyLogger()
+	require.NoError(t, err)
+	tracer := NewOTLP(server.Client(), server.URL+"/", "gluetun", logger).(*otlpTracer)
+	now := time.Unix(10, 0)
+	tracer.timeNow = func() time.Time { return now }
+
+	ctx, parent := tracer.Start(context.Background(), "openvpn.connect")
+	parent.SetAttribute("provider", "mullvad")
+	_, child := tracer.Start(ctx, "openvpn.pick_server")
+	child.End(errors.New("no server found"))
+	child.End(nil) // no effect
+	parent.End(nil)
+	tracer.export(context.Background())
+
+	request := <-requests
+	require.Len(t, request.ResourceSpans, 1)
+	assert.Equal(t, []otlpAttribute{{Key: "service.name", Value: otlpValue{StringValue: "gluetun"}}},
+		request.ResourceSpans[0].Resource.Attributes)
+	require.Len(t, request.ResourceSpans[0].ScopeSpans, 1)
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 2)
+	childSpan, parentSpan := spans[0], spans[1]
+	assert.Equal(t, "openvpn.pick_server", childSpan.Name)
+	assert.Equal(t, parentSpan.TraceID, childSpan.TraceID)
+	assert.Equal(t, parentSpan.SpanID, childSpan.ParentSpanID)
+	assert.Equal(t, otlpStatus{Code: otlpStatusError, Message: "no server found"}, childSpan.Status)
+	assert.Equal(t, "openvpn.connect", parentSpan.Name)
+	assert.Empty(t, parentSpan.ParentSpanID)
+	assert.Len(t, parentSpan.TraceID, 32)
+	assert.Len(t, parentSpan.SpanID, 16)
+	assert.Equal(t, "10000000000", parentSpan.StartTimeUnixNano)
+	assert.Equal(t, []otlpAttribute{{Key: "provider", Value: otlpValue{StringValue: "mullvad"}}},
+		parentSpan.Attributes)
+	assert.Equal(t, otlpStatus{Code: otlpStatusOK}, parentSpan.Status)
+	assert.Empty(t, tracer.ended)
+}
@@ -0,0 +1,50 @@
+// Package tracing records spans of the major operations and exports
+// them to an OpenTelemetry collector using OTLP over HTTP with JSON.
+package tracing
+
+import (
+	"context"
+	"sync"
+)
+
+// Tracer starts spans, which are children of the span in the context given if any.
+type Tracer interface {
+	Start(ctx context.Context, name string) (spanCtx context.Context, span Span)
+	// Run exports the ended spans periodically until the context is canceled.
+	Run(ctx context.Context, wg *sync.WaitGroup)
+}
+
+// Span is a timed operation.
+type Span interface {
+	SetAttribute(key, value string)
+	// End ends the span, marking it as failed if the error is not nil.
+	// Only the first call has an effect.
+	End(err error)
+}
+
+// NewNoop returns a tracer doing nothing, used when tracing is not configured.
+func NewNoop() Tracer {
+	return noopTracer{}
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+func (noopTracer) Run(ctx context.Context, wg *sync.WaitGroup) { wg.Done() }
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(key, value string) {}
+func (noopSpan) End(err error)                  {}
+
+// Do runs the function given within a span with the name given,
+// ending the span with the error returned by the function.
+func Do(ctx context.Context, tracer Tracer, name string, f func(ctx context.Context) error) error {
+	ctx, span := tracer.Start(ctx, name)
+	err := f(ctx)
+	span.End(err)
+	return err
+}
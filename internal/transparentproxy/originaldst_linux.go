@@ -0,0 +1,33 @@
+package transparentproxy
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"syscall"
+)
+
+// originalDestination obtains the destination address of a connection
+// before it was redirected by iptables, using the SO_ORIGINAL_DST socket option.
+func originalDestination(connection *net.TCPConn) (address string, err error) {
+	rawConn, err := connection.SyscallConn()
+	if err != nil {
+		return "", err
+	}
+	const soOriginalDst = 80 // from linux/netfilter_ipv4.h
+	var mreq *syscall.IPv6Mreq
+	var sockoptErr error
+	err = rawConn.Control(func(fd uintptr) {
+		// IPv6Mreq has the same 16+ bytes layout as sockaddr_in
+		mreq, sockoptErr = syscall.GetsockoptIPv6Mreq(int(fd), syscall.SOL_IP, soOriginalDst)
+	})
+	if err != nil {
+		return "", err
+	} else if sockoptErr != nil {
+		return "", fmt.Errorf("cannot get socket option SO_ORIGINAL_DST: %w", sockoptErr)
+	}
+	raw := mreq.Multiaddr
+	port := int(raw[2])<<8 + int(raw[3]) //nolint:gomnd
+	ip := net.IPv4(raw[4], raw[5], raw[6], raw[7])
+	return net.JoinHostPort(ip.String(), strconv.Itoa(port)), nil
+}
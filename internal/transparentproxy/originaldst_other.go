@@ -0,0 +1,27 @@
+// +build !linux
+
+package transparentproxy
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+var errNotLinux = errors.New("transparent proxy is only supported on Linux")
+
+func originalDestination(connection *net.TCPConn) (address string, err error) {
+	return "", errNotLinux
+}
+
+func listenTransparentUDP(ctx context.Context, address string) (conn *net.UDPConn, err error) {
+	return nil, errNotLinux
+}
+
+func listenUDPFrom(ctx context.Context, address *net.UDPAddr) (conn *net.UDPConn, err error) {
+	return nil, errNotLinux
+}
+
+func parseOriginalDestination(oob []byte) (address *net.UDPAddr, err error) {
+	return nil, errNotLinux
+}
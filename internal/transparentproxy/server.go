@@ -0,0 +1,115 @@
+// Package transparentproxy implements a TCP and UDP proxy receiving traffic
+// redirected by iptables and forwarding it to its original destination,
+// through the VPN tunnel.
+package transparentproxy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/qdm12/golibs/logging"
+)
+
+type Server interface {
+	Run(ctx context.Context, wg *sync.WaitGroup)
+}
+
+type server struct {
+	address string
+	logger  logging.Logger
+}
+
+func NewServer(address string, logger logging.Logger) Server {
+	return &server{
+		address: address,
+		logger:  logger.WithPrefix("transparent proxy: "),
+	}
+}
+
+func (s *server) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	udpWg := &sync.WaitGroup{}
+	defer udpWg.Wait()
+	udpWg.Add(1)
+	go s.runUDP(ctx, udpWg)
+
+	listenConfig := net.ListenConfig{}
+	listener, err := listenConfig.Listen(ctx, "tcp", s.address)
+	if err != nil {
+		s.logger.Error(err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		s.logger.Warn("context canceled: shutting down server")
+		if err := listener.Close(); err != nil {
+			s.logger.Error("failed shutting down: %s", err)
+		}
+	}()
+	s.logger.Info("listening on %s/tcp", s.address)
+
+	connectionsWg := &sync.WaitGroup{}
+	defer connectionsWg.Wait()
+	for {
+		connection, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Error(err)
+			}
+			return
+		}
+		connectionsWg.Add(1)
+		go s.handle(ctx, connection.(*net.TCPConn), connectionsWg)
+	}
+}
+
+func (s *server) handle(ctx context.Context, clientConn *net.TCPConn, wg *sync.WaitGroup) {
+	defer wg.Done()
+	defer clientConn.Close()
+
+	destination, err := originalDestination(clientConn)
+	if err != nil {
+		s.logger.Warn("cannot get original destination for %s: %s", clientConn.RemoteAddr(), err)
+		return
+	}
+
+	dialer := net.Dialer{}
+	destinationConn, err := dialer.DialContext(ctx, "tcp", destination)
+	if err != nil {
+		s.logger.Warn("cannot connect to %s for %s: %s", destination, clientConn.RemoteAddr(), err)
+		return
+	}
+	defer destinationConn.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = clientConn.Close()
+		_ = destinationConn.Close()
+	}()
+
+	const transferGoroutines = 2
+	errs := make(chan error, transferGoroutines)
+	go transfer(destinationConn, clientConn, errs)
+	go transfer(clientConn, destinationConn, errs)
+	for i := 0; i < transferGoroutines; i++ {
+		if err := <-errs; err != nil && ctx.Err() == nil && !isClosedError(err) {
+			s.logger.Debug("%s <-> %s: %s", clientConn.RemoteAddr(), destination, err)
+		}
+		cancel()
+	}
+}
+
+func transfer(destination io.Writer, source io.Reader, errs chan<- error) {
+	_, err := io.Copy(destination, source)
+	errs <- err
+}
+
+func isClosedError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Err.Error() == "use of closed network connection"
+}
@@ -0,0 +1,141 @@
+package transparentproxy
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// udpFlowTimeout is the time without reply after which a UDP flow is closed.
+	udpFlowTimeout   = time.Minute
+	maxUDPPacketSize = 65535
+)
+
+// udpFlow relays the UDP packets of a client to one original destination.
+type udpFlow struct {
+	// destinationConn is connected to the original destination, through the tunnel.
+	destinationConn net.Conn
+	// replyConn is bound to the original destination address, to send the
+	// replies to the client as if they came from the original destination.
+	replyConn *net.UDPConn
+}
+
+func (s *server) runUDP(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	conn, err := listenTransparentUDP(ctx, s.address)
+	if err != nil {
+		s.logger.Error(err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			s.logger.Error("failed shutting down UDP listener: %s", err)
+		}
+	}()
+	s.logger.Info("listening on %s/udp", s.address)
+
+	flows := make(map[string]*udpFlow)
+	flowsMutex := &sync.Mutex{}
+	flowsWg := &sync.WaitGroup{}
+	defer flowsWg.Wait()
+
+	buffer := make([]byte, maxUDPPacketSize)
+	oob := make([]byte, 64) //nolint:gomnd
+	for {
+		n, oobn, _, clientAddress, err := conn.ReadMsgUDP(buffer, oob)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Error(err)
+			}
+			return
+		}
+		destination, err := parseOriginalDestination(oob[:oobn])
+		if err != nil {
+			s.logger.Warn("cannot get original destination for %s: %s", clientAddress, err)
+			continue
+		}
+
+		key := clientAddress.String() + "-" + destination.String()
+		flowsMutex.Lock()
+		flow, ok := flows[key]
+		if !ok {
+			flow, err = newUDPFlow(ctx, destination)
+			if err != nil {
+				flowsMutex.Unlock()
+				s.logger.Warn("cannot relay UDP from %s to %s: %s", clientAddress, destination, err)
+				continue
+			}
+			flows[key] = flow
+			flowsWg.Add(1)
+			go func() {
+				defer flowsWg.Done()
+				s.relayUDPReplies(ctx, flow, clientAddress)
+				flowsMutex.Lock()
+				delete(flows, key)
+				flowsMutex.Unlock()
+			}()
+		}
+		flowsMutex.Unlock()
+
+		if _, err := flow.destinationConn.Write(buffer[:n]); err != nil && !isClosedError(err) {
+			s.logger.Debug("%s -> %s: %s", clientAddress, destination, err)
+		}
+	}
+}
+
+func newUDPFlow(ctx context.Context, destination *net.UDPAddr) (flow *udpFlow, err error) {
+	dialer := net.Dialer{}
+	destinationConn, err := dialer.DialContext(ctx, "udp", destination.String())
+	if err != nil {
+		return nil, err
+	}
+	replyConn, err := listenUDPFrom(ctx, destination)
+	if err != nil {
+		_ = destinationConn.Close()
+		return nil, err
+	}
+	return &udpFlow{
+		destinationConn: destinationConn,
+		replyConn:       replyConn,
+	}, nil
+}
+
+// relayUDPReplies sends the packets received from the original destination
+// back to the client, until no packet is received for the flow timeout
+// or the context is canceled. It closes the flow connections on exit.
+func (s *server) relayUDPReplies(ctx context.Context, flow *udpFlow, clientAddress *net.UDPAddr) {
+	defer func() {
+		_ = flow.destinationConn.Close()
+		_ = flow.replyConn.Close()
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = flow.destinationConn.Close() // unblock the read
+	}()
+
+	buffer := make([]byte, maxUDPPacketSize)
+	for {
+		if err := flow.destinationConn.SetReadDeadline(time.Now().Add(udpFlowTimeout)); err != nil {
+			s.logger.Debug("%s <- %s: %s", clientAddress, flow.destinationConn.RemoteAddr(), err)
+			return
+		}
+		n, err := flow.destinationConn.Read(buffer)
+		if err != nil {
+			var netErr net.Error
+			if ctx.Err() == nil && !isClosedError(err) &&
+				!(errors.As(err, &netErr) && netErr.Timeout()) {
+				s.logger.Debug("%s <- %s: %s", clientAddress, flow.destinationConn.RemoteAddr(), err)
+			}
+			return
+		}
+		if _, err := flow.replyConn.WriteToUDP(buffer[:n], clientAddress); err != nil {
+			s.logger.Debug("%s <- %s: %s", clientAddress, flow.destinationConn.RemoteAddr(), err)
+		}
+	}
+}
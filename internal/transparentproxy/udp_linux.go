@@ -0,0 +1,88 @@
+package transparentproxy
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// listenTransparentUDP listens on the address given with a transparent socket
+// receiving the UDP packets redirected by the TPROXY firewall rule, together
+// with their original destination address.
+func listenTransparentUDP(ctx context.Context, address string) (conn *net.UDPConn, err error) {
+	listenConfig := net.ListenConfig{
+		Control: func(network, address string, rawConn syscall.RawConn) error {
+			return setSocketOptions(rawConn, syscall.IP_TRANSPARENT, syscall.IP_RECVORIGDSTADDR)
+		},
+	}
+	packetConn, err := listenConfig.ListenPacket(ctx, "udp4", address)
+	if err != nil {
+		return nil, err
+	}
+	return packetConn.(*net.UDPConn), nil
+}
+
+// listenUDPFrom creates a transparent UDP socket bound to the non local
+// address given, to send packets with this address as source address.
+func listenUDPFrom(ctx context.Context, address *net.UDPAddr) (conn *net.UDPConn, err error) {
+	listenConfig := net.ListenConfig{
+		Control: func(network, address string, rawConn syscall.RawConn) error {
+			return setSocketOptions(rawConn, syscall.IP_TRANSPARENT, syscall.SO_REUSEADDR)
+		},
+	}
+	packetConn, err := listenConfig.ListenPacket(ctx, "udp4", address.String())
+	if err != nil {
+		return nil, err
+	}
+	return packetConn.(*net.UDPConn), nil
+}
+
+// setSocketOptions enables the options given, which are at the IP level
+// except SO_REUSEADDR which is at the socket level.
+func setSocketOptions(rawConn syscall.RawConn, options ...int) (err error) {
+	var sockoptErr error
+	err = rawConn.Control(func(fd uintptr) {
+		for _, option := range options {
+			level := syscall.SOL_IP
+			if option == syscall.SO_REUSEADDR {
+				level = syscall.SOL_SOCKET
+			}
+			if sockoptErr = syscall.SetsockoptInt(int(fd), level, option, 1); sockoptErr != nil {
+				sockoptErr = fmt.Errorf("cannot set socket option %d: %w", option, sockoptErr)
+				return
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return sockoptErr
+}
+
+var ErrOriginalDestinationNotFound = errors.New("original destination not found in control messages")
+
+// parseOriginalDestination finds the original destination address of
+// the UDP packet in the IP_ORIGDSTADDR control message given.
+func parseOriginalDestination(oob []byte) (address *net.UDPAddr, err error) {
+	messages, err := syscall.ParseSocketControlMessage(oob)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse control messages: %w", err)
+	}
+	for _, message := range messages {
+		if message.Header.Level != syscall.SOL_IP || message.Header.Type != syscall.IP_ORIGDSTADDR {
+			continue
+		}
+		if len(message.Data) < syscall.SizeofSockaddrInet4 {
+			return nil, fmt.Errorf("original destination is too short: %d bytes", len(message.Data))
+		}
+		// struct sockaddr_in: family, port in network byte order and address
+		return &net.UDPAddr{
+			IP:   net.IPv4(message.Data[4], message.Data[5], message.Data[6], message.Data[7]),
+			Port: int(binary.BigEndian.Uint16(message.Data[2:4])),
+		}, nil
+	}
+	return nil, ErrOriginalDestinationNotFound
+}
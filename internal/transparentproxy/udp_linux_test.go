@@ -0,0 +1,72 @@
+package transparentproxy
+
+import (
+	"net"
+	"syscall"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// controlMessage returns a control message of the level, type and data given.
+func controlMessage(level, messageType int32, data []byte) (message []byte) {
+	message = make([]byte, syscall.CmsgSpace(len(data)))
+	header := (*syscall.Cmsghdr)(unsafe.Pointer(&message[0]))
+	header.Level = level
+	header.Type = messageType
+	header.SetLen(syscall.CmsgLen(len(data)))
+	copy(message[syscall.CmsgLen(0):], data)
+	return message
+}
+
+func Test_parseOriginalDestination(t *testing.T) {
+	t.Parallel()
+	// sockaddr_in with family AF_INET in little endian, port 53 and 1.2.3.4
+	sockaddr := []byte{2, 0, 0, 53, 1, 2, 3, 4, 0, 0, 0, 0, 0, 0, 0, 0}
+	testCases := map[string]struct {
+		oob     []byte
+		address *net.UDPAddr
+		err     string
+	}{
+		"no control message": {
+			err: "original destination not found in control messages",
+		},
+		"other control message": {
+			oob: controlMessage(syscall.SOL_IP, syscall.IP_TTL, []byte{64, 0, 0, 0}),
+			err: "original destination not found in control messages",
+		},
+		"truncated control message": {
+			oob: controlMessage(syscall.SOL_IP, syscall.IP_ORIGDSTADDR, sockaddr)[:syscall.CmsgLen(0)+2],
+			err: "cannot parse control messages: invalid argument",
+		},
+		"short original destination": {
+			oob: controlMessage(syscall.SOL_IP, syscall.IP_ORIGDSTADDR, sockaddr[:4]),
+			err: "original destination is too short: 4 bytes",
+		},
+		"original destination": {
+			oob:     controlMessage(syscall.SOL_IP, syscall.IP_ORIGDSTADDR, sockaddr),
+			address: &net.UDPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 53},
+		},
+		"original destination after other message": {
+			oob: append(controlMessage(syscall.SOL_IP, syscall.IP_TTL, []byte{64, 0, 0, 0}),
+				controlMessage(syscall.SOL_IP, syscall.IP_ORIGDSTADDR, sockaddr)...),
+			address: &net.UDPAddr{IP: net.IPv4(1, 2, 3, 4), Port: 53},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			address, err := parseOriginalDestination(testCase.oob)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.address, address)
+		})
+	}
+}
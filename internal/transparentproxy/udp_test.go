@@ -0,0 +1,68 @@
+package transparentproxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_server_relayUDPReplies(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	logger := mock_logging.NewMockLogger(mockCtrl)
+	s := &server{logger: logger}
+
+	// destination replies to each packet with the packet and " reply"
+	destination, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer destination.Close()
+	go func() {
+		buffer := make([]byte, maxUDPPacketSize)
+		for {
+			n, address, err := destination.ReadFromUDP(buffer)
+			if err != nil {
+				return
+			}
+			_, _ = destination.WriteToUDP([]byte(string(buffer[:n])+" reply"), address)
+		}
+	}()
+
+	client, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	defer client.Close()
+
+	destinationConn, err := net.DialUDP("udp4", nil, destination.LocalAddr().(*net.UDPAddr))
+	require.NoError(t, err)
+	replyConn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	flow := &udpFlow{destinationConn: destinationConn, replyConn: replyConn}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.relayUDPReplies(ctx, flow, client.LocalAddr().(*net.UDPAddr))
+		close(done)
+	}()
+
+	_, err = destinationConn.Write([]byte("query"))
+	require.NoError(t, err)
+
+	require.NoError(t, client.SetReadDeadline(time.Now().Add(time.Second)))
+	buffer := make([]byte, maxUDPPacketSize)
+	n, from, err := client.ReadFromUDP(buffer)
+	require.NoError(t, err)
+	assert.Equal(t, "query reply", string(buffer[:n]))
+	assert.Equal(t, replyConn.LocalAddr(), from)
+
+	cancel()
+	<-done
+	_, err = replyConn.WriteToUDP([]byte("x"), client.LocalAddr().(*net.UDPAddr))
+	assert.Error(t, err, "reply connection must be closed")
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 )
 
@@ -13,6 +15,8 @@ func (u *updater) updateCyberghost(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	servers, warnings := dedupCyberghostServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifyCyberghostServers(servers))
 	}
@@ -23,7 +27,7 @@ func (u *updater) updateCyberghost(ctx context.Context) (err error) {
 
 func findCyberghostServers(ctx context.Context, lookupIP lookupIPFunc) (servers []models.CyberghostServer, err error) {
 	groups := getCyberghostGroups()
-	allCountryCodes := getCountryCodes()
+	allCountryCodes := constants.CountryCodes()
 	cyberghostCountryCodes := getCyberghostSubdomainToRegion()
 	possibleCountryCodes := mergeCountryCodes(cyberghostCountryCodes, allCountryCodes)
 
@@ -70,12 +74,27 @@ func tryCyberghostHostname(ctx context.Context, lookupIP lookupIPFunc,
 		return
 	}
 	results <- models.CyberghostServer{
-		Region: region,
-		Group:  groupName,
-		IPs:    IPs,
+		Region:   region,
+		Group:    groupName,
+		Hostname: host,
+		IPs:      IPs,
+		Ports:    cyberghostGroupPorts(groupName),
 	}
 }
 
+// cyberghostGroupPorts returns the ports supported by the servers of a group,
+// since each group only supports the protocol in its name on port 443.
+func cyberghostGroupPorts(groupName string) (ports models.OpenVPNPorts) {
+	const port = 443
+	switch {
+	case strings.Contains(groupName, " TCP "):
+		ports.TCP = []uint16{port}
+	case strings.Contains(groupName, " UDP "):
+		ports.UDP = []uint16{port}
+	}
+	return ports
+}
+
 //nolint:goconst
 func stringifyCyberghostServers(servers []models.CyberghostServer) (s string) {
 	s = "func CyberghostServers() []models.CyberghostServer {\n"
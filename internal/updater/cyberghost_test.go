@@ -0,0 +1,36 @@
+package updater
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_cyberghostGroupPorts(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		groupName string
+		ports     models.OpenVPNPorts
+	}{
+		"udp group": {
+			groupName: "Premium UDP Europe",
+			ports:     models.OpenVPNPorts{UDP: []uint16{443}},
+		},
+		"tcp group": {
+			groupName: "NoSpy TCP Europe",
+			ports:     models.OpenVPNPorts{TCP: []uint16{443}},
+		},
+		"unknown group": {
+			groupName: "Other",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ports := cyberghostGroupPorts(testCase.groupName)
+			assert.Equal(t, testCase.ports, ports)
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package updater
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+// serverKey contains the fields of a server used to find its duplicates.
+type serverKey struct {
+	hostname string
+	region   string
+	ips      []net.IP
+}
+
+// groupDuplicates groups the indexes of the servers with the same hostname,
+// in their original order, with the first server of each group being the
+// one to keep. Warnings are returned for each hostname listed more than once
+// and for each IP address shared by servers with different hostnames, which
+// are not merged since they can be selected separately.
+func groupDuplicates(keys []serverKey) (groups [][]int, warnings []string) {
+	hostnameToGroup := make(map[string]int, len(keys))
+	ipToHostname := make(map[string]string)
+	sharedIPs := make(map[string]struct{})
+	for i, key := range keys {
+		hostname := strings.ToLower(key.hostname)
+		groupIndex, ok := hostnameToGroup[hostname]
+		switch {
+		case hostname == "" || !ok:
+			if hostname != "" {
+				hostnameToGroup[hostname] = len(groups)
+			}
+			groups = append(groups, []int{i})
+		default:
+			first := keys[groups[groupIndex][0]]
+			warning := fmt.Sprintf("hostname %q is listed more than once in region %q, merging them",
+				key.hostname, key.region)
+			if first.region != key.region {
+				warning = fmt.Sprintf("hostname %q is listed in regions %q and %q, merging it in region %q",
+					key.hostname, first.region, key.region, first.region)
+			}
+			warnings = append(warnings, warning)
+			groups[groupIndex] = append(groups[groupIndex], i)
+		}
+
+		for _, ip := range key.ips {
+			if ip == nil {
+				continue
+			}
+			ipString := ip.String()
+			otherHostname, ok := ipToHostname[ipString]
+			if !ok {
+				ipToHostname[ipString] = hostname
+				continue
+			}
+			if _, warned := sharedIPs[ipString]; warned || otherHostname == hostname {
+				continue
+			}
+			sharedIPs[ipString] = struct{}{}
+			warnings = append(warnings, fmt.Sprintf("IP address %s is shared by hostnames %q and %q",
+				ipString, otherHostname, hostname))
+		}
+	}
+	return groups, warnings
+}
+
+func dedupCyberghostServers(servers []models.CyberghostServer) (
+	deduped []models.CyberghostServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Region, ips: server.IPs}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.CyberghostServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+			deduped[i].Ports = mergePorts(deduped[i].Ports, servers[duplicate].Ports)
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupHideMyAssServers(servers []models.HideMyAssServer) (
+	deduped []models.HideMyAssServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Country + " " + server.City, ips: server.IPs}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.HideMyAssServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+			deduped[i].Ports = mergePorts(deduped[i].Ports, servers[duplicate].Ports)
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupMullvadServers(servers []models.MullvadServer) (
+	deduped []models.MullvadServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		ips := append(append([]net.IP{}, server.IPs...), server.IPsV6...)
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Country + " " + server.City, ips: ips}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.MullvadServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+			deduped[i].IPsV6 = uniqueSortedIPs(append(deduped[i].IPsV6, servers[duplicate].IPsV6...))
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupNordvpnServers(servers []models.NordvpnServer) (
+	deduped []models.NordvpnServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Region, ips: []net.IP{server.IP}}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.NordvpnServer, len(groups))
+	for i, group := range groups {
+		// servers have a single IP address so duplicates are dropped
+		deduped[i] = servers[group[0]]
+	}
+	return deduped, warnings
+}
+
+func dedupPrivadoServers(servers []models.PrivadoServer) (
+	deduped []models.PrivadoServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Country + " " + server.City, ips: []net.IP{server.IP}}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.PrivadoServer, len(groups))
+	for i, group := range groups {
+		// servers have a single IP address so duplicates are dropped
+		deduped[i] = servers[group[0]]
+	}
+	return deduped, warnings
+}
+
+func dedupPurevpnServers(servers []models.PurevpnServer) (
+	deduped []models.PurevpnServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Region, ips: server.IPs}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.PurevpnServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+			deduped[i].Ports = mergePorts(deduped[i].Ports, servers[duplicate].Ports)
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupSurfsharkServers(servers []models.SurfsharkServer) (
+	deduped []models.SurfsharkServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Region, ips: server.IPs}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.SurfsharkServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+			deduped[i].Ports = mergePorts(deduped[i].Ports, servers[duplicate].Ports)
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupVPNUnlimitedServers(servers []models.VPNUnlimitedServer) (
+	deduped []models.VPNUnlimitedServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Country + " " + server.City, ips: server.IPs}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.VPNUnlimitedServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupVyprvpnServers(servers []models.VyprvpnServer) (
+	deduped []models.VyprvpnServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Region, ips: server.IPs}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.VyprvpnServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+			deduped[i].Ports = mergePorts(deduped[i].Ports, servers[duplicate].Ports)
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupWeVPNServers(servers []models.WeVPNServer) (
+	deduped []models.WeVPNServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Country + " " + server.City, ips: server.IPs}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.WeVPNServer, len(groups))
+	for i, group := range groups {
+		deduped[i] = servers[group[0]]
+		for _, duplicate := range group[1:] {
+			deduped[i].IPs = uniqueSortedIPs(append(deduped[i].IPs, servers[duplicate].IPs...))
+		}
+	}
+	return deduped, warnings
+}
+
+func dedupWindscribeServers(servers []models.WindscribeServer) (
+	deduped []models.WindscribeServer, warnings []string) {
+	keys := make([]serverKey, len(servers))
+	for i, server := range servers {
+		keys[i] = serverKey{hostname: server.Hostname, region: server.Region, ips: []net.IP{server.IP}}
+	}
+	groups, warnings := groupDuplicates(keys)
+	deduped = make([]models.WindscribeServer, len(groups))
+	for i, group := range groups {
+		// servers have a single IP address so duplicates are dropped
+		deduped[i] = servers[group[0]]
+	}
+	return deduped, warnings
+}
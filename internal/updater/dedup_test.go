@@ -0,0 +1,90 @@
+package updater
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_groupDuplicates(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		keys     []serverKey
+		groups   [][]int
+		warnings []string
+	}{
+		"empty": {},
+		"no duplicate": {
+			keys: []serverKey{
+				{hostname: "a.com", region: "A", ips: []net.IP{{1, 1, 1, 1}}},
+				{hostname: "b.com", region: "B", ips: []net.IP{{2, 2, 2, 2}}},
+			},
+			groups: [][]int{{0}, {1}},
+		},
+		"duplicate hostname in region": {
+			keys: []serverKey{
+				{hostname: "a.com", region: "A", ips: []net.IP{{1, 1, 1, 1}}},
+				{hostname: "b.com", region: "A"},
+				{hostname: "A.com", region: "A", ips: []net.IP{{1, 1, 1, 1}}},
+			},
+			groups:   [][]int{{0, 2}, {1}},
+			warnings: []string{`hostname "A.com" is listed more than once in region "A", merging them`},
+		},
+		"duplicate hostname across regions": {
+			keys: []serverKey{
+				{hostname: "a.com", region: "A"},
+				{hostname: "a.com", region: "B"},
+			},
+			groups: [][]int{{0, 1}},
+			warnings: []string{
+				`hostname "a.com" is listed in regions "A" and "B", merging it in region "A"`,
+			},
+		},
+		"shared IP address": {
+			keys: []serverKey{
+				{hostname: "a.com", ips: []net.IP{{1, 1, 1, 1}}},
+				{hostname: "b.com", ips: []net.IP{{1, 1, 1, 1}}},
+				{hostname: "c.com", ips: []net.IP{{1, 1, 1, 1}, nil}},
+			},
+			groups:   [][]int{{0}, {1}, {2}},
+			warnings: []string{`IP address 1.1.1.1 is shared by hostnames "a.com" and "b.com"`},
+		},
+		"empty hostnames": {
+			keys: []serverKey{
+				{region: "A"},
+				{region: "A"},
+			},
+			groups: [][]int{{0}, {1}},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			groups, warnings := groupDuplicates(testCase.keys)
+			assert.Equal(t, testCase.groups, groups)
+			assert.Equal(t, testCase.warnings, warnings)
+		})
+	}
+}
+
+func Test_dedupSurfsharkServers(t *testing.T) {
+	t.Parallel()
+	servers := []models.SurfsharkServer{
+		{Region: "A", Hostname: "a.com", IPs: []net.IP{{1, 1, 1, 1}}, Ports: models.OpenVPNPorts{UDP: []uint16{1194}}},
+		{Region: "B", Hostname: "b.com", IPs: []net.IP{{3, 3, 3, 3}}},
+		{Region: "A", Hostname: "a.com", IPs: []net.IP{{2, 2, 2, 2}, {1, 1, 1, 1}}, Ports: models.OpenVPNPorts{TCP: []uint16{443}}},
+	}
+	deduped, warnings := dedupSurfsharkServers(servers)
+	expected := []models.SurfsharkServer{
+		{
+			Region: "A", Hostname: "a.com", IPs: []net.IP{{1, 1, 1, 1}, {2, 2, 2, 2}},
+			Ports: models.OpenVPNPorts{TCP: []uint16{443}, UDP: []uint16{1194}},
+		},
+		{Region: "B", Hostname: "b.com", IPs: []net.IP{{3, 3, 3, 3}}},
+	}
+	assert.Equal(t, expected, deduped)
+	assert.Equal(t, []string{`hostname "a.com" is listed more than once in region "A", merging them`}, warnings)
+}
@@ -0,0 +1,166 @@
+package updater
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/network"
+)
+
+func (u *updater) updateHideMyAss(ctx context.Context) (err error) {
+	servers, warnings, err := findHideMyAssServers(ctx, u.client, u.lookupIP)
+	u.warn(warnings)
+	if err != nil {
+		return fmt.Errorf("cannot update HideMyAss servers: %w", err)
+	}
+	servers, warnings = dedupHideMyAssServers(servers)
+	u.warn(warnings)
+	if u.options.Stdout {
+		u.println(stringifyHideMyAssServers(servers))
+	}
+	u.servers.HideMyAss.Timestamp = u.timeNow().Unix()
+	u.servers.HideMyAss.Servers = servers
+	return nil
+}
+
+func findHideMyAssServers(ctx context.Context, client network.Client, lookupIP lookupIPFunc) (
+	servers []models.HideMyAssServer, warnings []string, err error) {
+	hostToServer := make(map[string]models.HideMyAssServer)
+	for _, protocol := range []string{"TCP", "UDP"} {
+		directoryURL := "https://vpn.hidemyass.com/vpn-config/" + protocol + "/"
+		fileNames, err := fetchHideMyAssFileNames(ctx, client, directoryURL)
+		if err != nil {
+			return nil, warnings, err
+		}
+		for _, fileName := range fileNames {
+			if err := ctx.Err(); err != nil {
+				return nil, warnings, err
+			}
+			content, status, err := client.Get(ctx, directoryURL+fileName)
+			if err != nil {
+				return nil, warnings, err
+			} else if status != http.StatusOK {
+				warnings = append(warnings, fmt.Sprintf("HTTP status code %d for %s", status, fileName))
+				continue
+			}
+			hostnames := extractHostnamesFromRemoteLines(extractRemoteLinesFromOpenvpn(content))
+			if len(hostnames) == 0 {
+				warnings = append(warnings, fmt.Sprintf("cannot find any host in %s", fileName))
+				continue
+			}
+			hostname := hostnames[0]
+			if net.ParseIP(hostname) != nil {
+				warnings = append(warnings, fmt.Sprintf("ignoring IP address host %q in %s", hostname, fileName))
+				continue
+			}
+			server, ok := hostToServer[hostname]
+			if !ok {
+				server.Country, server.Region, server.City = parseHideMyAssFileName(fileName, protocol)
+				server.CountryCode = constants.CountryCode(server.Country)
+				if server.CountryCode == "" {
+					warnings = append(warnings, fmt.Sprintf("cannot find ISO country code for country %q", server.Country))
+				}
+				server.Hostname = hostname
+			}
+			server.Ports = mergePorts(server.Ports, extractPortsFromOpenvpn(content))
+			hostToServer[hostname] = server
+		}
+	}
+
+	for hostname, server := range hostToServer {
+		if err := ctx.Err(); err != nil {
+			return nil, warnings, err
+		}
+		const repetition = 3
+		IPs, err := resolveRepeat(ctx, lookupIP, hostname, repetition)
+		switch {
+		case err != nil:
+			warnings = append(warnings, err.Error())
+			continue
+		case len(IPs) == 0:
+			warnings = append(warnings, fmt.Sprintf("no IP address found for host %q", hostname))
+			continue
+		}
+		server.IPs = IPs
+		servers = append(servers, server)
+	}
+	if len(servers) == 0 {
+		return nil, warnings, fmt.Errorf("no server found")
+	}
+
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Hostname < servers[j].Hostname
+	})
+	return servers, warnings, nil
+}
+
+var hideMyAssFileNameRegex = regexp.MustCompile(`href="([^"/]+\.ovpn)"`)
+
+// fetchHideMyAssFileNames returns the OpenVPN configuration file names
+// linked from the directory listing at the URL given.
+func fetchHideMyAssFileNames(ctx context.Context, client network.Client, url string) (
+	fileNames []string, err error) {
+	content, status, err := client.Get(ctx, url)
+	if err != nil {
+		return nil, err
+	} else if status != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status code %d for %s", status, url)
+	}
+	return extractHideMyAssFileNames(content), nil
+}
+
+func extractHideMyAssFileNames(html []byte) (fileNames []string) {
+	for _, match := range hideMyAssFileNameRegex.FindAllSubmatch(html, -1) {
+		fileNames = append(fileNames, string(match[1]))
+	}
+	return fileNames
+}
+
+// parseHideMyAssFileName returns the location of a server from its OpenVPN
+// configuration file name, such as USA.California.LosAngeles.TCP.ovpn.
+func parseHideMyAssFileName(fileName, protocol string) (country, region, city string) {
+	fileName = strings.TrimSuffix(fileName, ".ovpn")
+	fileName = strings.TrimSuffix(fileName, "."+protocol)
+	parts := strings.Split(fileName, ".")
+	switch len(parts) {
+	case 1:
+		country = parts[0]
+	case 2: //nolint:gomnd
+		country, city = parts[0], parts[1]
+	default:
+		country, region, city = parts[0], parts[1], parts[2]
+	}
+	return camelCaseToWords(country), camelCaseToWords(region), camelCaseToWords(city)
+}
+
+// camelCaseToWords separates the words of a camel case string with spaces,
+// for example NewYork becomes New York.
+func camelCaseToWords(camelCase string) (words string) {
+	runes := []rune(camelCase)
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(runes[i-1]) {
+			words += " "
+		}
+		words += string(r)
+	}
+	return words
+}
+
+func stringifyHideMyAssServers(servers []models.HideMyAssServer) (s string) {
+	s = "func HideMyAssServers() []models.HideMyAssServer {\n"
+	s += "	return []models.HideMyAssServer{\n"
+	for _, server := range servers {
+		s += "		" + server.String() + ",\n"
+	}
+	s += "	}\n"
+	s += "}"
+	return s
+}
@@ -0,0 +1,60 @@
+package updater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_extractHideMyAssFileNames(t *testing.T) {
+	t.Parallel()
+	const html = `<html><body><h1>Index of /vpn-config/TCP/</h1>
+<a href="../">../</a>
+<a href="Albania.TCP.ovpn">Albania.TCP.ovpn</a>
+<a href="USA.California.LosAngeles.TCP.ovpn">USA.California.LosAngeles.TCP.ovpn</a>
+<a href="readme.txt">readme.txt</a>
+</body></html>`
+	fileNames := extractHideMyAssFileNames([]byte(html))
+	expected := []string{"Albania.TCP.ovpn", "USA.California.LosAngeles.TCP.ovpn"}
+	assert.Equal(t, expected, fileNames)
+}
+
+func Test_parseHideMyAssFileName(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		fileName string
+		protocol string
+		country  string
+		region   string
+		city     string
+	}{
+		"country only": {
+			fileName: "Albania.TCP.ovpn",
+			protocol: "TCP",
+			country:  "Albania",
+		},
+		"country and city": {
+			fileName: "UnitedKingdom.London.UDP.ovpn",
+			protocol: "UDP",
+			country:  "United Kingdom",
+			city:     "London",
+		},
+		"country, region and city": {
+			fileName: "USA.NewYork.NewYorkCity.TCP.ovpn",
+			protocol: "TCP",
+			country:  "USA",
+			region:   "New York",
+			city:     "New York City",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			country, region, city := parseHideMyAssFileName(testCase.fileName, testCase.protocol)
+			assert.Equal(t, testCase.country, country)
+			assert.Equal(t, testCase.region, region)
+			assert.Equal(t, testCase.city, city)
+		})
+	}
+}
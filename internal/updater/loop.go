@@ -2,12 +2,16 @@ package updater
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"sync"
 	"time"
 
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/loopstate"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/gluetun/internal/storage"
+	"github.com/qdm12/gluetun/internal/tracing"
 	"github.com/qdm12/golibs/logging"
 )
 
@@ -18,15 +22,20 @@ type Looper interface {
 	Stop()
 	GetPeriod() (period time.Duration)
 	SetPeriod(period time.Duration)
+	GetBackups() (backups []storage.Backup, err error)
+	Rollback(backup int) (err error)
 }
 
 type looper struct {
 	period        time.Duration
 	periodMutex   sync.RWMutex
 	updater       Updater
+	updateMutex   sync.Mutex // prevents rollbacks during updates
 	storage       storage.Storage
 	setAllServers func(allServers models.AllServers)
 	logger        logging.Logger
+	state         loopstate.Reporter
+	tracer        tracing.Tracer
 	restart       chan struct{}
 	stop          chan struct{}
 	updateTicker  chan struct{}
@@ -36,7 +45,7 @@ type looper struct {
 
 func NewLooper(options Options, period time.Duration, currentServers models.AllServers,
 	storage storage.Storage, setAllServers func(allServers models.AllServers),
-	client *http.Client, logger logging.Logger) Looper {
+	client *http.Client, logger logging.Logger, state loopstate.Reporter, tracer tracing.Tracer) Looper {
 	loggerWithPrefix := logger.WithPrefix("updater: ")
 	return &looper{
 		period:        period,
@@ -44,6 +53,8 @@ func NewLooper(options Options, period time.Duration, currentServers models.AllS
 		storage:       storage,
 		setAllServers: setAllServers,
 		logger:        loggerWithPrefix,
+		state:         state,
+		tracer:        tracer,
 		restart:       make(chan struct{}),
 		stop:          make(chan struct{}),
 		updateTicker:  make(chan struct{}),
@@ -70,6 +81,7 @@ func (l *looper) SetPeriod(period time.Duration) {
 
 func (l *looper) logAndWait(ctx context.Context, err error) {
 	l.logger.Error(err)
+	l.state.SetError(err)
 	const waitTime = 5 * time.Minute
 	l.logger.Info("retrying in %s", waitTime)
 	timer := time.NewTimer(waitTime)
@@ -82,6 +94,21 @@ func (l *looper) logAndWait(ctx context.Context, err error) {
 	}
 }
 
+// savePartialUpdate saves the servers of the providers updated before
+// the update got canceled, if any.
+func (l *looper) savePartialUpdate(servers models.AllServers) {
+	updated := l.updater.Report().Updated()
+	if updated == 0 {
+		return
+	}
+	l.setAllServers(servers)
+	if err := l.storage.FlushToFile(servers); err != nil {
+		l.logger.Error(err)
+		return
+	}
+	l.logger.Info("update canceled, saved servers information of %d provider(s) already updated", updated)
+}
+
 func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 	select {
@@ -91,6 +118,7 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 		return
 	}
 	defer l.logger.Warn("loop exited")
+	defer l.state.SetState(loopstate.Stopped)
 
 	enabled := true
 
@@ -109,11 +137,17 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 
 		// Enabled and has a period set
 
-		servers, err := l.updater.UpdateServers(ctx)
-		if err != nil {
-			if ctx.Err() != nil {
-				return
-			}
+		l.state.SetState(loopstate.Starting)
+		l.updateMutex.Lock()
+		updateCtx, updateSpan := l.tracer.Start(ctx, "updater.update")
+		servers, err := l.updater.UpdateServers(updateCtx)
+		updateSpan.End(err)
+		if errors.Is(err, ErrCanceled) {
+			l.savePartialUpdate(servers)
+			l.updateMutex.Unlock()
+			return
+		} else if err != nil {
+			l.updateMutex.Unlock()
 			l.logAndWait(ctx, err)
 			continue
 		}
@@ -121,18 +155,39 @@ func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
 		if err := l.storage.FlushToFile(servers); err != nil {
 			l.logger.Error(err)
 		}
+		l.updateMutex.Unlock()
 		l.logger.Info("Updated servers information")
+		l.state.SetState(loopstate.Running)
 
 		select {
 		case <-l.restart: // triggered restart
 		case <-l.stop:
 			enabled = false
+			l.state.SetState(loopstate.Stopped)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+func (l *looper) GetBackups() (backups []storage.Backup, err error) {
+	return l.storage.ListBackups()
+}
+
+// Rollback restores the servers data from the backup number given, waiting
+// for any update in progress to finish first.
+func (l *looper) Rollback(backup int) (err error) {
+	l.updateMutex.Lock()
+	defer l.updateMutex.Unlock()
+	servers, err := l.storage.Rollback(constants.GetAllServers(), backup)
+	if err != nil {
+		return err
+	}
+	l.updater.SetServers(servers)
+	l.setAllServers(servers)
+	return nil
+}
+
 func (l *looper) RunRestartTicker(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 	timer := time.NewTimer(time.Hour)
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/network"
 )
@@ -18,6 +19,8 @@ func (u *updater) updateMullvad(ctx context.Context) (err error) {
 	if err != nil {
 		return fmt.Errorf("cannot update Mullvad servers: %w", err)
 	}
+	servers, warnings := dedupMullvadServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifyMullvadServers(servers))
 	}
@@ -27,52 +30,35 @@ func (u *updater) updateMullvad(ctx context.Context) (err error) {
 }
 
 func findMullvadServers(ctx context.Context, client network.Client) (servers []models.MullvadServer, err error) {
-	const url = "https://api.mullvad.net/www/relays/openvpn/"
-	bytes, status, err := client.Get(ctx, url)
+	relays, err := fetchMullvadRelays(ctx, client, "https://api.mullvad.net/www/relays/openvpn/")
 	if err != nil {
 		return nil, err
 	}
-	if status != http.StatusOK {
-		return nil, fmt.Errorf("HTTP status code %d", status)
-	}
-	var data []struct {
-		Country  string `json:"country_name"`
-		City     string `json:"city_name"`
-		Active   bool   `json:"active"`
-		Owned    bool   `json:"owned"`
-		Provider string `json:"provider"`
-		IPv4     string `json:"ipv4_addr_in"`
-		IPv6     string `json:"ipv6_addr_in"`
-	}
-	if err := json.Unmarshal(bytes, &data); err != nil {
-		return nil, err
+	wireguardRelays, err := fetchMullvadRelays(ctx, client, "https://api.mullvad.net/www/relays/wireguard/")
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch Wireguard relays: %w", err)
 	}
 	serversByKey := map[string]models.MullvadServer{}
-	for _, jsonServer := range data {
-		if !jsonServer.Active {
+	for _, relay := range relays {
+		if !relay.Active {
 			continue
 		}
-		ipv4 := net.ParseIP(jsonServer.IPv4)
-		ipv6 := net.ParseIP(jsonServer.IPv6)
-		if ipv4 == nil || ipv4.To4() == nil {
-			return nil, fmt.Errorf("cannot parse ipv4 address %q", jsonServer.IPv4)
-		} else if ipv6 == nil || ipv6.To4() != nil {
-			return nil, fmt.Errorf("cannot parse ipv6 address %q", jsonServer.IPv6)
+		ipv4, ipv6, err := relay.parseIPs()
+		if err != nil {
+			return nil, err
 		}
-		key := fmt.Sprintf("%s%s%t%s", jsonServer.Country, jsonServer.City, jsonServer.Owned, jsonServer.Provider)
+		// each relay has its own hostname, so relays are only grouped if the hostname is missing
+		key := fmt.Sprintf("%s%s%s%t%s", relay.Hostname, relay.Country,
+			relay.City, relay.Owned, relay.Provider)
 		if server, ok := serversByKey[key]; ok {
 			server.IPs = append(server.IPs, ipv4)
 			server.IPsV6 = append(server.IPsV6, ipv6)
 			serversByKey[key] = server
 		} else {
-			serversByKey[key] = models.MullvadServer{
-				IPs:     []net.IP{ipv4},
-				IPsV6:   []net.IP{ipv6},
-				Country: jsonServer.Country,
-				City:    strings.ReplaceAll(jsonServer.City, ",", ""),
-				ISP:     jsonServer.Provider,
-				Owned:   jsonServer.Owned,
-			}
+			server := relay.server()
+			server.IPs = []net.IP{ipv4}
+			server.IPsV6 = []net.IP{ipv6}
+			serversByKey[key] = server
 		}
 	}
 	for _, server := range serversByKey {
@@ -80,12 +66,103 @@ func findMullvadServers(ctx context.Context, client network.Client) (servers []m
 		server.IPsV6 = uniqueSortedIPs(server.IPsV6)
 		servers = append(servers, server)
 	}
+	wireguardServers, err := mullvadWireguardServers(wireguardRelays)
+	if err != nil {
+		return nil, err
+	}
+	servers = append(servers, wireguardServers...)
 	sort.Slice(servers, func(i, j int) bool {
-		return servers[i].Country+servers[i].City+servers[i].ISP < servers[j].Country+servers[j].City+servers[j].ISP
+		a, b := servers[i], servers[j]
+		return a.Country+a.City+a.ISP+a.Hostname < b.Country+b.City+b.ISP+b.Hostname
 	})
 	return servers, nil
 }
 
+type mullvadRelay struct {
+	Hostname    string `json:"hostname"`
+	Country     string `json:"country_name"`
+	CountryCode string `json:"country_code"`
+	City        string `json:"city_name"`
+	Active      bool   `json:"active"`
+	Owned       bool   `json:"owned"`
+	Provider    string `json:"provider"`
+	IPv4        string `json:"ipv4_addr_in"`
+	IPv6        string `json:"ipv6_addr_in"`
+	PublicKey   string `json:"pubkey"`
+}
+
+func fetchMullvadRelays(ctx context.Context, client network.Client, url string) (relays []mullvadRelay, err error) {
+	bytes, status, err := client.Get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status code %d", status)
+	}
+	if err := json.Unmarshal(bytes, &relays); err != nil {
+		return nil, err
+	}
+	return relays, nil
+}
+
+func (r *mullvadRelay) parseIPs() (ipv4, ipv6 net.IP, err error) {
+	ipv4 = net.ParseIP(r.IPv4)
+	ipv6 = net.ParseIP(r.IPv6)
+	if ipv4 == nil || ipv4.To4() == nil {
+		return nil, nil, fmt.Errorf("cannot parse ipv4 address %q", r.IPv4)
+	} else if ipv6 == nil || ipv6.To4() != nil {
+		return nil, nil, fmt.Errorf("cannot parse ipv6 address %q", r.IPv6)
+	}
+	return ipv4, ipv6, nil
+}
+
+func (r *mullvadRelay) server() models.MullvadServer {
+	return models.MullvadServer{
+		Country:     r.Country,
+		CountryCode: mullvadCountryCode(r.CountryCode, r.Country),
+		City:        strings.ReplaceAll(r.City, ",", ""),
+		Hostname:    r.Hostname,
+		ISP:         r.Provider,
+		Owned:       r.Owned,
+	}
+}
+
+// mullvadWireguardServers returns a server for each active Wireguard relay,
+// with its endpoint data set and without OpenVPN IP addresses.
+func mullvadWireguardServers(relays []mullvadRelay) (servers []models.MullvadServer, err error) {
+	const wireguardPort = 51820
+	for _, relay := range relays {
+		if !relay.Active {
+			continue
+		}
+		if relay.PublicKey == "" {
+			return nil, fmt.Errorf("no public key for Wireguard relay %q", relay.Hostname)
+		}
+		ipv4, ipv6, err := relay.parseIPs()
+		if err != nil {
+			return nil, err
+		}
+		server := relay.server()
+		server.Wireguard = &models.WireguardEndpoint{
+			PublicKey: relay.PublicKey,
+			IPs:       []net.IP{ipv4, ipv6},
+			Port:      wireguardPort,
+		}
+		servers = append(servers, server)
+	}
+	return servers, nil
+}
+
+// mullvadCountryCode returns the lowercase country code given by the Mullvad API,
+// falling back on deducing it from the country name if it is not valid.
+func mullvadCountryCode(apiCode, country string) (code string) {
+	apiCode = strings.ToLower(apiCode)
+	if _, ok := constants.CountryCodes()[apiCode]; ok {
+		return apiCode
+	}
+	return constants.CountryCode(country)
+}
+
 func stringifyMullvadServers(servers []models.MullvadServer) (s string) {
 	s = "func MullvadServers() []models.MullvadServer {\n"
 	s += "	return []models.MullvadServer{\n"
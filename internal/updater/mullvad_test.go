@@ -7,22 +7,25 @@ import (
 
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_stringifyMullvadServers(t *testing.T) {
 	servers := []models.MullvadServer{{
-		Country: "webland",
-		City:    "webcity",
-		ISP:     "not nsa",
-		Owned:   true,
-		IPs:     []net.IP{{1, 1, 1, 1}},
-		IPsV6:   []net.IP{{1, 1, 1, 1}},
+		Country:     "webland",
+		CountryCode: "wl",
+		City:        "webcity",
+		Hostname:    "wl-web-001",
+		ISP:         "not nsa",
+		Owned:       true,
+		IPs:         []net.IP{{1, 1, 1, 1}},
+		IPsV6:       []net.IP{{1, 1, 1, 1}},
 	}}
 	//nolint:lll
 	expected := `
 func MullvadServers() []models.MullvadServer {
 	return []models.MullvadServer{
-		{Country: "webland", City: "webcity", ISP: "not nsa", Owned: true, IPs: []net.IP{{1, 1, 1, 1}}, IPsV6: []net.IP{{1, 1, 1, 1}}},
+		{Country: "webland", CountryCode: "wl", City: "webcity", Hostname: "wl-web-001", ISP: "not nsa", Owned: true, IPs: []net.IP{{1, 1, 1, 1}}, IPsV6: []net.IP{{1, 1, 1, 1}}},
 	}
 }
 `
@@ -30,3 +33,64 @@ func MullvadServers() []models.MullvadServer {
 	s := stringifyMullvadServers(servers)
 	assert.Equal(t, expected, s)
 }
+
+func Test_mullvadWireguardServers(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		relays  []mullvadRelay
+		servers []models.MullvadServer
+		err     string
+	}{
+		"no relay": {},
+		"inactive relay": {
+			relays: []mullvadRelay{{Hostname: "se-got-wg-001"}},
+		},
+		"missing public key": {
+			relays: []mullvadRelay{{Hostname: "se-got-wg-001", Active: true}},
+			err:    `no public key for Wireguard relay "se-got-wg-001"`,
+		},
+		"bad ipv4": {
+			relays: []mullvadRelay{{Hostname: "se-got-wg-001", Active: true, PublicKey: "key", IPv4: "x"}},
+			err:    `cannot parse ipv4 address "x"`,
+		},
+		"relay": {
+			relays: []mullvadRelay{{
+				Hostname:    "se-got-wg-001",
+				Country:     "Sweden",
+				CountryCode: "se",
+				City:        "Gothenburg, West",
+				Active:      true,
+				Provider:    "not nsa",
+				IPv4:        "1.1.1.1",
+				IPv6:        "::1",
+				PublicKey:   "key",
+			}},
+			servers: []models.MullvadServer{{
+				Country:     "Sweden",
+				CountryCode: "se",
+				City:        "Gothenburg West",
+				Hostname:    "se-got-wg-001",
+				ISP:         "not nsa",
+				Wireguard: &models.WireguardEndpoint{
+					PublicKey: "key",
+					IPs:       []net.IP{net.IPv4(1, 1, 1, 1), net.IPv6loopback},
+					Port:      51820,
+				},
+			}},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			servers, err := mullvadWireguardServers(testCase.relays)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.servers, servers)
+		})
+	}
+}
@@ -10,20 +10,19 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/network"
 )
 
 func (u *updater) updateNordvpn(ctx context.Context) (err error) {
 	servers, warnings, err := findNordvpnServers(ctx, u.client)
-	if u.options.CLI {
-		for _, warning := range warnings {
-			u.logger.Warn("Nordvpn: %s", warning)
-		}
-	}
+	u.warn(warnings)
 	if err != nil {
 		return fmt.Errorf("cannot update Nordvpn servers: %w", err)
 	}
+	servers, warnings = dedupNordvpnServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifyNordvpnServers(servers))
 	}
@@ -45,15 +44,23 @@ func findNordvpnServers(ctx context.Context, client network.Client) (
 	var data []struct {
 		IPAddress string `json:"ip_address"`
 		Name      string `json:"name"`
+		Domain    string `json:"domain"`
 		Country   string `json:"country"`
 		Features  struct {
 			UDP bool `json:"openvpn_udp"`
 			TCP bool `json:"openvpn_tcp"`
 		} `json:"features"`
+		Categories []struct {
+			Name string `json:"name"`
+		} `json:"categories"`
 	}
 	if err := json.Unmarshal(bytes, &data); err != nil {
 		return nil, nil, err
 	}
+	wireguardKeys, err := findNordvpnWireguardKeys(ctx, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot fetch Wireguard public keys: %w", err)
+	}
 	sort.Slice(data, func(i, j int) bool {
 		if data[i].Country == data[j].Country {
 			return data[i].Name < data[j].Name
@@ -82,17 +89,90 @@ func findNordvpnServers(ctx context.Context, client network.Client) (
 			return nil, nil, fmt.Errorf("Bad ID in server name %q", jsonServer.Name)
 		}
 		server := models.NordvpnServer{
-			Region: jsonServer.Country,
-			Number: uint16(idUint64),
-			IP:     ip,
-			TCP:    jsonServer.Features.TCP,
-			UDP:    jsonServer.Features.UDP,
+			Region:   jsonServer.Country,
+			Number:   uint16(idUint64),
+			Hostname: jsonServer.Domain,
+			IP:       ip,
+			TCP:      jsonServer.Features.TCP,
+			UDP:      jsonServer.Features.UDP,
+		}
+		for _, category := range jsonServer.Categories {
+			identifier, ok := nordvpnCategories[category.Name]
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("unknown category %q for server %q", category.Name, jsonServer.Name))
+				continue
+			}
+			server.Categories = append(server.Categories, identifier)
+		}
+		if publicKey, ok := wireguardKeys[jsonServer.Domain]; ok {
+			server.Wireguard = &models.WireguardEndpoint{
+				PublicKey: publicKey,
+				IPs:       []net.IP{ip},
+				Port:      nordvpnWireguardPort,
+			}
 		}
 		servers = append(servers, server)
 	}
 	return servers, warnings, nil
 }
 
+const nordvpnWireguardPort = 51820
+
+// nordvpnCategories maps the NordVPN API category names to the
+// category identifiers which can be selected.
+var nordvpnCategories = map[string]string{ //nolint:gochecknoglobals
+	"Standard VPN servers": constants.NordvpnCategoryStandard,
+	"P2P":                  constants.NordvpnCategoryP2P,
+	"Dedicated IP":         constants.NordvpnCategoryDedicatedIP,
+	"Double VPN":           constants.NordvpnCategoryDoubleVPN,
+	"Onion Over VPN":       constants.NordvpnCategoryOnion,
+	"Obfuscated Servers":   constants.NordvpnCategoryObfuscated,
+}
+
+// findNordvpnWireguardKeys returns the Wireguard public keys of the
+// servers supporting Wireguard, mapped by server hostname.
+func findNordvpnWireguardKeys(ctx context.Context, client network.Client) (keys map[string]string, err error) {
+	const url = "https://api.nordvpn.com/v1/servers?limit=16384&filters[servers_technologies][identifier]=wireguard_udp"
+	bytes, status, err := client.Get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status code %d", status)
+	}
+	return parseNordvpnWireguardKeys(bytes)
+}
+
+func parseNordvpnWireguardKeys(b []byte) (keys map[string]string, err error) {
+	var data []struct {
+		Hostname     string `json:"hostname"`
+		Technologies []struct {
+			Identifier string `json:"identifier"`
+			Metadata   []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"metadata"`
+		} `json:"technologies"`
+	}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	keys = make(map[string]string, len(data))
+	for _, jsonServer := range data {
+		for _, technology := range jsonServer.Technologies {
+			if technology.Identifier != "wireguard_udp" {
+				continue
+			}
+			for _, metadata := range technology.Metadata {
+				if metadata.Name == "public_key" && metadata.Value != "" {
+					keys[jsonServer.Hostname] = metadata.Value
+				}
+			}
+		}
+	}
+	return keys, nil
+}
+
 func stringifyNordvpnServers(servers []models.NordvpnServer) (s string) {
 	s = "func NordvpnServers() []models.NordvpnServer {\n"
 	s += "	return []models.NordvpnServer{\n"
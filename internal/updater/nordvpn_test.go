@@ -0,0 +1,52 @@
+package updater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseNordvpnWireguardKeys(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		data []byte
+		keys map[string]string
+		err  string
+	}{
+		"bad json": {
+			data: []byte(`{`),
+			err:  "unexpected end of JSON input",
+		},
+		"no server": {
+			data: []byte(`[]`),
+			keys: map[string]string{},
+		},
+		"servers": {
+			data: []byte(`[
+				{"hostname": "us1.nordvpn.com", "technologies": [
+					{"identifier": "openvpn_udp", "metadata": []},
+					{"identifier": "wireguard_udp", "metadata": [{"name": "public_key", "value": "key1"}]}
+				]},
+				{"hostname": "us2.nordvpn.com", "technologies": [
+					{"identifier": "openvpn_tcp", "metadata": [{"name": "public_key", "value": "key2"}]}
+				]}
+			]`),
+			keys: map[string]string{"us1.nordvpn.com": "key1"},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			keys, err := parseNordvpnWireguardKeys(testCase.data)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.keys, keys)
+		})
+	}
+}
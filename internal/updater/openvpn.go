@@ -1,7 +1,11 @@
 package updater
 
 import (
+	"sort"
+	"strconv"
 	"strings"
+
+	"github.com/qdm12/gluetun/internal/models"
 )
 
 func extractRemoteLinesFromOpenvpn(content []byte) (remoteLines []string) {
@@ -24,3 +28,70 @@ func extractHostnamesFromRemoteLines(remoteLines []string) (hostnames []string)
 	}
 	return hostnames
 }
+
+// extractPortsFromOpenvpn returns the ports and protocols of the remote lines
+// of an OpenVPN configuration file, using its proto and port lines as defaults.
+func extractPortsFromOpenvpn(content []byte) (ports models.OpenVPNPorts) {
+	const openvpnDefaultPort = "1194"
+	protocol, port := "udp", openvpnDefaultPort
+	var remoteLines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) < 2: //nolint:gomnd
+		case fields[0] == "proto":
+			protocol = fields[1]
+		case fields[0] == "port":
+			port = fields[1]
+		case fields[0] == "remote":
+			remoteLines = append(remoteLines, line)
+		}
+	}
+	for _, remoteLine := range remoteLines {
+		fields := strings.Fields(remoteLine)
+		remotePort, remoteProtocol := port, protocol
+		if len(fields) > 2 { //nolint:gomnd
+			remotePort = fields[2]
+		}
+		if len(fields) > 3 { //nolint:gomnd
+			remoteProtocol = fields[3]
+		}
+		n, err := strconv.ParseUint(remotePort, 10, 16) //nolint:gomnd
+		if err != nil || n == 0 {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(remoteProtocol, "tcp"): // tcp, tcp4, tcp6 and tcp-client
+			ports.TCP = append(ports.TCP, uint16(n))
+		case strings.HasPrefix(remoteProtocol, "udp"):
+			ports.UDP = append(ports.UDP, uint16(n))
+		}
+	}
+	return models.OpenVPNPorts{
+		TCP: uniqueSortedPorts(ports.TCP),
+		UDP: uniqueSortedPorts(ports.UDP),
+	}
+}
+
+func mergePorts(a, b models.OpenVPNPorts) (merged models.OpenVPNPorts) {
+	return models.OpenVPNPorts{
+		TCP: uniqueSortedPorts(append(append([]uint16{}, a.TCP...), b.TCP...)),
+		UDP: uniqueSortedPorts(append(append([]uint16{}, a.UDP...), b.UDP...)),
+	}
+}
+
+func uniqueSortedPorts(ports []uint16) []uint16 {
+	if len(ports) == 0 {
+		return nil
+	}
+	unique := make(map[uint16]struct{}, len(ports))
+	for _, port := range ports {
+		unique[port] = struct{}{}
+	}
+	ports = make([]uint16, 0, len(unique))
+	for port := range unique {
+		ports = append(ports, port)
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports
+}
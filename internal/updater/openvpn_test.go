@@ -0,0 +1,50 @@
+package updater
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_extractPortsFromOpenvpn(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		content string
+		ports   models.OpenVPNPorts
+	}{
+		"empty": {},
+		"default port and protocol": {
+			content: "client\nremote host.com\n",
+			ports:   models.OpenVPNPorts{UDP: []uint16{1194}},
+		},
+		"proto and port lines": {
+			content: "proto tcp-client\nport 443\nremote host.com\nremote host.com 80\n",
+			ports:   models.OpenVPNPorts{TCP: []uint16{80, 443}},
+		},
+		"remote line protocols": {
+			content: "proto udp\nremote host.com 1194\nremote host.com 443 tcp\nremote host.com 1194\n",
+			ports:   models.OpenVPNPorts{TCP: []uint16{443}, UDP: []uint16{1194}},
+		},
+		"bad port": {
+			content: "remote host.com abc\n",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ports := extractPortsFromOpenvpn([]byte(testCase.content))
+			assert.Equal(t, testCase.ports, ports)
+		})
+	}
+}
+
+func Test_mergePorts(t *testing.T) {
+	t.Parallel()
+	a := models.OpenVPNPorts{UDP: []uint16{1194}}
+	b := models.OpenVPNPorts{TCP: []uint16{1443}, UDP: []uint16{1194}}
+	merged := mergePorts(a, b)
+	assert.Equal(t, models.OpenVPNPorts{TCP: []uint16{1443}, UDP: []uint16{1194}}, merged)
+	assert.Equal(t, models.OpenVPNPorts{UDP: []uint16{1194}}, a)
+}
@@ -1,32 +1,39 @@
 package updater
 
 type Options struct {
-	Cyberghost bool
-	Mullvad    bool
-	Nordvpn    bool
-	PIA        bool
-	Privado    bool
-	Purevpn    bool
-	Surfshark  bool
-	Vyprvpn    bool
-	Windscribe bool
-	Stdout     bool // in order to update constants file (maintainer side)
-	CLI        bool
-	DNSAddress string
+	Cyberghost   bool
+	HideMyAss    bool
+	Mullvad      bool
+	Nordvpn      bool
+	PIA          bool
+	Privado      bool
+	Purevpn      bool
+	Surfshark    bool
+	VPNUnlimited bool
+	Vyprvpn      bool
+	WeVPN        bool
+	Windscribe   bool
+	Stdout       bool // in order to update constants file (maintainer side)
+	CLI          bool
+	DNSAddress   string
 }
 
 func NewOptions(dnsAddress string) Options {
 	return Options{
-		Cyberghost: true,
-		Mullvad:    true,
-		Nordvpn:    true,
-		PIA:        true,
-		Purevpn:    true,
-		Surfshark:  true,
-		Vyprvpn:    true,
-		Windscribe: true,
-		Stdout:     false,
-		CLI:        false,
-		DNSAddress: dnsAddress,
+		Cyberghost:   true,
+		HideMyAss:    true,
+		Mullvad:      true,
+		Nordvpn:      true,
+		PIA:          true,
+		Privado:      true,
+		Purevpn:      true,
+		Surfshark:    true,
+		VPNUnlimited: true,
+		Vyprvpn:      true,
+		WeVPN:        true,
+		Windscribe:   true,
+		Stdout:       false,
+		CLI:          false,
+		DNSAddress:   dnsAddress,
 	}
 }
@@ -40,6 +40,10 @@ func (u *updater) updatePIA(ctx context.Context) (err error) {
 					IP net.IP `json:"ip"`
 					CN string `json:"cn"`
 				} `json:"ovpntcp"`
+				WG []struct {
+					IP net.IP `json:"ip"`
+					CN string `json:"cn"`
+				} `json:"wg"`
 			} `json:"servers"`
 		} `json:"regions"`
 	}
@@ -72,11 +76,28 @@ func (u *updater) updatePIA(ctx context.Context) (err error) {
 			}
 			server.OpenvpnTCP.CN = tcpServer.CN
 		}
+		for _, wgServer := range region.Servers.WG {
+			if server.Wireguard == nil {
+				// the public key is only known once the client key is
+				// registered with the server when connecting.
+				server.Wireguard = &models.WireguardEndpoint{
+					CN:   wgServer.CN,
+					Port: piaWireguardPort,
+				}
+			} else if server.Wireguard.CN != wgServer.CN {
+				return fmt.Errorf("CN is different for Wireguard for region %q: %q and %q",
+					region.Name, server.Wireguard.CN, wgServer.CN)
+			}
+			if wgServer.IP != nil {
+				server.Wireguard.IPs = append(server.Wireguard.IPs, wgServer.IP)
+			}
+		}
 		servers = append(servers, server)
 	}
 	sort.Slice(servers, func(i, j int) bool {
 		return servers[i].Region < servers[j].Region
 	})
+	u.warn(piaPortForwardChanges(u.servers.Pia.Servers, servers))
 	if u.options.Stdout {
 		u.println(stringifyPIAServers(servers))
 	}
@@ -85,6 +106,28 @@ func (u *updater) updatePIA(ctx context.Context) (err error) {
 	return nil
 }
 
+const piaWireguardPort = 1337
+
+// piaPortForwardChanges returns warnings for the regions which started
+// or stopped supporting port forwarding since the previous update.
+func piaPortForwardChanges(previous, current []models.PIAServer) (warnings []string) {
+	previousPortForward := make(map[string]bool, len(previous))
+	for _, server := range previous {
+		previousPortForward[server.Region] = server.PortForward
+	}
+	for _, server := range current {
+		portForward, ok := previousPortForward[server.Region]
+		switch {
+		case !ok || portForward == server.PortForward:
+		case server.PortForward:
+			warnings = append(warnings, fmt.Sprintf("region %q now supports port forwarding", server.Region))
+		default:
+			warnings = append(warnings, fmt.Sprintf("region %q no longer supports port forwarding", server.Region))
+		}
+	}
+	return warnings
+}
+
 func stringifyPIAServers(servers []models.PIAServer) (s string) {
 	s = "func PIAServers() []models.PIAServer {\n"
 	s += "	return []models.PIAServer{\n"
@@ -0,0 +1,29 @@
+package updater
+
+import (
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_piaPortForwardChanges(t *testing.T) {
+	t.Parallel()
+	previous := []models.PIAServer{
+		{Region: "A", PortForward: true},
+		{Region: "B"},
+		{Region: "C", PortForward: true},
+	}
+	current := []models.PIAServer{
+		{Region: "A"},
+		{Region: "B", PortForward: true},
+		{Region: "C", PortForward: true},
+		{Region: "D"},
+	}
+	warnings := piaPortForwardChanges(previous, current)
+	expected := []string{
+		`region "A" no longer supports port forwarding`,
+		`region "B" now supports port forwarding`,
+	}
+	assert.Equal(t, expected, warnings)
+}
@@ -5,21 +5,21 @@ import (
 	"fmt"
 	"net"
 	"sort"
+	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/network"
 )
 
 func (u *updater) updatePrivado(ctx context.Context) (err error) {
 	servers, warnings, err := findPrivadoServersFromZip(ctx, u.client, u.lookupIP)
-	if u.options.CLI {
-		for _, warning := range warnings {
-			u.logger.Warn("Privado: %s", warning)
-		}
-	}
+	u.warn(warnings)
 	if err != nil {
 		return fmt.Errorf("cannot update Privado servers: %w", err)
 	}
+	servers, warnings = dedupPrivadoServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifyPrivadoServers(servers))
 	}
@@ -69,9 +69,18 @@ func findPrivadoServersFromZip(ctx context.Context, client network.Client, looku
 			warning := fmt.Sprintf("more than one IP address found for host %q", hostname)
 			warnings = append(warnings, warning)
 		}
+		country, countryCode, city := privadoLocation(hostname)
+		if countryCode == "" {
+			warning := fmt.Sprintf("cannot find location of host %q", hostname)
+			warnings = append(warnings, warning)
+		}
 		server := models.PrivadoServer{
-			Hostname: hostname,
-			IP:       IPs[0],
+			Country:     country,
+			CountryCode: countryCode,
+			City:        city,
+			Hostname:    hostname,
+			IP:          IPs[0],
+			Ports:       extractPortsFromOpenvpn(content),
 		}
 		servers = append(servers, server)
 	}
@@ -82,6 +91,82 @@ func findPrivadoServersFromZip(ctx context.Context, client network.Client, looku
 	return servers, warnings, nil
 }
 
+// privadoLocation returns the location of the server from the airport code
+// prefixing its hostname, for example ams for ams-001.vpn.privado.io.
+// Empty strings are returned if the airport code is unknown.
+func privadoLocation(hostname string) (country, countryCode, city string) {
+	airportCode := strings.Split(hostname, "-")[0]
+	location, ok := privadoAirportCodes()[airportCode]
+	if !ok {
+		return "", "", ""
+	}
+	country = constants.CountryCodes()[location.countryCode]
+	return country, location.countryCode, location.city
+}
+
+type privadoAirport struct {
+	countryCode string
+	city        string
+}
+
+func privadoAirportCodes() map[string]privadoAirport {
+	return map[string]privadoAirport{
+		"akl": {countryCode: "nz", city: "Auckland"},
+		"ams": {countryCode: "nl", city: "Amsterdam"},
+		"arn": {countryCode: "se", city: "Stockholm"},
+		"ath": {countryCode: "gr", city: "Athens"},
+		"beg": {countryCode: "rs", city: "Belgrade"},
+		"bkk": {countryCode: "th", city: "Bangkok"},
+		"bom": {countryCode: "in", city: "Mumbai"},
+		"bru": {countryCode: "be", city: "Brussels"},
+		"bts": {countryCode: "sk", city: "Bratislava"},
+		"bud": {countryCode: "hu", city: "Budapest"},
+		"cdg": {countryCode: "fr", city: "Paris"},
+		"cph": {countryCode: "dk", city: "Copenhagen"},
+		"dca": {countryCode: "us", city: "Washington DC"},
+		"dfw": {countryCode: "us", city: "Dallas"},
+		"dub": {countryCode: "ie", city: "Dublin"},
+		"eze": {countryCode: "ar", city: "Buenos Aires"},
+		"fra": {countryCode: "de", city: "Frankfurt"},
+		"gru": {countryCode: "br", city: "Sao Paulo"},
+		"hel": {countryCode: "fi", city: "Helsinki"},
+		"hkg": {countryCode: "hk", city: "Hong Kong"},
+		"icn": {countryCode: "kr", city: "Seoul"},
+		"iev": {countryCode: "ua", city: "Kiev"},
+		"ist": {countryCode: "tr", city: "Istanbul"},
+		"jfk": {countryCode: "us", city: "New York"},
+		"jnb": {countryCode: "za", city: "Johannesburg"},
+		"lax": {countryCode: "us", city: "Los Angeles"},
+		"lis": {countryCode: "pt", city: "Lisbon"},
+		"lon": {countryCode: "gb", city: "London"},
+		"mad": {countryCode: "es", city: "Madrid"},
+		"man": {countryCode: "gb", city: "Manchester"},
+		"mex": {countryCode: "mx", city: "Mexico City"},
+		"mia": {countryCode: "us", city: "Miami"},
+		"mxp": {countryCode: "it", city: "Milan"},
+		"nrt": {countryCode: "jp", city: "Tokyo"},
+		"ord": {countryCode: "us", city: "Chicago"},
+		"osl": {countryCode: "no", city: "Oslo"},
+		"otp": {countryCode: "ro", city: "Bucharest"},
+		"phx": {countryCode: "us", city: "Phoenix"},
+		"prg": {countryCode: "cz", city: "Prague"},
+		"rix": {countryCode: "lv", city: "Riga"},
+		"rkv": {countryCode: "is", city: "Reykjavik"},
+		"sea": {countryCode: "us", city: "Seattle"},
+		"sin": {countryCode: "sg", city: "Singapore"},
+		"sof": {countryCode: "bg", city: "Sofia"},
+		"stl": {countryCode: "us", city: "St. Louis"},
+		"syd": {countryCode: "au", city: "Sydney"},
+		"vie": {countryCode: "at", city: "Vienna"},
+		"vno": {countryCode: "lt", city: "Vilnius"},
+		"waw": {countryCode: "pl", city: "Warsaw"},
+		"yul": {countryCode: "ca", city: "Montreal"},
+		"yvr": {countryCode: "ca", city: "Vancouver"},
+		"yyz": {countryCode: "ca", city: "Toronto"},
+		"zrh": {countryCode: "ch", city: "Zurich"},
+	}
+}
+
 func stringifyPrivadoServers(servers []models.PrivadoServer) (s string) {
 	s = "func PrivadoServers() []models.PrivadoServer {\n"
 	s += "	return []models.PrivadoServer{\n"
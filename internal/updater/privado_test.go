@@ -0,0 +1,38 @@
+package updater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_privadoLocation(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		hostname    string
+		country     string
+		countryCode string
+		city        string
+	}{
+		"empty hostname": {},
+		"unknown airport code": {
+			hostname: "xxx-001.vpn.privado.io",
+		},
+		"known airport code": {
+			hostname:    "ams-001.vpn.privado.io",
+			country:     "Netherlands",
+			countryCode: "nl",
+			city:        "Amsterdam",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			country, countryCode, city := privadoLocation(testCase.hostname)
+			assert.Equal(t, testCase.country, country)
+			assert.Equal(t, testCase.countryCode, countryCode)
+			assert.Equal(t, testCase.city, city)
+		})
+	}
+}
@@ -8,20 +8,19 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/qdm12/gluetun/internal/constants"
 	"github.com/qdm12/gluetun/internal/models"
 	"github.com/qdm12/golibs/network"
 )
 
 func (u *updater) updatePurevpn(ctx context.Context) (err error) {
 	servers, warnings, err := findPurevpnServers(ctx, u.client, u.lookupIP)
-	if u.options.CLI {
-		for _, warning := range warnings {
-			u.logger.Warn("PureVPN: %s", warning)
-		}
-	}
+	u.warn(warnings)
 	if err != nil {
 		return fmt.Errorf("cannot update Purevpn servers: %w", err)
 	}
+	servers, warnings = dedupPurevpnServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifyPurevpnServers(servers))
 	}
@@ -81,23 +80,35 @@ func findPurevpnServers(ctx context.Context, client network.Client, lookupIP loo
 				jsonServer.Region, jsonServer.Country, jsonServer.City))
 			continue
 		}
-		if jsonServer.UDP == "" || jsonServer.TCP == "" {
-			warnings = append(warnings, fmt.Sprintf("server %s %s %s does not support TCP or UDP for openvpn",
-				jsonServer.Region, jsonServer.Country, jsonServer.City))
-			continue
-		}
+		// PureVPN servers use port 80 for TCP and port 53 for UDP
+		var ports models.OpenVPNPorts
 		host := jsonServer.UDP
+		if jsonServer.UDP != "" {
+			ports.UDP = []uint16{53}
+		} else {
+			host = jsonServer.TCP
+		}
+		if jsonServer.TCP != "" {
+			ports.TCP = []uint16{80}
+		}
 		const repetition = 5
 		IPs, err := resolveRepeat(ctx, lookupIP, host, repetition)
 		if err != nil {
 			warnings = append(warnings, err.Error())
 			continue
 		}
+		countryCode := constants.CountryCode(jsonServer.Country)
+		if countryCode == "" {
+			warnings = append(warnings, fmt.Sprintf("cannot find ISO country code for country %q", jsonServer.Country))
+		}
 		servers = append(servers, models.PurevpnServer{
-			Region:  jsonServer.Region,
-			Country: jsonServer.Country,
-			City:    jsonServer.City,
-			IPs:     IPs,
+			Region:      jsonServer.Region,
+			Country:     jsonServer.Country,
+			CountryCode: countryCode,
+			City:        jsonServer.City,
+			Hostname:    host,
+			IPs:         IPs,
+			Ports:       ports,
 		})
 	}
 	return servers, warnings, nil
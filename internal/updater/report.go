@@ -0,0 +1,33 @@
+package updater
+
+// Report contains the outcome of updating the servers
+// of each provider selected.
+type Report struct {
+	Providers []ProviderReport `json:"providers"`
+	// Canceled is true if the update was canceled, in which case
+	// the providers after the last one reported were not updated.
+	Canceled bool `json:"canceled,omitempty"`
+}
+
+// ProviderReport contains the warnings and the error, if any,
+// encountered when updating the servers of a provider.
+type ProviderReport struct {
+	Provider string   `json:"provider"`
+	Warnings []string `json:"warnings,omitempty"`
+	Error    string   `json:"error,omitempty"`
+}
+
+// Failed returns the number of providers which could not be updated.
+func (r Report) Failed() (failed int) {
+	for _, provider := range r.Providers {
+		if provider.Error != "" {
+			failed++
+		}
+	}
+	return failed
+}
+
+// Updated returns the number of providers which were updated.
+func (r Report) Updated() (updated int) {
+	return len(r.Providers) - r.Failed()
+}
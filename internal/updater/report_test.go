@@ -0,0 +1,47 @@
+package updater
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Report_Failed(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		report Report
+		failed int
+	}{
+		"empty report": {},
+		"no failure": {
+			report: Report{Providers: []ProviderReport{
+				{Provider: "Mullvad", Warnings: []string{"warning"}},
+			}},
+		},
+		"partial failure": {
+			report: Report{Providers: []ProviderReport{
+				{Provider: "Mullvad"},
+				{Provider: "Surfshark", Error: "error"},
+			}},
+			failed: 1,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			failed := testCase.report.Failed()
+			assert.Equal(t, testCase.failed, failed)
+		})
+	}
+}
+
+func Test_Report_Updated(t *testing.T) {
+	t.Parallel()
+	report := Report{Providers: []ProviderReport{
+		{Provider: "Mullvad"},
+		{Provider: "Surfshark", Error: "error"},
+		{Provider: "Windscribe", Warnings: []string{"warning"}},
+	}}
+	assert.Equal(t, 2, report.Updated())
+}
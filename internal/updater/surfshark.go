@@ -15,14 +15,12 @@ import (
 
 func (u *updater) updateSurfshark(ctx context.Context) (err error) {
 	servers, warnings, err := findSurfsharkServersFromZip(ctx, u.client, u.lookupIP)
-	if u.options.CLI {
-		for _, warning := range warnings {
-			u.logger.Warn("Surfshark: %s", warning)
-		}
-	}
+	u.warn(warnings)
 	if err != nil {
 		return fmt.Errorf("cannot update Surfshark servers: %w", err)
 	}
+	servers, warnings = dedupSurfsharkServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifySurfsharkServers(servers))
 	}
@@ -61,8 +59,9 @@ func findSurfsharkServersFromAPI(ctx context.Context, client network.Client, loo
 			continue
 		}
 		server := models.SurfsharkServer{
-			Region: jsonServer.Country + " " + jsonServer.Location,
-			IPs:    uniqueSortedIPs(IPs),
+			Region:   jsonServer.Country + " " + jsonServer.Location,
+			Hostname: host,
+			IPs:      uniqueSortedIPs(IPs),
 		}
 		servers = append(servers, server)
 	}
@@ -76,6 +75,14 @@ func findSurfsharkServersFromZip(ctx context.Context, client network.Client, loo
 	if err != nil {
 		return nil, nil, err
 	}
+	// the UDP and TCP files of a host are merged to find its ports
+	hostPorts := make(map[string]models.OpenVPNPorts)
+	for _, content := range contents {
+		ports := extractPortsFromOpenvpn(content)
+		for _, host := range extractHostnamesFromRemoteLines(extractRemoteLinesFromOpenvpn(content)) {
+			hostPorts[host] = mergePorts(hostPorts[host], ports)
+		}
+	}
 	mapping := surfsharkSubdomainToRegion()
 	for fileName, content := range contents {
 		if err := ctx.Err(); err != nil {
@@ -120,8 +127,10 @@ func findSurfsharkServersFromZip(ctx context.Context, client network.Client, loo
 			warnings = append(warnings, warning)
 		}
 		server := models.SurfsharkServer{
-			Region: region,
-			IPs:    uniqueSortedIPs(IPs),
+			Region:   region,
+			Hostname: host,
+			IPs:      uniqueSortedIPs(IPs),
+			Ports:    hostPorts[host],
 		}
 		servers = append(servers, server)
 	}
@@ -160,8 +169,9 @@ func getRemainingServers(ctx context.Context, mapping map[string]string, lookupI
 			continue
 		}
 		server := models.SurfsharkServer{
-			Region: region,
-			IPs:    uniqueSortedIPs(IPs),
+			Region:   region,
+			Hostname: host,
+			IPs:      uniqueSortedIPs(IPs),
 		}
 		servers = append(servers, server)
 	}
@@ -2,6 +2,7 @@ package updater
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -11,8 +12,17 @@ import (
 	"github.com/qdm12/golibs/network"
 )
 
+// ErrCanceled is returned if the context is canceled during an update, in
+// which case the servers returned contain the providers already updated.
+var ErrCanceled = errors.New("update canceled")
+
 type Updater interface {
 	UpdateServers(ctx context.Context) (allServers models.AllServers, err error)
+	// Report returns the outcome for each provider of the last update.
+	Report() Report
+	// SetServers sets the servers to update, for example after
+	// the servers data is rolled back.
+	SetServers(allServers models.AllServers)
 }
 
 type updater struct {
@@ -21,6 +31,7 @@ type updater struct {
 
 	// state
 	servers models.AllServers
+	report  Report
 
 	// Functions for tests
 	logger   logging.Logger
@@ -48,98 +59,76 @@ func New(options Options, httpClient *http.Client, currentServers models.AllServ
 }
 
 // TODO parallelize DNS resolution.
-func (u *updater) UpdateServers(ctx context.Context) (allServers models.AllServers, err error) { //nolint:gocognit
-	if u.options.Cyberghost {
-		u.logger.Info("updating Cyberghost servers...")
-		if err := u.updateCyberghost(ctx); err != nil {
-			if ctxErr := ctx.Err(); ctxErr != nil {
-				return allServers, ctxErr
-			}
-			u.logger.Error(err)
-		}
-	}
-
-	if u.options.Mullvad {
-		u.logger.Info("updating Mullvad servers...")
-		if err := u.updateMullvad(ctx); err != nil {
-			u.logger.Error(err)
-		}
-		if err := ctx.Err(); err != nil {
-			return allServers, err
-		}
-	}
-
-	if u.options.Nordvpn {
+func (u *updater) UpdateServers(ctx context.Context) (allServers models.AllServers, err error) {
+	u.report = Report{}
+	updates := []struct {
+		name    string
+		enabled bool
+		update  func(ctx context.Context) error
+	}{
+		{"Cyberghost", u.options.Cyberghost, u.updateCyberghost},
+		{"HideMyAss", u.options.HideMyAss, u.updateHideMyAss},
+		{"Mullvad", u.options.Mullvad, u.updateMullvad},
 		// TODO support servers offering only TCP or only UDP
-		u.logger.Info("updating NordVPN servers...")
-		if err := u.updateNordvpn(ctx); err != nil {
-			u.logger.Error(err)
-		}
-		if err := ctx.Err(); err != nil {
-			return allServers, err
-		}
+		{"NordVPN", u.options.Nordvpn, u.updateNordvpn},
+		{"Private Internet Access", u.options.PIA, u.updatePIA},
+		{"Privado", u.options.Privado, u.updatePrivado},
+		{"PureVPN", u.options.Purevpn, u.updatePurevpn},
+		{"Surfshark", u.options.Surfshark, u.updateSurfshark},
+		{"VPN Unlimited", u.options.VPNUnlimited, u.updateVPNUnlimited},
+		{"Vyprvpn", u.options.Vyprvpn, u.updateVyprvpn},
+		{"WeVPN", u.options.WeVPN, u.updateWeVPN},
+		{"Windscribe", u.options.Windscribe, u.updateWindscribe},
 	}
 
-	if u.options.PIA {
-		u.logger.Info("updating Private Internet Access servers...")
-		if err := u.updatePIA(ctx); err != nil {
-			u.logger.Error(err)
+	for _, update := range updates {
+		if !update.enabled {
+			continue
 		}
 		if ctx.Err() != nil {
-			return allServers, ctx.Err()
-		}
-	}
-
-	if u.options.Privado {
-		u.logger.Info("updating Privado servers...")
-		if err := u.updatePrivado(ctx); err != nil {
-			u.logger.Error(err)
+			break
 		}
-		if ctx.Err() != nil {
-			return allServers, ctx.Err()
-		}
-	}
-
-	if u.options.Purevpn {
-		u.logger.Info("updating PureVPN servers...")
-		// TODO support servers offering only TCP or only UDP
-		if err := u.updatePurevpn(ctx); err != nil {
-			if ctxErr := ctx.Err(); ctxErr != nil {
-				return allServers, ctxErr
+		u.logger.Info("updating %s servers...", update.name)
+		u.report.Providers = append(u.report.Providers, ProviderReport{Provider: update.name})
+		// the servers of a provider are only set once fully updated, so the
+		// servers of a provider canceled mid-update are left untouched.
+		err := update.update(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				u.logger.Error(err)
 			}
-			u.logger.Error(err)
+			u.report.Providers[len(u.report.Providers)-1].Error = err.Error()
 		}
 	}
 
-	if u.options.Surfshark {
-		u.logger.Info("updating Surfshark servers...")
-		if err := u.updateSurfshark(ctx); err != nil {
-			if ctxErr := ctx.Err(); ctxErr != nil {
-				return allServers, ctxErr
-			}
-			u.logger.Error(err)
-		}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		u.report.Canceled = true
+		return u.servers, fmt.Errorf("%w: %s", ErrCanceled, ctxErr)
 	}
+	return u.servers, nil
+}
 
-	if u.options.Vyprvpn {
-		u.logger.Info("updating Vyprvpn servers...")
-		if err := u.updateVyprvpn(ctx); err != nil {
-			if ctxErr := ctx.Err(); ctxErr != nil {
-				return allServers, ctxErr
-			}
-			u.logger.Error(err)
-		}
-	}
+func (u *updater) Report() Report {
+	return u.report
+}
 
-	if u.options.Windscribe {
-		u.logger.Info("updating Windscribe servers...")
-		if err := u.updateWindscribe(ctx); err != nil {
-			if ctxErr := ctx.Err(); ctxErr != nil {
-				return allServers, ctxErr
-			}
-			u.logger.Error(err)
-		}
-	}
+func (u *updater) SetServers(allServers models.AllServers) {
+	u.servers = allServers
+}
 
-	return u.servers, nil
+// warn records the warnings for the provider being updated, and logs
+// them if running from the command line.
+func (u *updater) warn(warnings []string) {
+	provider := "updater"
+	if n := len(u.report.Providers); n > 0 {
+		current := &u.report.Providers[n-1]
+		current.Warnings = append(current.Warnings, warnings...)
+		provider = current.Provider
+	}
+	if !u.options.CLI {
+		return
+	}
+	for _, warning := range warnings {
+		u.logger.Warn("%s: %s", provider, warning)
+	}
 }
@@ -0,0 +1,65 @@
+package updater
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/logging/mock_logging"
+	"github.com/qdm12/golibs/network/mock_network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_updater_UpdateServers_canceled(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	logger := mock_logging.NewMockLogger(mockCtrl)
+	logger.EXPECT().Info("updating %s servers...", "Mullvad")
+	logger.EXPECT().Info("updating %s servers...", "NordVPN")
+	client := mock_network.NewMockClient(mockCtrl)
+	client.EXPECT().Get(ctx, "https://api.mullvad.net/www/relays/openvpn/").
+		Return([]byte(`[{"hostname":"se-got-001","country_name":"Sweden","country_code":"se",`+
+			`"city_name":"Gothenburg","active":true,"ipv4_addr_in":"1.1.1.1","ipv6_addr_in":"::1"}]`),
+			http.StatusOK, nil)
+	client.EXPECT().Get(ctx, "https://api.mullvad.net/www/relays/wireguard/").
+		Return([]byte(`[]`), http.StatusOK, nil)
+	// the update is canceled while updating NordVPN servers
+	client.EXPECT().Get(ctx, "https://nordvpn.com/api/server").
+		DoAndReturn(func(ctx context.Context, url string) ([]byte, int, error) {
+			cancel()
+			return nil, 0, ctx.Err()
+		})
+
+	nordvpnServers := models.NordvpnServers{Timestamp: 1, Servers: []models.NordvpnServer{{Hostname: "old"}}}
+	u := &updater{
+		options: Options{Mullvad: true, Nordvpn: true, Windscribe: true},
+		servers: models.AllServers{Nordvpn: nordvpnServers},
+		logger:  logger,
+		timeNow: func() time.Time { return time.Unix(2, 0) },
+		client:  client,
+	}
+
+	servers, err := u.UpdateServers(ctx)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrCanceled))
+	assert.Equal(t, "update canceled: context canceled", err.Error())
+	assert.Equal(t, int64(2), servers.Mullvad.Timestamp)
+	assert.Len(t, servers.Mullvad.Servers, 1)
+	assert.Equal(t, nordvpnServers, servers.Nordvpn)
+	assert.Equal(t, Report{
+		Providers: []ProviderReport{
+			{Provider: "Mullvad"},
+			{Provider: "NordVPN", Error: "cannot update Nordvpn servers: context canceled"},
+		},
+		Canceled: true,
+	}, u.Report())
+}
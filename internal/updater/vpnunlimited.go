@@ -0,0 +1,137 @@
+package updater
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/network"
+)
+
+func (u *updater) updateVPNUnlimited(ctx context.Context) (err error) {
+	servers, warnings, err := findVPNUnlimitedServers(ctx, u.client, u.lookupIP)
+	u.warn(warnings)
+	if err != nil {
+		return fmt.Errorf("cannot update VPN Unlimited servers: %w", err)
+	}
+	servers, warnings = dedupVPNUnlimitedServers(servers)
+	u.warn(warnings)
+	if u.options.Stdout {
+		u.println(stringifyVPNUnlimitedServers(servers))
+	}
+	u.servers.VPNUnlimited.Timestamp = u.timeNow().Unix()
+	u.servers.VPNUnlimited.Servers = servers
+	return nil
+}
+
+func findVPNUnlimitedServers(ctx context.Context, client network.Client, lookupIP lookupIPFunc) (
+	servers []models.VPNUnlimitedServer, warnings []string, err error) {
+	const zipURL = "https://vpnunlimitedapp.com/downloads/ovpn-configs.zip"
+	contents, err := fetchAndExtractFiles(ctx, client, zipURL)
+	if err != nil {
+		return nil, nil, err
+	}
+	hostnames, newWarnings := extractVPNUnlimitedHostnames(contents)
+	warnings = append(warnings, newWarnings...)
+
+	knownServers := make(map[string]models.VPNUnlimitedServer)
+	for _, server := range constants.VPNUnlimitedServers() {
+		knownServers[server.Hostname] = server
+	}
+
+	for _, hostname := range hostnames {
+		if err := ctx.Err(); err != nil {
+			return nil, warnings, err
+		}
+		server, ok := knownServers[hostname]
+		if !ok {
+			server, ok = parseVPNUnlimitedHostname(hostname)
+			if !ok {
+				warnings = append(warnings, fmt.Sprintf("cannot find the location of host %q", hostname))
+				continue
+			}
+		}
+		const repetition = 3
+		IPs, err := resolveRepeat(ctx, lookupIP, hostname, repetition)
+		switch {
+		case err != nil:
+			warnings = append(warnings, err.Error())
+			continue
+		case len(IPs) == 0:
+			warnings = append(warnings, fmt.Sprintf("no IP address found for host %q", hostname))
+			continue
+		}
+		server.IPs = IPs
+		servers = append(servers, server)
+	}
+	if len(servers) == 0 {
+		return nil, warnings, fmt.Errorf("no server could be resolved")
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].Hostname < servers[j].Hostname
+	})
+	return servers, warnings, nil
+}
+
+// extractVPNUnlimitedHostnames returns the sorted unique VPN Unlimited
+// hostnames found in the remote lines of the OpenVPN configuration files.
+func extractVPNUnlimitedHostnames(contents map[string][]byte) (hostnames []string, warnings []string) {
+	uniqueHostnames := make(map[string]struct{})
+	for fileName, content := range contents {
+		fileHostnames := extractHostnamesFromRemoteLines(extractRemoteLinesFromOpenvpn(content))
+		if len(fileHostnames) == 0 {
+			warnings = append(warnings, fmt.Sprintf("cannot find any host in %s", fileName))
+			continue
+		}
+		for _, hostname := range fileHostnames {
+			switch {
+			case net.ParseIP(hostname) != nil:
+				warnings = append(warnings, fmt.Sprintf("ignoring IP address host %q in %s", hostname, fileName))
+			case !strings.HasSuffix(hostname, ".vpnunlimitedapp.com"):
+				warnings = append(warnings, fmt.Sprintf("ignoring unknown host %q in %s", hostname, fileName))
+			default:
+				uniqueHostnames[hostname] = struct{}{}
+			}
+		}
+	}
+	for hostname := range uniqueHostnames {
+		hostnames = append(hostnames, hostname)
+	}
+	sort.Strings(hostnames)
+	return hostnames, warnings
+}
+
+// parseVPNUnlimitedHostname returns a server with its country found from the
+// country code prefixing the hostname given, such as us-ny.vpnunlimitedapp.com,
+// for servers not yet known to the program.
+func parseVPNUnlimitedHostname(hostname string) (server models.VPNUnlimitedServer, ok bool) {
+	subdomain := strings.TrimSuffix(hostname, ".vpnunlimitedapp.com")
+	countryCode := strings.Split(subdomain, "-")[0]
+	if countryCode == "uk" { // the United Kingdom servers use uk instead of gb
+		countryCode = "gb"
+	}
+	country, ok := constants.CountryCodes()[countryCode]
+	if !ok {
+		return server, false
+	}
+	return models.VPNUnlimitedServer{
+		Country:     country,
+		CountryCode: countryCode,
+		Hostname:    hostname,
+	}, true
+}
+
+func stringifyVPNUnlimitedServers(servers []models.VPNUnlimitedServer) (s string) {
+	s = "func VPNUnlimitedServers() []models.VPNUnlimitedServer {\n"
+	s += "	return []models.VPNUnlimitedServer{\n"
+	for _, server := range servers {
+		s += "		" + server.String() + ",\n"
+	}
+	s += "	}\n"
+	s += "}"
+	return s
+}
@@ -0,0 +1,70 @@
+package updater
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/network/mock_network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_findVPNUnlimitedServers(t *testing.T) {
+	t.Parallel()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	ctx := context.Background()
+
+	buffer := bytes.NewBuffer(nil)
+	zipWriter := zip.NewWriter(buffer)
+	files := map[string]string{
+		"configs/de.ovpn":     "client\nremote de.vpnunlimitedapp.com 1194\n",
+		"configs/us-ny.ovpn":  "client\nremote us-ny.vpnunlimitedapp.com 1194\nremote us-ny.vpnunlimitedapp.com 443\n",
+		"configs/uk-man.ovpn": "client\nremote uk-man.vpnunlimitedapp.com 1194\n",
+		"configs/xx.ovpn":     "client\nremote xx.vpnunlimitedapp.com 1194\n",
+		"configs/ip.ovpn":     "client\nremote 1.2.3.4 1194\n",
+		"readme.txt":          "not a configuration file",
+	}
+	for name, content := range files {
+		w, err := zipWriter.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zipWriter.Close())
+
+	client := mock_network.NewMockClient(mockCtrl)
+	client.EXPECT().Get(ctx, "https://vpnunlimitedapp.com/downloads/ovpn-configs.zip").
+		Return(buffer.Bytes(), http.StatusOK, nil)
+	lookupIP := func(ctx context.Context, host string) (ips []net.IP, err error) {
+		hostToIPs := map[string][]net.IP{
+			"de.vpnunlimitedapp.com":     {{1, 1, 1, 1}},
+			"us-ny.vpnunlimitedapp.com":  {{2, 2, 2, 2}},
+			"uk-man.vpnunlimitedapp.com": {{3, 3, 3, 3}},
+		}
+		return hostToIPs[host], nil
+	}
+
+	servers, warnings, err := findVPNUnlimitedServers(ctx, client, lookupIP)
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		`ignoring IP address host "1.2.3.4" in ip.ovpn`,
+		`cannot find the location of host "xx.vpnunlimitedapp.com"`,
+	}, warnings)
+	expected := []models.VPNUnlimitedServer{
+		{Country: "Germany", CountryCode: "de", City: "Frankfurt", Hostname: "de.vpnunlimitedapp.com",
+			IPs: []net.IP{{1, 1, 1, 1}}},
+		{Country: "United Kingdom", CountryCode: "gb", Hostname: "uk-man.vpnunlimitedapp.com",
+			IPs: []net.IP{{3, 3, 3, 3}}},
+		{Country: "United States", CountryCode: "us", City: "New York", Hostname: "us-ny.vpnunlimitedapp.com",
+			IPs: []net.IP{{2, 2, 2, 2}}},
+	}
+	assert.Equal(t, expected, servers)
+}
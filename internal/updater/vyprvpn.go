@@ -16,6 +16,8 @@ func (u *updater) updateVyprvpn(ctx context.Context) (err error) {
 	if err != nil {
 		return fmt.Errorf("cannot update Vyprvpn servers: %w", err)
 	}
+	servers, warnings := dedupVyprvpnServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifyVyprvpnServers(servers))
 	}
@@ -55,8 +57,10 @@ func findVyprvpnServers(ctx context.Context, client network.Client, lookupIP loo
 		region := strings.TrimSuffix(fileName, ".ovpn")
 		region = strings.ReplaceAll(region, " - ", " ")
 		server := models.VyprvpnServer{
-			Region: region,
-			IPs:    uniqueSortedIPs(IPs),
+			Region:   region,
+			Hostname: hosts[0],
+			IPs:      uniqueSortedIPs(IPs),
+			Ports:    extractPortsFromOpenvpn(content),
 		}
 		servers = append(servers, server)
 	}
@@ -0,0 +1,68 @@
+package updater
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+)
+
+func (u *updater) updateWeVPN(ctx context.Context) (err error) {
+	servers, warnings, err := findWeVPNServers(ctx, u.lookupIP)
+	u.warn(warnings)
+	if err != nil {
+		return fmt.Errorf("cannot update WeVPN servers: %w", err)
+	}
+	servers, warnings = dedupWeVPNServers(servers)
+	u.warn(warnings)
+	if u.options.Stdout {
+		u.println(stringifyWeVPNServers(servers))
+	}
+	u.servers.WeVPN.Timestamp = u.timeNow().Unix()
+	u.servers.WeVPN.Servers = servers
+	return nil
+}
+
+// findWeVPNServers resolves the IP addresses of the WeVPN servers, which are
+// named after their city, keeping the servers whose hostname resolves.
+func findWeVPNServers(ctx context.Context, lookupIP lookupIPFunc) (
+	servers []models.WeVPNServer, warnings []string, err error) {
+	for _, server := range constants.WeVPNServers() {
+		if err := ctx.Err(); err != nil {
+			return nil, warnings, err
+		}
+		const repetition = 3
+		IPs, err := resolveRepeat(ctx, lookupIP, server.Hostname, repetition)
+		switch {
+		case err != nil:
+			warnings = append(warnings, err.Error())
+			continue
+		case len(IPs) == 0:
+			warning := fmt.Sprintf("no IP address found for host %q", server.Hostname)
+			warnings = append(warnings, warning)
+			continue
+		}
+		server.IPs = IPs
+		servers = append(servers, server)
+	}
+	if len(servers) == 0 {
+		return nil, warnings, fmt.Errorf("no server could be resolved")
+	}
+	sort.Slice(servers, func(i, j int) bool {
+		return servers[i].City < servers[j].City
+	})
+	return servers, warnings, nil
+}
+
+func stringifyWeVPNServers(servers []models.WeVPNServer) (s string) {
+	s = "func WeVPNServers() []models.WeVPNServer {\n"
+	s += "	return []models.WeVPNServer{\n"
+	for _, server := range servers {
+		s += "		" + server.String() + ",\n"
+	}
+	s += "	}\n"
+	s += "}"
+	return s
+}
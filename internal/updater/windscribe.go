@@ -18,6 +18,8 @@ func (u *updater) updateWindscribe(ctx context.Context) (err error) {
 	if err != nil {
 		return fmt.Errorf("cannot update Windscribe servers: %w", err)
 	}
+	servers, warnings := dedupWindscribeServers(servers)
+	u.warn(warnings)
 	if u.options.Stdout {
 		u.println(stringifyWindscribeServers(servers))
 	}
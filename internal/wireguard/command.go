@@ -0,0 +1,61 @@
+package wireguard
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (c *configurator) Version(ctx context.Context) (string, error) {
+	output, err := c.commander.Run(ctx, "wg", "--version")
+	if err != nil {
+		return "", err
+	}
+	words := strings.Fields(output)
+	const minWords = 2
+	if len(words) < minWords {
+		return "", fmt.Errorf("wg --version: output is too short: %q", output)
+	}
+	return strings.TrimPrefix(words[1], "v"), nil
+}
+
+func (c *configurator) LatestHandshake(ctx context.Context, name string) (handshake time.Time, err error) {
+	output, err := c.commander.Run(ctx, "wg", "show", name, "latest-handshakes")
+	if err != nil {
+		return handshake, fmt.Errorf("cannot obtain latest handshake: %w: %s", err, output)
+	}
+	handshake, err = parseLatestHandshake(output)
+	if err != nil {
+		return handshake, fmt.Errorf("cannot obtain latest handshake: %w", err)
+	}
+	return handshake, nil
+}
+
+// parseLatestHandshake parses the output of `wg show <interface> latest-handshakes`,
+// which is the public key and Unix time of the latest handshake for each peer,
+// with a time of 0 if there is no handshake yet.
+func parseLatestHandshake(output string) (handshake time.Time, err error) {
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		const expectedFields = 2
+		if len(fields) != expectedFields {
+			return handshake, fmt.Errorf("line is malformed: %q", line)
+		}
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return handshake, fmt.Errorf("handshake time is malformed: %q", line)
+		}
+		if seconds == 0 {
+			continue
+		}
+		if t := time.Unix(seconds, 0); t.After(handshake) {
+			handshake = t
+		}
+	}
+	return handshake, nil
+}
@@ -0,0 +1,53 @@
+package wireguard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseLatestHandshake(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		output    string
+		handshake time.Time
+		err       string
+	}{
+		"empty": {},
+		"no handshake yet": {
+			output: "key=\t0\n",
+		},
+		"handshake": {
+			output:    "key=\t1612345678\n",
+			handshake: time.Unix(1612345678, 0),
+		},
+		"latest of peers": {
+			output:    "a=\t1000\nb=\t2000\n",
+			handshake: time.Unix(2000, 0),
+		},
+		"malformed line": {
+			output: "key=",
+			err:    `line is malformed: "key="`,
+		},
+		"malformed time": {
+			output: "key=\tabc",
+			err:    "handshake time is malformed: \"key=\\tabc\"",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			handshake, err := parseLatestHandshake(testCase.output)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.True(t, testCase.handshake.Equal(handshake))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package wireguard
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/files"
+)
+
+// persistentKeepalive is in seconds, to keep the NAT mappings open.
+const persistentKeepalive = 25
+
+func (c *configurator) WriteConf(privateKey string, connection models.WireguardConnection) error {
+	if err := c.fileManager.CreateDir("/etc/wireguard"); err != nil {
+		return fmt.Errorf("cannot write Wireguard configuration: %w", err)
+	}
+	lines := buildConf(privateKey, connection)
+	err := c.fileManager.WriteLinesToFile(string(constants.WireguardConf), lines,
+		files.Permissions(constants.UserReadPermission))
+	if err != nil {
+		return fmt.Errorf("cannot write Wireguard configuration: %w", err)
+	}
+	return nil
+}
+
+// buildConf returns the lines of the configuration for the wg tool, which
+// does not support the Address and DNS fields of wg-quick configurations.
+func buildConf(privateKey string, connection models.WireguardConnection) (lines []string) {
+	endpoint := net.JoinHostPort(connection.IP.String(), strconv.Itoa(int(connection.Port)))
+	return []string{
+		"[Interface]",
+		"PrivateKey = " + privateKey,
+		"",
+		"[Peer]",
+		"PublicKey = " + connection.PublicKey,
+		"Endpoint = " + endpoint,
+		"AllowedIPs = 0.0.0.0/0, ::/0",
+		"PersistentKeepalive = " + strconv.Itoa(persistentKeepalive),
+	}
+}
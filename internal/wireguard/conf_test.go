@@ -0,0 +1,29 @@
+package wireguard
+
+import (
+	"net"
+	"testing"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_buildConf(t *testing.T) {
+	t.Parallel()
+	connection := models.WireguardConnection{
+		IP:        net.IP{1, 2, 3, 4},
+		Port:      51820,
+		PublicKey: "public",
+	}
+	expected := []string{
+		"[Interface]",
+		"PrivateKey = private",
+		"",
+		"[Peer]",
+		"PublicKey = public",
+		"Endpoint = 1.2.3.4:51820",
+		"AllowedIPs = 0.0.0.0/0, ::/0",
+		"PersistentKeepalive = 25",
+	}
+	assert.Equal(t, expected, buildConf("private", connection))
+}
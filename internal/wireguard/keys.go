@@ -0,0 +1,50 @@
+package wireguard
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+const keyLength = 32
+
+// GenerateKeyPair returns a new Wireguard private key and its
+// public key, both base64 encoded as the wg tool does.
+func GenerateKeyPair() (privateKey, publicKey string, err error) {
+	return generateKeyPair(rand.Reader)
+}
+
+func generateKeyPair(random io.Reader) (privateKey, publicKey string, err error) {
+	private := make([]byte, keyLength)
+	if _, err := io.ReadFull(random, private); err != nil {
+		return "", "", fmt.Errorf("cannot generate private key: %w", err)
+	}
+	// clamp the private key as specified for Curve25519
+	private[0] &= 248
+	private[31] = (private[31] & 127) | 64
+	privateKey = base64.StdEncoding.EncodeToString(private)
+	publicKey, err = PublicKey(privateKey)
+	if err != nil {
+		return "", "", err
+	}
+	return privateKey, publicKey, nil
+}
+
+// PublicKey returns the base64 encoded public key of the base64
+// encoded Wireguard private key given.
+func PublicKey(privateKey string) (publicKey string, err error) {
+	private, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil {
+		return "", fmt.Errorf("cannot decode private key: %w", err)
+	} else if len(private) != keyLength {
+		return "", fmt.Errorf("private key is %d bytes long instead of %d", len(private), keyLength)
+	}
+	public, err := curve25519.X25519(private, curve25519.Basepoint)
+	if err != nil {
+		return "", fmt.Errorf("cannot derive public key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(public), nil
+}
@@ -0,0 +1,70 @@
+package wireguard
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PublicKey(t *testing.T) {
+	t.Parallel()
+	// test vector from RFC 7748 section 6.1
+	private, err := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	require.NoError(t, err)
+	public, err := hex.DecodeString("8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a")
+	require.NoError(t, err)
+	testCases := map[string]struct {
+		privateKey string
+		publicKey  string
+		err        string
+	}{
+		"bad base64": {
+			privateKey: "%",
+			err:        "cannot decode private key: illegal base64 data at input byte 0",
+		},
+		"bad length": {
+			privateKey: "AAAA",
+			err:        "private key is 3 bytes long instead of 32",
+		},
+		"valid key": {
+			privateKey: base64.StdEncoding.EncodeToString(private),
+			publicKey:  base64.StdEncoding.EncodeToString(public),
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			publicKey, err := PublicKey(testCase.privateKey)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.publicKey, publicKey)
+		})
+	}
+}
+
+func Test_generateKeyPair(t *testing.T) {
+	t.Parallel()
+	random := bytes.NewReader(bytes.Repeat([]byte{0xff}, keyLength))
+	privateKey, publicKey, err := generateKeyPair(random)
+	require.NoError(t, err)
+	private, err := base64.StdEncoding.DecodeString(privateKey)
+	require.NoError(t, err)
+	assert.Equal(t, byte(0xf8), private[0])
+	assert.Equal(t, byte(0x7f), private[31])
+	expectedPublicKey, err := PublicKey(privateKey)
+	require.NoError(t, err)
+	assert.Equal(t, expectedPublicKey, publicKey)
+
+	_, _, err = generateKeyPair(bytes.NewReader(nil))
+	require.Error(t, err)
+	assert.Equal(t, "cannot generate private key: EOF", err.Error())
+}
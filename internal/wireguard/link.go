@@ -0,0 +1,60 @@
+package wireguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/vishvananda/netlink"
+)
+
+// mtu is the MTU used by wg-quick, leaving room for the
+// Wireguard overhead over IPv6.
+const mtu = 1420
+
+func (c *configurator) Up(ctx context.Context, name string, addresses []net.IPNet) error {
+	c.logger.Info("creating interface %s", name)
+	link := &netlink.GenericLink{
+		LinkAttrs: netlink.LinkAttrs{Name: name, MTU: mtu},
+		LinkType:  "wireguard",
+	}
+	if err := netlink.LinkAdd(link); err != nil {
+		return fmt.Errorf("cannot create Wireguard interface %s: %w, "+
+			"the Wireguard kernel module might not be loaded on the host", name, err)
+	}
+
+	output, err := c.commander.Run(ctx, "wg", "setconf", name, string(constants.WireguardConf))
+	if err != nil {
+		return fmt.Errorf("cannot configure Wireguard interface %s: %w: %s", name, err, output)
+	}
+
+	for _, address := range addresses {
+		address := address
+		if err := netlink.AddrAdd(link, &netlink.Addr{IPNet: &address}); err != nil {
+			return fmt.Errorf("cannot add address %s to Wireguard interface %s: %w", address.String(), name, err)
+		}
+	}
+
+	if err := netlink.LinkSetUp(link); err != nil {
+		return fmt.Errorf("cannot set Wireguard interface %s up: %w", name, err)
+	}
+	return nil
+}
+
+func (c *configurator) Down(name string) error {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		var notFoundErr netlink.LinkNotFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("cannot delete Wireguard interface %s: %w", name, err)
+	}
+	c.logger.Info("deleting interface %s", name)
+	if err := netlink.LinkDel(link); err != nil {
+		return fmt.Errorf("cannot delete Wireguard interface %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,393 @@
+package wireguard
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/constants"
+	"github.com/qdm12/gluetun/internal/firewall"
+	"github.com/qdm12/gluetun/internal/loopstate"
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/gluetun/internal/openvpn"
+	"github.com/qdm12/gluetun/internal/provider"
+	"github.com/qdm12/gluetun/internal/routing"
+	"github.com/qdm12/gluetun/internal/settings"
+	"github.com/qdm12/golibs/logging"
+)
+
+const (
+	initialRetryWait = 5 * time.Second
+	maxRetryWait     = 5 * time.Minute
+	// handshakeCheckPeriod is the period to check the latest handshake,
+	// to detect when the tunnel is up and when it is down.
+	handshakeCheckPeriod = 5 * time.Second
+	// handshakeTimeout is the time to wait for the first handshake.
+	handshakeTimeout = 30 * time.Second
+	// staleHandshake is the age after which a handshake is stale, since
+	// handshakes are renewed every 2 minutes while the peer is reachable.
+	staleHandshake = 3 * time.Minute
+)
+
+type Looper interface {
+	Run(ctx context.Context, wg *sync.WaitGroup)
+	Restart()
+	Start()
+	Stop()
+	// GetConnectionStatus returns the connection status, using the
+	// OpenVPN connection status for the health checks and metrics.
+	GetConnectionStatus() (status openvpn.ConnectionStatus)
+	GetSettings() (settings settings.Wireguard)
+	SetSettings(settings settings.Wireguard)
+	SetAllServers(allServers models.AllServers)
+	GetAllServers() (allServers models.AllServers)
+}
+
+type looper struct {
+	// Variable parameters
+	settings        settings.Wireguard
+	settingsMutex   sync.RWMutex
+	allServers      models.AllServers
+	allServersMutex sync.RWMutex
+	status          openvpn.ConnectionStatus
+	statusMutex     sync.RWMutex
+	// Configurators
+	conf    Configurator
+	fw      firewall.Configurator
+	routing routing.Routing
+	// Other objects
+	logger            logging.Logger
+	state             loopstate.Reporter
+	signalTunnelReady func()
+	cancel            context.CancelFunc
+	timeNow           func() time.Time
+	// piaToken is the PIA token kept across connections, since the
+	// PIA website may not be resolvable once the tunnel is down.
+	piaToken string
+	// Internal channels
+	restart chan struct{}
+	start   chan struct{}
+	stop    chan struct{}
+}
+
+func NewLooper(settings settings.Wireguard, allServers models.AllServers,
+	conf Configurator, fw firewall.Configurator, routing routing.Routing,
+	logger logging.Logger, state loopstate.Reporter, signalTunnelReady func(),
+	cancel context.CancelFunc) Looper {
+	return &looper{
+		settings:          settings,
+		allServers:        allServers,
+		conf:              conf,
+		fw:                fw,
+		routing:           routing,
+		logger:            logger.WithPrefix("wireguard: "),
+		state:             state,
+		signalTunnelReady: signalTunnelReady,
+		cancel:            cancel,
+		timeNow:           time.Now,
+		restart:           make(chan struct{}),
+		start:             make(chan struct{}),
+		stop:              make(chan struct{}),
+		status: openvpn.ConnectionStatus{
+			State:    openvpn.StateDisconnected,
+			Since:    time.Now(),
+			Provider: settings.Provider.Name,
+		},
+	}
+}
+
+func (l *looper) Restart() { l.restart <- struct{}{} }
+func (l *looper) Start()   { l.start <- struct{}{} }
+func (l *looper) Stop()    { l.stop <- struct{}{} }
+
+func (l *looper) GetConnectionStatus() (status openvpn.ConnectionStatus) {
+	l.statusMutex.RLock()
+	defer l.statusMutex.RUnlock()
+	return l.status
+}
+
+func (l *looper) setState(state openvpn.ConnectionState) {
+	l.statusMutex.Lock()
+	defer l.statusMutex.Unlock()
+	l.state.SetState(loopState(state))
+	if l.status.State == state {
+		return
+	}
+	l.status.State = state
+	l.status.Since = l.timeNow()
+}
+
+// loopState returns the looper state for the connection state given.
+func loopState(state openvpn.ConnectionState) loopstate.State {
+	switch state {
+	case openvpn.StateConnecting, openvpn.StateReconnecting:
+		return loopstate.Starting
+	case openvpn.StateConnected:
+		return loopstate.Running
+	default:
+		return loopstate.Stopped
+	}
+}
+
+func (l *looper) setServer(provider models.VPNProvider, connection models.WireguardConnection) {
+	l.statusMutex.Lock()
+	defer l.statusMutex.Unlock()
+	endpoint := connection.Endpoint()
+	l.status.Provider = provider
+	l.status.Server = &endpoint
+}
+
+func (l *looper) GetSettings() (settings settings.Wireguard) {
+	l.settingsMutex.RLock()
+	defer l.settingsMutex.RUnlock()
+	return l.settings
+}
+
+func (l *looper) SetSettings(settings settings.Wireguard) {
+	l.settingsMutex.Lock()
+	defer l.settingsMutex.Unlock()
+	l.settings = settings
+}
+
+func (l *looper) GetAllServers() (allServers models.AllServers) {
+	l.allServersMutex.RLock()
+	defer l.allServersMutex.RUnlock()
+	return l.allServers
+}
+
+func (l *looper) SetAllServers(allServers models.AllServers) {
+	l.allServersMutex.Lock()
+	defer l.allServersMutex.Unlock()
+	l.allServers = allServers
+}
+
+// waitForStart blocks until Wireguard is started or restarted, and returns
+// false if the context is canceled.
+func (l *looper) waitForStart(ctx context.Context, stoppedMessage string) (started bool) {
+	for {
+		select {
+		case <-l.stop:
+			l.logger.Info(stoppedMessage)
+			l.setState(openvpn.StateStopped)
+		case <-l.start:
+			return true
+		case <-l.restart:
+			l.logger.Info("restarting")
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+func (l *looper) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if !l.waitForStart(ctx, "not started yet") {
+		return
+	}
+	defer l.logger.Warn("loop exited")
+
+	retryWait := initialRetryWait
+	// the picker is kept across attempts for the round robin pick mode,
+	// and is only re-created if its settings change.
+	var picker provider.Picker
+	var pickMode string
+	var pickSeed int64
+
+	for ctx.Err() == nil {
+		settings := l.GetSettings()
+		selection := settings.Provider.ServerSelection
+		if picker == nil || selection.PickMode != pickMode || selection.PickSeed != pickSeed {
+			picker = provider.NewPicker(selection.PickMode, selection.PickSeed, time.Now)
+			pickMode, pickSeed = selection.PickMode, selection.PickSeed
+		}
+		l.allServersMutex.RLock()
+		providerConf := provider.New(settings.Provider.Name, l.allServers, time.Now, picker)
+		l.allServersMutex.RUnlock()
+		connection, err := providerConf.GetWireguardConnection(selection)
+		if err != nil {
+			l.logger.Error(err)
+			l.state.SetError(err)
+			l.cancel()
+			return
+		}
+
+		l.setServer(settings.Provider.Name, connection)
+		l.setState(openvpn.StateConnecting)
+		if connection.PublicKey == "" { // the key is added to the server when connecting
+			connection, settings.Addresses, err = l.addKey(ctx, settings, connection)
+		}
+		if err == nil {
+			err = l.connect(ctx, settings, connection)
+		}
+		if err != nil {
+			l.disconnect(settings, connection)
+			l.setState(openvpn.StateDisconnected)
+			l.logAndWait(ctx, err, retryWait)
+			retryWait = nextRetryWait(retryWait)
+			continue
+		}
+		startTime := l.timeNow()
+		connected := false
+		ticker := time.NewTicker(handshakeCheckPeriod)
+
+	waitLoop:
+		for {
+			select {
+			case <-ctx.Done():
+				l.logger.Warn("context canceled: exiting loop")
+				ticker.Stop()
+				l.disconnect(settings, connection)
+				l.setState(openvpn.StateDisconnected)
+				return
+			case <-l.restart: // triggered restart
+				l.logger.Info("restarting")
+				ticker.Stop()
+				l.disconnect(settings, connection)
+				l.setState(openvpn.StateDisconnected)
+				retryWait = initialRetryWait
+				break waitLoop
+			case <-l.start:
+				l.logger.Info("already started")
+			case <-l.stop:
+				l.logger.Info("stopping")
+				ticker.Stop()
+				l.disconnect(settings, connection)
+				l.setState(openvpn.StateStopped)
+				if !l.waitForStart(ctx, "already stopped") {
+					return
+				}
+				retryWait = initialRetryWait
+				break waitLoop
+			case <-ticker.C:
+				handshake, err := l.conf.LatestHandshake(ctx, settings.Interface)
+				if err != nil {
+					l.logger.Warn(err)
+					continue
+				}
+				err = checkHandshake(handshake, connected, startTime, l.timeNow())
+				if err == nil && !connected && !handshake.IsZero() {
+					connected = true
+					retryWait = initialRetryWait
+					l.setState(openvpn.StateConnected)
+					l.logger.Info("connected to %s", connection.IP)
+					l.signalTunnelReady()
+				}
+				if err == nil {
+					continue
+				}
+				ticker.Stop()
+				l.disconnect(settings, connection)
+				l.setState(openvpn.StateDisconnected)
+				l.logAndWait(ctx, err, retryWait)
+				retryWait = nextRetryWait(retryWait)
+				break waitLoop
+			}
+		}
+	}
+}
+
+// addKey adds the public key of the Wireguard private key to the server of the
+// connection given, for PIA, and returns the connection with the server public key
+// and the addresses of the Wireguard interface assigned to the key.
+func (l *looper) addKey(ctx context.Context, settings settings.Wireguard,
+	connection models.WireguardConnection) (updated models.WireguardConnection, addresses []net.IPNet, err error) {
+	if settings.Provider.Name != constants.PrivateInternetAccess {
+		return connection, nil, fmt.Errorf("no public key found for server %s", connection.IP)
+	}
+	publicKey, err := PublicKey(settings.PrivateKey)
+	if err != nil {
+		return connection, nil, err
+	}
+	if l.piaToken == "" {
+		l.piaToken, err = provider.FetchPIAWireguardToken(ctx, l.fw, settings.User, settings.Password)
+		if err != nil {
+			return connection, nil, err
+		}
+	}
+	updated, address, err := provider.AddPIAWireguardKey(ctx, l.fw, l.piaToken, publicKey, connection)
+	if err != nil {
+		l.piaToken = "" // the token may have expired
+		return connection, nil, err
+	}
+	return updated, []net.IPNet{address}, nil
+}
+
+// connect writes the Wireguard configuration, allows the connection to the
+// endpoint through the firewall, sets up the interface and routes through it.
+func (l *looper) connect(ctx context.Context, settings settings.Wireguard,
+	connection models.WireguardConnection) (err error) {
+	if err := l.conf.WriteConf(settings.PrivateKey, connection); err != nil {
+		return err
+	}
+	if err := l.fw.SetVPNConnection(ctx, connection.Endpoint()); err != nil {
+		return err
+	}
+	// the interface is left if the program exited unexpectedly
+	if err := l.conf.Down(settings.Interface); err != nil {
+		return err
+	}
+	if err := l.conf.Up(ctx, settings.Interface, settings.Addresses); err != nil {
+		return err
+	}
+	return l.routing.SetWireguardRoutes(connection.IP, hasIPv6(settings.Addresses))
+}
+
+// hasIPv6 returns true if one of the addresses given is an IPv6 address.
+func hasIPv6(addresses []net.IPNet) bool {
+	for _, address := range addresses {
+		if address.IP.To4() == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// disconnect deletes the Wireguard interface and the route to the endpoint.
+func (l *looper) disconnect(settings settings.Wireguard, connection models.WireguardConnection) {
+	if err := l.conf.Down(settings.Interface); err != nil {
+		l.logger.Error(err)
+	}
+	if err := l.routing.RemoveWireguardRoutes(connection.IP); err != nil {
+		l.logger.Debug(err)
+	}
+}
+
+// checkHandshake returns an error if there is no handshake yet after the
+// handshake timeout since the start time, or if the connected tunnel has
+// a stale latest handshake.
+func checkHandshake(handshake time.Time, connected bool, startTime, now time.Time) (err error) {
+	switch {
+	case !connected && handshake.IsZero() && now.Sub(startTime) > handshakeTimeout:
+		return fmt.Errorf("no handshake with the server after %s", handshakeTimeout)
+	case connected && now.Sub(handshake) > staleHandshake:
+		return fmt.Errorf("latest handshake with the server is %s old, reconnecting",
+			now.Sub(handshake).Round(time.Second))
+	default:
+		return nil
+	}
+}
+
+func nextRetryWait(wait time.Duration) time.Duration {
+	wait *= 2
+	if wait > maxRetryWait {
+		return maxRetryWait
+	}
+	return wait
+}
+
+func (l *looper) logAndWait(ctx context.Context, err error, waitTime time.Duration) {
+	l.logger.Error(err)
+	l.state.SetError(err)
+	l.logger.Info("retrying in %s", waitTime)
+	timer := time.NewTimer(waitTime)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		if !timer.Stop() {
+			<-timer.C
+		}
+	}
+}
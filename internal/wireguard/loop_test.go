@@ -0,0 +1,91 @@
+package wireguard
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkHandshake(t *testing.T) {
+	t.Parallel()
+	start := time.Unix(1000, 0)
+	testCases := map[string]struct {
+		handshake time.Time
+		connected bool
+		now       time.Time
+		err       string
+	}{
+		"waiting for first handshake": {
+			now: start.Add(time.Second),
+		},
+		"first handshake timeout": {
+			now: start.Add(time.Minute),
+			err: "no handshake with the server after 30s",
+		},
+		"first handshake": {
+			handshake: start.Add(time.Second),
+			now:       start.Add(time.Second),
+		},
+		"recent handshake": {
+			handshake: start.Add(time.Minute),
+			connected: true,
+			now:       start.Add(2 * time.Minute),
+		},
+		"stale handshake": {
+			handshake: start,
+			connected: true,
+			now:       start.Add(4 * time.Minute),
+			err:       "latest handshake with the server is 4m0s old, reconnecting",
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := checkHandshake(testCase.handshake, testCase.connected, start, testCase.now)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_nextRetryWait(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, 10*time.Second, nextRetryWait(5*time.Second))
+	assert.Equal(t, 5*time.Minute, nextRetryWait(4*time.Minute))
+}
+
+func Test_hasIPv6(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		addresses []net.IPNet
+		ipv6      bool
+	}{
+		"no address": {},
+		"IPv4 only": {
+			addresses: []net.IPNet{{IP: net.IP{10, 64, 222, 21}, Mask: net.CIDRMask(32, 32)}},
+		},
+		"IPv4 and IPv6": {
+			addresses: []net.IPNet{
+				{IP: net.IP{10, 64, 222, 21}, Mask: net.CIDRMask(32, 32)},
+				{IP: net.ParseIP("fc00:bbbb:bbbb:bb01::1"), Mask: net.CIDRMask(128, 128)},
+			},
+			ipv6: true,
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ipv6 := hasIPv6(testCase.addresses)
+			assert.Equal(t, testCase.ipv6, ipv6)
+		})
+	}
+}
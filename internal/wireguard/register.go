@@ -0,0 +1,53 @@
+package wireguard
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/qdm12/golibs/network"
+)
+
+// RegisterMullvadKey uploads the Wireguard public key given to the Mullvad
+// account given, and returns the tunnel addresses assigned to the key.
+func RegisterMullvadKey(ctx context.Context, client network.Client, account, publicKey string) (
+	addresses []string, err error) {
+	const apiURL = "https://api.mullvad.net/wg/"
+	form := url.Values{
+		"account": []string{account},
+		"pubkey":  []string{publicKey},
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	content, status, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("cannot register key with Mullvad: %w", err)
+	}
+	body := strings.TrimSpace(string(content))
+	if status != http.StatusOK && status != http.StatusCreated {
+		return nil, fmt.Errorf("cannot register key with Mullvad: HTTP status code %d: %s", status, body)
+	}
+	return parseMullvadAddresses(body)
+}
+
+// parseMullvadAddresses parses the comma separated addresses returned
+// by the Mullvad API, for example 10.64.1.2/32,fc00:bbbb:bbbb:bb01::1:2/128.
+func parseMullvadAddresses(body string) (addresses []string, err error) {
+	if body == "" {
+		return nil, fmt.Errorf("no address returned by Mullvad")
+	}
+	for _, address := range strings.Split(body, ",") {
+		address = strings.TrimSpace(address)
+		if _, _, err := net.ParseCIDR(address); err != nil {
+			return nil, fmt.Errorf("address %q returned by Mullvad is not valid: %w", address, err)
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
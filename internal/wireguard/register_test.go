@@ -0,0 +1,43 @@
+package wireguard
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseMullvadAddresses(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		body      string
+		addresses []string
+		err       string
+	}{
+		"empty body": {
+			err: "no address returned by Mullvad",
+		},
+		"bad address": {
+			body: "10.64.1.2",
+			err:  `address "10.64.1.2" returned by Mullvad is not valid: invalid CIDR address: 10.64.1.2`,
+		},
+		"addresses": {
+			body:      "10.64.1.2/32, fc00:bbbb:bbbb:bb01::1:2/128",
+			addresses: []string{"10.64.1.2/32", "fc00:bbbb:bbbb:bb01::1:2/128"},
+		},
+	}
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			addresses, err := parseMullvadAddresses(testCase.body)
+			if testCase.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, testCase.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.addresses, addresses)
+		})
+	}
+}
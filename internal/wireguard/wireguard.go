@@ -0,0 +1,41 @@
+package wireguard
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/qdm12/gluetun/internal/models"
+	"github.com/qdm12/golibs/command"
+	"github.com/qdm12/golibs/files"
+	"github.com/qdm12/golibs/logging"
+)
+
+// Configurator sets up the Wireguard interface using the kernel
+// Wireguard module and the wg tool.
+type Configurator interface {
+	Version(ctx context.Context) (string, error)
+	WriteConf(privateKey string, connection models.WireguardConnection) error
+	// Up creates the Wireguard interface with the addresses given, configures
+	// it with the configuration file written and sets it up.
+	Up(ctx context.Context, name string, addresses []net.IPNet) error
+	// Down deletes the Wireguard interface, which removes its routes.
+	Down(name string) error
+	// LatestHandshake returns the time of the latest handshake with the
+	// peer of the interface, which is the zero time if there is none yet.
+	LatestHandshake(ctx context.Context, name string) (handshake time.Time, err error)
+}
+
+type configurator struct {
+	fileManager files.FileManager
+	logger      logging.Logger
+	commander   command.Commander
+}
+
+func NewConfigurator(logger logging.Logger, fileManager files.FileManager) Configurator {
+	return &configurator{
+		fileManager: fileManager,
+		logger:      logger.WithPrefix("wireguard configurator: "),
+		commander:   command.NewCommander(),
+	}
+}